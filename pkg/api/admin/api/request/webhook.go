@@ -0,0 +1,15 @@
+package request
+
+// WebhookRequest is the request struct for the CreateWebhook and UpdateWebhook endpoints.
+type WebhookRequest struct {
+	URL         string   `json:"url"`
+	Secret      string   `json:"secret"`
+	Events      []string `json:"events"`
+	Description string   `json:"description"`
+	Active      *bool    `json:"active"`
+}
+
+// ListWebhookDeliveriesRequest is the request struct for the ListWebhookDeliveries endpoint.
+type ListWebhookDeliveriesRequest struct {
+	MaxResults int `query:"max_results"`
+}
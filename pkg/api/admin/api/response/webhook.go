@@ -0,0 +1,96 @@
+package response
+
+import (
+	"time"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// Webhook is the response struct for the GetWebhook and UpdateWebhook endpoints.
+type Webhook struct {
+	ID          uint      `json:"id"`
+	URL         string    `json:"url"`
+	Events      []string  `json:"events"`
+	Description string    `json:"description"`
+	Active      bool      `json:"active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreateWebhook is the response struct for the CreateWebhook endpoint. The secret is returned only once.
+type CreateWebhook struct {
+	Webhook
+	Secret string `json:"secret"`
+}
+
+// ListWebhooks is the response struct for the ListWebhooks endpoint (slice of Webhook).
+type ListWebhooks []Webhook
+
+// WebhookDelivery is the single entry of ListWebhookDeliveries response.
+type WebhookDelivery struct {
+	ID           string    `json:"id"`
+	Event        string    `json:"event"`
+	Payload      string    `json:"payload"`
+	Status       string    `json:"status"`
+	Attempts     int       `json:"attempts"`
+	ResponseCode int       `json:"response_code"`
+	Error        string    `json:"error"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// ListWebhookDeliveries is the response struct for the ListWebhookDeliveries endpoint.
+type ListWebhookDeliveries []WebhookDelivery
+
+// NewWebhookResponse creates new instance of Webhook.
+func NewWebhookResponse(webhook *models.Webhook) *Webhook {
+	events := make([]string, 0)
+	for _, event := range webhook.GetEvents() {
+		events = append(events, string(event))
+	}
+	return &Webhook{
+		ID:          webhook.ID,
+		URL:         webhook.URL,
+		Events:      events,
+		Description: webhook.Description,
+		Active:      webhook.Active,
+		CreatedAt:   webhook.CreatedAt,
+		UpdatedAt:   webhook.UpdatedAt,
+	}
+}
+
+// NewCreateWebhookResponse creates new instance of CreateWebhook.
+func NewCreateWebhookResponse(webhook *models.Webhook) *CreateWebhook {
+	return &CreateWebhook{
+		Webhook: *NewWebhookResponse(webhook),
+		Secret:  webhook.Secret,
+	}
+}
+
+// NewListWebhooksResponse creates new instance of ListWebhooks.
+func NewListWebhooksResponse(webhooks []models.Webhook) *ListWebhooks {
+	response := ListWebhooks(make([]Webhook, len(webhooks)))
+	for i := range webhooks {
+		response[i] = *NewWebhookResponse(&webhooks[i])
+	}
+	return &response
+}
+
+// NewListWebhookDeliveriesResponse creates new instance of ListWebhookDeliveries.
+func NewListWebhookDeliveriesResponse(deliveries []models.WebhookDelivery) *ListWebhookDeliveries {
+	response := ListWebhookDeliveries(make([]WebhookDelivery, len(deliveries)))
+	for i, delivery := range deliveries {
+		response[i] = WebhookDelivery{
+			ID:           delivery.ID,
+			Event:        string(delivery.Event),
+			Payload:      delivery.Payload,
+			Status:       string(delivery.Status),
+			Attempts:     delivery.Attempts,
+			ResponseCode: delivery.ResponseCode,
+			Error:        delivery.Error,
+			CreatedAt:    delivery.CreatedAt,
+			UpdatedAt:    delivery.UpdatedAt,
+		}
+	}
+	return &response
+}
@@ -1,15 +1,25 @@
 package controller
 
-import "github.com/G-Research/fasttrackml/pkg/api/admin/service/namespace"
+import (
+	"github.com/G-Research/fasttrackml/pkg/api/admin/service/namespace"
+	"github.com/G-Research/fasttrackml/pkg/api/admin/service/webhook"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/model"
+)
 
 // Controller contains all the request handler functions for the admin api.
 type Controller struct {
 	namespaceService *namespace.Service
+	webhookService   *webhook.Service
+	modelService     *model.Service
 }
 
 // NewController creates new Controller instance.
-func NewController(namespaceService *namespace.Service) *Controller {
+func NewController(
+	namespaceService *namespace.Service, webhookService *webhook.Service, modelService *model.Service,
+) *Controller {
 	return &Controller{
 		namespaceService: namespaceService,
+		webhookService:   webhookService,
+		modelService:     modelService,
 	}
 }
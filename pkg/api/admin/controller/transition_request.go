@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+)
+
+// ApproveTransitionRequest handles `POST /transition-requests/approve` endpoint.
+func (c Controller) ApproveTransitionRequest(ctx *fiber.Ctx) error {
+	var req request.ApproveTransitionRequestRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("approveTransitionRequest request: %#v", req)
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("approveTransitionRequest namespace: %s", ns.Code)
+	transitionRequest, err := c.modelService.ApproveTransitionRequest(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewTransitionRequestResponse(transitionRequest)
+	log.Debugf("approveTransitionRequest response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// RejectTransitionRequest handles `POST /transition-requests/reject` endpoint.
+func (c Controller) RejectTransitionRequest(ctx *fiber.Ctx) error {
+	var req request.RejectTransitionRequestRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("rejectTransitionRequest request: %#v", req)
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("rejectTransitionRequest namespace: %s", ns.Code)
+	transitionRequest, err := c.modelService.RejectTransitionRequest(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewTransitionRequestResponse(transitionRequest)
+	log.Debugf("rejectTransitionRequest response: %#v", resp)
+	return ctx.JSON(resp)
+}
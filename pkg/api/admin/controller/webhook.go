@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/admin/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/admin/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+)
+
+// ListWebhooks handles `GET /webhooks/list` endpoint.
+func (c Controller) ListWebhooks(ctx *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("listWebhooks namespace: %s", ns.Code)
+
+	webhooks, err := c.webhookService.ListWebhooks(ctx.Context(), ns)
+	if err != nil {
+		return err
+	}
+	resp := response.NewListWebhooksResponse(webhooks)
+	log.Debugf("listWebhooks response: %#v", resp)
+
+	return ctx.JSON(resp)
+}
+
+// GetWebhook handles `GET /webhooks/:id` endpoint.
+func (c Controller) GetWebhook(ctx *fiber.Ctx) error {
+	id, err := getWebhookID(ctx)
+	if err != nil {
+		return err
+	}
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getWebhook namespace: %s", ns.Code)
+
+	webhook, err := c.webhookService.GetWebhook(ctx.Context(), ns, id)
+	if err != nil {
+		return err
+	}
+	resp := response.NewWebhookResponse(webhook)
+	log.Debugf("getWebhook response: %#v", resp)
+
+	return ctx.JSON(resp)
+}
+
+// CreateWebhook handles `POST /webhooks/create` endpoint.
+func (c Controller) CreateWebhook(ctx *fiber.Ctx) error {
+	var req request.WebhookRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("createWebhook request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("createWebhook namespace: %s", ns.Code)
+
+	webhook, err := c.webhookService.CreateWebhook(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(response.NewCreateWebhookResponse(webhook))
+}
+
+// UpdateWebhook handles `PUT /webhooks/:id` endpoint.
+func (c Controller) UpdateWebhook(ctx *fiber.Ctx) error {
+	id, err := getWebhookID(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req request.WebhookRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("updateWebhook request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("updateWebhook namespace: %s", ns.Code)
+
+	webhook, err := c.webhookService.UpdateWebhook(ctx.Context(), ns, id, &req)
+	if err != nil {
+		return err
+	}
+	resp := response.NewWebhookResponse(webhook)
+	log.Debugf("updateWebhook response: %#v", resp)
+
+	return ctx.JSON(resp)
+}
+
+// DeleteWebhook handles `DELETE /webhooks/:id` endpoint.
+func (c Controller) DeleteWebhook(ctx *fiber.Ctx) error {
+	id, err := getWebhookID(ctx)
+	if err != nil {
+		return err
+	}
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("deleteWebhook namespace: %s", ns.Code)
+
+	if err := c.webhookService.DeleteWebhook(ctx.Context(), ns, id); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// ListWebhookDeliveries handles `GET /webhooks/:id/deliveries` endpoint.
+func (c Controller) ListWebhookDeliveries(ctx *fiber.Ctx) error {
+	id, err := getWebhookID(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req request.ListWebhookDeliveriesRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request query: %s", err)
+	}
+	log.Debugf("listWebhookDeliveries request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("listWebhookDeliveries namespace: %s", ns.Code)
+
+	deliveries, err := c.webhookService.ListWebhookDeliveries(ctx.Context(), ns, id, &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(response.NewListWebhookDeliveriesResponse(deliveries))
+}
+
+// getWebhookID returns webhook id from the request path.
+func getWebhookID(ctx *fiber.Ctx) (uint, error) {
+	id, err := ctx.ParamsInt("id")
+	if err != nil || id <= 0 {
+		return 0, api.NewBadRequestError("Unable to parse webhook id '%s'", ctx.Params("id"))
+	}
+	return uint(id), nil
+}
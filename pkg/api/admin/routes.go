@@ -24,4 +24,14 @@ func (r Router) Init(fr fiber.Router) {
 	namespaces := mainGroup.Group("namespaces")
 	namespaces.Get("/list", r.controller.ListNamespaces)
 	namespaces.Get("/current", r.controller.GetCurrentNamespace)
+	webhooks := mainGroup.Group("webhooks")
+	webhooks.Get("/list", r.controller.ListWebhooks)
+	webhooks.Post("/create", r.controller.CreateWebhook)
+	webhooks.Get("/:id<int>", r.controller.GetWebhook)
+	webhooks.Put("/:id<int>", r.controller.UpdateWebhook)
+	webhooks.Delete("/:id<int>", r.controller.DeleteWebhook)
+	webhooks.Get("/:id<int>/deliveries", r.controller.ListWebhookDeliveries)
+	transitionRequests := mainGroup.Group("transition-requests")
+	transitionRequests.Post("/approve", r.controller.ApproveTransitionRequest)
+	transitionRequests.Post("/reject", r.controller.RejectTransitionRequest)
 }
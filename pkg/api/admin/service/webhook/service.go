@@ -0,0 +1,158 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/G-Research/fasttrackml/pkg/api/admin/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+// DefaultDeliveriesResults is the number of deliveries, which are returned when no limit was requested.
+const DefaultDeliveriesResults = 100
+
+// Service provides service layer to work with `webhook` business logic.
+type Service struct {
+	webhookRepository repositories.WebhookRepositoryProvider
+}
+
+// NewService creates new Service instance.
+func NewService(webhookRepository repositories.WebhookRepositoryProvider) *Service {
+	return &Service{
+		webhookRepository: webhookRepository,
+	}
+}
+
+// ListWebhooks returns all webhooks of the namespace.
+func (s Service) ListWebhooks(ctx context.Context, namespace *models.Namespace) ([]models.Webhook, error) {
+	webhooks, err := s.webhookRepository.ListByNamespaceID(ctx, namespace.ID)
+	if err != nil {
+		return nil, api.NewInternalError("unable to list webhooks: %s", err)
+	}
+	return webhooks, nil
+}
+
+// GetWebhook returns one webhook of the namespace by its ID.
+func (s Service) GetWebhook(ctx context.Context, namespace *models.Namespace, id uint) (*models.Webhook, error) {
+	webhook, err := s.webhookRepository.GetByNamespaceIDAndID(ctx, namespace.ID, id)
+	if err != nil {
+		return nil, api.NewInternalError("unable to find webhook '%d': %s", id, err)
+	}
+	if webhook == nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find webhook '%d'", id)
+	}
+	return webhook, nil
+}
+
+// CreateWebhook creates new webhook in the namespace. The secret is generated, when it wasn't provided.
+func (s Service) CreateWebhook(
+	ctx context.Context, namespace *models.Namespace, req *request.WebhookRequest,
+) (*models.Webhook, error) {
+	if err := ValidateWebhookRequest(req); err != nil {
+		return nil, err
+	}
+
+	webhook := models.Webhook{
+		NamespaceID: namespace.ID,
+		Secret:      req.Secret,
+		Active:      true,
+	}
+	if webhook.Secret == "" {
+		secret, err := generateSecret()
+		if err != nil {
+			return nil, api.NewInternalError("unable to generate webhook secret: %s", err)
+		}
+		webhook.Secret = secret
+	}
+	applyWebhookRequest(&webhook, req)
+
+	if err := s.webhookRepository.Create(ctx, &webhook); err != nil {
+		return nil, api.NewInternalError("unable to create webhook: %s", err)
+	}
+	return &webhook, nil
+}
+
+// UpdateWebhook updates existing webhook of the namespace. The secret is kept, when it wasn't provided.
+func (s Service) UpdateWebhook(
+	ctx context.Context, namespace *models.Namespace, id uint, req *request.WebhookRequest,
+) (*models.Webhook, error) {
+	if err := ValidateWebhookRequest(req); err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.GetWebhook(ctx, namespace, id)
+	if err != nil {
+		return nil, err
+	}
+	if req.Secret != "" {
+		webhook.Secret = req.Secret
+	}
+	applyWebhookRequest(webhook, req)
+
+	if err := s.webhookRepository.Update(ctx, webhook); err != nil {
+		return nil, api.NewInternalError("unable to update webhook '%d': %s", id, err)
+	}
+	return webhook, nil
+}
+
+// DeleteWebhook deletes existing webhook of the namespace together with its delivery log.
+func (s Service) DeleteWebhook(ctx context.Context, namespace *models.Namespace, id uint) error {
+	webhook, err := s.GetWebhook(ctx, namespace, id)
+	if err != nil {
+		return err
+	}
+	if err := s.webhookRepository.Delete(ctx, webhook); err != nil {
+		return api.NewInternalError("unable to delete webhook '%d': %s", id, err)
+	}
+	return nil
+}
+
+// ListWebhookDeliveries returns the latest deliveries of the webhook.
+func (s Service) ListWebhookDeliveries(
+	ctx context.Context, namespace *models.Namespace, id uint, req *request.ListWebhookDeliveriesRequest,
+) ([]models.WebhookDelivery, error) {
+	if err := ValidateListWebhookDeliveriesRequest(req); err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.GetWebhook(ctx, namespace, id)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.MaxResults
+	if limit == 0 {
+		limit = DefaultDeliveriesResults
+	}
+	deliveries, err := s.webhookRepository.ListDeliveries(ctx, webhook.ID, limit)
+	if err != nil {
+		return nil, api.NewInternalError("unable to list deliveries of webhook '%d': %s", id, err)
+	}
+	return deliveries, nil
+}
+
+// applyWebhookRequest copies the common fields of the request to the webhook.
+func applyWebhookRequest(webhook *models.Webhook, req *request.WebhookRequest) {
+	webhook.URL = req.URL
+	webhook.Description = req.Description
+	events := make([]models.WebhookEvent, len(req.Events))
+	for i, event := range req.Events {
+		events[i] = models.WebhookEvent(event)
+	}
+	webhook.SetEvents(events)
+	if req.Active != nil {
+		webhook.Active = *req.Active
+	}
+}
+
+// generateSecret generates random secret, which is used to sign the payloads.
+func generateSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/admin/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+func TestService_CreateWebhook_Ok(t *testing.T) {
+	// init repository mocks.
+	webhookRepository := repositories.MockWebhookRepositoryProvider{}
+	webhookRepository.On(
+		"Create", context.TODO(), mock.AnythingOfType("*models.Webhook"),
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(&webhookRepository)
+	webhook, err := service.CreateWebhook(context.TODO(), &models.Namespace{ID: 1}, &request.WebhookRequest{
+		URL:    "http://localhost:8080",
+		Events: []string{"run.finished", "run.failed"},
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, uint(1), webhook.NamespaceID)
+	assert.Equal(t, "run.finished,run.failed", webhook.Events)
+	assert.True(t, webhook.Active)
+	assert.Len(t, webhook.Secret, 64)
+}
+
+func TestService_UpdateWebhook_Ok(t *testing.T) {
+	// init repository mocks.
+	webhookRepository := repositories.MockWebhookRepositoryProvider{}
+	webhookRepository.On("GetByNamespaceIDAndID", context.TODO(), uint(1), uint(2)).Return(&models.Webhook{
+		ID:          2,
+		NamespaceID: 1,
+		URL:         "http://localhost:8080",
+		Secret:      "secret",
+		Events:      "run.finished",
+		Active:      true,
+	}, nil)
+	webhookRepository.On("Update", context.TODO(), &models.Webhook{
+		ID:          2,
+		NamespaceID: 1,
+		URL:         "http://localhost:9090",
+		Secret:      "secret",
+		Events:      "experiment.created",
+		Active:      false,
+	}).Return(nil)
+
+	// call service under testing.
+	service := NewService(&webhookRepository)
+	_, err := service.UpdateWebhook(context.TODO(), &models.Namespace{ID: 1}, 2, &request.WebhookRequest{
+		URL:    "http://localhost:9090",
+		Events: []string{"experiment.created"},
+		Active: common.GetPointer(false),
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	webhookRepository.AssertExpectations(t)
+}
+
+func TestService_DeleteWebhook_Error(t *testing.T) {
+	// init repository mocks.
+	webhookRepository := repositories.MockWebhookRepositoryProvider{}
+	webhookRepository.On("GetByNamespaceIDAndID", context.TODO(), uint(1), uint(2)).Return(nil, nil)
+
+	// call service under testing.
+	service := NewService(&webhookRepository)
+	err := service.DeleteWebhook(context.TODO(), &models.Namespace{ID: 1}, 2)
+
+	// compare results.
+	assert.Equal(t, api.NewResourceDoesNotExistError("unable to find webhook '2'"), err)
+}
@@ -0,0 +1,41 @@
+package webhook
+
+import (
+	"net/url"
+	"slices"
+
+	"github.com/G-Research/fasttrackml/pkg/api/admin/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// MaxDeliveriesResults is the maximum number of deliveries, which can be requested at once.
+const MaxDeliveriesResults = 1000
+
+// ValidateWebhookRequest validates `POST /admin/webhooks/create` and `PUT /admin/webhooks/:id` requests.
+func ValidateWebhookRequest(req *request.WebhookRequest) error {
+	parsed, err := url.Parse(req.URL)
+	if err != nil || !slices.Contains([]string{"http", "https"}, parsed.Scheme) || parsed.Host == "" {
+		return api.NewInvalidParameterValueError("webhook url is invalid -- must be an absolute http(s) url")
+	}
+
+	if len(req.Events) == 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'events'")
+	}
+	for _, event := range req.Events {
+		if !slices.Contains(models.WebhookEvents, models.WebhookEvent(event)) {
+			return api.NewInvalidParameterValueError("Unsupported webhook event '%s'", event)
+		}
+	}
+	return nil
+}
+
+// ValidateListWebhookDeliveriesRequest validates `GET /admin/webhooks/:id/deliveries` request.
+func ValidateListWebhookDeliveriesRequest(req *request.ListWebhookDeliveriesRequest) error {
+	if req.MaxResults < 0 || req.MaxResults > MaxDeliveriesResults {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'max_results' supplied. It must be at most %d", MaxDeliveriesResults,
+		)
+	}
+	return nil
+}
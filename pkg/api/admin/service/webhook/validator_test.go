@@ -0,0 +1,67 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/admin/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+)
+
+func TestValidateWebhookRequest_Ok(t *testing.T) {
+	err := ValidateWebhookRequest(&request.WebhookRequest{
+		URL:    "https://example.com/hooks/fasttrackml",
+		Events: []string{"run.finished", "experiment.deleted"},
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateWebhookRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.WebhookRequest
+	}{
+		{
+			name:    "EmptyURL",
+			error:   api.NewInvalidParameterValueError("webhook url is invalid -- must be an absolute http(s) url"),
+			request: &request.WebhookRequest{Events: []string{"run.finished"}},
+		},
+		{
+			name:  "UnsupportedScheme",
+			error: api.NewInvalidParameterValueError("webhook url is invalid -- must be an absolute http(s) url"),
+			request: &request.WebhookRequest{
+				URL:    "ftp://example.com",
+				Events: []string{"run.finished"},
+			},
+		},
+		{
+			name:    "EmptyEvents",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'events'"),
+			request: &request.WebhookRequest{URL: "http://localhost:8080"},
+		},
+		{
+			name:  "UnsupportedEvent",
+			error: api.NewInvalidParameterValueError("Unsupported webhook event 'run.created'"),
+			request: &request.WebhookRequest{
+				URL:    "http://localhost:8080",
+				Events: []string{"run.finished", "run.created"},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.error, ValidateWebhookRequest(tt.request))
+		})
+	}
+}
+
+func TestValidateListWebhookDeliveriesRequest_Error(t *testing.T) {
+	err := ValidateListWebhookDeliveriesRequest(&request.ListWebhookDeliveriesRequest{MaxResults: 1001})
+	assert.Equal(t, api.NewInvalidParameterValueError(
+		"Invalid value for parameter 'max_results' supplied. It must be at most 1000",
+	), err)
+}
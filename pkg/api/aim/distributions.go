@@ -0,0 +1,132 @@
+package aim
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// GetRunDistributions handles `POST /runs/:id/distributions/get-batch/` endpoint.
+func GetRunDistributions(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getRunDistributions namespace: %s", ns.Code)
+
+	p := struct {
+		ID string `params:"id"`
+	}{}
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	q := request.GetRunSequencesBatchRequest{}
+	if err := c.QueryParser(&q); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	if c.Query("record_density") == "" {
+		q.RecordDensity = defaultRecordDensity
+	}
+
+	var b []request.RunSequenceTraceRequest
+	if err := c.BodyParser(&b); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	run, err := getRun(c, ns.ID, p.ID)
+	if err != nil {
+		return err
+	}
+
+	traces, err := getRunSequenceTraces("distributions", run.ID, false)
+	if err != nil {
+		return err
+	}
+
+	result := []fiber.Map{}
+	for _, trace := range traces {
+		if !isSequenceTraceRequested(trace, b) {
+			continue
+		}
+
+		recordTotal := sequenceRange{Start: trace.MinStep, Stop: trace.MaxStep + 1}
+		recordUsed, err := parseSequenceRange(q.RecordRange, recordTotal)
+		if err != nil {
+			return err
+		}
+
+		distributions, err := getDistributionsTrace(trace, recordUsed, q.RecordDensity)
+		if err != nil {
+			return err
+		}
+		distributions["record_range"] = recordTotal.toList()
+		result = append(result, distributions)
+	}
+
+	return c.JSON(result)
+}
+
+// getDistributionsTrace samples the distributions of the sequence by step within the used record range.
+func getDistributionsTrace(trace sequenceTrace, recordRange sequenceRange, recordDensity int) (fiber.Map, error) {
+	steps, err := sampleSequenceSteps("distributions", trace, recordRange, recordDensity)
+	if err != nil {
+		return nil, err
+	}
+
+	var distributions []database.Distribution
+	if len(steps) > 0 {
+		if err := database.DB.Where(
+			"run_uuid = ? AND name = ? AND context_id = ? AND step IN ?",
+			trace.RunID, trace.Name, trace.ContextID, steps,
+		).Order("step").Find(&distributions).Error; err != nil {
+			return nil, fmt.Errorf("error getting distributions %q: %w", trace.Name, err)
+		}
+	}
+
+	values := make([]fiber.Map, len(distributions))
+	iters := make([]int64, len(distributions))
+	timestamps := make([]float64, len(distributions))
+	for i, distribution := range distributions {
+		binCount := len(distribution.Counts) / 8
+		values[i] = fiber.Map{
+			"data": fiber.Map{
+				"type":  "numpy",
+				"dtype": "float64",
+				"shape": binCount,
+				"blob":  distribution.Counts,
+			},
+			"bin_count": binCount,
+			"range":     getDistributionRange(distribution.BinEdges),
+		}
+		iters[i] = distribution.Step
+		timestamps[i] = float64(distribution.Timestamp) / 1000
+	}
+
+	return fiber.Map{
+		"name":       trace.Name,
+		"context":    trace.Context,
+		"values":     values,
+		"iters":      iters,
+		"timestamps": timestamps,
+	}, nil
+}
+
+// getDistributionRange returns the first and the last of bin edges, which are kept as little-endian float64 values.
+func getDistributionRange(binEdges []byte) []float64 {
+	if len(binEdges) < 16 {
+		return []float64{0, 0}
+	}
+	return []float64{
+		math.Float64frombits(binary.LittleEndian.Uint64(binEdges)),
+		math.Float64frombits(binary.LittleEndian.Uint64(binEdges[len(binEdges)-8:])),
+	}
+}
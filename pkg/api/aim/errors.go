@@ -5,6 +5,8 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
 )
 
 func ErrorHandler(c *fiber.Ctx, err error) error {
@@ -48,3 +50,16 @@ func ErrorHandler(c *fiber.Ctx, err error) error {
 
 	return c.Status(e.Code).JSON(e)
 }
+
+// convertServiceError converts the error returned by the mlflow service into the error of the aim api.
+func convertServiceError(err error) error {
+	var e *api.ErrorResponse
+	if !errors.As(err, &e) {
+		return err
+	}
+	code := e.StatusCode
+	if e.ErrorCode == api.ErrorCodeResourceDoesNotExist {
+		code = fiber.StatusNotFound
+	}
+	return fiber.NewError(code, e.Message)
+}
@@ -0,0 +1,177 @@
+package aim
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// SearchImages handles `GET /runs/search/images/` endpoint.
+func SearchImages(c *fiber.Ctx) error {
+	return searchSequences(c, "images", getImagesTrace)
+}
+
+// getImagesTrace samples the images of the sequence by step and by index within the used ranges.
+func getImagesTrace(
+	trace sequenceTrace, recordRange, indexRange sequenceRange, recordDensity, indexDensity int,
+) (fiber.Map, error) {
+	tx, err := sampleSequenceItems("images", trace, recordRange, indexRange, recordDensity, indexDensity)
+	if err != nil {
+		return nil, err
+	}
+
+	var images []database.Image
+	if tx != nil {
+		if err := tx.Order("step").Order("idx").Find(&images).Error; err != nil {
+			return nil, fmt.Errorf("error getting images %q: %w", trace.Name, err)
+		}
+	}
+
+	values := [][]fiber.Map{}
+	iters := []int64{}
+	timestamps := []float64{}
+	for n, image := range images {
+		if n == 0 || image.Step != images[n-1].Step {
+			values = append(values, []fiber.Map{})
+			iters = append(iters, image.Step)
+			timestamps = append(timestamps, float64(image.Timestamp)/1000)
+		}
+		values[len(values)-1] = append(values[len(values)-1], fiber.Map{
+			"caption":  image.Caption,
+			"width":    image.Width,
+			"height":   image.Height,
+			"format":   image.Format,
+			"blob_uri": imageBlobURI(image.RunID, image.BlobURI),
+			"index":    image.Index,
+		})
+	}
+
+	return fiber.Map{
+		"name":       trace.Name,
+		"context":    trace.Context,
+		"values":     values,
+		"iters":      iters,
+		"timestamps": timestamps,
+	}, nil
+}
+
+// GetImagesBatch streams the content of the requested images, which is kept in the run artifact storage.
+func GetImagesBatch(artifactStorageFactory storage.ArtifactStorageFactoryProvider) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ns, err := namespace.GetNamespaceFromContext(c.Context())
+		if err != nil {
+			return api.NewInternalError("error getting namespace from context")
+		}
+		log.Debugf("getImagesBatch namespace: %s", ns.Code)
+
+		var uris []string
+		if err := c.BodyParser(&uris); err != nil {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+		}
+
+		type blob struct {
+			uri         string
+			path        string
+			artifactURI string
+			storage     storage.ArtifactStorageProvider
+		}
+		blobs := make([]blob, len(uris))
+		for i, uri := range uris {
+			runID, path, ok := parseImageBlobURI(uri)
+			if !ok {
+				return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("invalid image uri %q", uri))
+			}
+
+			run, err := getRun(c, ns.ID, runID)
+			if err != nil {
+				return err
+			}
+
+			// only the blobs of the logged images are served, not any artifact of the run.
+			var count int64
+			if err := database.DB.Model(
+				&database.Image{},
+			).Where(
+				"run_uuid = ? AND blob_uri = ?", run.ID, path,
+			).Count(&count).Error; err != nil {
+				return fiber.NewError(
+					fiber.StatusInternalServerError, fmt.Sprintf("error getting image %q: %s", uri, err),
+				)
+			}
+			if count == 0 {
+				return fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find image %q", uri))
+			}
+
+			artifactStorage, err := artifactStorageFactory.GetStorage(c.Context(), run.ArtifactURI)
+			if err != nil {
+				return fiber.NewError(
+					fiber.StatusInternalServerError,
+					fmt.Sprintf("run with id '%s' has unsupported artifact storage", run.ID),
+				)
+			}
+			blobs[i] = blob{
+				uri:         uri,
+				path:        path,
+				artifactURI: run.ArtifactURI,
+				storage:     artifactStorage,
+			}
+		}
+
+		ctx := c.Context()
+		c.Set("Content-Type", "application/octet-stream")
+		c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+			start := time.Now()
+			if err := func() error {
+				for _, b := range blobs {
+					reader, err := b.storage.Get(ctx, b.artifactURI, b.path)
+					if err != nil {
+						return fmt.Errorf("error getting image %q: %w", b.uri, err)
+					}
+					data, err := io.ReadAll(reader)
+					//nolint:errcheck,gosec
+					reader.Close()
+					if err != nil {
+						return fmt.Errorf("error reading image %q: %w", b.uri, err)
+					}
+					if err := encoding.EncodeTree(w, fiber.Map{
+						b.uri: data,
+					}); err != nil {
+						return err
+					}
+					if err := w.Flush(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				log.Errorf("Error encountered in %s %s: error streaming images: %s", c.Method(), c.Path(), err)
+			}
+
+			log.Infof("body - %s %s %s", time.Since(start), c.Method(), c.Path())
+		})
+
+		return nil
+	}
+}
+
+// imageBlobURI returns the uri, which is used by Aim UI to request the content of the image.
+func imageBlobURI(runID, path string) string {
+	return fmt.Sprintf("%s/%s", runID, path)
+}
+
+// parseImageBlobURI parses the uri of the image into run ID and path of the image in the run artifact storage.
+func parseImageBlobURI(uri string) (string, string, bool) {
+	runID, path, ok := strings.Cut(uri, "/")
+	return runID, path, ok && runID != "" && path != ""
+}
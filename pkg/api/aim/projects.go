@@ -9,7 +9,11 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/rotisserie/eris"
 	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
 	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
 	"github.com/G-Research/fasttrackml/pkg/database"
@@ -84,18 +88,97 @@ func GetProjectActivity(c *fiber.Ctx) error {
 	})
 }
 
-// TODO
 func GetProjectPinnedSequences(c *fiber.Ctx) error {
-	return c.JSON(fiber.Map{
-		"sequences": []string{},
-	})
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getProjectPinnedSequences namespace: %s", ns.Code)
+
+	resp, err := getPinnedSequences(database.DB, ns.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(resp)
 }
 
-// TODO
 func UpdateProjectPinnedSequences(c *fiber.Ctx) error {
-	return c.JSON(fiber.Map{
-		"sequences": []string{},
-	})
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("updateProjectPinnedSequences namespace: %s", ns.Code)
+
+	var req request.UpdateProjectPinnedSequencesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	sequences := make([]database.AimPinnedSequence, len(req.Sequences))
+	for i, s := range req.Sequences {
+		if s.Name == "" {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, "sequence name can't be empty")
+		}
+		if s.Context == nil {
+			s.Context = map[string]any{}
+		}
+		data, err := json.Marshal(s.Context)
+		if err != nil {
+			return fiber.NewError(
+				fiber.StatusUnprocessableEntity, fmt.Sprintf("error parsing context of sequence %q: %s", s.Name, err),
+			)
+		}
+		sequences[i] = database.AimPinnedSequence{
+			Name:        s.Name,
+			Context:     data,
+			NamespaceID: ns.ID,
+		}
+	}
+
+	var resp *response.ProjectPinnedSequencesResponse
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("namespace_id = ?", ns.ID).Delete(&database.AimPinnedSequence{}).Error; err != nil {
+			return fmt.Errorf("error deleting pinned sequences: %w", err)
+		}
+		if len(sequences) > 0 {
+			if err := tx.Omit(clause.Associations).Create(&sequences).Error; err != nil {
+				return fmt.Errorf("error inserting pinned sequences: %w", err)
+			}
+		}
+		resp, err = getPinnedSequences(tx, ns.ID)
+		return err
+	}); err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(resp)
+}
+
+// getPinnedSequences returns the pinned sequences of the namespace in the order they were stored.
+func getPinnedSequences(tx *gorm.DB, namespaceID uint) (*response.ProjectPinnedSequencesResponse, error) {
+	var sequences []database.AimPinnedSequence
+	if err := tx.Where("namespace_id = ?", namespaceID).Order("id").Find(&sequences).Error; err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("error getting pinned sequences: %s", err))
+	}
+
+	resp := response.ProjectPinnedSequencesResponse{
+		Sequences: make([]response.ProjectPinnedSequence, len(sequences)),
+	}
+	for i, s := range sequences {
+		sequenceContext := map[string]any{}
+		if err := json.Unmarshal(s.Context, &sequenceContext); err != nil {
+			return nil, fiber.NewError(
+				fiber.StatusInternalServerError,
+				fmt.Sprintf("error decoding context of pinned sequence %q: %s", s.Name, err),
+			)
+		}
+		resp.Sequences[i] = response.ProjectPinnedSequence{
+			Name:    s.Name,
+			Context: sequenceContext,
+		}
+	}
+	return &resp, nil
 }
 
 func GetProjectParams(c *fiber.Ctx) error {
@@ -180,8 +263,14 @@ func GetProjectParams(c *fiber.Ctx) error {
 
 	for _, s := range q.Sequences {
 		switch s {
-		case "images", "texts", "figures", "distributions", "audios":
+		case "figures", "audios":
 			resp[s] = fiber.Map{}
+		case "images", "texts", "distributions":
+			sequences, err := getProjectSequences(s, ns.ID)
+			if err != nil {
+				return err
+			}
+			resp[s] = sequences
 		case "metric":
 			var metrics []database.LatestMetric
 			if tx := database.DB.Distinct().Model(
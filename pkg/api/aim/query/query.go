@@ -638,6 +638,8 @@ func (pq *parsedQuery) parseName(node *ast.Name) (any, error) {
 					}
 				},
 			), nil
+		case "images", "texts":
+			return pq.parseSequenceName(string(node.Id))
 		case "re":
 			return attributeGetter(
 				func(attr string) (any, error) {
@@ -912,3 +914,48 @@ func negativeClause(expression clause.Expression) clause.Expression {
 		},
 	}
 }
+
+// parseSequenceName returns getter of the attributes of the sequence, like `images`. The sequence is
+// queried through the table, which is provided by QueryParser.Tables under the name of the sequence.
+func (pq *parsedQuery) parseSequenceName(sequence string) (any, error) {
+	table, ok := pq.qp.Tables[sequence]
+	if !ok {
+		return nil, fmt.Errorf("unsupported name identifier '%s'", sequence)
+	}
+	return attributeGetter(
+		func(attr string) (any, error) {
+			switch attr {
+			case "name":
+				return clause.Column{
+					Table: table,
+					Name:  "name",
+				}, nil
+			case "context":
+				return attributeGetter(
+					func(contextKey string) (any, error) {
+						// create the join for contexts
+						alias := fmt.Sprintf("%s_contexts", sequence)
+						if _, ok := pq.joins[alias]; !ok {
+							pq.joins[alias] = join{
+								alias: alias,
+								query: fmt.Sprintf(
+									"LEFT JOIN contexts %s ON %s.context_id = %s.id", alias, table, alias,
+								),
+							}
+						}
+						return Json{
+							Column: clause.Column{
+								Table: alias,
+								Name:  "json",
+							},
+							JsonPath:  contextKey,
+							Dialector: pq.qp.Dialector,
+						}, nil
+					},
+				), nil
+			default:
+				return nil, fmt.Errorf("unsupported %s attribute %q", sequence, attr)
+			}
+		},
+	), nil
+}
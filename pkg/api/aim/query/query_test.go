@@ -140,6 +140,36 @@ func (s *QueryTestSuite) TestPostgresDialector_Ok() {
 				`WHERE ("contexts"."json"#>>$1 <> $2 AND "runs"."lifecycle_stage" <> $3)`,
 			expectedVars: []interface{}{"{key1}", "value1", models.LifecycleStageDeleted},
 		},
+		{
+			name:  "TestImagesName",
+			query: `images.name == 'samples'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE ("images"."name" = $1 AND "runs"."lifecycle_stage" <> $2)`,
+			expectedVars: []interface{}{"samples", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestImagesContext",
+			query: `images.context.subset == 'val'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN contexts images_contexts ON images.context_id = images_contexts.id ` +
+				`WHERE ("images_contexts"."json"#>>$1 = $2 AND "runs"."lifecycle_stage" <> $3)`,
+			expectedVars: []interface{}{"{subset}", "val", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestTextsName",
+			query: `texts.name == 'samples'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE ("texts"."name" = $1 AND "runs"."lifecycle_stage" <> $2)`,
+			expectedVars: []interface{}{"samples", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestTextsContext",
+			query: `texts.context.subset == 'val'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN contexts texts_contexts ON texts.context_id = texts_contexts.id ` +
+				`WHERE ("texts_contexts"."json"#>>$1 = $2 AND "runs"."lifecycle_stage" <> $3)`,
+			expectedVars: []interface{}{"{subset}", "val", models.LifecycleStageDeleted},
+		},
 	}
 
 	for _, tt := range tests {
@@ -153,6 +183,8 @@ func (s *QueryTestSuite) TestPostgresDialector_Ok() {
 					"runs":        "runs",
 					"experiments": "Experiment",
 					"metrics":     "metrics",
+					"images":      "images",
+					"texts":       "texts",
 				},
 				Dialector: postgres.Dialector{}.Name(),
 			}
@@ -281,6 +313,36 @@ func (s *QueryTestSuite) TestSqliteDialector_Ok() {
 				`WHERE (IFNULL("contexts"."json", JSON('{}'))->>$1 <> $2 AND "runs"."lifecycle_stage" <> $3)`,
 			expectedVars: []interface{}{"key1", "value1", models.LifecycleStageDeleted},
 		},
+		{
+			name:  "TestImagesName",
+			query: `images.name == 'samples'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE ("images"."name" = $1 AND "runs"."lifecycle_stage" <> $2)`,
+			expectedVars: []interface{}{"samples", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestImagesContext",
+			query: `images.context.subset == 'val'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN contexts images_contexts ON images.context_id = images_contexts.id ` +
+				`WHERE (IFNULL("images_contexts"."json", JSON('{}'))->>$1 = $2 AND "runs"."lifecycle_stage" <> $3)`,
+			expectedVars: []interface{}{"subset", "val", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestTextsName",
+			query: `texts.name == 'samples'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`WHERE ("texts"."name" = $1 AND "runs"."lifecycle_stage" <> $2)`,
+			expectedVars: []interface{}{"samples", models.LifecycleStageDeleted},
+		},
+		{
+			name:  "TestTextsContext",
+			query: `texts.context.subset == 'val'`,
+			expectedSQL: `SELECT "run_uuid" FROM "runs" ` +
+				`LEFT JOIN contexts texts_contexts ON texts.context_id = texts_contexts.id ` +
+				`WHERE (IFNULL("texts_contexts"."json", JSON('{}'))->>$1 = $2 AND "runs"."lifecycle_stage" <> $3)`,
+			expectedVars: []interface{}{"subset", "val", models.LifecycleStageDeleted},
+		},
 	}
 
 	for _, tt := range tests {
@@ -294,6 +356,8 @@ func (s *QueryTestSuite) TestSqliteDialector_Ok() {
 					"runs":        "runs",
 					"experiments": "Experiment",
 					"metrics":     "metrics",
+					"images":      "images",
+					"texts":       "texts",
 				},
 				Dialector: sqlite.Dialector{}.Name(),
 			}
@@ -340,6 +404,8 @@ func (s *QueryTestSuite) Test_Error() {
 					"runs":        "runs",
 					"experiments": "Experiment",
 					"metrics":     "metrics",
+					"images":      "images",
+					"texts":       "texts",
 				},
 				Dialector: sqlite.Dialector{}.Name(),
 			}
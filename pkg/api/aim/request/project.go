@@ -0,0 +1,12 @@
+package request
+
+// UpdateProjectPinnedSequencesRequest represents the request json for `POST aim/projects/pinned-sequences` endpoint.
+type UpdateProjectPinnedSequencesRequest struct {
+	Sequences []PinnedSequence `json:"sequences"`
+}
+
+// PinnedSequence represents a single sequence pinned in the Aim UI.
+type PinnedSequence struct {
+	Name    string         `json:"name"`
+	Context map[string]any `json:"context"`
+}
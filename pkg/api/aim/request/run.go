@@ -11,6 +11,21 @@ type UpdateRunRequest struct {
 	Archived    *bool   `json:"archived"`
 }
 
+// MoveRunRequest is a request struct for `POST /runs/:id/move` endpoint.
+type MoveRunRequest struct {
+	ExperimentID  string `json:"experiment_id"`
+	Namespace     string `json:"namespace"`
+	MoveArtifacts bool   `json:"move_artifacts"`
+}
+
+// CloneRunRequest is a request struct for `POST /runs/:id/clone` endpoint.
+type CloneRunRequest struct {
+	ExperimentID  string `json:"experiment_id"`
+	Namespace     string `json:"namespace"`
+	Name          string `json:"run_name"`
+	CopyArtifacts bool   `json:"copy_artifacts"`
+}
+
 // GetRunMetrics is a request struct for `POST /runs/:id/metric/get-batch`
 type GetRunMetrics []GetRunMetric
 
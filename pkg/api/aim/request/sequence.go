@@ -0,0 +1,25 @@
+package request
+
+// SearchSequencesRequest is a request struct for `GET /runs/search/images/` and `GET /runs/search/texts/` endpoints.
+type SearchSequencesRequest struct {
+	Query          string `query:"q"`
+	RecordRange    string `query:"record_range"`
+	RecordDensity  int    `query:"record_density"`
+	IndexRange     string `query:"index_range"`
+	IndexDensity   int    `query:"index_density"`
+	ReportProgress bool   `query:"report_progress"`
+}
+
+// GetRunSequencesBatchRequest is a request struct for `POST /runs/:id/texts/get-batch/` endpoint.
+type GetRunSequencesBatchRequest struct {
+	RecordRange   string `query:"record_range"`
+	RecordDensity int    `query:"record_density"`
+	IndexRange    string `query:"index_range"`
+	IndexDensity  int    `query:"index_density"`
+}
+
+// RunSequenceTraceRequest is a partial request object for `POST /runs/:id/texts/get-batch/` endpoint.
+type RunSequenceTraceRequest struct {
+	Name    string         `json:"name"`
+	Context map[string]any `json:"context"`
+}
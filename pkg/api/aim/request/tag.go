@@ -0,0 +1,21 @@
+package request
+
+// CreateTagRequest is a request struct for `POST /tags` endpoint.
+type CreateTagRequest struct {
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+}
+
+// UpdateTagRequest is a request struct for `PUT /tags/:id` endpoint.
+type UpdateTagRequest struct {
+	Name        *string `json:"name"`
+	Color       *string `json:"color"`
+	Description *string `json:"description"`
+	Archived    *bool   `json:"archived"`
+}
+
+// AddRunTagRequest is a request struct for `POST /runs/:id/tags/new` endpoint.
+type AddRunTagRequest struct {
+	TagName string `json:"tag_name"`
+}
@@ -19,6 +19,17 @@ type GetProjectResponse struct {
 	TelemetryEnabled int    `json:"telementry_enabled"`
 }
 
+// ProjectPinnedSequencesResponse represents the response json for the `aim/projects/pinned-sequences` endpoints.
+type ProjectPinnedSequencesResponse struct {
+	Sequences []ProjectPinnedSequence `json:"sequences"`
+}
+
+// ProjectPinnedSequence represents a single pinned sequence.
+type ProjectPinnedSequence struct {
+	Name    string         `json:"name"`
+	Context map[string]any `json:"context"`
+}
+
 // ProjectParamsResponse is a response object for `GET aim/projects/params` endpoint.
 type ProjectParamsResponse struct {
 	Metric map[string][]fiber.Map `json:"metric"`
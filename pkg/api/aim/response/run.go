@@ -19,8 +19,11 @@ type GetRunInfoParams struct {
 
 // GetRunInfoTraces is a partial response object for GetRunInfo.
 type GetRunInfoTraces struct {
-	Tags   map[string]string        `json:"tags"`
-	Metric []GetRunInfoTracesMetric `json:"metric"`
+	Tags          map[string]string          `json:"tags"`
+	Metric        []GetRunInfoTracesMetric   `json:"metric"`
+	Images        []GetRunInfoTracesSequence `json:"images"`
+	Texts         []GetRunInfoTracesSequence `json:"texts"`
+	Distributions []GetRunInfoTracesSequence `json:"distributions"`
 }
 
 // GetRunInfoTracesMetric is a partial response object for GetRunInfoTraces.
@@ -30,18 +33,32 @@ type GetRunInfoTracesMetric struct {
 	LastValue float64         `json:"last_value"`
 }
 
+// GetRunInfoTracesSequence is a partial response object for GetRunInfoTraces.
+type GetRunInfoTracesSequence struct {
+	Name    string         `json:"name"`
+	Context map[string]any `json:"context"`
+}
+
 // GetRunInfoProps is a partial response object for GetRunInfo.
 type GetRunInfoProps struct {
 	Name         string               `json:"name"`
 	Description  string               `json:"description"`
 	Experiment   GetRunInfoExperiment `json:"experiment"`
-	Tags         []string             `json:"tags"`
+	Tags         []GetRunInfoTag      `json:"tags"`
 	CreationTime float64              `json:"creation_time"`
 	EndTime      float64              `json:"end_time"`
 	Archived     bool                 `json:"archived"`
 	Active       bool                 `json:"active"`
 }
 
+// GetRunInfoTag Aim tag properties
+type GetRunInfoTag struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+}
+
 // GetRunInfoExperiment experiment properties
 type GetRunInfoExperiment struct {
 	ID   string `json:"id"`
@@ -58,3 +75,51 @@ type RunMetrics struct {
 	Values  []float64       `json:"values"`
 	Iters   []int64         `json:"iters"`
 }
+
+// GetRunTexts is the response struct for GetRunTexts endpoint (slice of RunTexts).
+type GetRunTexts []RunTexts
+
+// RunTexts is one text sequence of the run.
+type RunTexts struct {
+	Name        string           `json:"name"`
+	Context     map[string]any   `json:"context"`
+	Values      [][]RunTextValue `json:"values"`
+	Iters       []int64          `json:"iters"`
+	Timestamps  []float64        `json:"timestamps"`
+	RecordRange []int64          `json:"record_range"`
+	IndexRange  []int64          `json:"index_range"`
+}
+
+// RunTextValue is a partial response object for RunTexts.
+type RunTextValue struct {
+	Data  string `json:"data"`
+	Index int64  `json:"index"`
+}
+
+// GetRunDistributions is the response struct for GetRunDistributions endpoint (slice of RunDistributions).
+type GetRunDistributions []RunDistributions
+
+// RunDistributions is one distribution sequence of the run.
+type RunDistributions struct {
+	Name        string                 `json:"name"`
+	Context     map[string]any         `json:"context"`
+	Values      []RunDistributionValue `json:"values"`
+	Iters       []int64                `json:"iters"`
+	Timestamps  []float64              `json:"timestamps"`
+	RecordRange []int64                `json:"record_range"`
+}
+
+// RunDistributionValue is a partial response object for RunDistributions.
+type RunDistributionValue struct {
+	Data     RunDistributionData `json:"data"`
+	BinCount int                 `json:"bin_count"`
+	Range    []float64           `json:"range"`
+}
+
+// RunDistributionData is the numpy encoded counts of the distribution bins.
+type RunDistributionData struct {
+	Type  string `json:"type"`
+	DType string `json:"dtype"`
+	Shape int    `json:"shape"`
+	Blob  []byte `json:"blob"`
+}
@@ -0,0 +1,30 @@
+package response
+
+import (
+	"github.com/google/uuid"
+)
+
+// Tag represents the response json in Tag endpoints
+type Tag struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Color       string    `json:"color"`
+	Description string    `json:"description"`
+	RunCount    int64     `json:"run_count"`
+	Archived    bool      `json:"archived"`
+}
+
+// TagRuns represents the response json in `GET /tags/:id/runs` endpoint
+type TagRuns struct {
+	ID   uuid.UUID `json:"id"`
+	Runs []TagRun  `json:"runs"`
+}
+
+// TagRun represents the run, which the tag is attached to
+type TagRun struct {
+	RunID        string  `json:"run_id"`
+	Name         string  `json:"name"`
+	Experiment   string  `json:"experiment"`
+	CreationTime float64 `json:"creation_time"`
+	EndTime      float64 `json:"end_time"`
+}
@@ -2,9 +2,14 @@ package aim
 
 import (
 	"github.com/gofiber/fiber/v2"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+	mlflowRun "github.com/G-Research/fasttrackml/pkg/api/mlflow/service/run"
 )
 
-func AddRoutes(r fiber.Router) {
+func AddRoutes(
+	r fiber.Router, runService *mlflowRun.Service, artifactStorageFactory storage.ArtifactStorageFactoryProvider,
+) {
 	apps := r.Group("apps")
 	apps.Get("/", GetApps)
 	apps.Post("/", CreateApp)
@@ -40,15 +45,30 @@ func AddRoutes(r fiber.Router) {
 	runs.Get("/search/run/", SearchRuns)
 	runs.Get("/search/metric/", SearchMetrics)
 	runs.Post("/search/metric/align/", SearchAlignedMetrics)
+	runs.Get("/search/images/", SearchImages)
+	runs.Post("/images/get-batch/", GetImagesBatch(artifactStorageFactory))
+	runs.Get("/search/texts/", SearchTexts)
 	runs.Get("/:id/info/", GetRunInfo)
 	runs.Post("/:id/metric/get-batch/", GetRunMetrics)
+	runs.Post("/:id/texts/get-batch/", GetRunTexts)
+	runs.Post("/:id/distributions/get-batch/", GetRunDistributions)
 	runs.Put("/:id/", UpdateRun)
 	runs.Delete("/:id/", DeleteRun)
+	runs.Post("/:id/move/", MoveRun(runService))
+	runs.Post("/:id/clone/", CloneRun(runService))
+	runs.Post("/:id/tags/new/", AddRunTag)
+	runs.Delete("/:id/tags/:tagID/", RemoveRunTag)
 	runs.Post("/delete-batch/", DeleteBatch)
 	runs.Post("/archive-batch/", ArchiveBatch)
 
 	tags := r.Group("/tags")
 	tags.Get("/", GetTags)
+	tags.Post("/", CreateTag)
+	tags.Get("/search/", SearchTags)
+	tags.Get("/:id/", GetTag)
+	tags.Put("/:id/", UpdateTag)
+	tags.Delete("/:id/", DeleteTag)
+	tags.Get("/:id/runs/", GetTagRuns)
 
 	r.Use(func(c *fiber.Ctx) error {
 		return fiber.ErrNotFound
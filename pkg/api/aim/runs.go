@@ -22,8 +22,10 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/aim/query"
 	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	mlflowRequest "github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	mlflowRun "github.com/G-Research/fasttrackml/pkg/api/mlflow/service/run"
 	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
 	"github.com/G-Research/fasttrackml/pkg/database"
 )
@@ -63,7 +65,8 @@ func GetRunInfo(c *fiber.Ctx) error {
 			),
 		).
 		Preload("Params").
-		Preload("Tags")
+		Preload("Tags").
+		Preload("AimTags", preloadAimTags)
 
 	if len(q.Sequences) == 0 {
 		q.Sequences = []string{
@@ -81,7 +84,10 @@ func GetRunInfo(c *fiber.Ctx) error {
 	traces := make(map[string][]fiber.Map, len(q.Sequences))
 	for _, s := range q.Sequences {
 		switch s {
-		case "audios", "distributions", "figures", "images", "log_records", "logs", "texts":
+		case "audios", "figures", "log_records", "logs":
+			traces[s] = []fiber.Map{}
+		case "distributions", "images", "texts":
+			// filled in, when the run is found in the namespace.
 			traces[s] = []fiber.Map{}
 		case "metric":
 			tx.Preload("LatestMetrics", func(db *gorm.DB) *gorm.DB {
@@ -110,7 +116,7 @@ func GetRunInfo(c *fiber.Ctx) error {
 			"id":   fmt.Sprintf("%d", *r.Experiment.ID),
 			"name": r.Experiment.Name,
 		},
-		"tags":          []string{}, // TODO insert real tags
+		"tags":          convertAimTags(r.AimTags),
 		"creation_time": float64(r.StartTime.Int64) / 1000,
 		"end_time":      float64(r.EndTime.Int64) / 1000,
 		"archived":      r.LifecycleStage == database.LifecycleStageDeleted,
@@ -138,6 +144,14 @@ func GetRunInfo(c *fiber.Ctx) error {
 	}
 	traces["metric"] = metrics
 
+	for _, sequence := range []string{"distributions", "images", "texts"} {
+		if _, ok := traces[sequence]; ok {
+			if traces[sequence], err = getRunSequences(sequence, r.ID); err != nil {
+				return err
+			}
+		}
+	}
+
 	return c.JSON(fiber.Map{
 		"params": params,
 		"traces": traces,
@@ -274,6 +288,7 @@ func GetRunsActive(c *fiber.Ctx) error {
 			),
 		).
 		Preload("LatestMetrics.Context").
+		Preload("AimTags", preloadAimTags).
 		Limit(50).
 		Order("start_time DESC").
 		Find(&runs).Error; err != nil {
@@ -292,7 +307,7 @@ func GetRunsActive(c *fiber.Ctx) error {
 						"id":   fmt.Sprintf("%d", *r.Experiment.ID),
 						"name": r.Experiment.Name,
 					},
-					"tags":          []string{}, // TODO insert real tags
+					"tags":          convertAimTags(r.AimTags),
 					"creation_time": float64(r.StartTime.Int64) / 1000,
 					"end_time":      float64(r.EndTime.Int64) / 1000,
 					"archived":      r.LifecycleStage == database.LifecycleStageDeleted,
@@ -438,6 +453,7 @@ func SearchRuns(c *fiber.Ctx) error {
 				&models.Experiment{NamespaceID: ns.ID},
 			),
 		).
+		Preload("AimTags", preloadAimTags).
 		Order("row_num DESC")
 
 	if q.Limit > 0 {
@@ -486,7 +502,7 @@ func SearchRuns(c *fiber.Ctx) error {
 							"id":   fmt.Sprintf("%d", *r.Experiment.ID),
 							"name": r.Experiment.Name,
 						},
-						"tags":          []string{}, // TODO insert real tags
+						"tags":          convertAimTags(r.AimTags),
 						"creation_time": float64(r.StartTime.Int64) / 1000,
 						"end_time":      float64(r.EndTime.Int64) / 1000,
 						"archived":      r.LifecycleStage == database.LifecycleStageDeleted,
@@ -653,6 +669,7 @@ func SearchMetrics(c *fiber.Ctx) error {
 		).
 		Preload("Params").
 		Preload("Tags").
+		Preload("AimTags", preloadAimTags).
 		Where("run_uuid IN (?)", pq.Filter(database.DB.
 			Select("runs.run_uuid").
 			Table("runs").
@@ -679,7 +696,7 @@ func SearchMetrics(c *fiber.Ctx) error {
 					"id":   fmt.Sprintf("%d", *r.Experiment.ID),
 					"name": r.Experiment.Name,
 				},
-				"tags":          []string{}, // TODO insert real tags
+				"tags":          convertAimTags(r.AimTags),
 				"creation_time": float64(r.StartTime.Int64) / 1000,
 				"end_time":      float64(r.EndTime.Int64) / 1000,
 				"archived":      r.LifecycleStage == database.LifecycleStageDeleted,
@@ -1182,6 +1199,83 @@ func UpdateRun(c *fiber.Ctx) error {
 	})
 }
 
+// MoveRun will move the run into another experiment, optionally of another namespace.
+func MoveRun(runService *mlflowRun.Service) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ns, err := namespace.GetNamespaceFromContext(c.Context())
+		if err != nil {
+			return api.NewInternalError("error getting namespace from context")
+		}
+		log.Debugf("moveRun namespace: %s", ns.Code)
+
+		params := struct {
+			ID string `params:"id"`
+		}{}
+		if err = c.ParamsParser(&params); err != nil {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+		}
+
+		var moveRequest request.MoveRunRequest
+		if err = c.BodyParser(&moveRequest); err != nil {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+		}
+
+		run, err := runService.MoveRun(c.Context(), ns, &mlflowRequest.MoveRunRequest{
+			RunID:         params.ID,
+			ExperimentID:  moveRequest.ExperimentID,
+			Namespace:     moveRequest.Namespace,
+			MoveArtifacts: moveRequest.MoveArtifacts,
+		})
+		if err != nil {
+			return convertServiceError(err)
+		}
+
+		return c.JSON(fiber.Map{
+			"id":     run.ID,
+			"status": "OK",
+		})
+	}
+}
+
+// CloneRun will create the copy of the run in another experiment, optionally of another namespace.
+func CloneRun(runService *mlflowRun.Service) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ns, err := namespace.GetNamespaceFromContext(c.Context())
+		if err != nil {
+			return api.NewInternalError("error getting namespace from context")
+		}
+		log.Debugf("cloneRun namespace: %s", ns.Code)
+
+		params := struct {
+			ID string `params:"id"`
+		}{}
+		if err = c.ParamsParser(&params); err != nil {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+		}
+
+		var cloneRequest request.CloneRunRequest
+		if err = c.BodyParser(&cloneRequest); err != nil {
+			return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+		}
+
+		run, err := runService.CloneRun(c.Context(), ns, &mlflowRequest.CloneRunRequest{
+			RunID:         params.ID,
+			ExperimentID:  cloneRequest.ExperimentID,
+			Namespace:     cloneRequest.Namespace,
+			Name:          cloneRequest.Name,
+			CopyArtifacts: cloneRequest.CopyArtifacts,
+		})
+		if err != nil {
+			return convertServiceError(err)
+		}
+
+		return c.JSON(fiber.Map{
+			"id":     run.ID,
+			"status": "OK",
+		})
+	}
+}
+
 func ArchiveBatch(c *fiber.Ctx) error {
 	ns, err := namespace.GetNamespaceFromContext(c.Context())
 	if err != nil {
@@ -0,0 +1,549 @@
+package aim
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/query"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// Default densities of the sequence search, used when they are not requested.
+const (
+	defaultRecordDensity = 50
+	defaultIndexDensity  = 5
+)
+
+// sequenceTrace represents the single sequence of the run, found by the sequence search.
+type sequenceTrace struct {
+	RunID     string `gorm:"column:run_uuid"`
+	RowNum    int64
+	Name      string
+	ContextID uint
+	MinStep   int64
+	MaxStep   int64
+	MaxIndex  int64
+	Context   fiber.Map `gorm:"-"`
+}
+
+// sequenceTraceGetter returns the trace of the sequence with its values sampled within the used ranges.
+type sequenceTraceGetter func(
+	trace sequenceTrace, recordRange, indexRange sequenceRange, recordDensity, indexDensity int,
+) (fiber.Map, error)
+
+// sequenceRange represents `[start, stop)` range of the sequence steps or indexes.
+type sequenceRange struct {
+	Start int64
+	Stop  int64
+}
+
+// toList converts the range into its Aim representation.
+func (r sequenceRange) toList() []int64 {
+	return []int64{r.Start, r.Stop}
+}
+
+// parseSequenceRange parses the range requested in `start:stop` form, where both bounds are optional.
+// The range is limited by the total range of the sequences.
+func parseSequenceRange(s string, total sequenceRange) (sequenceRange, error) {
+	used := total
+	if s == "" {
+		return used, nil
+	}
+
+	start, stop, ok := strings.Cut(s, ":")
+	if !ok {
+		return used, fiber.NewError(fiber.StatusUnprocessableEntity, fmt.Sprintf("invalid range %q", s))
+	}
+	if start != "" {
+		v, err := strconv.ParseInt(start, 10, 64)
+		if err != nil {
+			return used, fiber.NewError(fiber.StatusUnprocessableEntity, fmt.Sprintf("invalid range %q", s))
+		}
+		used.Start = max(v, total.Start)
+	}
+	if stop != "" {
+		v, err := strconv.ParseInt(stop, 10, 64)
+		if err != nil {
+			return used, fiber.NewError(fiber.StatusUnprocessableEntity, fmt.Sprintf("invalid range %q", s))
+		}
+		used.Stop = min(v, total.Stop)
+	}
+	return used, nil
+}
+
+// sampleSequence returns positions of up to `density` items, spread evenly over `n` items.
+// The first and the last items are always sampled. All the items are sampled, when density is not positive.
+func sampleSequence(n, density int) []int {
+	if density <= 0 || n <= density {
+		positions := make([]int, n)
+		for i := range positions {
+			positions[i] = i
+		}
+		return positions
+	}
+	if density == 1 {
+		return []int{0}
+	}
+	positions := make([]int, density)
+	for i := range positions {
+		positions[i] = i * (n - 1) / (density - 1)
+	}
+	return positions
+}
+
+// searchSequences handles the search of the sequences, which are kept in provided table, and streams
+// the sampled traces of the found sequences, grouped by run, in Aim binary encoding.
+func searchSequences(c *fiber.Ctx, table string, getTrace sequenceTraceGetter) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("search %s namespace: %s", table, ns.Code)
+
+	var q request.SearchSequencesRequest
+	if err = c.QueryParser(&q); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	if c.Query("report_progress") == "" {
+		q.ReportProgress = true
+	}
+	if c.Query("record_density") == "" {
+		q.RecordDensity = defaultRecordDensity
+	}
+	if c.Query("index_density") == "" {
+		q.IndexDensity = defaultIndexDensity
+	}
+
+	tzOffset, err := strconv.Atoi(c.Get("x-timezone-offset", "0"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, "x-timezone-offset header is not a valid integer")
+	}
+
+	traces, err := searchSequenceTraces(ns.ID, table, tzOffset, &q)
+	if err != nil {
+		return err
+	}
+
+	var runIDs []string
+	var recordTotal, indexTotal sequenceRange
+	for i, trace := range traces {
+		if i == 0 || trace.RunID != traces[i-1].RunID {
+			runIDs = append(runIDs, trace.RunID)
+		}
+		if i == 0 || trace.MinStep < recordTotal.Start {
+			recordTotal.Start = trace.MinStep
+		}
+		recordTotal.Stop = max(recordTotal.Stop, trace.MaxStep+1)
+		indexTotal.Stop = max(indexTotal.Stop, trace.MaxIndex+1)
+	}
+
+	recordUsed, err := parseSequenceRange(q.RecordRange, recordTotal)
+	if err != nil {
+		return err
+	}
+	indexUsed, err := parseSequenceRange(q.IndexRange, indexTotal)
+	if err != nil {
+		return err
+	}
+
+	runs, err := getSequenceRuns(ns.ID, runIDs)
+	if err != nil {
+		return err
+	}
+
+	ranges := fiber.Map{
+		"record_range_total": recordTotal.toList(),
+		"record_range_used":  recordUsed.toList(),
+		"index_range_total":  indexTotal.toList(),
+		"index_range_used":   indexUsed.toList(),
+	}
+
+	c.Set("Content-Type", "application/octet-stream")
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		start := time.Now()
+		if err := func() error {
+			var progress int
+			reportProgress := func() error {
+				if !q.ReportProgress {
+					return nil
+				}
+				err := encoding.EncodeTree(w, fiber.Map{
+					fmt.Sprintf("progress_%d", progress): []int{progress + 1, len(runIDs)},
+				})
+				if err != nil {
+					return err
+				}
+				progress++
+				return w.Flush()
+			}
+
+			for i := 0; i < len(traces); {
+				runID := traces[i].RunID
+				runTraces := []fiber.Map{}
+				for ; i < len(traces) && traces[i].RunID == runID; i++ {
+					trace, err := getTrace(traces[i], recordUsed, indexUsed, q.RecordDensity, q.IndexDensity)
+					if err != nil {
+						return err
+					}
+					runTraces = append(runTraces, trace)
+				}
+
+				if err := encoding.EncodeTree(w, fiber.Map{
+					runID: fiber.Map{
+						"ranges": ranges,
+						"params": runs[runID]["params"],
+						"props":  runs[runID]["props"],
+						"traces": runTraces,
+					},
+				}); err != nil {
+					return err
+				}
+				if err := reportProgress(); err != nil {
+					return err
+				}
+			}
+			return nil
+		}(); err != nil {
+			log.Errorf("Error encountered in %s %s: error streaming %s: %s", c.Method(), c.Path(), table, err)
+		}
+
+		log.Infof("body - %s %s %s", time.Since(start), c.Method(), c.Path())
+	})
+
+	return nil
+}
+
+// sampleSequenceSteps returns the steps of the sequence, which is kept in provided table,
+// sampled within the used record range.
+func sampleSequenceSteps(
+	table string, trace sequenceTrace, recordRange sequenceRange, recordDensity int,
+) ([]int64, error) {
+	var steps []int64
+	if err := database.DB.Table(
+		table,
+	).Distinct(
+		"step",
+	).Where(
+		"run_uuid = ? AND name = ? AND context_id = ? AND step >= ? AND step < ?",
+		trace.RunID, trace.Name, trace.ContextID, recordRange.Start, recordRange.Stop,
+	).Order(
+		"step",
+	).Pluck("step", &steps).Error; err != nil {
+		return nil, fmt.Errorf("error getting steps of %s %q: %w", table, trace.Name, err)
+	}
+
+	sampledSteps := make([]int64, 0, recordDensity)
+	for _, i := range sampleSequence(len(steps), recordDensity) {
+		sampledSteps = append(sampledSteps, steps[i])
+	}
+	return sampledSteps, nil
+}
+
+// sampleSequenceItems returns the query of the items of the sequence, which is kept in provided table,
+// sampled by step and by index within the used ranges. Nil query is returned, when there are no steps to sample.
+func sampleSequenceItems(
+	table string, trace sequenceTrace, recordRange, indexRange sequenceRange, recordDensity, indexDensity int,
+) (*gorm.DB, error) {
+	sampledSteps, err := sampleSequenceSteps(table, trace, recordRange, recordDensity)
+	if err != nil {
+		return nil, err
+	}
+	if len(sampledSteps) == 0 {
+		return nil, nil
+	}
+
+	tx := database.DB.Where(
+		"run_uuid = ? AND name = ? AND context_id = ? AND step IN ? AND idx >= ? AND idx < ?",
+		trace.RunID, trace.Name, trace.ContextID, sampledSteps, indexRange.Start, indexRange.Stop,
+	)
+	if indexDensity > 0 && indexRange.Stop-indexRange.Start > int64(indexDensity) {
+		sampledIndexes := make([]int64, 0, indexDensity)
+		for _, i := range sampleSequence(int(indexRange.Stop-indexRange.Start), indexDensity) {
+			sampledIndexes = append(sampledIndexes, indexRange.Start+int64(i))
+		}
+		tx = tx.Where("idx IN ?", sampledIndexes)
+	}
+	return tx, nil
+}
+
+// searchSequenceTraces returns the sequences, which are kept in provided table and match the search query.
+func searchSequenceTraces(
+	namespaceID uint, table string, tzOffset int, req *request.SearchSequencesRequest,
+) ([]sequenceTrace, error) {
+	qp := query.QueryParser{
+		Default: query.DefaultExpression{
+			Contains:   "run.archived",
+			Expression: "not run.archived",
+		},
+		Tables: map[string]string{
+			"runs":        "runs",
+			"experiments": "experiments",
+			table:         table,
+		},
+		TzOffset:  tzOffset,
+		Dialector: database.DB.Dialector.Name(),
+	}
+	pq, err := qp.Parse(req.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	var traces []sequenceTrace
+	if err := pq.Filter(database.DB.
+		Select(
+			"runs.run_uuid",
+			"runs.row_num",
+			fmt.Sprintf("%s.name", table),
+			fmt.Sprintf("%s.context_id", table),
+			fmt.Sprintf("MIN(%s.step) AS min_step", table),
+			fmt.Sprintf("MAX(%s.step) AS max_step", table),
+			fmt.Sprintf("MAX(%s.idx) AS max_index", table),
+		).
+		Table("runs").
+		Joins(
+			"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+			namespaceID,
+		).
+		Joins(fmt.Sprintf("INNER JOIN %s USING(run_uuid)", table))).
+		Group("runs.run_uuid").
+		Group("runs.row_num").
+		Group(fmt.Sprintf("%s.name", table)).
+		Group(fmt.Sprintf("%s.context_id", table)).
+		Order("runs.row_num DESC").
+		Order(fmt.Sprintf("%s.name", table)).
+		Order(fmt.Sprintf("%s.context_id", table)).
+		Scan(&traces).Error; err != nil {
+		return nil, fmt.Errorf("error searching %s: %w", table, err)
+	}
+
+	contextIDs := make([]uint, len(traces))
+	for i, trace := range traces {
+		contextIDs[i] = trace.ContextID
+	}
+	contexts, err := getContexts(contextIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range traces {
+		traces[i].Context = contexts[traces[i].ContextID]
+	}
+
+	return traces, nil
+}
+
+// getRunSequenceTraces returns every sequence of the run, which is kept in provided table.
+// The max index is only collected for the tables, which keep several items per step.
+func getRunSequenceTraces(table, runID string, indexed bool) ([]sequenceTrace, error) {
+	columns := []any{
+		"run_uuid",
+		"name",
+		"context_id",
+		"MIN(step) AS min_step",
+		"MAX(step) AS max_step",
+	}
+	if indexed {
+		columns = append(columns, "MAX(idx) AS max_index")
+	}
+
+	var traces []sequenceTrace
+	if err := database.DB.
+		Select(columns[0], columns[1:]...).
+		Table(table).
+		Where("run_uuid = ?", runID).
+		Group("run_uuid").
+		Group("name").
+		Group("context_id").
+		Order("name").
+		Order("context_id").
+		Scan(&traces).Error; err != nil {
+		return nil, fmt.Errorf("error getting %s of run %q: %w", table, runID, err)
+	}
+
+	contextIDs := make([]uint, len(traces))
+	for i, trace := range traces {
+		contextIDs[i] = trace.ContextID
+	}
+	contexts, err := getContexts(contextIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range traces {
+		traces[i].Context = contexts[traces[i].ContextID]
+	}
+
+	return traces, nil
+}
+
+// isSequenceTraceRequested checks, whether the sequence matches name and context of any of requested traces.
+func isSequenceTraceRequested(trace sequenceTrace, requested []request.RunSequenceTraceRequest) bool {
+	for _, r := range requested {
+		if r.Name != trace.Name {
+			continue
+		}
+		if len(r.Context) == 0 && len(trace.Context) == 0 {
+			return true
+		}
+		if reflect.DeepEqual(r.Context, map[string]any(trace.Context)) {
+			return true
+		}
+	}
+	return false
+}
+
+// getContexts returns the contexts by their IDs, converted into key:value objects.
+func getContexts(ids []uint) (map[uint]fiber.Map, error) {
+	var contexts []database.Context
+	if len(ids) > 0 {
+		if err := database.DB.Where("id IN ?", ids).Find(&contexts).Error; err != nil {
+			return nil, fmt.Errorf("error getting contexts: %w", err)
+		}
+	}
+
+	result := make(map[uint]fiber.Map, len(contexts))
+	for _, c := range contexts {
+		// to be properly decoded by AIM UI, json should be represented as a key:value object.
+		context := fiber.Map{}
+		if err := json.Unmarshal(c.Json, &context); err != nil {
+			return nil, eris.Wrap(err, "error unmarshalling `context` json to `fiber.Map` object")
+		}
+		result[c.ID] = context
+	}
+	return result, nil
+}
+
+// getSequenceRuns returns props and params of the runs, found by the sequence search.
+func getSequenceRuns(namespaceID uint, runIDs []string) (map[string]fiber.Map, error) {
+	var runs []database.Run
+	if err := database.DB.
+		InnerJoins(
+			"Experiment",
+			database.DB.Select(
+				"ID", "Name",
+			).Where(&models.Experiment{NamespaceID: namespaceID}),
+		).
+		Preload("Params").
+		Preload("Tags").
+		Preload("AimTags", preloadAimTags).
+		Where("run_uuid IN ?", runIDs).
+		Find(&runs).Error; err != nil {
+		return nil, fmt.Errorf("error getting runs: %w", err)
+	}
+
+	result := make(map[string]fiber.Map, len(runs))
+	for _, r := range runs {
+		params := make(fiber.Map, len(r.Params)+1)
+		for _, p := range r.Params {
+			params[p.Key] = p.Value
+		}
+		tags := make(map[string]string, len(r.Tags))
+		for _, t := range r.Tags {
+			tags[t.Key] = t.Value
+		}
+		params["tags"] = tags
+
+		result[r.ID] = fiber.Map{
+			"params": params,
+			"props": fiber.Map{
+				"name":        r.Name,
+				"description": nil,
+				"experiment": fiber.Map{
+					"id":   fmt.Sprintf("%d", *r.Experiment.ID),
+					"name": r.Experiment.Name,
+				},
+				"tags":          convertAimTags(r.AimTags),
+				"creation_time": float64(r.StartTime.Int64) / 1000,
+				"end_time":      float64(r.EndTime.Int64) / 1000,
+				"archived":      r.LifecycleStage == database.LifecycleStageDeleted,
+				"active":        r.Status == database.StatusRunning,
+			},
+		}
+	}
+	return result, nil
+}
+
+// getRunSequences returns name and context of every sequence of the run, which is kept in provided table.
+func getRunSequences(table, runID string) ([]fiber.Map, error) {
+	var sequences []struct {
+		Name      string
+		ContextID uint
+	}
+	if err := database.DB.
+		Table(table).
+		Distinct("name", "context_id").
+		Where("run_uuid = ?", runID).
+		Order("name").
+		Order("context_id").
+		Scan(&sequences).Error; err != nil {
+		return nil, fmt.Errorf("error getting %s of run %q: %w", table, runID, err)
+	}
+
+	contextIDs := make([]uint, len(sequences))
+	for i, s := range sequences {
+		contextIDs[i] = s.ContextID
+	}
+	contexts, err := getContexts(contextIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]fiber.Map, len(sequences))
+	for i, s := range sequences {
+		result[i] = fiber.Map{
+			"name":    s.Name,
+			"context": contexts[s.ContextID],
+		}
+	}
+	return result, nil
+}
+
+// getProjectSequences returns contexts of the sequences of namespace active runs, which are kept
+// in provided table, grouped by the sequence name.
+func getProjectSequences(table string, namespaceID uint) (map[string][]fiber.Map, error) {
+	var sequences []struct {
+		Name      string
+		ContextID uint
+	}
+	if err := database.DB.
+		Table(table).
+		Distinct(fmt.Sprintf("%s.name", table), fmt.Sprintf("%s.context_id", table)).
+		Joins("JOIN runs USING(run_uuid)").
+		Joins(
+			"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+			namespaceID,
+		).
+		Where("runs.lifecycle_stage = ?", database.LifecycleStageActive).
+		Scan(&sequences).Error; err != nil {
+		return nil, fmt.Errorf("error retrieving %s: %w", table, err)
+	}
+
+	contextIDs := make([]uint, len(sequences))
+	for i, s := range sequences {
+		contextIDs[i] = s.ContextID
+	}
+	contexts, err := getContexts(contextIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]fiber.Map, len(sequences))
+	for _, s := range sequences {
+		result[s.Name] = append(result[s.Name], contexts[s.ContextID])
+	}
+	return result, nil
+}
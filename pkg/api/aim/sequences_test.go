@@ -0,0 +1,55 @@
+package aim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sampleSequence(t *testing.T) {
+	tests := []struct {
+		name      string
+		n         int
+		density   int
+		positions []int
+	}{
+		{name: "Empty", n: 0, density: 5, positions: []int{}},
+		{name: "LessThanDensity", n: 3, density: 5, positions: []int{0, 1, 2}},
+		{name: "NoDensity", n: 3, density: 0, positions: []int{0, 1, 2}},
+		{name: "SingleItem", n: 10, density: 1, positions: []int{0}},
+		{name: "Sampled", n: 10, density: 4, positions: []int{0, 3, 6, 9}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.positions, sampleSequence(tt.n, tt.density))
+		})
+	}
+}
+
+func Test_parseSequenceRange(t *testing.T) {
+	total := sequenceRange{Start: 2, Stop: 10}
+	tests := []struct {
+		name  string
+		input string
+		used  sequenceRange
+	}{
+		{name: "Empty", input: "", used: total},
+		{name: "OpenBounds", input: ":", used: total},
+		{name: "Start", input: "5:", used: sequenceRange{Start: 5, Stop: 10}},
+		{name: "Stop", input: ":5", used: sequenceRange{Start: 2, Stop: 5}},
+		{name: "OutOfTotal", input: "0:20", used: total},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			used, err := parseSequenceRange(tt.input, total)
+			require.Nil(t, err)
+			assert.Equal(t, tt.used, used)
+		})
+	}
+
+	for _, input := range []string{"5", "a:", ":b"} {
+		_, err := parseSequenceRange(input, total)
+		assert.EqualError(t, err, `invalid range "`+input+`"`)
+	}
+}
@@ -1,7 +1,480 @@
 package aim
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// tagWithRunCount is the Aim tag together with the number of runs it is attached to.
+type tagWithRunCount struct {
+	database.AimTag
+	RunCount int64
+}
 
 func GetTags(c *fiber.Ctx) error {
-	return c.JSON([]string{})
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getTags namespace: %s", ns.Code)
+
+	tags, err := findTags(ns.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(tags)
+}
+
+func SearchTags(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("searchTags namespace: %s", ns.Code)
+
+	q := struct {
+		Query string `query:"q"`
+	}{}
+
+	if err := c.QueryParser(&q); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	tags, err := findTags(ns.ID, func(db *gorm.DB) *gorm.DB {
+		return db.Where("aim_tags.name LIKE ?", "%"+q.Query+"%")
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(tags)
+}
+
+func CreateTag(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("createTag namespace: %s", ns.Code)
+
+	var req request.CreateTagRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	if req.Name == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "tag name can't be empty")
+	}
+	if err := checkTagNameIsFree(ns.ID, req.Name); err != nil {
+		return err
+	}
+
+	tag := database.AimTag{
+		Name:        req.Name,
+		Color:       req.Color,
+		Description: req.Description,
+		NamespaceID: ns.ID,
+	}
+	if err := database.DB.
+		Create(&tag).
+		Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("error inserting tag: %s", err))
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(response.Success{
+		ID:     tag.ID.String(),
+		Status: "OK",
+	})
+}
+
+func GetTag(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getTag namespace: %s", ns.Code)
+
+	p := struct {
+		ID uuid.UUID `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	tags, err := findTags(ns.ID, func(db *gorm.DB) *gorm.DB {
+		return db.Where("aim_tags.id = ?", p.ID)
+	})
+	if err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return fiber.ErrNotFound
+	}
+
+	return c.JSON(tags[0])
+}
+
+func UpdateTag(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("updateTag namespace: %s", ns.Code)
+
+	p := struct {
+		ID uuid.UUID `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	var req request.UpdateTagRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	tag, err := getTag(ns.ID, p.ID)
+	if err != nil {
+		return err
+	}
+
+	updates := map[string]any{}
+	if req.Name != nil && *req.Name != tag.Name {
+		if *req.Name == "" {
+			return fiber.NewError(fiber.StatusBadRequest, "tag name can't be empty")
+		}
+		if err := checkTagNameIsFree(ns.ID, *req.Name); err != nil {
+			return err
+		}
+		updates["name"] = *req.Name
+	}
+	if req.Color != nil {
+		updates["color"] = *req.Color
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+	if req.Archived != nil {
+		updates["is_archived"] = *req.Archived
+	}
+
+	if len(updates) > 0 {
+		if err := database.DB.
+			Model(&tag).
+			Updates(updates).
+			Error; err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("error updating tag %q: %s", p.ID, err))
+		}
+	}
+
+	return c.JSON(response.Success{
+		ID:     tag.ID.String(),
+		Status: "OK",
+	})
+}
+
+func DeleteTag(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("deleteTag namespace: %s", ns.Code)
+
+	p := struct {
+		ID uuid.UUID `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	tag, err := getTag(ns.ID, p.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM aim_run_tags WHERE aim_tag_id = ?", tag.ID).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&tag).Error
+	}); err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to delete tag %q: %s", p.ID, err))
+	}
+
+	return c.Status(http.StatusOK).JSON(nil)
+}
+
+func GetTagRuns(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getTagRuns namespace: %s", ns.Code)
+
+	p := struct {
+		ID uuid.UUID `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	tag, err := getTag(ns.ID, p.ID)
+	if err != nil {
+		return err
+	}
+
+	var runs []database.Run
+	if err := database.DB.
+		InnerJoins(
+			"Experiment",
+			database.DB.Select(
+				"ID", "Name",
+			).Where(
+				&models.Experiment{NamespaceID: ns.ID},
+			),
+		).
+		Joins("INNER JOIN aim_run_tags ON aim_run_tags.run_uuid = runs.run_uuid").
+		Where("aim_run_tags.aim_tag_id = ?", tag.ID).
+		Order("runs.row_num DESC").
+		Find(&runs).
+		Error; err != nil {
+		return fmt.Errorf("error fetching runs of tag %q: %w", p.ID, err)
+	}
+
+	resp := response.TagRuns{
+		ID:   tag.ID,
+		Runs: make([]response.TagRun, len(runs)),
+	}
+	for i, r := range runs {
+		resp.Runs[i] = response.TagRun{
+			RunID:        r.ID,
+			Name:         r.Name,
+			Experiment:   r.Experiment.Name,
+			CreationTime: float64(r.StartTime.Int64) / 1000,
+			EndTime:      float64(r.EndTime.Int64) / 1000,
+		}
+	}
+
+	return c.JSON(resp)
+}
+
+// AddRunTag attaches the tag with provided name to the run, creating the tag when it doesn't exist yet.
+func AddRunTag(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("addRunTag namespace: %s", ns.Code)
+
+	p := struct {
+		ID string `params:"id"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	var req request.AddRunTagRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	if req.TagName == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "tag name can't be empty")
+	}
+
+	run, err := getRun(c, ns.ID, p.ID)
+	if err != nil {
+		return err
+	}
+
+	tag := database.AimTag{
+		Name:        req.TagName,
+		NamespaceID: ns.ID,
+	}
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Where("namespace_id = ?", ns.ID).
+			Where("name = ?", req.TagName).
+			FirstOrCreate(&tag).
+			Error; err != nil {
+			return err
+		}
+		return tx.
+			Table("aim_run_tags").
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(map[string]any{
+				"run_uuid":   run.ID,
+				"aim_tag_id": tag.ID,
+			}).
+			Error
+	}); err != nil {
+		return fiber.NewError(
+			fiber.StatusInternalServerError,
+			fmt.Sprintf("unable to add tag %q to run %q: %s", req.TagName, p.ID, err),
+		)
+	}
+
+	return c.JSON(response.Success{
+		ID:     tag.ID.String(),
+		Status: "OK",
+	})
+}
+
+// RemoveRunTag detaches the tag from the run.
+func RemoveRunTag(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("removeRunTag namespace: %s", ns.Code)
+
+	p := struct {
+		ID    string    `params:"id"`
+		TagID uuid.UUID `params:"tagID"`
+	}{}
+
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	run, err := getRun(c, ns.ID, p.ID)
+	if err != nil {
+		return err
+	}
+
+	tx := database.DB.Exec(
+		"DELETE FROM aim_run_tags WHERE run_uuid = ? AND aim_tag_id = ?", run.ID, p.TagID,
+	)
+	if tx.Error != nil {
+		return fiber.NewError(
+			fiber.StatusInternalServerError,
+			fmt.Sprintf("unable to remove tag %q from run %q: %s", p.TagID, p.ID, tx.Error),
+		)
+	}
+
+	return c.JSON(fiber.Map{
+		"id":      run.ID,
+		"removed": tx.RowsAffected > 0,
+		"status":  "OK",
+	})
+}
+
+// findTags returns the tags of the namespace together with their run counts.
+func findTags(namespaceID uint, scopes ...func(*gorm.DB) *gorm.DB) ([]response.Tag, error) {
+	var tags []tagWithRunCount
+	if err := database.DB.
+		Model(&database.AimTag{}).
+		Select("aim_tags.*", "COUNT(aim_run_tags.run_uuid) AS run_count").
+		Joins("LEFT JOIN aim_run_tags ON aim_run_tags.aim_tag_id = aim_tags.id").
+		Where("aim_tags.namespace_id = ?", namespaceID).
+		Scopes(scopes...).
+		Group("aim_tags.id").
+		Order("aim_tags.name").
+		Scan(&tags).
+		Error; err != nil {
+		return nil, fmt.Errorf("error fetching tags: %w", err)
+	}
+
+	resp := make([]response.Tag, len(tags))
+	for i, t := range tags {
+		resp[i] = response.Tag{
+			ID:          t.ID,
+			Name:        t.Name,
+			Color:       t.Color,
+			Description: t.Description,
+			RunCount:    t.RunCount,
+			Archived:    t.IsArchived,
+		}
+	}
+	return resp, nil
+}
+
+// getTag returns the tag of the namespace or fiber.ErrNotFound, when the tag doesn't exist.
+func getTag(namespaceID uint, id uuid.UUID) (*database.AimTag, error) {
+	tag := database.AimTag{
+		Base: database.Base{
+			ID: id,
+		},
+	}
+	if err := database.DB.
+		Where("namespace_id = ?", namespaceID).
+		First(&tag).
+		Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.ErrNotFound
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to find tag %q: %s", id, err))
+	}
+	return &tag, nil
+}
+
+// checkTagNameIsFree checks that there is no tag with provided name in the namespace.
+func checkTagNameIsFree(namespaceID uint, name string) error {
+	var count int64
+	if err := database.DB.
+		Model(&database.AimTag{}).
+		Where("namespace_id = ?", namespaceID).
+		Where("name = ?", name).
+		Count(&count).
+		Error; err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, fmt.Sprintf("unable to find tag %q: %s", name, err))
+	}
+	if count > 0 {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("tag with name %q already exists", name))
+	}
+	return nil
+}
+
+// getRun returns the run of the namespace or 404 error, when the run doesn't exist.
+func getRun(c *fiber.Ctx, namespaceID uint, id string) (*models.Run, error) {
+	run, err := repositories.NewRunRepository(database.DB).GetByNamespaceIDAndRunID(c.Context(), namespaceID, id)
+	if err != nil {
+		return nil, fiber.NewError(
+			fiber.StatusInternalServerError, fmt.Sprintf("unable to find run '%s': %s", id, err),
+		)
+	}
+	if run == nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("unable to find run '%s'", id))
+	}
+	return run, nil
+}
+
+// preloadAimTags preloads the not archived Aim tags of the runs.
+func preloadAimTags(db *gorm.DB) *gorm.DB {
+	return db.Where("NOT aim_tags.is_archived").Order("aim_tags.name")
+}
+
+// convertAimTags converts the Aim tags of the run to its props representation.
+func convertAimTags(tags []database.AimTag) []fiber.Map {
+	props := make([]fiber.Map, len(tags))
+	for i, t := range tags {
+		props[i] = fiber.Map{
+			"id":          t.ID.String(),
+			"name":        t.Name,
+			"color":       t.Color,
+			"description": t.Description,
+		}
+	}
+	return props
 }
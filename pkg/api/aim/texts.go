@@ -0,0 +1,128 @@
+package aim
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// SearchTexts handles `GET /runs/search/texts/` endpoint.
+func SearchTexts(c *fiber.Ctx) error {
+	return searchSequences(c, "texts", getTextsTrace)
+}
+
+// GetRunTexts handles `POST /runs/:id/texts/get-batch/` endpoint.
+func GetRunTexts(c *fiber.Ctx) error {
+	ns, err := namespace.GetNamespaceFromContext(c.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getRunTexts namespace: %s", ns.Code)
+
+	p := struct {
+		ID string `params:"id"`
+	}{}
+	if err := c.ParamsParser(&p); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	q := request.GetRunSequencesBatchRequest{}
+	if err := c.QueryParser(&q); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	if c.Query("record_density") == "" {
+		q.RecordDensity = defaultRecordDensity
+	}
+	if c.Query("index_density") == "" {
+		q.IndexDensity = defaultIndexDensity
+	}
+
+	var b []request.RunSequenceTraceRequest
+	if err := c.BodyParser(&b); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+
+	run, err := getRun(c, ns.ID, p.ID)
+	if err != nil {
+		return err
+	}
+
+	traces, err := getRunSequenceTraces("texts", run.ID, true)
+	if err != nil {
+		return err
+	}
+
+	result := []fiber.Map{}
+	for _, trace := range traces {
+		if !isSequenceTraceRequested(trace, b) {
+			continue
+		}
+
+		recordTotal := sequenceRange{Start: trace.MinStep, Stop: trace.MaxStep + 1}
+		indexTotal := sequenceRange{Start: 0, Stop: trace.MaxIndex + 1}
+		recordUsed, err := parseSequenceRange(q.RecordRange, recordTotal)
+		if err != nil {
+			return err
+		}
+		indexUsed, err := parseSequenceRange(q.IndexRange, indexTotal)
+		if err != nil {
+			return err
+		}
+
+		texts, err := getTextsTrace(trace, recordUsed, indexUsed, q.RecordDensity, q.IndexDensity)
+		if err != nil {
+			return err
+		}
+		texts["record_range"] = recordTotal.toList()
+		texts["index_range"] = indexTotal.toList()
+		result = append(result, texts)
+	}
+
+	return c.JSON(result)
+}
+
+// getTextsTrace samples the texts of the sequence by step and by index within the used ranges.
+func getTextsTrace(
+	trace sequenceTrace, recordRange, indexRange sequenceRange, recordDensity, indexDensity int,
+) (fiber.Map, error) {
+	tx, err := sampleSequenceItems("texts", trace, recordRange, indexRange, recordDensity, indexDensity)
+	if err != nil {
+		return nil, err
+	}
+
+	var texts []database.Text
+	if tx != nil {
+		if err := tx.Order("step").Order("idx").Find(&texts).Error; err != nil {
+			return nil, fmt.Errorf("error getting texts %q: %w", trace.Name, err)
+		}
+	}
+
+	values := [][]fiber.Map{}
+	iters := []int64{}
+	timestamps := []float64{}
+	for n, text := range texts {
+		if n == 0 || text.Step != texts[n-1].Step {
+			values = append(values, []fiber.Map{})
+			iters = append(iters, text.Step)
+			timestamps = append(timestamps, float64(text.Timestamp)/1000)
+		}
+		values[len(values)-1] = append(values[len(values)-1], fiber.Map{
+			"data":  text.Data,
+			"index": text.Index,
+		})
+	}
+
+	return fiber.Map{
+		"name":       trace.Name,
+		"context":    trace.Context,
+		"values":     values,
+		"iters":      iters,
+		"timestamps": timestamps,
+	}, nil
+}
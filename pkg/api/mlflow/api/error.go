@@ -33,6 +33,7 @@ const (
 	ErrorCodeEndpointNotFound       = "ENDPOINT_NOT_FOUND"
 	ErrorCodeResourceAlreadyExists  = "RESOURCE_ALREADY_EXISTS"
 	ErrorCodeResourceDoesNotExist   = "RESOURCE_DOES_NOT_EXIST"
+	ErrorCodePermissionDenied       = "PERMISSION_DENIED"
 )
 
 // NewBadRequestError creates new Response object with ErrorCodeBadRequest.
@@ -80,6 +81,15 @@ func NewResourceAlreadyExistsError(msg string, args ...any) *ErrorResponse {
 	}
 }
 
+// NewPermissionDeniedError creates new Response object with ErrorCodePermissionDenied.
+func NewPermissionDeniedError(msg string, args ...any) *ErrorResponse {
+	return &ErrorResponse{
+		Message:    fmt.Sprintf(msg, args...),
+		ErrorCode:  ErrorCodePermissionDenied,
+		StatusCode: http.StatusForbidden,
+	}
+}
+
 // NewEndpointNotFound creates new Response object with ErrorCodeEndpointNotFound.
 func NewEndpointNotFound(msg string, args ...any) *ErrorResponse {
 	return &ErrorResponse{
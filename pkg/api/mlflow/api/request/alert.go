@@ -0,0 +1,41 @@
+package request
+
+// CreateAlertRuleRequest is a request object for `POST /mlflow/alert-rules/create` endpoint.
+type CreateAlertRuleRequest struct {
+	ExperimentID  string  `json:"experiment_id"`
+	Name          string  `json:"name"`
+	Key           string  `json:"key"`
+	Condition     string  `json:"condition"`
+	Threshold     float64 `json:"threshold"`
+	MinStep       int64   `json:"min_step"`
+	WindowSeconds int64   `json:"window_seconds"`
+}
+
+// UpdateAlertRuleRequest is a request object for `POST /mlflow/alert-rules/update` endpoint.
+type UpdateAlertRuleRequest struct {
+	ID     string `json:"alert_rule_id"`
+	Name   string `json:"name"`
+	Active *bool  `json:"active"`
+}
+
+// GetAlertRuleRequest is a request object for `GET /mlflow/alert-rules/get` endpoint.
+type GetAlertRuleRequest struct {
+	ID string `query:"alert_rule_id"`
+}
+
+// DeleteAlertRuleRequest is a request object for `POST /mlflow/alert-rules/delete` endpoint.
+type DeleteAlertRuleRequest struct {
+	ID string `json:"alert_rule_id"`
+}
+
+// ListAlertRulesRequest is a request object for `GET /mlflow/alert-rules/list` endpoint.
+type ListAlertRulesRequest struct {
+	ExperimentID string `query:"experiment_id"`
+}
+
+// ListAlertsRequest is a request object for `GET /mlflow/alerts/list` endpoint.
+type ListAlertsRequest struct {
+	ExperimentID string `query:"experiment_id"`
+	RunID        string `query:"run_id"`
+	MaxResults   int    `query:"max_results"`
+}
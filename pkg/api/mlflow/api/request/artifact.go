@@ -2,9 +2,12 @@ package request
 
 // ListArtifactsRequest is a request object for `GET /mlflow/artifacts/list` endpoint.
 type ListArtifactsRequest struct {
-	Path    string `query:"path"`
-	RunID   string `query:"run_id"`
-	RunUUID string `query:"run_uuid"`
+	Path       string `query:"path"`
+	RunID      string `query:"run_id"`
+	RunUUID    string `query:"run_uuid"`
+	PageToken  string `query:"page_token"`
+	MaxResults int32  `query:"max_results"`
+	Recursive  bool   `query:"recursive"`
 }
 
 // GetRunID returns Run ID.
@@ -29,3 +32,73 @@ func (r GetArtifactRequest) GetRunID() string {
 	}
 	return r.RunUUID
 }
+
+// DownloadArtifactsRequest is a request object for `GET /mlflow/artifacts/download` endpoint.
+type DownloadArtifactsRequest struct {
+	Path    string `query:"path"`
+	RunID   string `query:"run_id"`
+	RunUUID string `query:"run_uuid"`
+	Format  string `query:"format"`
+}
+
+// GetRunID returns Run ID.
+func (r DownloadArtifactsRequest) GetRunID() string {
+	if r.RunID != "" {
+		return r.RunID
+	}
+	return r.RunUUID
+}
+
+// ListProxiedArtifactsRequest is a request object for `GET /mlflow-artifacts/artifacts` endpoint.
+type ListProxiedArtifactsRequest struct {
+	Path string `query:"path"`
+}
+
+// DownloadProxiedArtifactRequest is a request object for `GET /mlflow-artifacts/artifacts/*` endpoint.
+type DownloadProxiedArtifactRequest struct {
+	Path string
+}
+
+// UploadProxiedArtifactRequest is a request object for `PUT /mlflow-artifacts/artifacts/*` endpoint.
+type UploadProxiedArtifactRequest struct {
+	Path string
+}
+
+// DeleteProxiedArtifactRequest is a request object for `DELETE /mlflow-artifacts/artifacts/*` endpoint.
+type DeleteProxiedArtifactRequest struct {
+	Path string
+}
+
+// CreateMultipartUploadRequest is a request object for `POST /mlflow-artifacts/mpu/create/*` endpoint.
+type CreateMultipartUploadRequest struct {
+	ArtifactPath string `json:"-"`
+	Path         string `json:"path"`
+	NumParts     int32  `json:"num_parts"`
+}
+
+// MultipartUploadPartPartialRequest is a partial request object for different requests.
+type MultipartUploadPartPartialRequest struct {
+	PartNumber int32  `json:"part_number"`
+	ETag       string `json:"etag"`
+	URL        string `json:"url"`
+}
+
+// CompleteMultipartUploadRequest is a request object for `POST /mlflow-artifacts/mpu/complete/*` endpoint.
+type CompleteMultipartUploadRequest struct {
+	ArtifactPath string                              `json:"-"`
+	Path         string                              `json:"path"`
+	UploadID     string                              `json:"upload_id"`
+	Parts        []MultipartUploadPartPartialRequest `json:"parts"`
+}
+
+// AbortMultipartUploadRequest is a request object for `POST /mlflow-artifacts/mpu/abort/*` endpoint.
+type AbortMultipartUploadRequest struct {
+	ArtifactPath string `json:"-"`
+	Path         string `json:"path"`
+	UploadID     string `json:"upload_id"`
+}
+
+// GetPresignedDownloadURLRequest is a request object for `GET /mlflow-artifacts/presigned-download/*` endpoint.
+type GetPresignedDownloadURLRequest struct {
+	Path string
+}
@@ -1,5 +1,13 @@
 package request
 
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/rotisserie/eris"
+)
+
 type ViewType string
 
 const (
@@ -8,6 +16,52 @@ const (
 	ViewTypeDeletedOnly ViewType = "DELETED_ONLY"
 )
 
+// PageToken represents decoded `page_token` of the search requests. Tokens of the old format
+// hold offset of the next page, while keyset tokens hold values of the sort columns of the last
+// returned row, so the next page starts right after that row regardless of the newly created ones.
 type PageToken struct {
-	Offset int32 `json:"offset"`
+	Offset int32 `json:"offset,omitempty"`
+	Keys   []any `json:"keys,omitempty"`
+}
+
+// DecodePageToken decodes base64 encoded JSON page token.
+func DecodePageToken(token string) (*PageToken, error) {
+	var pageToken PageToken
+	decoder := json.NewDecoder(base64.NewDecoder(base64.StdEncoding, strings.NewReader(token)))
+	// keep int64 values, like timestamps, precise.
+	decoder.UseNumber()
+	if err := decoder.Decode(&pageToken); err != nil {
+		return nil, eris.Wrap(err, "error decoding page token")
+	}
+	for i, key := range pageToken.Keys {
+		switch key := key.(type) {
+		case nil, string, bool:
+		case json.Number:
+			if value, err := key.Int64(); err == nil {
+				pageToken.Keys[i] = value
+				continue
+			}
+			value, err := key.Float64()
+			if err != nil {
+				return nil, eris.Wrapf(err, "error decoding page token key '%s'", key)
+			}
+			pageToken.Keys[i] = value
+		default:
+			return nil, eris.Errorf("unsupported page token key '%v'", key)
+		}
+	}
+	return &pageToken, nil
+}
+
+// Encode encodes page token into base64 encoded JSON.
+func (t PageToken) Encode() (string, error) {
+	var token strings.Builder
+	encoder := base64.NewEncoder(base64.StdEncoding, &token)
+	if err := json.NewEncoder(encoder).Encode(t); err != nil {
+		return "", eris.Wrap(err, "error encoding page token")
+	}
+	if err := encoder.Close(); err != nil {
+		return "", eris.Wrap(err, "error encoding page token")
+	}
+	return token.String(), nil
 }
@@ -51,3 +51,8 @@ type SearchExperimentsRequest struct {
 	OrderBy    []string `json:"order_by"    query:"order_by"`
 	ViewType   ViewType `json:"view_type"   query:"view_type"`
 }
+
+// SearchDatasetsRequest is a request object for `POST mlflow/experiments/search-datasets` endpoint.
+type SearchDatasetsRequest struct {
+	ExperimentIDs []string `json:"experiment_ids"`
+}
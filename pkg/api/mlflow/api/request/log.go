@@ -19,6 +19,7 @@ type MetricPartialRequest struct {
 	Timestamp int64          `json:"timestamp"`
 	Step      int64          `json:"step"`
 	Context   map[string]any `json:"context"`
+	ModelID   string         `json:"model_id"`
 }
 
 // LogParamRequest is a request object for `POST mlflow/runs/log-parameter` endpoint.
@@ -46,6 +47,7 @@ type LogMetricRequest struct {
 	Timestamp int64          `json:"timestamp"`
 	Step      int64          `json:"step"`
 	Context   map[string]any `json:"context"`
+	ModelID   string         `json:"model_id"`
 }
 
 // GetRunID returns Run ID.
@@ -63,3 +65,31 @@ type LogBatchRequest struct {
 	Params  []ParamPartialRequest  `json:"params,omitempty"`
 	Metrics []MetricPartialRequest `json:"metrics,omitempty"`
 }
+
+// DatasetPartialRequest is a partial request object for different requests.
+type DatasetPartialRequest struct {
+	Name       string `json:"name"`
+	Digest     string `json:"digest"`
+	SourceType string `json:"source_type"`
+	Source     string `json:"source"`
+	Schema     string `json:"schema,omitempty"`
+	Profile    string `json:"profile,omitempty"`
+}
+
+// InputTagPartialRequest is a partial request object for different requests.
+type InputTagPartialRequest struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// DatasetInputPartialRequest is a partial request object for different requests.
+type DatasetInputPartialRequest struct {
+	Tags    []InputTagPartialRequest `json:"tags,omitempty"`
+	Dataset DatasetPartialRequest    `json:"dataset"`
+}
+
+// LogInputsRequest is a request object for `POST mlflow/runs/log-inputs` endpoint.
+type LogInputsRequest struct {
+	RunID    string                       `json:"run_id"`
+	Datasets []DatasetInputPartialRequest `json:"datasets,omitempty"`
+}
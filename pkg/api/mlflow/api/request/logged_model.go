@@ -0,0 +1,73 @@
+package request
+
+// LoggedModelParamPartialRequest is a partial request object for different requests.
+type LoggedModelParamPartialRequest struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// LoggedModelTagPartialRequest is a partial request object for different requests.
+type LoggedModelTagPartialRequest struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// LoggedModelOrderByPartialRequest is a partial request object for SearchLoggedModelsRequest.
+type LoggedModelOrderByPartialRequest struct {
+	FieldName string `json:"field_name"`
+	Ascending *bool  `json:"ascending"`
+}
+
+// CreateLoggedModelRequest is a request object for `POST /mlflow/logged-models` endpoint.
+type CreateLoggedModelRequest struct {
+	ExperimentID string                           `json:"experiment_id"`
+	Name         string                           `json:"name"`
+	ModelType    string                           `json:"model_type"`
+	SourceRunID  string                           `json:"source_run_id"`
+	Params       []LoggedModelParamPartialRequest `json:"params"`
+	Tags         []LoggedModelTagPartialRequest   `json:"tags"`
+}
+
+// GetLoggedModelRequest is a request object for `GET /mlflow/logged-models/:model_id` endpoint.
+type GetLoggedModelRequest struct {
+	ModelID string `json:"model_id"`
+}
+
+// FinalizeLoggedModelRequest is a request object for `PATCH /mlflow/logged-models/:model_id` endpoint.
+type FinalizeLoggedModelRequest struct {
+	ModelID string `json:"model_id"`
+	Status  string `json:"status"`
+}
+
+// DeleteLoggedModelRequest is a request object for `DELETE /mlflow/logged-models/:model_id` endpoint.
+type DeleteLoggedModelRequest struct {
+	ModelID string `json:"model_id"`
+}
+
+// SetLoggedModelTagsRequest is a request object for `PATCH /mlflow/logged-models/:model_id/tags` endpoint.
+type SetLoggedModelTagsRequest struct {
+	ModelID string                         `json:"model_id"`
+	Tags    []LoggedModelTagPartialRequest `json:"tags"`
+}
+
+// DeleteLoggedModelTagRequest is a request object for
+// `DELETE /mlflow/logged-models/:model_id/tags/:tag_key` endpoint.
+type DeleteLoggedModelTagRequest struct {
+	ModelID string `json:"model_id"`
+	TagKey  string `json:"tag_key"`
+}
+
+// LogLoggedModelParamsRequest is a request object for `POST /mlflow/logged-models/:model_id/params` endpoint.
+type LogLoggedModelParamsRequest struct {
+	ModelID string                           `json:"model_id"`
+	Params  []LoggedModelParamPartialRequest `json:"params"`
+}
+
+// SearchLoggedModelsRequest is a request object for `POST /mlflow/logged-models/search` endpoint.
+type SearchLoggedModelsRequest struct {
+	ExperimentIDs []string                           `json:"experiment_ids"`
+	Filter        string                             `json:"filter"`
+	MaxResults    int                                `json:"max_results"`
+	OrderBy       []LoggedModelOrderByPartialRequest `json:"order_by"`
+	PageToken     string                             `json:"page_token"`
+}
@@ -2,9 +2,12 @@ package request
 
 // GetMetricHistoryRequest is a request object for `GET /mlflow/metrics/get-history` endpoint.
 type GetMetricHistoryRequest struct {
-	RunID     string `query:"run_id"`
-	RunUUID   string `query:"run_uuid"`
-	MetricKey string `query:"metric_key"`
+	RunID      string `query:"run_id"`
+	RunUUID    string `query:"run_uuid"`
+	MetricKey  string `query:"metric_key"`
+	MaxResults int    `query:"max_results"`
+	PageToken  string `query:"page_token"`
+	Context    string `query:"context"`
 }
 
 // GetRunID returns Run RunID.
@@ -20,6 +23,18 @@ type GetMetricHistoryBulkRequest struct {
 	RunIDs     []string `query:"run_id"`
 	MetricKey  string   `query:"metric_key"`
 	MaxResults int      `query:"max_results"`
+	PageToken  string   `query:"page_token"`
+	Context    string   `query:"context"`
+}
+
+// GetMetricHistoryBulkIntervalRequest is a request object for
+// `GET /mlflow/metrics/get-history-bulk-interval` endpoint.
+type GetMetricHistoryBulkIntervalRequest struct {
+	RunIDs     []string `query:"run_ids"`
+	MetricKey  string   `query:"metric_key"`
+	StartStep  *int64   `query:"start_step"`
+	EndStep    *int64   `query:"end_step"`
+	MaxResults int      `query:"max_results"`
 }
 
 // GetMetricHistoriesRequest is a request object for `POST /mlflow/metrics/get-histories` endpoint.
@@ -31,3 +46,18 @@ type GetMetricHistoriesRequest struct {
 	MaxResults    int32             `json:"max_results"`
 	Context       map[string]string `json:"context"`
 }
+
+// DeleteMetricRequest is a request object for `POST /mlflow/metrics/delete` endpoint.
+type DeleteMetricRequest struct {
+	RunID   string            `json:"run_id"`
+	Key     string            `json:"key"`
+	Context map[string]string `json:"context"`
+}
+
+// TruncateMetricsRequest is a request object for `POST /mlflow/metrics/truncate` endpoint.
+type TruncateMetricsRequest struct {
+	RunID   string            `json:"run_id"`
+	Key     string            `json:"key"`
+	Context map[string]string `json:"context"`
+	Step    *int64            `json:"step"`
+}
@@ -0,0 +1,194 @@
+package request
+
+// RegisteredModelTagPartialRequest is a partial request object for different requests.
+type RegisteredModelTagPartialRequest struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ModelVersionTagPartialRequest is a partial request object for different requests.
+type ModelVersionTagPartialRequest struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// CreateRegisteredModelRequest is a request object for `POST /mlflow/registered-models/create` endpoint.
+type CreateRegisteredModelRequest struct {
+	Name        string                             `json:"name"`
+	Tags        []RegisteredModelTagPartialRequest `json:"tags"`
+	Description string                             `json:"description"`
+}
+
+// GetRegisteredModelRequest is a request object for `GET /mlflow/registered-models/get` endpoint.
+type GetRegisteredModelRequest struct {
+	Name string `query:"name"`
+}
+
+// RenameRegisteredModelRequest is a request object for `POST /mlflow/registered-models/rename` endpoint.
+type RenameRegisteredModelRequest struct {
+	Name    string `json:"name"`
+	NewName string `json:"new_name"`
+}
+
+// UpdateRegisteredModelRequest is a request object for `PATCH /mlflow/registered-models/update` endpoint.
+type UpdateRegisteredModelRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// DeleteRegisteredModelRequest is a request object for `DELETE /mlflow/registered-models/delete` endpoint.
+type DeleteRegisteredModelRequest struct {
+	Name string `json:"name"`
+}
+
+// SearchRegisteredModelsRequest is a request object for `GET /mlflow/registered-models/search` endpoint.
+type SearchRegisteredModelsRequest struct {
+	Filter     string   `json:"filter"      query:"filter"`
+	MaxResults int64    `json:"max_results" query:"max_results"`
+	OrderBy    []string `json:"order_by"    query:"order_by"`
+	PageToken  string   `json:"page_token"  query:"page_token"`
+}
+
+// GetLatestVersionsRequest is a request object for
+// `POST /mlflow/registered-models/get-latest-versions` or `GET /mlflow/registered-models/get-latest-versions` endpoints.
+type GetLatestVersionsRequest struct {
+	Name   string   `json:"name"   query:"name"`
+	Stages []string `json:"stages" query:"stages"`
+}
+
+// SetRegisteredModelTagRequest is a request object for `POST /mlflow/registered-models/set-tag` endpoint.
+type SetRegisteredModelTagRequest struct {
+	Name  string `json:"name"`
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// DeleteRegisteredModelTagRequest is a request object for `DELETE /mlflow/registered-models/delete-tag` endpoint.
+type DeleteRegisteredModelTagRequest struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// SetRegisteredModelAliasRequest is a request object for `POST /mlflow/registered-models/alias` endpoint.
+type SetRegisteredModelAliasRequest struct {
+	Name    string `json:"name"`
+	Alias   string `json:"alias"`
+	Version string `json:"version"`
+}
+
+// DeleteRegisteredModelAliasRequest is a request object for `DELETE /mlflow/registered-models/alias` endpoint.
+type DeleteRegisteredModelAliasRequest struct {
+	Name  string `json:"name"`
+	Alias string `json:"alias"`
+}
+
+// GetModelVersionByAliasRequest is a request object for `GET /mlflow/registered-models/alias` endpoint.
+type GetModelVersionByAliasRequest struct {
+	Name  string `query:"name"`
+	Alias string `query:"alias"`
+}
+
+// CreateModelVersionRequest is a request object for `POST /mlflow/model-versions/create` endpoint.
+type CreateModelVersionRequest struct {
+	Name        string                          `json:"name"`
+	Source      string                          `json:"source"`
+	RunID       string                          `json:"run_id"`
+	Tags        []ModelVersionTagPartialRequest `json:"tags"`
+	RunLink     string                          `json:"run_link"`
+	Description string                          `json:"description"`
+}
+
+// GetModelVersionRequest is a request object for `GET /mlflow/model-versions/get` endpoint.
+type GetModelVersionRequest struct {
+	Name    string `query:"name"`
+	Version string `query:"version"`
+}
+
+// UpdateModelVersionRequest is a request object for `PATCH /mlflow/model-versions/update` endpoint.
+type UpdateModelVersionRequest struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Description string `json:"description"`
+}
+
+// DeleteModelVersionRequest is a request object for `DELETE /mlflow/model-versions/delete` endpoint.
+type DeleteModelVersionRequest struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// SearchModelVersionsRequest is a request object for `GET /mlflow/model-versions/search` endpoint.
+type SearchModelVersionsRequest struct {
+	Filter     string   `json:"filter"      query:"filter"`
+	MaxResults int64    `json:"max_results" query:"max_results"`
+	OrderBy    []string `json:"order_by"    query:"order_by"`
+	PageToken  string   `json:"page_token"  query:"page_token"`
+}
+
+// GetModelVersionDownloadURIRequest is a request object for `GET /mlflow/model-versions/get-download-uri` endpoint.
+type GetModelVersionDownloadURIRequest struct {
+	Name    string `query:"name"`
+	Version string `query:"version"`
+}
+
+// SetModelVersionTagRequest is a request object for `POST /mlflow/model-versions/set-tag` endpoint.
+type SetModelVersionTagRequest struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+}
+
+// DeleteModelVersionTagRequest is a request object for `DELETE /mlflow/model-versions/delete-tag` endpoint.
+type DeleteModelVersionTagRequest struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Key     string `json:"key"`
+}
+
+// TransitionModelVersionStageRequest is a request object for `POST /mlflow/model-versions/transition-stage` endpoint.
+type TransitionModelVersionStageRequest struct {
+	Name                    string `json:"name"`
+	Version                 string `json:"version"`
+	Stage                   string `json:"stage"`
+	ArchiveExistingVersions bool   `json:"archive_existing_versions"`
+	Comment                 string `json:"comment"`
+}
+
+// GetModelVersionTransitionHistoryRequest is a request object for
+// `GET /mlflow/model-versions/transition-history` endpoint.
+type GetModelVersionTransitionHistoryRequest struct {
+	Name    string `query:"name"`
+	Version string `query:"version"`
+}
+
+// CreateTransitionRequestRequest is a request object for `POST /mlflow/transition-requests/create` endpoint.
+type CreateTransitionRequestRequest struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Stage   string `json:"stage"`
+	Comment string `json:"comment"`
+}
+
+// ListTransitionRequestsRequest is a request object for `GET /mlflow/transition-requests/list` endpoint.
+type ListTransitionRequestsRequest struct {
+	Name    string `query:"name"`
+	Version string `query:"version"`
+}
+
+// ApproveTransitionRequestRequest is a request object for `POST /admin/transition-requests/approve` endpoint.
+type ApproveTransitionRequestRequest struct {
+	Name                    string `json:"name"`
+	Version                 string `json:"version"`
+	Stage                   string `json:"stage"`
+	ArchiveExistingVersions bool   `json:"archive_existing_versions"`
+	Comment                 string `json:"comment"`
+}
+
+// RejectTransitionRequestRequest is a request object for `POST /admin/transition-requests/reject` endpoint.
+type RejectTransitionRequestRequest struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Stage   string `json:"stage"`
+	Comment string `json:"comment"`
+}
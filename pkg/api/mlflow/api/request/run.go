@@ -46,6 +46,20 @@ func (r UpdateRunRequest) GetRunID() string {
 	return r.RunUUID
 }
 
+// RunHeartbeatRequest is a request object for `POST /mlflow/runs/heartbeat` endpoint.
+type RunHeartbeatRequest struct {
+	RunID   string `json:"run_id"`
+	RunUUID string `json:"run_uuid"`
+}
+
+// GetRunID returns Run RunID.
+func (r RunHeartbeatRequest) GetRunID() string {
+	if r.RunID != "" {
+		return r.RunID
+	}
+	return r.RunUUID
+}
+
 // SearchRunsRequest is a request object for `POST /mlflow/runs/search` endpoint.
 type SearchRunsRequest struct {
 	ExperimentIDs []string `json:"experiment_ids"`
@@ -87,3 +101,20 @@ type DeleteRunTagRequest struct {
 	RunID string `json:"run_id"`
 	Key   string `json:"key"`
 }
+
+// MoveRunRequest is a request object for `POST /mlflow/runs/move` endpoint.
+type MoveRunRequest struct {
+	RunID         string `json:"run_id"`
+	ExperimentID  string `json:"experiment_id"`
+	Namespace     string `json:"namespace"`
+	MoveArtifacts bool   `json:"move_artifacts"`
+}
+
+// CloneRunRequest is a request object for `POST /mlflow/runs/clone` endpoint.
+type CloneRunRequest struct {
+	RunID         string `json:"run_id"`
+	ExperimentID  string `json:"experiment_id"`
+	Namespace     string `json:"namespace"`
+	Name          string `json:"run_name"`
+	CopyArtifacts bool   `json:"copy_artifacts"`
+}
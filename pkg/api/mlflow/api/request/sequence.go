@@ -0,0 +1,55 @@
+package request
+
+// ImagePartialRequest is a partial request object for `POST mlflow/runs/log-images` endpoint.
+type ImagePartialRequest struct {
+	Name      string         `json:"name"`
+	Context   map[string]any `json:"context"`
+	Step      int64          `json:"step"`
+	Index     int64          `json:"index"`
+	Timestamp int64          `json:"timestamp"`
+	Caption   string         `json:"caption"`
+	Format    string         `json:"format"`
+	Width     int64          `json:"width"`
+	Height    int64          `json:"height"`
+	BlobURI   string         `json:"blob_uri"`
+}
+
+// LogImagesRequest is a request object for `POST mlflow/runs/log-images` endpoint.
+// Image content has to be uploaded into the run artifacts beforehand, `blob_uri` is its artifact path.
+type LogImagesRequest struct {
+	RunID  string                `json:"run_id"`
+	Images []ImagePartialRequest `json:"images"`
+}
+
+// TextPartialRequest is a partial request object for `POST mlflow/runs/log-texts` endpoint.
+type TextPartialRequest struct {
+	Name      string         `json:"name"`
+	Context   map[string]any `json:"context"`
+	Step      int64          `json:"step"`
+	Index     int64          `json:"index"`
+	Timestamp int64          `json:"timestamp"`
+	Data      string         `json:"data"`
+}
+
+// LogTextsRequest is a request object for `POST mlflow/runs/log-texts` endpoint.
+type LogTextsRequest struct {
+	RunID string               `json:"run_id"`
+	Texts []TextPartialRequest `json:"texts"`
+}
+
+// DistributionPartialRequest is a partial request object for `POST mlflow/runs/log-distributions` endpoint.
+// Histogram is described by `len(counts) + 1` bin edges and by the counts of the values in every bin.
+type DistributionPartialRequest struct {
+	Name      string         `json:"name"`
+	Context   map[string]any `json:"context"`
+	Step      int64          `json:"step"`
+	Timestamp int64          `json:"timestamp"`
+	BinEdges  []float64      `json:"bin_edges"`
+	Counts    []float64      `json:"counts"`
+}
+
+// LogDistributionsRequest is a request object for `POST mlflow/runs/log-distributions` endpoint.
+type LogDistributionsRequest struct {
+	RunID         string                       `json:"run_id"`
+	Distributions []DistributionPartialRequest `json:"distributions"`
+}
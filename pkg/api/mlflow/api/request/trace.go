@@ -0,0 +1,70 @@
+package request
+
+// TraceTagPartialRequest is a partial request object for different requests.
+type TraceTagPartialRequest struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// TraceRequestMetadataPartialRequest is a partial request object for different requests.
+type TraceRequestMetadataPartialRequest struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// StartTraceRequest is a request object for `POST /mlflow/traces` endpoint.
+type StartTraceRequest struct {
+	ExperimentID    string                               `json:"experiment_id"`
+	TimestampMS     int64                                `json:"timestamp_ms"`
+	RequestMetadata []TraceRequestMetadataPartialRequest `json:"request_metadata"`
+	Tags            []TraceTagPartialRequest             `json:"tags"`
+}
+
+// EndTraceRequest is a request object for `PATCH /mlflow/traces/:request_id` endpoint.
+type EndTraceRequest struct {
+	RequestID       string                               `json:"request_id"`
+	TimestampMS     int64                                `json:"timestamp_ms"`
+	Status          string                               `json:"status"`
+	RequestMetadata []TraceRequestMetadataPartialRequest `json:"request_metadata"`
+	Tags            []TraceTagPartialRequest             `json:"tags"`
+}
+
+// GetTraceInfoRequest is a request object for `GET /mlflow/traces/:request_id/info` endpoint.
+type GetTraceInfoRequest struct {
+	RequestID string `query:"request_id"`
+}
+
+// SearchTracesRequest is a request object for `GET /mlflow/traces` endpoint.
+type SearchTracesRequest struct {
+	ExperimentIDs []string `query:"experiment_ids"`
+	Filter        string   `query:"filter"`
+	MaxResults    int      `query:"max_results"`
+	OrderBy       []string `query:"order_by"`
+	PageToken     string   `query:"page_token"`
+}
+
+// DeleteTracesRequest is a request object for `POST /mlflow/traces/delete-traces` endpoint.
+type DeleteTracesRequest struct {
+	ExperimentID       string   `json:"experiment_id"`
+	MaxTimestampMillis int64    `json:"max_timestamp_millis"`
+	MaxTraces          int      `json:"max_traces"`
+	RequestIDs         []string `json:"request_ids"`
+}
+
+// SetTraceTagRequest is a request object for `PATCH /mlflow/traces/:request_id/tags` endpoint.
+type SetTraceTagRequest struct {
+	RequestID string `json:"request_id"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+}
+
+// DeleteTraceTagRequest is a request object for `DELETE /mlflow/traces/:request_id/tags` endpoint.
+type DeleteTraceTagRequest struct {
+	RequestID string `json:"request_id" query:"request_id"`
+	Key       string `json:"key"        query:"key"`
+}
+
+// GetTraceArtifactRequest is a request object for `GET /mlflow/get-trace-artifact` endpoint.
+type GetTraceArtifactRequest struct {
+	RequestID string `query:"request_id"`
+}
@@ -0,0 +1,125 @@
+package response
+
+import (
+	"fmt"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// AlertRulePartialResponse is a partial response object for different responses.
+type AlertRulePartialResponse struct {
+	ID             string  `json:"alert_rule_id"`
+	ExperimentID   string  `json:"experiment_id"`
+	Name           string  `json:"name"`
+	Key            string  `json:"key"`
+	Condition      string  `json:"condition"`
+	Threshold      float64 `json:"threshold"`
+	MinStep        int64   `json:"min_step"`
+	WindowSeconds  int64   `json:"window_seconds"`
+	Active         bool    `json:"active"`
+	CreationTime   int64   `json:"creation_time"`
+	LastUpdateTime int64   `json:"last_update_time"`
+}
+
+// NewAlertRulePartialResponse is a helper function for the different alert rule responses.
+func NewAlertRulePartialResponse(rule *models.AlertRule) *AlertRulePartialResponse {
+	return &AlertRulePartialResponse{
+		ID:             fmt.Sprint(rule.ID),
+		ExperimentID:   fmt.Sprint(rule.ExperimentID),
+		Name:           rule.Name,
+		Key:            rule.Key,
+		Condition:      string(rule.Condition),
+		Threshold:      rule.Threshold,
+		MinStep:        rule.MinStep,
+		WindowSeconds:  rule.WindowSeconds,
+		Active:         rule.Active,
+		CreationTime:   rule.CreationTime,
+		LastUpdateTime: rule.LastUpdateTime,
+	}
+}
+
+// AlertRuleResponse is a response object for `POST /mlflow/alert-rules/create`,
+// `POST /mlflow/alert-rules/update` and `GET /mlflow/alert-rules/get` endpoints.
+type AlertRuleResponse struct {
+	AlertRule *AlertRulePartialResponse `json:"alert_rule"`
+}
+
+// NewAlertRuleResponse creates new AlertRuleResponse object.
+func NewAlertRuleResponse(rule *models.AlertRule) *AlertRuleResponse {
+	return &AlertRuleResponse{
+		AlertRule: NewAlertRulePartialResponse(rule),
+	}
+}
+
+// ListAlertRulesResponse is a response object for `GET /mlflow/alert-rules/list` endpoint.
+type ListAlertRulesResponse struct {
+	AlertRules []*AlertRulePartialResponse `json:"alert_rules"`
+}
+
+// NewListAlertRulesResponse creates new ListAlertRulesResponse object.
+func NewListAlertRulesResponse(rules []models.AlertRule) *ListAlertRulesResponse {
+	resp := ListAlertRulesResponse{
+		AlertRules: make([]*AlertRulePartialResponse, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		//nolint:gosec
+		resp.AlertRules = append(resp.AlertRules, NewAlertRulePartialResponse(&rule))
+	}
+	return &resp
+}
+
+// AlertPartialResponse is a partial response object for different responses.
+type AlertPartialResponse struct {
+	ID            string `json:"alert_id"`
+	AlertRuleID   string `json:"alert_rule_id"`
+	AlertRuleName string `json:"alert_rule_name"`
+	ExperimentID  string `json:"experiment_id"`
+	RunID         string `json:"run_id"`
+	Key           string `json:"key"`
+	Value         any    `json:"value"`
+	Step          int64  `json:"step"`
+	Timestamp     int64  `json:"timestamp"`
+	Message       string `json:"message"`
+	CreationTime  int64  `json:"creation_time"`
+	DeliveredTime int64  `json:"delivered_time,omitempty"`
+}
+
+// NewAlertPartialResponse is a helper function for the different alert responses.
+func NewAlertPartialResponse(alert *models.Alert) *AlertPartialResponse {
+	resp := AlertPartialResponse{
+		ID:            alert.ID,
+		AlertRuleID:   fmt.Sprint(alert.AlertRuleID),
+		AlertRuleName: alert.AlertRule.Name,
+		ExperimentID:  fmt.Sprint(alert.AlertRule.ExperimentID),
+		RunID:         alert.RunID,
+		Key:           alert.Key,
+		Value:         alert.Value,
+		Step:          alert.Step,
+		Timestamp:     alert.Timestamp,
+		Message:       alert.Message,
+		CreationTime:  alert.CreationTime,
+		DeliveredTime: alert.DeliveredTime.Int64,
+	}
+	if alert.IsNan {
+		resp.Value = common.NANValue
+	}
+	return &resp
+}
+
+// ListAlertsResponse is a response object for `GET /mlflow/alerts/list` endpoint.
+type ListAlertsResponse struct {
+	Alerts []*AlertPartialResponse `json:"alerts"`
+}
+
+// NewListAlertsResponse creates new ListAlertsResponse object.
+func NewListAlertsResponse(alerts []models.Alert) *ListAlertsResponse {
+	resp := ListAlertsResponse{
+		Alerts: make([]*AlertPartialResponse, 0, len(alerts)),
+	}
+	for _, alert := range alerts {
+		//nolint:gosec
+		resp.Alerts = append(resp.Alerts, NewAlertPartialResponse(&alert))
+	}
+	return &resp
+}
@@ -1,6 +1,10 @@
 package response
 
-import "github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+import (
+	"path/filepath"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+)
 
 // FilePartialResponse is a partial response object for different responses.
 type FilePartialResponse struct {
@@ -11,17 +15,19 @@ type FilePartialResponse struct {
 
 // ListArtifactsResponse is a response object for `GET mlflow/artifacts/list` endpoint.
 type ListArtifactsResponse struct {
-	Files   []FilePartialResponse `json:"files"`
-	RootURI string                `json:"root_uri"`
+	Files         []FilePartialResponse `json:"files"`
+	RootURI       string                `json:"root_uri"`
+	NextPageToken string                `json:"next_page_token,omitempty"`
 }
 
 // NewListArtifactsResponse creates new instance of ListArtifactsResponse.
 func NewListArtifactsResponse(
-	rootURI string, artifacts []storage.ArtifactObject,
+	rootURI string, artifacts []storage.ArtifactObject, nextPageToken string,
 ) *ListArtifactsResponse {
 	response := ListArtifactsResponse{
-		Files:   make([]FilePartialResponse, len(artifacts)),
-		RootURI: rootURI,
+		Files:         make([]FilePartialResponse, len(artifacts)),
+		RootURI:       rootURI,
+		NextPageToken: nextPageToken,
 	}
 
 	for i, artifact := range artifacts {
@@ -34,3 +40,75 @@ func NewListArtifactsResponse(
 
 	return &response
 }
+
+// ListProxiedArtifactsResponse is a response object for `GET /mlflow-artifacts/artifacts` endpoint.
+type ListProxiedArtifactsResponse struct {
+	Files []FilePartialResponse `json:"files"`
+}
+
+// NewListProxiedArtifactsResponse creates new instance of ListProxiedArtifactsResponse.
+// Paths of artifacts are relative to the requested path, so only base names are returned.
+func NewListProxiedArtifactsResponse(artifacts []storage.ArtifactObject) *ListProxiedArtifactsResponse {
+	response := ListProxiedArtifactsResponse{
+		Files: make([]FilePartialResponse, len(artifacts)),
+	}
+
+	for i, artifact := range artifacts {
+		response.Files[i] = FilePartialResponse{
+			Path:     filepath.Base(artifact.GetPath()),
+			IsDir:    artifact.IsDirectory(),
+			FileSize: artifact.GetSize(),
+		}
+	}
+
+	return &response
+}
+
+// MultipartUploadCredentialPartialResponse is a partial response object for different responses.
+type MultipartUploadCredentialPartialResponse struct {
+	URL        string            `json:"url"`
+	PartNumber int32             `json:"part_number"`
+	Headers    map[string]string `json:"headers"`
+}
+
+// CreateMultipartUploadResponse is a response object for `POST /mlflow-artifacts/mpu/create/*` endpoint.
+type CreateMultipartUploadResponse struct {
+	UploadID    string                                     `json:"upload_id"`
+	Credentials []MultipartUploadCredentialPartialResponse `json:"credentials"`
+}
+
+// NewCreateMultipartUploadResponse creates new instance of CreateMultipartUploadResponse.
+func NewCreateMultipartUploadResponse(upload *storage.MultipartUpload) *CreateMultipartUploadResponse {
+	response := CreateMultipartUploadResponse{
+		UploadID:    upload.UploadID,
+		Credentials: make([]MultipartUploadCredentialPartialResponse, len(upload.Credentials)),
+	}
+
+	for i, credential := range upload.Credentials {
+		headers := credential.Headers
+		if headers == nil {
+			headers = map[string]string{}
+		}
+		response.Credentials[i] = MultipartUploadCredentialPartialResponse{
+			URL:        credential.URL,
+			PartNumber: credential.PartNumber,
+			Headers:    headers,
+		}
+	}
+
+	return &response
+}
+
+// GetPresignedDownloadURLResponse is a response object for `GET /mlflow-artifacts/presigned-download/*` endpoint.
+type GetPresignedDownloadURLResponse struct {
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers"`
+}
+
+// NewGetPresignedDownloadURLResponse creates new instance of GetPresignedDownloadURLResponse.
+func NewGetPresignedDownloadURLResponse(url string) *GetPresignedDownloadURLResponse {
+	return &GetPresignedDownloadURLResponse{
+		URL:     url,
+		Headers: map[string]string{},
+	}
+}
@@ -20,7 +20,7 @@ func TestNewListArtifactsResponse_Ok(t *testing.T) {
 			Size:  0,
 			IsDir: true,
 		},
-	})
+	}, "token")
 
 	assert.Equal(t, &ListArtifactsResponse{
 		Files: []FilePartialResponse{
@@ -35,6 +35,70 @@ func TestNewListArtifactsResponse_Ok(t *testing.T) {
 				FileSize: 0,
 			},
 		},
-		RootURI: "rootUri",
+		RootURI:       "rootUri",
+		NextPageToken: "token",
+	}, response)
+}
+
+func TestNewListProxiedArtifactsResponse_Ok(t *testing.T) {
+	response := NewListProxiedArtifactsResponse([]storage.ArtifactObject{
+		{
+			Path:  "dir/path1",
+			Size:  1234567890,
+			IsDir: false,
+		},
+		{
+			Path:  "dir/path2",
+			Size:  0,
+			IsDir: true,
+		},
+	})
+
+	assert.Equal(t, &ListProxiedArtifactsResponse{
+		Files: []FilePartialResponse{
+			{
+				Path:     "path1",
+				IsDir:    false,
+				FileSize: 1234567890,
+			},
+			{
+				Path:     "path2",
+				IsDir:    true,
+				FileSize: 0,
+			},
+		},
+	}, response)
+}
+
+func TestNewCreateMultipartUploadResponse_Ok(t *testing.T) {
+	response := NewCreateMultipartUploadResponse(&storage.MultipartUpload{
+		UploadID: "upload-id",
+		Credentials: []storage.MultipartUploadCredential{
+			{
+				URL:        "https://bucket/key?partNumber=1",
+				PartNumber: 1,
+			},
+			{
+				URL:        "https://bucket/key?partNumber=2",
+				PartNumber: 2,
+				Headers:    map[string]string{"key": "value"},
+			},
+		},
+	})
+
+	assert.Equal(t, &CreateMultipartUploadResponse{
+		UploadID: "upload-id",
+		Credentials: []MultipartUploadCredentialPartialResponse{
+			{
+				URL:        "https://bucket/key?partNumber=1",
+				PartNumber: 1,
+				Headers:    map[string]string{},
+			},
+			{
+				URL:        "https://bucket/key?partNumber=2",
+				PartNumber: 2,
+				Headers:    map[string]string{"key": "value"},
+			},
+		},
 	}, response)
 }
@@ -1,10 +1,7 @@
 package response
 
 import (
-	"encoding/base64"
-	"encoding/json"
 	"fmt"
-	"strings"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 
@@ -62,24 +59,19 @@ type SearchExperimentsResponse struct {
 
 // NewSearchExperimentsResponse  creates new SearchExperimentsResponse object.
 func NewSearchExperimentsResponse(
-	experiments []models.Experiment, limit, offset int,
+	experiments []models.Experiment, nextPageToken *request.PageToken,
 ) (*SearchExperimentsResponse, error) {
+	resp := SearchExperimentsResponse{}
+
 	// encode `nextPageToken` value.
-	var token strings.Builder
-	if len(experiments) > limit {
-		experiments = experiments[:limit]
-		if err := json.NewEncoder(
-			base64.NewEncoder(base64.StdEncoding, &token),
-		).Encode(request.PageToken{
-			Offset: int32(offset + limit),
-		}); err != nil {
+	if nextPageToken != nil {
+		token, err := nextPageToken.Encode()
+		if err != nil {
 			return nil, eris.Wrap(err, "error encoding 'nextPageToken' value")
 		}
+		resp.NextPageToken = token
 	}
 
-	resp := SearchExperimentsResponse{
-		NextPageToken: token.String(),
-	}
 	// transform each models.Experiment entity.
 	for _, experiment := range experiments {
 		//nolint:gosec
@@ -110,3 +102,32 @@ func NewExperimentPartialResponse(experiment *models.Experiment) *ExperimentPart
 		Tags:             tags,
 	}
 }
+
+// DatasetSummaryPartialResponse is a partial response object for different responses.
+type DatasetSummaryPartialResponse struct {
+	ExperimentID string `json:"experiment_id"`
+	Name         string `json:"name"`
+	Digest       string `json:"digest"`
+	Context      string `json:"context,omitempty"`
+}
+
+// SearchDatasetsResponse is a response object for `POST mlflow/experiments/search-datasets` endpoint.
+type SearchDatasetsResponse struct {
+	DatasetSummaries []DatasetSummaryPartialResponse `json:"dataset_summaries"`
+}
+
+// NewSearchDatasetsResponse creates new SearchDatasetsResponse object.
+func NewSearchDatasetsResponse(summaries []models.DatasetSummary) *SearchDatasetsResponse {
+	resp := SearchDatasetsResponse{
+		DatasetSummaries: make([]DatasetSummaryPartialResponse, len(summaries)),
+	}
+	for n, summary := range summaries {
+		resp.DatasetSummaries[n] = DatasetSummaryPartialResponse{
+			ExperimentID: fmt.Sprint(summary.ExperimentID),
+			Name:         summary.Name,
+			Digest:       summary.Digest,
+			Context:      summary.Context,
+		}
+	}
+	return &resp
+}
@@ -0,0 +1,157 @@
+package response
+
+import (
+	"fmt"
+
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// LoggedModelTagPartialResponse is a partial response object for different responses.
+type LoggedModelTagPartialResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// LoggedModelParamPartialResponse is a partial response object for different responses.
+type LoggedModelParamPartialResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// LoggedModelMetricPartialResponse is a partial response object for different responses.
+type LoggedModelMetricPartialResponse struct {
+	Key       string `json:"key"`
+	Value     any    `json:"value"`
+	Timestamp int64  `json:"timestamp"`
+	Step      int64  `json:"step"`
+	RunID     string `json:"run_id"`
+	ModelID   string `json:"model_id"`
+}
+
+// LoggedModelInfoPartialResponse is a partial response object for different responses.
+type LoggedModelInfoPartialResponse struct {
+	ModelID                string                          `json:"model_id"`
+	ExperimentID           string                          `json:"experiment_id"`
+	Name                   string                          `json:"name"`
+	CreationTimestampMS    int64                           `json:"creation_timestamp_ms"`
+	LastUpdatedTimestampMS int64                           `json:"last_updated_timestamp_ms"`
+	ArtifactURI            string                          `json:"artifact_uri"`
+	Status                 string                          `json:"status"`
+	StatusMessage          string                          `json:"status_message,omitempty"`
+	ModelType              string                          `json:"model_type,omitempty"`
+	SourceRunID            string                          `json:"source_run_id,omitempty"`
+	Tags                   []LoggedModelTagPartialResponse `json:"tags"`
+}
+
+// LoggedModelDataPartialResponse is a partial response object for different responses.
+type LoggedModelDataPartialResponse struct {
+	Params  []LoggedModelParamPartialResponse  `json:"params"`
+	Metrics []LoggedModelMetricPartialResponse `json:"metrics"`
+}
+
+// LoggedModelPartialResponse is a partial response object for different responses.
+type LoggedModelPartialResponse struct {
+	Info LoggedModelInfoPartialResponse `json:"info"`
+	Data LoggedModelDataPartialResponse `json:"data"`
+}
+
+// LoggedModelResponse is a response object for `POST /mlflow/logged-models`,
+// `GET /mlflow/logged-models/:model_id` and `PATCH /mlflow/logged-models/:model_id` endpoints.
+type LoggedModelResponse struct {
+	Model *LoggedModelPartialResponse `json:"model"`
+}
+
+// NewLoggedModelResponse creates new LoggedModelResponse object.
+func NewLoggedModelResponse(model *models.LoggedModel) *LoggedModelResponse {
+	return &LoggedModelResponse{
+		Model: NewLoggedModelPartialResponse(model),
+	}
+}
+
+// SearchLoggedModelsResponse is a response object for `POST /mlflow/logged-models/search` endpoint.
+type SearchLoggedModelsResponse struct {
+	Models        []*LoggedModelPartialResponse `json:"models"`
+	NextPageToken string                        `json:"next_page_token,omitempty"`
+}
+
+// NewSearchLoggedModelsResponse creates new SearchLoggedModelsResponse object.
+func NewSearchLoggedModelsResponse(
+	loggedModels []models.LoggedModel, nextPageToken *request.PageToken,
+) (*SearchLoggedModelsResponse, error) {
+	resp := SearchLoggedModelsResponse{
+		Models: make([]*LoggedModelPartialResponse, len(loggedModels)),
+	}
+
+	// encode `nextPageToken` value.
+	if nextPageToken != nil {
+		token, err := nextPageToken.Encode()
+		if err != nil {
+			return nil, eris.Wrap(err, "error encoding 'nextPageToken' value")
+		}
+		resp.NextPageToken = token
+	}
+
+	for n := range loggedModels {
+		resp.Models[n] = NewLoggedModelPartialResponse(&loggedModels[n])
+	}
+	return &resp, nil
+}
+
+// NewLoggedModelPartialResponse is a helper function for logged model responses,
+// because they use the same logged model structure.
+func NewLoggedModelPartialResponse(model *models.LoggedModel) *LoggedModelPartialResponse {
+	tags := make([]LoggedModelTagPartialResponse, len(model.Tags))
+	for n, tag := range model.Tags {
+		tags[n] = LoggedModelTagPartialResponse{
+			Key:   tag.Key,
+			Value: tag.Value,
+		}
+	}
+
+	params := make([]LoggedModelParamPartialResponse, len(model.Params))
+	for n, param := range model.Params {
+		params[n] = LoggedModelParamPartialResponse{
+			Key:   param.Key,
+			Value: param.Value,
+		}
+	}
+
+	metrics := make([]LoggedModelMetricPartialResponse, len(model.Metrics))
+	for n, m := range model.Metrics {
+		metrics[n] = LoggedModelMetricPartialResponse{
+			Key:       m.Key,
+			Value:     m.Value,
+			Timestamp: m.Timestamp,
+			Step:      m.Step,
+			RunID:     m.RunID,
+			ModelID:   model.ID,
+		}
+		if m.IsNan {
+			metrics[n].Value = common.NANValue
+		}
+	}
+
+	return &LoggedModelPartialResponse{
+		Info: LoggedModelInfoPartialResponse{
+			ModelID:                model.ID,
+			ExperimentID:           fmt.Sprint(model.ExperimentID),
+			Name:                   model.Name,
+			CreationTimestampMS:    model.CreationTimestampMS,
+			LastUpdatedTimestampMS: model.LastUpdatedTimestampMS,
+			ArtifactURI:            model.ArtifactLocation,
+			Status:                 string(model.Status),
+			StatusMessage:          model.StatusMessage,
+			ModelType:              model.ModelType,
+			SourceRunID:            model.SourceRunID,
+			Tags:                   tags,
+		},
+		Data: LoggedModelDataPartialResponse{
+			Params:  params,
+			Metrics: metrics,
+		},
+	}
+}
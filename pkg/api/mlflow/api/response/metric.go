@@ -5,6 +5,7 @@ import (
 
 	"github.com/rotisserie/eris"
 
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 )
@@ -30,11 +31,14 @@ type MetricPartialResponse struct {
 
 // GetMetricHistoryResponse is a response object for `GET mlflow/metrics/get-history` endpoint.
 type GetMetricHistoryResponse struct {
-	Metrics []MetricPartialResponse `json:"metrics"`
+	Metrics       []MetricPartialResponse `json:"metrics"`
+	NextPageToken string                  `json:"next_page_token,omitempty"`
 }
 
 // NewMetricHistoryResponse creates new GetMetricHistoryResponse object.
-func NewMetricHistoryResponse(metrics []models.Metric) (*GetMetricHistoryResponse, error) {
+func NewMetricHistoryResponse(
+	metrics []models.Metric, nextPageToken *request.PageToken,
+) (*GetMetricHistoryResponse, error) {
 	resp := GetMetricHistoryResponse{
 		Metrics: make([]MetricPartialResponse, len(metrics)),
 	}
@@ -55,16 +59,29 @@ func NewMetricHistoryResponse(metrics []models.Metric) (*GetMetricHistoryRespons
 			resp.Metrics[n].Value = common.NANValue
 		}
 	}
+
+	// encode `nextPageToken` value.
+	if nextPageToken != nil {
+		token, err := nextPageToken.Encode()
+		if err != nil {
+			return nil, eris.Wrap(err, "error encoding 'nextPageToken' value")
+		}
+		resp.NextPageToken = token
+	}
+
 	return &resp, nil
 }
 
 // GetMetricHistoryBulkResponse is a response object for `GET mlflow/metrics/get-history-bulk` endpoint.
 type GetMetricHistoryBulkResponse struct {
-	Metrics []MetricPartialResponseBulk `json:"metrics"`
+	Metrics       []MetricPartialResponseBulk `json:"metrics"`
+	NextPageToken string                      `json:"next_page_token,omitempty"`
 }
 
 // NewMetricHistoryBulkResponse creates new GetMetricHistoryBulkResponse object.
-func NewMetricHistoryBulkResponse(metrics []models.Metric) *GetMetricHistoryBulkResponse {
+func NewMetricHistoryBulkResponse(
+	metrics []models.Metric, nextPageToken *request.PageToken,
+) (*GetMetricHistoryBulkResponse, error) {
 	resp := GetMetricHistoryBulkResponse{
 		Metrics: make([]MetricPartialResponseBulk, len(metrics)),
 	}
@@ -81,5 +98,15 @@ func NewMetricHistoryBulkResponse(metrics []models.Metric) *GetMetricHistoryBulk
 			resp.Metrics[n].Value = common.NANValue
 		}
 	}
-	return &resp
+
+	// encode `nextPageToken` value.
+	if nextPageToken != nil {
+		token, err := nextPageToken.Encode()
+		if err != nil {
+			return nil, eris.Wrap(err, "error encoding 'nextPageToken' value")
+		}
+		resp.NextPageToken = token
+	}
+
+	return &resp, nil
 }
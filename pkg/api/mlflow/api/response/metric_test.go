@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 )
@@ -103,7 +104,7 @@ func TestNewMetricHistoryResponse_Ok(t *testing.T) {
 
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
-			actualResponse, err := NewMetricHistoryResponse(tt.metrics)
+			actualResponse, err := NewMetricHistoryResponse(tt.metrics, nil)
 			require.Nil(t, err)
 			assert.Equal(t, tt.expectedResponse, actualResponse)
 		})
@@ -114,6 +115,7 @@ func TestNewMetricHistoryBulkResponse_Ok(t *testing.T) {
 	testData := []struct {
 		name             string
 		metrics          []models.Metric
+		nextPageToken    *request.PageToken
 		expectedResponse *GetMetricHistoryBulkResponse
 	}{
 		{
@@ -166,11 +168,40 @@ func TestNewMetricHistoryBulkResponse_Ok(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "WithNextPageToken",
+			metrics: []models.Metric{
+				{
+					Key:       "key",
+					Value:     123.4,
+					Timestamp: 1234567890,
+					RunID:     "run_id",
+					Step:      1,
+					Iter:      1,
+				},
+			},
+			nextPageToken: &request.PageToken{
+				Keys: []any{"run_id", int64(1234567890), int64(1), 123.4, false, uint(0)},
+			},
+			expectedResponse: &GetMetricHistoryBulkResponse{
+				Metrics: []MetricPartialResponseBulk{
+					{
+						RunID:     "run_id",
+						Key:       "key",
+						Timestamp: 1234567890,
+						Step:      1,
+						Value:     123.4,
+					},
+				},
+				NextPageToken: "eyJrZXlzIjpbInJ1bl9pZCIsMTIzNDU2Nzg5MCwxLDEyMy40LGZhbHNlLDBdfQo=",
+			},
+		},
 	}
 
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
-			actualResponse := NewMetricHistoryBulkResponse(tt.metrics)
+			actualResponse, err := NewMetricHistoryBulkResponse(tt.metrics, tt.nextPageToken)
+			require.Nil(t, err)
 			assert.Equal(t, tt.expectedResponse, actualResponse)
 		})
 	}
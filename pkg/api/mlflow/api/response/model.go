@@ -0,0 +1,357 @@
+package response
+
+import (
+	"fmt"
+
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// RegisteredModelTagPartialResponse is a partial response object for different responses.
+type RegisteredModelTagPartialResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// RegisteredModelAliasPartialResponse is a partial response object for different responses.
+type RegisteredModelAliasPartialResponse struct {
+	Alias   string `json:"alias"`
+	Version string `json:"version"`
+}
+
+// ModelVersionTagPartialResponse is a partial response object for different responses.
+type ModelVersionTagPartialResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// RegisteredModelPartialResponse is a partial response object for different responses.
+type RegisteredModelPartialResponse struct {
+	Name                 string                                `json:"name"`
+	CreationTimestamp    int64                                 `json:"creation_timestamp"`
+	LastUpdatedTimestamp int64                                 `json:"last_updated_timestamp"`
+	UserID               string                                `json:"user_id,omitempty"`
+	Description          string                                `json:"description,omitempty"`
+	LatestVersions       []*ModelVersionPartialResponse        `json:"latest_versions,omitempty"`
+	Tags                 []RegisteredModelTagPartialResponse   `json:"tags,omitempty"`
+	Aliases              []RegisteredModelAliasPartialResponse `json:"aliases,omitempty"`
+}
+
+// ModelVersionPartialResponse is a partial response object for different responses.
+type ModelVersionPartialResponse struct {
+	Name                 string                           `json:"name"`
+	Version              string                           `json:"version"`
+	CreationTimestamp    int64                            `json:"creation_timestamp"`
+	LastUpdatedTimestamp int64                            `json:"last_updated_timestamp"`
+	UserID               string                           `json:"user_id,omitempty"`
+	CurrentStage         string                           `json:"current_stage"`
+	Description          string                           `json:"description,omitempty"`
+	Source               string                           `json:"source"`
+	RunID                string                           `json:"run_id,omitempty"`
+	Status               string                           `json:"status"`
+	StatusMessage        string                           `json:"status_message,omitempty"`
+	Tags                 []ModelVersionTagPartialResponse `json:"tags,omitempty"`
+	RunLink              string                           `json:"run_link,omitempty"`
+	Aliases              []string                         `json:"aliases,omitempty"`
+}
+
+// RegisteredModelResponse is a response object for `POST /mlflow/registered-models/create`,
+// `GET /mlflow/registered-models/get`, `POST /mlflow/registered-models/rename` and
+// `PATCH /mlflow/registered-models/update` endpoints.
+type RegisteredModelResponse struct {
+	RegisteredModel *RegisteredModelPartialResponse `json:"registered_model"`
+}
+
+// NewRegisteredModelResponse creates new RegisteredModelResponse object.
+func NewRegisteredModelResponse(registeredModel *models.RegisteredModel) *RegisteredModelResponse {
+	return &RegisteredModelResponse{
+		RegisteredModel: NewRegisteredModelPartialResponse(registeredModel),
+	}
+}
+
+// SearchRegisteredModelsResponse is a response object for `GET /mlflow/registered-models/search` endpoint.
+type SearchRegisteredModelsResponse struct {
+	RegisteredModels []*RegisteredModelPartialResponse `json:"registered_models"`
+	NextPageToken    string                            `json:"next_page_token,omitempty"`
+}
+
+// NewSearchRegisteredModelsResponse creates new SearchRegisteredModelsResponse object.
+func NewSearchRegisteredModelsResponse(
+	registeredModels []models.RegisteredModel, nextPageToken *request.PageToken,
+) (*SearchRegisteredModelsResponse, error) {
+	resp := SearchRegisteredModelsResponse{
+		RegisteredModels: make([]*RegisteredModelPartialResponse, 0, len(registeredModels)),
+	}
+
+	// encode `nextPageToken` value.
+	if nextPageToken != nil {
+		token, err := nextPageToken.Encode()
+		if err != nil {
+			return nil, eris.Wrap(err, "error encoding 'nextPageToken' value")
+		}
+		resp.NextPageToken = token
+	}
+
+	for _, registeredModel := range registeredModels {
+		//nolint:gosec
+		resp.RegisteredModels = append(resp.RegisteredModels, NewRegisteredModelPartialResponse(&registeredModel))
+	}
+
+	return &resp, nil
+}
+
+// ModelVersionsResponse is a response object for `POST /mlflow/registered-models/get-latest-versions` endpoint.
+type ModelVersionsResponse struct {
+	ModelVersions []*ModelVersionPartialResponse `json:"model_versions"`
+}
+
+// NewModelVersionsResponse creates new ModelVersionsResponse object.
+func NewModelVersionsResponse(modelVersions []models.ModelVersion) *ModelVersionsResponse {
+	resp := ModelVersionsResponse{
+		ModelVersions: make([]*ModelVersionPartialResponse, 0, len(modelVersions)),
+	}
+	for _, modelVersion := range modelVersions {
+		//nolint:gosec
+		resp.ModelVersions = append(resp.ModelVersions, NewModelVersionPartialResponse(&modelVersion))
+	}
+	return &resp
+}
+
+// ModelVersionResponse is a response object for `POST /mlflow/model-versions/create`,
+// `GET /mlflow/model-versions/get`, `PATCH /mlflow/model-versions/update` and
+// `GET /mlflow/registered-models/alias` endpoints.
+type ModelVersionResponse struct {
+	ModelVersion *ModelVersionPartialResponse `json:"model_version"`
+}
+
+// NewModelVersionResponse creates new ModelVersionResponse object.
+func NewModelVersionResponse(modelVersion *models.ModelVersion) *ModelVersionResponse {
+	return &ModelVersionResponse{
+		ModelVersion: NewModelVersionPartialResponse(modelVersion),
+	}
+}
+
+// SearchModelVersionsResponse is a response object for `GET /mlflow/model-versions/search` endpoint.
+type SearchModelVersionsResponse struct {
+	ModelVersions []*ModelVersionPartialResponse `json:"model_versions"`
+	NextPageToken string                         `json:"next_page_token,omitempty"`
+}
+
+// NewSearchModelVersionsResponse creates new SearchModelVersionsResponse object.
+func NewSearchModelVersionsResponse(
+	modelVersions []models.ModelVersion, nextPageToken *request.PageToken,
+) (*SearchModelVersionsResponse, error) {
+	resp := SearchModelVersionsResponse{
+		ModelVersions: make([]*ModelVersionPartialResponse, 0, len(modelVersions)),
+	}
+
+	// encode `nextPageToken` value.
+	if nextPageToken != nil {
+		token, err := nextPageToken.Encode()
+		if err != nil {
+			return nil, eris.Wrap(err, "error encoding 'nextPageToken' value")
+		}
+		resp.NextPageToken = token
+	}
+
+	for _, modelVersion := range modelVersions {
+		//nolint:gosec
+		resp.ModelVersions = append(resp.ModelVersions, NewModelVersionPartialResponse(&modelVersion))
+	}
+
+	return &resp, nil
+}
+
+// GetModelVersionDownloadURIResponse is a response object for `GET /mlflow/model-versions/get-download-uri` endpoint.
+type GetModelVersionDownloadURIResponse struct {
+	ArtifactURI string `json:"artifact_uri"`
+}
+
+// NewGetModelVersionDownloadURIResponse creates new GetModelVersionDownloadURIResponse object.
+func NewGetModelVersionDownloadURIResponse(modelVersion *models.ModelVersion) *GetModelVersionDownloadURIResponse {
+	return &GetModelVersionDownloadURIResponse{
+		ArtifactURI: modelVersion.Source,
+	}
+}
+
+// NewRegisteredModelPartialResponse is a helper function for the different registered model responses.
+// Latest versions are calculated from the preloaded versions as the latest version of each stage.
+func NewRegisteredModelPartialResponse(registeredModel *models.RegisteredModel) *RegisteredModelPartialResponse {
+	resp := RegisteredModelPartialResponse{
+		Name:                 registeredModel.Name,
+		CreationTimestamp:    registeredModel.CreationTime.Int64,
+		LastUpdatedTimestamp: registeredModel.LastUpdatedTime.Int64,
+		UserID:               registeredModel.UserID,
+		Description:          registeredModel.Description,
+	}
+
+	latest := map[models.ModelVersionStage]int{}
+	for n, version := range registeredModel.Versions {
+		if i, ok := latest[version.CurrentStage]; !ok || registeredModel.Versions[i].Version < version.Version {
+			latest[version.CurrentStage] = n
+		}
+	}
+	for n, version := range registeredModel.Versions {
+		if latest[version.CurrentStage] == n {
+			version.RegisteredModel = models.RegisteredModel{Name: registeredModel.Name, Aliases: registeredModel.Aliases}
+			resp.LatestVersions = append(resp.LatestVersions, NewModelVersionPartialResponse(&version))
+		}
+	}
+
+	for _, tag := range registeredModel.Tags {
+		resp.Tags = append(resp.Tags, RegisteredModelTagPartialResponse{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	for _, alias := range registeredModel.Aliases {
+		resp.Aliases = append(resp.Aliases, RegisteredModelAliasPartialResponse{
+			Alias:   alias.Alias,
+			Version: fmt.Sprint(alias.Version),
+		})
+	}
+
+	return &resp
+}
+
+// NewModelVersionPartialResponse is a helper function for the different model version responses.
+// Aliases are taken from the preloaded aliases of the parent registered model.
+func NewModelVersionPartialResponse(modelVersion *models.ModelVersion) *ModelVersionPartialResponse {
+	resp := ModelVersionPartialResponse{
+		Name:                 modelVersion.RegisteredModel.Name,
+		Version:              fmt.Sprint(modelVersion.Version),
+		CreationTimestamp:    modelVersion.CreationTime.Int64,
+		LastUpdatedTimestamp: modelVersion.LastUpdatedTime.Int64,
+		UserID:               modelVersion.UserID,
+		CurrentStage:         string(modelVersion.CurrentStage),
+		Description:          modelVersion.Description,
+		Source:               modelVersion.Source,
+		RunID:                modelVersion.RunID,
+		Status:               string(modelVersion.Status),
+		StatusMessage:        modelVersion.StatusMessage,
+		RunLink:              modelVersion.RunLink,
+	}
+	for _, tag := range modelVersion.Tags {
+		resp.Tags = append(resp.Tags, ModelVersionTagPartialResponse{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	for _, alias := range modelVersion.RegisteredModel.Aliases {
+		if alias.Version == modelVersion.Version {
+			resp.Aliases = append(resp.Aliases, alias.Alias)
+		}
+	}
+	return &resp
+}
+
+// TransitionRequestPartialResponse is a partial response object for different responses.
+type TransitionRequestPartialResponse struct {
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	Version              string `json:"version"`
+	ToStage              string `json:"to_stage"`
+	Status               string `json:"status"`
+	Comment              string `json:"comment,omitempty"`
+	UserID               string `json:"user_id,omitempty"`
+	ReviewerID           string `json:"reviewer_id,omitempty"`
+	ReviewComment        string `json:"review_comment,omitempty"`
+	CreationTimestamp    int64  `json:"creation_timestamp"`
+	LastUpdatedTimestamp int64  `json:"last_updated_timestamp"`
+}
+
+// TransitionRequestResponse is a response object for `POST /mlflow/transition-requests/create`,
+// `POST /admin/transition-requests/approve` and `POST /admin/transition-requests/reject` endpoints.
+type TransitionRequestResponse struct {
+	Request *TransitionRequestPartialResponse `json:"request"`
+}
+
+// NewTransitionRequestResponse creates new TransitionRequestResponse object.
+func NewTransitionRequestResponse(request *models.ModelVersionTransitionRequest) *TransitionRequestResponse {
+	return &TransitionRequestResponse{
+		Request: NewTransitionRequestPartialResponse(request),
+	}
+}
+
+// ListTransitionRequestsResponse is a response object for `GET /mlflow/transition-requests/list` endpoint.
+type ListTransitionRequestsResponse struct {
+	Requests []*TransitionRequestPartialResponse `json:"requests"`
+}
+
+// NewListTransitionRequestsResponse creates new ListTransitionRequestsResponse object.
+func NewListTransitionRequestsResponse(
+	requests []models.ModelVersionTransitionRequest,
+) *ListTransitionRequestsResponse {
+	resp := ListTransitionRequestsResponse{
+		Requests: make([]*TransitionRequestPartialResponse, 0, len(requests)),
+	}
+	for _, request := range requests {
+		//nolint:gosec
+		resp.Requests = append(resp.Requests, NewTransitionRequestPartialResponse(&request))
+	}
+	return &resp
+}
+
+// ModelVersionTransitionPartialResponse is a partial response object for different responses.
+type ModelVersionTransitionPartialResponse struct {
+	Name                string `json:"name"`
+	Version             string `json:"version"`
+	FromStage           string `json:"from_stage"`
+	ToStage             string `json:"to_stage"`
+	UserID              string `json:"user_id,omitempty"`
+	Comment             string `json:"comment,omitempty"`
+	Timestamp           int64  `json:"timestamp"`
+	TransitionRequestID string `json:"transition_request_id,omitempty"`
+}
+
+// GetModelVersionTransitionHistoryResponse is a response object for
+// `GET /mlflow/model-versions/transition-history` endpoint.
+type GetModelVersionTransitionHistoryResponse struct {
+	Transitions []*ModelVersionTransitionPartialResponse `json:"transitions"`
+}
+
+// NewGetModelVersionTransitionHistoryResponse creates new GetModelVersionTransitionHistoryResponse object.
+func NewGetModelVersionTransitionHistoryResponse(
+	transitions []models.ModelVersionTransition,
+) *GetModelVersionTransitionHistoryResponse {
+	resp := GetModelVersionTransitionHistoryResponse{
+		Transitions: make([]*ModelVersionTransitionPartialResponse, 0, len(transitions)),
+	}
+	for _, transition := range transitions {
+		partialResponse := ModelVersionTransitionPartialResponse{
+			Name:      transition.ModelVersion.RegisteredModel.Name,
+			Version:   fmt.Sprint(transition.ModelVersion.Version),
+			FromStage: string(transition.FromStage),
+			ToStage:   string(transition.ToStage),
+			UserID:    transition.UserID,
+			Comment:   transition.Comment,
+			Timestamp: transition.CreationTime.Int64,
+		}
+		if transition.TransitionRequestID != nil {
+			partialResponse.TransitionRequestID = fmt.Sprint(*transition.TransitionRequestID)
+		}
+		resp.Transitions = append(resp.Transitions, &partialResponse)
+	}
+	return &resp
+}
+
+// NewTransitionRequestPartialResponse is a helper function for the different transition request responses.
+func NewTransitionRequestPartialResponse(
+	request *models.ModelVersionTransitionRequest,
+) *TransitionRequestPartialResponse {
+	return &TransitionRequestPartialResponse{
+		ID:                   fmt.Sprint(request.ID),
+		Name:                 request.ModelVersion.RegisteredModel.Name,
+		Version:              fmt.Sprint(request.ModelVersion.Version),
+		ToStage:              string(request.ToStage),
+		Status:               string(request.Status),
+		Comment:              request.Comment,
+		UserID:               request.UserID,
+		ReviewerID:           request.ReviewerID,
+		ReviewComment:        request.ReviewComment,
+		CreationTimestamp:    request.CreationTime.Int64,
+		LastUpdatedTimestamp: request.LastUpdatedTime.Int64,
+	}
+}
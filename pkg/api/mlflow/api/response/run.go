@@ -1,10 +1,7 @@
 package response
 
 import (
-	"encoding/base64"
-	"encoding/json"
 	"fmt"
-	"strings"
 
 	"github.com/rotisserie/eris"
 
@@ -40,6 +37,33 @@ type RunDataPartialResponse struct {
 	Tags    []RunTagPartialResponse    `json:"tags,omitempty"`
 }
 
+// DatasetPartialResponse is a partial response object for different responses.
+type DatasetPartialResponse struct {
+	Name       string `json:"name"`
+	Digest     string `json:"digest"`
+	SourceType string `json:"source_type"`
+	Source     string `json:"source"`
+	Schema     string `json:"schema,omitempty"`
+	Profile    string `json:"profile,omitempty"`
+}
+
+// InputTagPartialResponse is a partial response object for different responses.
+type InputTagPartialResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// DatasetInputPartialResponse is a partial response object for different responses.
+type DatasetInputPartialResponse struct {
+	Tags    []InputTagPartialResponse `json:"tags,omitempty"`
+	Dataset DatasetPartialResponse    `json:"dataset"`
+}
+
+// RunInputsPartialResponse is a partial response object for different responses.
+type RunInputsPartialResponse struct {
+	DatasetInputs []DatasetInputPartialResponse `json:"dataset_inputs,omitempty"`
+}
+
 // RunInfoPartialResponse is a partial response object for different responses.
 type RunInfoPartialResponse struct {
 	ID             string `json:"run_id"`
@@ -56,8 +80,9 @@ type RunInfoPartialResponse struct {
 
 // RunPartialResponse is a partial response object for different responses.
 type RunPartialResponse struct {
-	Info RunInfoPartialResponse `json:"info"`
-	Data RunDataPartialResponse `json:"data"`
+	Info   RunInfoPartialResponse   `json:"info"`
+	Data   RunDataPartialResponse   `json:"data"`
+	Inputs RunInputsPartialResponse `json:"inputs"`
 }
 
 // CreateRunResponse is a response object for `POST mlflow/runs/create` endpoint.
@@ -137,7 +162,7 @@ type SearchRunsResponse struct {
 }
 
 // NewSearchRunsResponse creates new SearchRunsResponse object.
-func NewSearchRunsResponse(runs []models.Run, limit, offset int) (*SearchRunsResponse, error) {
+func NewSearchRunsResponse(runs []models.Run, nextPageToken *request.PageToken) (*SearchRunsResponse, error) {
 	resp := SearchRunsResponse{
 		Runs: make([]*RunPartialResponse, len(runs)),
 	}
@@ -149,16 +174,12 @@ func NewSearchRunsResponse(runs []models.Run, limit, offset int) (*SearchRunsRes
 	}
 
 	// encode `nextPageToken` value.
-	if len(runs) == limit {
-		var token strings.Builder
-		if err := json.NewEncoder(
-			base64.NewEncoder(base64.StdEncoding, &token),
-		).Encode(request.PageToken{
-			Offset: int32(offset + limit),
-		}); err != nil {
+	if nextPageToken != nil {
+		token, err := nextPageToken.Encode()
+		if err != nil {
 			return nil, eris.Wrap(err, "error encoding 'nextPageToken' value")
 		}
-		resp.NextPageToken = token.String()
+		resp.NextPageToken = token
 	}
 
 	return &resp, nil
@@ -202,6 +223,27 @@ func NewRunPartialResponse(run *models.Run) *RunPartialResponse {
 		}
 	}
 
+	datasetInputs := make([]DatasetInputPartialResponse, len(run.Inputs))
+	for n, input := range run.Inputs {
+		datasetInputs[n] = DatasetInputPartialResponse{
+			Tags: make([]InputTagPartialResponse, len(input.Tags)),
+			Dataset: DatasetPartialResponse{
+				Name:       input.Dataset.Name,
+				Digest:     input.Dataset.Digest,
+				SourceType: input.Dataset.SourceType,
+				Source:     input.Dataset.Source,
+				Schema:     input.Dataset.Schema,
+				Profile:    input.Dataset.Profile,
+			},
+		}
+		for i, tag := range input.Tags {
+			datasetInputs[n].Tags[i] = InputTagPartialResponse{
+				Key:   tag.Name,
+				Value: tag.Value,
+			}
+		}
+	}
+
 	return &RunPartialResponse{
 		Info: RunInfoPartialResponse{
 			ID:             run.ID,
@@ -220,5 +262,32 @@ func NewRunPartialResponse(run *models.Run) *RunPartialResponse {
 			Params:  params,
 			Tags:    tags,
 		},
+		Inputs: RunInputsPartialResponse{
+			DatasetInputs: datasetInputs,
+		},
+	}
+}
+
+// MoveRunResponse is a response object for `POST mlflow/runs/move` endpoint.
+type MoveRunResponse struct {
+	Run *RunPartialResponse `json:"run"`
+}
+
+// NewMoveRunResponse creates new MoveRunResponse object.
+func NewMoveRunResponse(run *models.Run) *MoveRunResponse {
+	return &MoveRunResponse{
+		Run: NewRunPartialResponse(run),
+	}
+}
+
+// CloneRunResponse is a response object for `POST mlflow/runs/clone` endpoint.
+type CloneRunResponse struct {
+	Run *RunPartialResponse `json:"run"`
+}
+
+// NewCloneRunResponse creates new CloneRunResponse object.
+func NewCloneRunResponse(run *models.Run) *CloneRunResponse {
+	return &CloneRunResponse{
+		Run: NewRunPartialResponse(run),
 	}
 }
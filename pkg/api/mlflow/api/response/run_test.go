@@ -97,6 +97,9 @@ func TestNewRunPartialResponse(t *testing.T) {
 						Value: "Value",
 					}},
 				},
+				Inputs: RunInputsPartialResponse{
+					DatasetInputs: []DatasetInputPartialResponse{},
+				},
 			},
 		},
 		{
@@ -179,6 +182,9 @@ func TestNewRunPartialResponse(t *testing.T) {
 						Value: "Value",
 					}},
 				},
+				Inputs: RunInputsPartialResponse{
+					DatasetInputs: []DatasetInputPartialResponse{},
+				},
 			},
 		},
 		{
@@ -207,6 +213,66 @@ func TestNewRunPartialResponse(t *testing.T) {
 					Params:  []RunParamPartialResponse{},
 					Metrics: []RunMetricPartialResponse{},
 				},
+				Inputs: RunInputsPartialResponse{
+					DatasetInputs: []DatasetInputPartialResponse{},
+				},
+			},
+		},
+		{
+			name: "WithDatasetInputs",
+			run: &models.Run{
+				Params:        []models.Param{},
+				Tags:          []models.Tag{},
+				LatestMetrics: []models.LatestMetric{},
+				Inputs: []models.Input{
+					{
+						ID: "input",
+						Dataset: models.Dataset{
+							ID:         "dataset",
+							Name:       "name",
+							Digest:     "digest",
+							SourceType: "local",
+							Source:     "source",
+							Schema:     "schema",
+							Profile:    "profile",
+						},
+						Tags: []models.InputTag{
+							{
+								InputID: "input",
+								Name:    models.InputTagContextKey,
+								Value:   "training",
+							},
+						},
+					},
+				},
+			},
+			expectedResponse: &RunPartialResponse{
+				Info: RunInfoPartialResponse{
+					ExperimentID: "0",
+				},
+				Data: RunDataPartialResponse{
+					Tags:    []RunTagPartialResponse{},
+					Params:  []RunParamPartialResponse{},
+					Metrics: []RunMetricPartialResponse{},
+				},
+				Inputs: RunInputsPartialResponse{
+					DatasetInputs: []DatasetInputPartialResponse{
+						{
+							Tags: []InputTagPartialResponse{{
+								Key:   models.InputTagContextKey,
+								Value: "training",
+							}},
+							Dataset: DatasetPartialResponse{
+								Name:       "name",
+								Digest:     "digest",
+								SourceType: "local",
+								Source:     "source",
+								Schema:     "schema",
+								Profile:    "profile",
+							},
+						},
+					},
+				},
 			},
 		},
 		{
@@ -235,6 +301,9 @@ func TestNewRunPartialResponse(t *testing.T) {
 					Params:  []RunParamPartialResponse{},
 					Metrics: []RunMetricPartialResponse{},
 				},
+				Inputs: RunInputsPartialResponse{
+					DatasetInputs: []DatasetInputPartialResponse{},
+				},
 			},
 		},
 	}
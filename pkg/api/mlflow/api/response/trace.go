@@ -0,0 +1,114 @@
+package response
+
+import (
+	"fmt"
+
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// TraceTagPartialResponse is a partial response object for different responses.
+type TraceTagPartialResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// TraceRequestMetadataPartialResponse is a partial response object for different responses.
+type TraceRequestMetadataPartialResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// TraceInfoPartialResponse is a partial response object for different responses.
+type TraceInfoPartialResponse struct {
+	RequestID       string                                `json:"request_id"`
+	ExperimentID    string                                `json:"experiment_id"`
+	TimestampMS     int64                                 `json:"timestamp_ms"`
+	ExecutionTimeMS int64                                 `json:"execution_time_ms,omitempty"`
+	Status          string                                `json:"status"`
+	RequestMetadata []TraceRequestMetadataPartialResponse `json:"request_metadata"`
+	Tags            []TraceTagPartialResponse             `json:"tags"`
+}
+
+// TraceInfoResponse is a response object for `POST /mlflow/traces`, `PATCH /mlflow/traces/:request_id`
+// and `GET /mlflow/traces/:request_id/info` endpoints.
+type TraceInfoResponse struct {
+	TraceInfo *TraceInfoPartialResponse `json:"trace_info"`
+}
+
+// NewTraceInfoResponse creates new TraceInfoResponse object.
+func NewTraceInfoResponse(trace *models.TraceInfo) *TraceInfoResponse {
+	return &TraceInfoResponse{
+		TraceInfo: NewTraceInfoPartialResponse(trace),
+	}
+}
+
+// SearchTracesResponse is a response object for `GET /mlflow/traces` endpoint.
+type SearchTracesResponse struct {
+	Traces        []*TraceInfoPartialResponse `json:"traces"`
+	NextPageToken string                      `json:"next_page_token,omitempty"`
+}
+
+// NewSearchTracesResponse creates new SearchTracesResponse object.
+func NewSearchTracesResponse(
+	traces []models.TraceInfo, nextPageToken *request.PageToken,
+) (*SearchTracesResponse, error) {
+	resp := SearchTracesResponse{
+		Traces: make([]*TraceInfoPartialResponse, len(traces)),
+	}
+
+	// encode `nextPageToken` value.
+	if nextPageToken != nil {
+		token, err := nextPageToken.Encode()
+		if err != nil {
+			return nil, eris.Wrap(err, "error encoding 'nextPageToken' value")
+		}
+		resp.NextPageToken = token
+	}
+
+	for n := range traces {
+		resp.Traces[n] = NewTraceInfoPartialResponse(&traces[n])
+	}
+	return &resp, nil
+}
+
+// DeleteTracesResponse is a response object for `POST /mlflow/traces/delete-traces` endpoint.
+type DeleteTracesResponse struct {
+	TracesDeleted int `json:"traces_deleted"`
+}
+
+// NewDeleteTracesResponse creates new DeleteTracesResponse object.
+func NewDeleteTracesResponse(tracesDeleted int) *DeleteTracesResponse {
+	return &DeleteTracesResponse{
+		TracesDeleted: tracesDeleted,
+	}
+}
+
+// NewTraceInfoPartialResponse is a helper function for trace responses,
+// because they use the same trace info structure.
+func NewTraceInfoPartialResponse(trace *models.TraceInfo) *TraceInfoPartialResponse {
+	resp := TraceInfoPartialResponse{
+		RequestID:       trace.RequestID,
+		ExperimentID:    fmt.Sprint(trace.ExperimentID),
+		TimestampMS:     trace.TimestampMS,
+		ExecutionTimeMS: trace.ExecutionTimeMS.Int64,
+		Status:          string(trace.Status),
+		RequestMetadata: make([]TraceRequestMetadataPartialResponse, len(trace.RequestMetadata)),
+		Tags:            make([]TraceTagPartialResponse, len(trace.Tags)),
+	}
+	for n, metadata := range trace.RequestMetadata {
+		resp.RequestMetadata[n] = TraceRequestMetadataPartialResponse{
+			Key:   metadata.Key,
+			Value: metadata.Value,
+		}
+	}
+	for n, tag := range trace.Tags {
+		resp.Tags[n] = TraceTagPartialResponse{
+			Key:   tag.Key,
+			Value: tag.Value,
+		}
+	}
+	return &resp
+}
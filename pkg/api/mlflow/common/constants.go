@@ -11,3 +11,6 @@ const (
 const (
 	DescriptionTagKey = "mlflow.note.content"
 )
+
+// UserContextKey is the context key used by the basic auth middleware to store the authenticated user.
+const UserContextKey = "username"
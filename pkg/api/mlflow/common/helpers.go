@@ -1,6 +1,7 @@
 package common
 
 import (
+	"context"
 	"mime"
 	"path"
 	"slices"
@@ -52,3 +53,10 @@ func GetContentType(filename string) string {
 	}
 	return "application/octet-stream"
 }
+
+// GetUserFromContext returns the name of the user authenticated by the basic auth middleware.
+// Empty string is returned when authentication is not configured.
+func GetUserFromContext(ctx context.Context) string {
+	user, _ := ctx.Value(UserContextKey).(string)
+	return user
+}
@@ -5,6 +5,7 @@ import (
 	"path"
 	"path/filepath"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/rotisserie/eris"
@@ -13,35 +14,61 @@ import (
 
 // ServiceConfig represents main service configuration.
 type ServiceConfig struct {
-	DevMode               bool
-	ListenAddress         string
-	AuthUsername          string
-	AuthPassword          string
-	DefaultArtifactRoot   string
-	S3EndpointURI         string
-	GSEndpointURI         string
-	DatabaseURI           string
-	DatabaseReset         bool
-	DatabasePoolMax       int
-	DatabaseMigrate       bool
-	DatabaseSlowThreshold time.Duration
+	DevMode                         bool
+	ListenAddress                   string
+	AuthUsername                    string
+	AuthPassword                    string
+	AuthUsers                       []string
+	DefaultArtifactRoot             string
+	ArtifactsDestination            string
+	S3EndpointURI                   string
+	GSEndpointURI                   string
+	AzureStorageConnectionString    string
+	AzureStorageAccessKey           string
+	DatabaseURI                     string
+	DatabaseReset                   bool
+	DatabasePoolMax                 int
+	DatabaseMigrate                 bool
+	DatabaseSlowThreshold           time.Duration
+	StaleRunTimeout                 time.Duration
+	StaleRunStatus                  string
+	StaleRunSweepInterval           time.Duration
+	WebhookMaxAttempts              int
+	WebhookRetryBackoff             time.Duration
+	WebhookTimeout                  time.Duration
+	AlertEndpoint                   string
+	AlertInterval                   time.Duration
+	AllowDirectProductionTransition bool
 }
 
 // NewServiceConfig creates new instance of ServiceConfig.
 func NewServiceConfig() *ServiceConfig {
 	return &ServiceConfig{
-		DevMode:               viper.GetBool("dev-mode"),
-		ListenAddress:         viper.GetString("listen-address"),
-		AuthUsername:          viper.GetString("auth-username"),
-		AuthPassword:          viper.GetString("auth-password"),
-		DefaultArtifactRoot:   viper.GetString("default-artifact-root"),
-		S3EndpointURI:         viper.GetString("s3-endpoint-uri"),
-		GSEndpointURI:         viper.GetString("gs-endpoint-uri"),
-		DatabaseURI:           viper.GetString("database-uri"),
-		DatabaseReset:         viper.GetBool("database-reset"),
-		DatabasePoolMax:       viper.GetInt("database-pool-max"),
-		DatabaseMigrate:       viper.GetBool("database-migrate"),
-		DatabaseSlowThreshold: viper.GetDuration("database-slow-threshold"),
+		DevMode:                         viper.GetBool("dev-mode"),
+		ListenAddress:                   viper.GetString("listen-address"),
+		AuthUsername:                    viper.GetString("auth-username"),
+		AuthPassword:                    viper.GetString("auth-password"),
+		AuthUsers:                       viper.GetStringSlice("auth-users"),
+		DefaultArtifactRoot:             viper.GetString("default-artifact-root"),
+		ArtifactsDestination:            viper.GetString("artifacts-destination"),
+		S3EndpointURI:                   viper.GetString("s3-endpoint-uri"),
+		GSEndpointURI:                   viper.GetString("gs-endpoint-uri"),
+		AzureStorageConnectionString:    viper.GetString("azure-storage-connection-string"),
+		AzureStorageAccessKey:           viper.GetString("azure-storage-access-key"),
+		DatabaseURI:                     viper.GetString("database-uri"),
+		DatabaseReset:                   viper.GetBool("database-reset"),
+		DatabasePoolMax:                 viper.GetInt("database-pool-max"),
+		DatabaseMigrate:                 viper.GetBool("database-migrate"),
+		DatabaseSlowThreshold:           viper.GetDuration("database-slow-threshold"),
+		StaleRunTimeout:                 viper.GetDuration("stale-run-timeout"),
+		StaleRunStatus:                  viper.GetString("stale-run-status"),
+		StaleRunSweepInterval:           viper.GetDuration("stale-run-sweep-interval"),
+		WebhookMaxAttempts:              viper.GetInt("webhook-max-attempts"),
+		WebhookRetryBackoff:             viper.GetDuration("webhook-retry-backoff"),
+		WebhookTimeout:                  viper.GetDuration("webhook-timeout"),
+		AlertEndpoint:                   viper.GetString("alert-endpoint"),
+		AlertInterval:                   viper.GetDuration("alert-interval"),
+		AllowDirectProductionTransition: viper.GetBool("allow-direct-production-transition"),
 	}
 }
 
@@ -64,17 +91,119 @@ func (c *ServiceConfig) validateConfiguration() error {
 		return eris.Wrap(err, "error parsing 'default-artifact-root' flag")
 	}
 
-	if parsed.User != nil || parsed.RawQuery != "" || parsed.RawFragment != "" {
+	if !isValidArtifactURI(parsed) {
 		return eris.New("incorrect format of 'default-artifact-root' flag")
 	}
 
-	if !slices.Contains([]string{"", "file", "s3", "gs"}, parsed.Scheme) {
+	if !slices.Contains([]string{"", "file", "s3", "gs", "wasbs", "abfss", "mlflow-artifacts"}, parsed.Scheme) {
 		return eris.New("unsupported schema of 'default-artifact-root' flag")
 	}
 
+	// artifacts could be proxied only when there is a destination to proxy them to.
+	if parsed.Scheme == "mlflow-artifacts" && c.ArtifactsDestination == "" {
+		return eris.New("'artifacts-destination' flag is required to proxy artifacts")
+	}
+
+	// 2. validate ArtifactsDestination configuration parameter for correctness and valid values.
+	parsed, err = url.Parse(c.ArtifactsDestination)
+	if err != nil {
+		return eris.Wrap(err, "error parsing 'artifacts-destination' flag")
+	}
+
+	if !isValidArtifactURI(parsed) {
+		return eris.New("incorrect format of 'artifacts-destination' flag")
+	}
+
+	if !slices.Contains([]string{"", "file", "s3", "gs", "wasbs", "abfss"}, parsed.Scheme) {
+		return eris.New("unsupported schema of 'artifacts-destination' flag")
+	}
+
+	// 3. validate stale run detection parameters. Detection is disabled, when there is no timeout.
+	if c.StaleRunTimeout < 0 {
+		return eris.New("'stale-run-timeout' flag must not be negative")
+	}
+	if c.StaleRunTimeout > 0 {
+		if !slices.Contains([]string{"FAILED", "KILLED"}, c.StaleRunStatus) {
+			return eris.New("'stale-run-status' flag must be either 'FAILED' or 'KILLED'")
+		}
+		if c.StaleRunSweepInterval <= 0 {
+			return eris.New("'stale-run-sweep-interval' flag must be positive")
+		}
+	}
+
+	// 4. validate webhook delivery parameters.
+	if c.WebhookMaxAttempts < 0 {
+		return eris.New("'webhook-max-attempts' flag must not be negative")
+	}
+	if c.WebhookRetryBackoff < 0 {
+		return eris.New("'webhook-retry-backoff' flag must not be negative")
+	}
+	if c.WebhookTimeout < 0 {
+		return eris.New("'webhook-timeout' flag must not be negative")
+	}
+
+	// 5. validate alert parameters. Periodic alert checks and deliveries are disabled, when there is no interval.
+	if c.AlertInterval < 0 {
+		return eris.New("'alert-interval' flag must not be negative")
+	}
+	if c.AlertEndpoint != "" {
+		parsed, err := url.Parse(c.AlertEndpoint)
+		if err != nil || !slices.Contains([]string{"http", "https"}, parsed.Scheme) || parsed.Host == "" {
+			return eris.New("'alert-endpoint' flag must be an absolute http(s) url")
+		}
+	}
+
+	// 6. validate additional users. Every user needs a password and must be configured only once.
+	users := map[string]struct{}{}
+	if c.AuthUsername != "" {
+		users[c.AuthUsername] = struct{}{}
+	}
+	for _, user := range c.AuthUsers {
+		username, password, _ := strings.Cut(user, ":")
+		if username == "" || password == "" {
+			return eris.New("'auth-users' flag must be a list of 'username:password' pairs")
+		}
+		if _, ok := users[username]; ok {
+			return eris.Errorf("user '%s' is configured more than once", username)
+		}
+		users[username] = struct{}{}
+	}
+
 	return nil
 }
 
+// GetAuthUsers returns passwords of all the users, which are allowed to access the server, by their names.
+func (c *ServiceConfig) GetAuthUsers() map[string]string {
+	users := map[string]string{}
+	if c.AuthUsername != "" && c.AuthPassword != "" {
+		users[c.AuthUsername] = c.AuthPassword
+	}
+	for _, user := range c.AuthUsers {
+		if username, password, ok := strings.Cut(user, ":"); ok {
+			users[username] = password
+		}
+	}
+	return users
+}
+
+// isValidArtifactURI checks that artifact uri has no unexpected parts. Azure uris are the only ones
+// which contain user information, because they reference the container as `<container>@<account>`.
+func isValidArtifactURI(parsed *url.URL) bool {
+	if parsed.RawQuery != "" || parsed.RawFragment != "" {
+		return false
+	}
+	switch parsed.Scheme {
+	case "wasbs", "abfss":
+		if parsed.User == nil {
+			return false
+		}
+		_, hasPassword := parsed.User.Password()
+		return parsed.User.Username() != "" && !hasPassword
+	default:
+		return parsed.User == nil
+	}
+}
+
 // normalizeConfiguration normalizes service configuration parameters.
 func (c *ServiceConfig) normalizeConfiguration() error {
 	parsed, err := url.Parse(c.DefaultArtifactRoot)
@@ -82,8 +211,11 @@ func (c *ServiceConfig) normalizeConfiguration() error {
 		return eris.Wrap(err, "error parsing 'default-artifact-root' flag")
 	}
 	switch parsed.Scheme {
-	case "s3", "gs":
-		return nil
+	case "s3", "gs", "wasbs", "abfss":
+	case "mlflow-artifacts":
+		if parsed.Host == "" && parsed.Path == "" {
+			c.DefaultArtifactRoot = "mlflow-artifacts:/"
+		}
 	case "", "file":
 		absoluteArtifactRoot, err := filepath.Abs(path.Join(parsed.Host, parsed.Path))
 		if err != nil {
@@ -91,5 +223,25 @@ func (c *ServiceConfig) normalizeConfiguration() error {
 		}
 		c.DefaultArtifactRoot = "file://" + absoluteArtifactRoot
 	}
+
+	// artifacts destination is optional, so normalize it only when it has been provided.
+	if c.ArtifactsDestination == "" {
+		return nil
+	}
+	parsed, err = url.Parse(c.ArtifactsDestination)
+	if err != nil {
+		return eris.Wrap(err, "error parsing 'artifacts-destination' flag")
+	}
+	switch parsed.Scheme {
+	case "s3", "gs", "wasbs", "abfss":
+	case "", "file":
+		absoluteArtifactsDestination, err := filepath.Abs(path.Join(parsed.Host, parsed.Path))
+		if err != nil {
+			return eris.Wrapf(
+				err, "error getting absolute path for 'artifacts-destination': %s", c.ArtifactsDestination,
+			)
+		}
+		c.ArtifactsDestination = "file://" + absoluteArtifactsDestination
+	}
 	return nil
 }
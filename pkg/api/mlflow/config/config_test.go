@@ -3,6 +3,7 @@ package config
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/rotisserie/eris"
 	"github.com/stretchr/testify/assert"
@@ -68,12 +69,59 @@ func TestServiceConfig_Validate_Ok(t *testing.T) {
 				})(),
 			},
 		},
+		{
+			name: "DefaultArtifactRootHasMlflowArtifactsPrefix",
+			providedConfig: &ServiceConfig{
+				DefaultArtifactRoot:  "mlflow-artifacts:",
+				ArtifactsDestination: "s3://bucket_name",
+			},
+			expectedConfig: &ServiceConfig{
+				DefaultArtifactRoot:  "mlflow-artifacts:/",
+				ArtifactsDestination: "s3://bucket_name",
+			},
+		},
+		{
+			name: "DefaultArtifactRootHasWasbsPrefix",
+			providedConfig: &ServiceConfig{
+				DefaultArtifactRoot: "wasbs://container@account.blob.core.windows.net/path",
+			},
+			expectedConfig: &ServiceConfig{
+				DefaultArtifactRoot: "wasbs://container@account.blob.core.windows.net/path",
+			},
+		},
+		{
+			name: "ArtifactsDestinationHasAbfssPrefix",
+			providedConfig: &ServiceConfig{
+				DefaultArtifactRoot:  "mlflow-artifacts:/",
+				ArtifactsDestination: "abfss://container@account.dfs.core.windows.net/path",
+			},
+			expectedConfig: &ServiceConfig{
+				DefaultArtifactRoot:  "mlflow-artifacts:/",
+				ArtifactsDestination: "abfss://container@account.dfs.core.windows.net/path",
+			},
+		},
+		{
+			name: "ArtifactsDestinationHasEmptyPrefixAndIsRelative",
+			providedConfig: &ServiceConfig{
+				DefaultArtifactRoot:  "mlflow-artifacts:/",
+				ArtifactsDestination: "path1/path2/path3",
+			},
+			expectedConfig: &ServiceConfig{
+				DefaultArtifactRoot: "mlflow-artifacts:/",
+				ArtifactsDestination: (func() string {
+					path, err := filepath.Abs("path1/path2/path3")
+					require.Nil(t, err)
+					return "file://" + path
+				})(),
+			},
+		},
 	}
 
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
 			require.Nil(t, tt.providedConfig.Validate())
 			assert.Equal(t, tt.providedConfig.DefaultArtifactRoot, tt.expectedConfig.DefaultArtifactRoot)
+			assert.Equal(t, tt.providedConfig.ArtifactsDestination, tt.expectedConfig.ArtifactsDestination)
 		})
 	}
 }
@@ -103,6 +151,129 @@ func TestServiceConfig_Validate_Error(t *testing.T) {
 				DefaultArtifactRoot: "unsupported://something",
 			},
 		},
+		{
+			name: "DefaultArtifactRootHasUserInformation",
+			error: eris.New(
+				"error validating service configuration: incorrect format of 'default-artifact-root' flag",
+			),
+			config: &ServiceConfig{
+				DefaultArtifactRoot: "s3://user@bucket_name",
+			},
+		},
+		{
+			name: "DefaultArtifactRootHasWasbsPrefixWithoutContainer",
+			error: eris.New(
+				"error validating service configuration: incorrect format of 'default-artifact-root' flag",
+			),
+			config: &ServiceConfig{
+				DefaultArtifactRoot: "wasbs://account.blob.core.windows.net/path",
+			},
+		},
+		{
+			name: "DefaultArtifactRootHasMlflowArtifactsPrefixWithoutArtifactsDestination",
+			error: eris.New(
+				"error validating service configuration: 'artifacts-destination' flag is required to proxy artifacts",
+			),
+			config: &ServiceConfig{
+				DefaultArtifactRoot: "mlflow-artifacts:/",
+			},
+		},
+		{
+			name: "ArtifactsDestinationHasUnsupportedSchema",
+			error: eris.New(
+				"error validating service configuration: unsupported schema of 'artifacts-destination' flag",
+			),
+			config: &ServiceConfig{
+				DefaultArtifactRoot:  "mlflow-artifacts:/",
+				ArtifactsDestination: "mlflow-artifacts:/",
+			},
+		},
+		{
+			name: "StaleRunTimeoutIsNegative",
+			error: eris.New(
+				"error validating service configuration: 'stale-run-timeout' flag must not be negative",
+			),
+			config: &ServiceConfig{
+				StaleRunTimeout: -time.Minute,
+			},
+		},
+		{
+			name: "StaleRunStatusIsUnsupported",
+			error: eris.New(
+				"error validating service configuration: 'stale-run-status' flag must be either 'FAILED' or 'KILLED'",
+			),
+			config: &ServiceConfig{
+				StaleRunTimeout:       time.Minute,
+				StaleRunStatus:        "FINISHED",
+				StaleRunSweepInterval: time.Minute,
+			},
+		},
+		{
+			name: "StaleRunSweepIntervalIsEmpty",
+			error: eris.New(
+				"error validating service configuration: 'stale-run-sweep-interval' flag must be positive",
+			),
+			config: &ServiceConfig{
+				StaleRunTimeout: time.Minute,
+				StaleRunStatus:  "KILLED",
+			},
+		},
+		{
+			name: "WebhookMaxAttemptsIsNegative",
+			error: eris.New(
+				"error validating service configuration: 'webhook-max-attempts' flag must not be negative",
+			),
+			config: &ServiceConfig{
+				WebhookMaxAttempts: -1,
+			},
+		},
+		{
+			name: "WebhookRetryBackoffIsNegative",
+			error: eris.New(
+				"error validating service configuration: 'webhook-retry-backoff' flag must not be negative",
+			),
+			config: &ServiceConfig{
+				WebhookRetryBackoff: -time.Second,
+			},
+		},
+		{
+			name: "AlertIntervalIsNegative",
+			error: eris.New(
+				"error validating service configuration: 'alert-interval' flag must not be negative",
+			),
+			config: &ServiceConfig{
+				AlertInterval: -time.Second,
+			},
+		},
+		{
+			name: "AlertEndpointIsNotHTTP",
+			error: eris.New(
+				"error validating service configuration: 'alert-endpoint' flag must be an absolute http(s) url",
+			),
+			config: &ServiceConfig{
+				AlertEndpoint: "ftp://example.com/alerts",
+			},
+		},
+		{
+			name: "AuthUsersHaveNoPassword",
+			error: eris.New(
+				"error validating service configuration: 'auth-users' flag must be a list of 'username:password' pairs",
+			),
+			config: &ServiceConfig{
+				AuthUsers: []string{"reviewer"},
+			},
+		},
+		{
+			name: "AuthUsersHaveDuplicateUser",
+			error: eris.New(
+				"error validating service configuration: user 'admin' is configured more than once",
+			),
+			config: &ServiceConfig{
+				AuthUsername: "admin",
+				AuthPassword: "password",
+				AuthUsers:    []string{"admin:another"},
+			},
+		},
 	}
 
 	for _, tt := range testData {
@@ -111,3 +282,16 @@ func TestServiceConfig_Validate_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceConfig_GetAuthUsers_Ok(t *testing.T) {
+	config := ServiceConfig{
+		AuthUsername: "admin",
+		AuthPassword: "password",
+		AuthUsers:    []string{"reviewer:pass:word"},
+	}
+	assert.Equal(t, map[string]string{
+		"admin":    "password",
+		"reviewer": "pass:word",
+	}, config.GetAuthUsers())
+	assert.Empty(t, (&ServiceConfig{AuthUsername: "admin"}).GetAuthUsers())
+}
@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+)
+
+// CreateAlertRule handles `POST /alert-rules/create` endpoint.
+func (c Controller) CreateAlertRule(ctx *fiber.Ctx) error {
+	var req request.CreateAlertRuleRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("createAlertRule request: %#v", req)
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("createAlertRule namespace: %s", ns.Code)
+	rule, err := c.alertService.CreateAlertRule(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewAlertRuleResponse(rule)
+	log.Debugf("createAlertRule response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// UpdateAlertRule handles `POST /alert-rules/update` endpoint.
+func (c Controller) UpdateAlertRule(ctx *fiber.Ctx) error {
+	var req request.UpdateAlertRuleRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("updateAlertRule request: %#v", req)
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("updateAlertRule namespace: %s", ns.Code)
+	rule, err := c.alertService.UpdateAlertRule(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewAlertRuleResponse(rule)
+	log.Debugf("updateAlertRule response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// GetAlertRule handles `GET /alert-rules/get` endpoint.
+func (c Controller) GetAlertRule(ctx *fiber.Ctx) error {
+	var req request.GetAlertRuleRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	log.Debugf("getAlertRule request: %#v", req)
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getAlertRule namespace: %s", ns.Code)
+	rule, err := c.alertService.GetAlertRule(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewAlertRuleResponse(rule)
+	log.Debugf("getAlertRule response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// DeleteAlertRule handles `POST /alert-rules/delete` endpoint.
+func (c Controller) DeleteAlertRule(ctx *fiber.Ctx) error {
+	var req request.DeleteAlertRuleRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("deleteAlertRule request: %#v", req)
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("deleteAlertRule namespace: %s", ns.Code)
+	if err := c.alertService.DeleteAlertRule(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// ListAlertRules handles `GET /alert-rules/list` endpoint.
+func (c Controller) ListAlertRules(ctx *fiber.Ctx) error {
+	var req request.ListAlertRulesRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	log.Debugf("listAlertRules request: %#v", req)
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("listAlertRules namespace: %s", ns.Code)
+	rules, err := c.alertService.ListAlertRules(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewListAlertRulesResponse(rules)
+	log.Debugf("listAlertRules response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// ListAlerts handles `GET /alerts/list` endpoint.
+func (c Controller) ListAlerts(ctx *fiber.Ctx) error {
+	var req request.ListAlertsRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	log.Debugf("listAlerts request: %#v", req)
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("listAlerts namespace: %s", ns.Code)
+	alerts, err := c.alertService.ListAlerts(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewListAlertsResponse(alerts)
+	log.Debugf("listAlerts response: %#v", resp)
+	return ctx.JSON(resp)
+}
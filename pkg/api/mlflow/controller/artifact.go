@@ -2,8 +2,11 @@ package controller
 
 import (
 	"bufio"
+	"bytes"
+	"errors"
 	"fmt"
 	"io"
+	"net/url"
 	"path/filepath"
 	"time"
 
@@ -15,6 +18,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact"
 	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
 )
 
@@ -32,12 +36,12 @@ func (c Controller) ListArtifacts(ctx *fiber.Ctx) error {
 	}
 	log.Debugf("listArtifacts namespace: %s", ns.Code)
 
-	rootURI, artifacts, err := c.artifactService.ListArtifacts(ctx.Context(), ns, &req)
+	rootURI, artifacts, nextPageToken, err := c.artifactService.ListArtifacts(ctx.Context(), ns, &req)
 	if err != nil {
 		return err
 	}
 
-	resp := response.NewListArtifactsResponse(rootURI, artifacts)
+	resp := response.NewListArtifactsResponse(rootURI, artifacts, nextPageToken)
 	log.Debugf("artifactList response: %#v", resp)
 	return ctx.JSON(resp)
 }
@@ -56,39 +60,285 @@ func (c Controller) GetArtifact(ctx *fiber.Ctx) error {
 	}
 	log.Debugf("getArtifact namespace: %s", ns.Code)
 
-	artifact, err := c.artifactService.GetArtifact(ctx.Context(), ns, &req)
+	content, err := c.artifactService.GetArtifact(ctx.Context(), ns, &req)
 	if err != nil {
 		return err
 	}
 
-	filename := filepath.Base(req.Path)
-	ctx.Set("Content-Type", common.GetContentType(filename))
-	ctx.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
-	ctx.Set("X-Content-Type-Options", "nosniff")
-	ctx.Context().Response.SetBodyStreamWriter(func(w *bufio.Writer) {
-		//nolint:errcheck
-		defer artifact.Close()
+	return sendArtifact(ctx, filepath.Base(req.Path), content)
+}
+
+// DownloadArtifacts handles `GET /artifacts/download` endpoint.
+func (c Controller) DownloadArtifacts(ctx *fiber.Ctx) error {
+	req := request.DownloadArtifactsRequest{}
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	log.Debugf("downloadArtifacts request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("downloadArtifacts namespace: %s", ns.Code)
+
+	archive, err := c.artifactService.DownloadArtifacts(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	streamArchive(ctx, archive)
+	return nil
+}
+
+// ListProxiedArtifacts handles `GET /mlflow-artifacts/artifacts` endpoint.
+func (c Controller) ListProxiedArtifacts(ctx *fiber.Ctx) error {
+	req := request.ListProxiedArtifactsRequest{}
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	log.Debugf("listProxiedArtifacts request: %#v", req)
+
+	artifacts, err := c.artifactService.ListProxiedArtifacts(ctx.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewListProxiedArtifactsResponse(artifacts)
+	log.Debugf("listProxiedArtifacts response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// DownloadProxiedArtifact handles `GET /mlflow-artifacts/artifacts/*` endpoint.
+func (c Controller) DownloadProxiedArtifact(ctx *fiber.Ctx) error {
+	path, err := getProxiedArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req := request.DownloadProxiedArtifactRequest{
+		Path: path,
+	}
+	log.Debugf("downloadProxiedArtifact request: %#v", req)
+
+	content, err := c.artifactService.DownloadProxiedArtifact(ctx.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return sendArtifact(ctx, filepath.Base(req.Path), content)
+}
+
+// UploadProxiedArtifact handles `PUT /mlflow-artifacts/artifacts/*` endpoint.
+func (c Controller) UploadProxiedArtifact(ctx *fiber.Ctx) error {
+	path, err := getProxiedArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req := request.UploadProxiedArtifactRequest{
+		Path: path,
+	}
+	log.Debugf("uploadProxiedArtifact request: %#v", req)
+
+	// artifacts can be much bigger than the body limit, so the body is streamed right into the storage.
+	// reading of the big body takes longer than the server read timeout, so the deadline is removed.
+	var body io.Reader
+	if ctx.Request().IsBodyStream() {
+		if err := ctx.Context().Conn().SetReadDeadline(time.Time{}); err != nil {
+			return api.NewInternalError("error resetting read deadline: %s", err)
+		}
+		body = ctx.Request().BodyStream()
+	} else {
+		body = bytes.NewReader(ctx.Body())
+	}
+
+	if err := c.artifactService.UploadProxiedArtifact(ctx.Context(), &req, body); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// DeleteProxiedArtifact handles `DELETE /mlflow-artifacts/artifacts/*` endpoint.
+func (c Controller) DeleteProxiedArtifact(ctx *fiber.Ctx) error {
+	path, err := getProxiedArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req := request.DeleteProxiedArtifactRequest{
+		Path: path,
+	}
+	log.Debugf("deleteProxiedArtifact request: %#v", req)
+
+	if err := c.artifactService.DeleteProxiedArtifact(ctx.Context(), &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
 
+// CreateMultipartUpload handles `POST /mlflow-artifacts/mpu/create/*` endpoint.
+func (c Controller) CreateMultipartUpload(ctx *fiber.Ctx) error {
+	var req request.CreateMultipartUploadRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	artifactPath, err := getProxiedArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req.ArtifactPath = artifactPath
+	log.Debugf("createMultipartUpload request: %#v", req)
+
+	upload, err := c.artifactService.CreateMultipartUpload(ctx.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewCreateMultipartUploadResponse(upload)
+	log.Debugf("createMultipartUpload response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// CompleteMultipartUpload handles `POST /mlflow-artifacts/mpu/complete/*` endpoint.
+func (c Controller) CompleteMultipartUpload(ctx *fiber.Ctx) error {
+	var req request.CompleteMultipartUploadRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	artifactPath, err := getProxiedArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req.ArtifactPath = artifactPath
+	log.Debugf("completeMultipartUpload request: %#v", req)
+
+	if err := c.artifactService.CompleteMultipartUpload(ctx.Context(), &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// AbortMultipartUpload handles `POST /mlflow-artifacts/mpu/abort/*` endpoint.
+func (c Controller) AbortMultipartUpload(ctx *fiber.Ctx) error {
+	var req request.AbortMultipartUploadRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	artifactPath, err := getProxiedArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req.ArtifactPath = artifactPath
+	log.Debugf("abortMultipartUpload request: %#v", req)
+
+	if err := c.artifactService.AbortMultipartUpload(ctx.Context(), &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// GetPresignedDownloadURL handles `GET /mlflow-artifacts/presigned-download/*` endpoint.
+func (c Controller) GetPresignedDownloadURL(ctx *fiber.Ctx) error {
+	path, err := getProxiedArtifactPath(ctx)
+	if err != nil {
+		return err
+	}
+	req := request.GetPresignedDownloadURLRequest{
+		Path: path,
+	}
+	log.Debugf("getPresignedDownloadURL request: %#v", req)
+
+	url, err := c.artifactService.GetPresignedDownloadURL(ctx.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewGetPresignedDownloadURLResponse(url)
+	log.Debugf("getPresignedDownloadURL response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// getProxiedArtifactPath returns unescaped artifact path from the wildcard route parameter.
+// Routes are matched against the original path, so this is the only decoding of the path,
+// and the result is validated by the service exactly as it is passed to the storage.
+func getProxiedArtifactPath(ctx *fiber.Ctx) (string, error) {
+	path, err := url.PathUnescape(ctx.Params("*"))
+	if err != nil {
+		return "", api.NewBadRequestError("error unescaping artifact path: %s", err)
+	}
+	return path, nil
+}
+
+// sendArtifact sends content of the artifact into the response. `Range` header with the single range is honoured,
+// so big artifacts could be previewed or downloaded partially. Malformed header and multiple ranges are ignored,
+// and the whole content is sent in this case.
+func sendArtifact(ctx *fiber.Ctx, filename string, content *artifact.Content) error {
+	var (
+		reader io.ReadCloser
+		err    error
+	)
+	if ctx.Get(fiber.HeaderRange) != "" {
+		ranges, rangeErr := ctx.Range(int(content.Size))
+		switch {
+		case errors.Is(rangeErr, fiber.ErrRangeUnsatisfiable):
+			ctx.Set(fiber.HeaderContentRange, fmt.Sprintf("bytes */%d", content.Size))
+			return ctx.SendStatus(fiber.StatusRequestedRangeNotSatisfiable)
+		case rangeErr == nil && ranges.Type == "bytes" && len(ranges.Ranges) == 1:
+			start, end := int64(ranges.Ranges[0].Start), int64(ranges.Ranges[0].End)
+			if reader, err = content.OpenRange(ctx.Context(), start, end-start+1); err != nil {
+				return err
+			}
+			ctx.Status(fiber.StatusPartialContent)
+			ctx.Set(fiber.HeaderContentRange, fmt.Sprintf("bytes %d-%d/%d", start, end, content.Size))
+			setArtifactHeaders(ctx, filename)
+			ctx.Context().SetBodyStream(reader, int(end-start+1))
+			return nil
+		}
+	}
+
+	if reader, err = content.Open(ctx.Context()); err != nil {
+		return err
+	}
+	setArtifactHeaders(ctx, filename)
+	ctx.Context().SetBodyStream(reader, int(content.Size))
+	return nil
+}
+
+// setArtifactHeaders sets headers of the downloaded artifact file.
+func setArtifactHeaders(ctx *fiber.Ctx, filename string) {
+	ctx.Set(fiber.HeaderContentType, common.GetContentType(filename))
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%s", filename))
+	ctx.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+	ctx.Set(fiber.HeaderAcceptRanges, "bytes")
+}
+
+// streamArchive streams the archive of artifact directory into the response.
+// Size of the archive is unknown until it is built, so the response is chunked.
+func streamArchive(ctx *fiber.Ctx, archive *artifact.Archive) {
+	ctx.Set(fiber.HeaderContentType, archive.ContentType())
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%s", archive.Name))
+	ctx.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+	requestCtx := ctx.Context()
+	requestCtx.Response.SetBodyStreamWriter(func(w *bufio.Writer) {
 		start := time.Now()
 		if err := func() error {
-			bytesWritten, err := io.CopyBuffer(w, artifact, make([]byte, 4096))
-			if err != nil {
-				return eris.Wrap(err, "error copying artifact Reader to output stream")
+			if err := archive.Write(requestCtx, w); err != nil {
+				return eris.Wrap(err, "error writing archive to output stream")
 			}
 			if err := w.Flush(); err != nil {
 				return eris.Wrap(err, "error flushing output stream")
 			}
-			log.Debugf("GetArtifact wrote bytes to output stream: %d", bytesWritten)
 			return nil
 		}(); err != nil {
 			log.Errorf(
-				"error encountered in %s %s: error streaming artifact: %s",
-				ctx.Method(),
-				ctx.Path(),
+				"error encountered in %s %s: error streaming artifact archive: %s",
+				requestCtx.Method(),
+				requestCtx.Path(),
 				err,
 			)
 		}
-		log.Infof("body - %s %s %s", time.Since(start), ctx.Method(), ctx.Path())
+		log.Infof("body - %s %s %s", time.Since(start), requestCtx.Method(), requestCtx.Path())
 	})
-	return nil
 }
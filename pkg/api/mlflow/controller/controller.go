@@ -1,20 +1,30 @@
 package controller
 
 import (
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/alert"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/dataset"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/experiment"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/loggedmodel"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/metric"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/model"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/run"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/sequence"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/trace"
 )
 
 // Controller handles all the input HTTP requests.
 type Controller struct {
-	runService        *run.Service
-	modelService      *model.Service
-	metricService     *metric.Service
-	artifactService   *artifact.Service
-	experimentService *experiment.Service
+	runService         *run.Service
+	modelService       *model.Service
+	metricService      *metric.Service
+	artifactService    *artifact.Service
+	experimentService  *experiment.Service
+	datasetService     *dataset.Service
+	traceService       *trace.Service
+	loggedModelService *loggedmodel.Service
+	alertService       *alert.Service
+	sequenceService    *sequence.Service
 }
 
 // NewController creates new Controller instance.
@@ -24,12 +34,22 @@ func NewController(
 	metricService *metric.Service,
 	artifactService *artifact.Service,
 	experimentService *experiment.Service,
+	datasetService *dataset.Service,
+	traceService *trace.Service,
+	loggedModelService *loggedmodel.Service,
+	alertService *alert.Service,
+	sequenceService *sequence.Service,
 ) *Controller {
 	return &Controller{
-		runService:        runService,
-		modelService:      modelService,
-		metricService:     metricService,
-		artifactService:   artifactService,
-		experimentService: experimentService,
+		runService:         runService,
+		modelService:       modelService,
+		metricService:      metricService,
+		artifactService:    artifactService,
+		experimentService:  experimentService,
+		datasetService:     datasetService,
+		traceService:       traceService,
+		loggedModelService: loggedModelService,
+		alertService:       alertService,
+		sequenceService:    sequenceService,
 	}
 }
@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+)
+
+// LogInputs handles `POST /runs/log-inputs` endpoint.
+func (c Controller) LogInputs(ctx *fiber.Ctx) error {
+	var req request.LogInputsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("logInputs request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("logInputs namespace: %s", ns.Code)
+
+	if err := c.datasetService.LogInputs(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// SearchDatasets handles `POST /experiments/search-datasets` endpoint.
+func (c Controller) SearchDatasets(ctx *fiber.Ctx) error {
+	var req request.SearchDatasetsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("searchDatasets request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("searchDatasets namespace: %s", ns.Code)
+
+	summaries, err := c.datasetService.SearchDatasets(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewSearchDatasetsResponse(summaries)
+	log.Debugf("searchDatasets response: %#v", resp)
+	return ctx.JSON(resp)
+}
@@ -179,12 +179,12 @@ func (c Controller) SearchExperiments(ctx *fiber.Ctx) error {
 		return api.NewInternalError("error getting namespace from context")
 	}
 	log.Debugf("searchExperiments namespace: %s", ns.Code)
-	experiments, limit, offset, err := c.experimentService.SearchExperiments(ctx.Context(), ns, &req)
+	experiments, nextPageToken, err := c.experimentService.SearchExperiments(ctx.Context(), ns, &req)
 	if err != nil {
 		return err
 	}
 
-	resp, err := response.NewSearchExperimentsResponse(experiments, limit, offset)
+	resp, err := response.NewSearchExperimentsResponse(experiments, nextPageToken)
 	if err != nil {
 		return api.NewInternalError("unable to build next_page_token: %s", err)
 	}
@@ -0,0 +1,198 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+)
+
+// CreateLoggedModel handles `POST /logged-models` endpoint.
+func (c Controller) CreateLoggedModel(ctx *fiber.Ctx) error {
+	var req request.CreateLoggedModelRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("createLoggedModel request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("createLoggedModel namespace: %s", ns.Code)
+
+	model, err := c.loggedModelService.CreateLoggedModel(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewLoggedModelResponse(model)
+	log.Debugf("createLoggedModel response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// GetLoggedModel handles `GET /logged-models/:model_id` endpoint.
+func (c Controller) GetLoggedModel(ctx *fiber.Ctx) error {
+	req := request.GetLoggedModelRequest{
+		ModelID: ctx.Params("model_id"),
+	}
+	log.Debugf("getLoggedModel request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getLoggedModel namespace: %s", ns.Code)
+
+	model, err := c.loggedModelService.GetLoggedModel(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewLoggedModelResponse(model)
+	log.Debugf("getLoggedModel response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// FinalizeLoggedModel handles `PATCH /logged-models/:model_id` endpoint.
+func (c Controller) FinalizeLoggedModel(ctx *fiber.Ctx) error {
+	var req request.FinalizeLoggedModelRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	req.ModelID = ctx.Params("model_id")
+	log.Debugf("finalizeLoggedModel request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("finalizeLoggedModel namespace: %s", ns.Code)
+
+	model, err := c.loggedModelService.FinalizeLoggedModel(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewLoggedModelResponse(model)
+	log.Debugf("finalizeLoggedModel response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// DeleteLoggedModel handles `DELETE /logged-models/:model_id` endpoint.
+func (c Controller) DeleteLoggedModel(ctx *fiber.Ctx) error {
+	req := request.DeleteLoggedModelRequest{
+		ModelID: ctx.Params("model_id"),
+	}
+	log.Debugf("deleteLoggedModel request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("deleteLoggedModel namespace: %s", ns.Code)
+
+	if err := c.loggedModelService.DeleteLoggedModel(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// SetLoggedModelTags handles `PATCH /logged-models/:model_id/tags` endpoint.
+func (c Controller) SetLoggedModelTags(ctx *fiber.Ctx) error {
+	var req request.SetLoggedModelTagsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	req.ModelID = ctx.Params("model_id")
+	log.Debugf("setLoggedModelTags request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("setLoggedModelTags namespace: %s", ns.Code)
+
+	model, err := c.loggedModelService.SetLoggedModelTags(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewLoggedModelResponse(model)
+	log.Debugf("setLoggedModelTags response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// DeleteLoggedModelTag handles `DELETE /logged-models/:model_id/tags/:tag_key` endpoint.
+func (c Controller) DeleteLoggedModelTag(ctx *fiber.Ctx) error {
+	req := request.DeleteLoggedModelTagRequest{
+		ModelID: ctx.Params("model_id"),
+		TagKey:  ctx.Params("tag_key"),
+	}
+	log.Debugf("deleteLoggedModelTag request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("deleteLoggedModelTag namespace: %s", ns.Code)
+
+	if err := c.loggedModelService.DeleteLoggedModelTag(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// LogLoggedModelParams handles `POST /logged-models/:model_id/params` endpoint.
+func (c Controller) LogLoggedModelParams(ctx *fiber.Ctx) error {
+	var req request.LogLoggedModelParamsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	req.ModelID = ctx.Params("model_id")
+	log.Debugf("logLoggedModelParams request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("logLoggedModelParams namespace: %s", ns.Code)
+
+	if err := c.loggedModelService.LogLoggedModelParams(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// SearchLoggedModels handles `POST /logged-models/search` endpoint.
+func (c Controller) SearchLoggedModels(ctx *fiber.Ctx) error {
+	var req request.SearchLoggedModelsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("searchLoggedModels request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("searchLoggedModels namespace: %s", ns.Code)
+
+	loggedModels, nextPageToken, err := c.loggedModelService.SearchLoggedModels(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := response.NewSearchLoggedModelsResponse(loggedModels, nextPageToken)
+	if err != nil {
+		return api.NewInternalError("unable to build next_page_token: %s", err)
+	}
+	log.Debugf("searchLoggedModels response: %#v", resp)
+	return ctx.JSON(resp)
+}
@@ -32,12 +32,12 @@ func (c Controller) GetMetricHistory(ctx *fiber.Ctx) error {
 		return api.NewInternalError("error getting namespace from context")
 	}
 	log.Debugf("getMetricHistory namespace: %s", ns.Code)
-	metrics, err := c.metricService.GetMetricHistory(ctx.Context(), ns, &req)
+	metrics, nextPageToken, err := c.metricService.GetMetricHistory(ctx.Context(), ns, &req)
 	if err != nil {
 		return err
 	}
 
-	resp, err := response.NewMetricHistoryResponse(metrics)
+	resp, err := response.NewMetricHistoryResponse(metrics, nextPageToken)
 	if err != nil {
 		return err
 	}
@@ -60,17 +60,48 @@ func (c Controller) GetMetricHistoryBulk(ctx *fiber.Ctx) error {
 	}
 	log.Debugf("getMetricHistoryBulk namespace: %s", ns.Code)
 
-	metrics, err := c.metricService.GetMetricHistoryBulk(ctx.Context(), ns, &req)
+	metrics, nextPageToken, err := c.metricService.GetMetricHistoryBulk(ctx.Context(), ns, &req)
 	if err != nil {
 		return err
 	}
 
-	resp := response.NewMetricHistoryBulkResponse(metrics)
+	resp, err := response.NewMetricHistoryBulkResponse(metrics, nextPageToken)
+	if err != nil {
+		return err
+	}
 	log.Debugf("getMetricHistoryBulk response: %#v", resp)
 
 	return ctx.JSON(resp)
 }
 
+// GetMetricHistoryBulkInterval handles `GET /metrics/get-history-bulk-interval` endpoint.
+func (c Controller) GetMetricHistoryBulkInterval(ctx *fiber.Ctx) error {
+	req := request.GetMetricHistoryBulkIntervalRequest{}
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	log.Debugf("getMetricHistoryBulkInterval request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getMetricHistoryBulkInterval namespace: %s", ns.Code)
+
+	metrics, err := c.metricService.GetMetricHistoryBulkInterval(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := response.NewMetricHistoryBulkResponse(metrics, nil)
+	if err != nil {
+		return err
+	}
+	log.Debugf("getMetricHistoryBulkInterval response: %#v", resp)
+
+	return ctx.JSON(resp)
+}
+
 // GetMetricHistories handles `POST /metrics/get-histories` endpoint.
 func (c Controller) GetMetricHistories(ctx *fiber.Ctx) error {
 	var req request.GetMetricHistoriesRequest
@@ -154,3 +185,45 @@ func (c Controller) GetMetricHistories(ctx *fiber.Ctx) error {
 	})
 	return nil
 }
+
+// DeleteMetric handles `POST /metrics/delete` endpoint.
+func (c Controller) DeleteMetric(ctx *fiber.Ctx) error {
+	var req request.DeleteMetricRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("deleteMetric request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("deleteMetric namespace: %s", ns.Code)
+
+	if err := c.metricService.DeleteMetric(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// TruncateMetrics handles `POST /metrics/truncate` endpoint.
+func (c Controller) TruncateMetrics(ctx *fiber.Ctx) error {
+	var req request.TruncateMetricsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("truncateMetrics request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("truncateMetrics namespace: %s", ns.Code)
+
+	if err := c.metricService.TruncateMetrics(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
@@ -100,12 +100,12 @@ func (c Controller) SearchRuns(ctx *fiber.Ctx) error {
 	}
 	log.Debugf("searchRuns namespace: %s", ns.Code)
 
-	runs, limit, offset, err := c.runService.SearchRuns(ctx.Context(), ns, &req)
+	runs, nextPageToken, err := c.runService.SearchRuns(ctx.Context(), ns, &req)
 	if err != nil {
 		return err
 	}
 
-	resp, err := response.NewSearchRunsResponse(runs, limit, offset)
+	resp, err := response.NewSearchRunsResponse(runs, nextPageToken)
 	if err != nil {
 		return api.NewInternalError("Unable to build next_page_token: %s", err)
 	}
@@ -156,6 +156,54 @@ func (c Controller) RestoreRun(ctx *fiber.Ctx) error {
 	return ctx.JSON(fiber.Map{})
 }
 
+// MoveRun handles `POST /runs/move` endpoint.
+func (c Controller) MoveRun(ctx *fiber.Ctx) error {
+	var req request.MoveRunRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("moveRun request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("moveRun namespace: %s", ns.Code)
+
+	run, err := c.runService.MoveRun(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+	resp := response.NewMoveRunResponse(run)
+	log.Debugf("moveRun response: %#v", resp)
+
+	return ctx.JSON(resp)
+}
+
+// CloneRun handles `POST /runs/clone` endpoint.
+func (c Controller) CloneRun(ctx *fiber.Ctx) error {
+	var req request.CloneRunRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("cloneRun request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("cloneRun namespace: %s", ns.Code)
+
+	run, err := c.runService.CloneRun(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+	resp := response.NewCloneRunResponse(run)
+	log.Debugf("cloneRun response: %#v", resp)
+
+	return ctx.JSON(resp)
+}
+
 // LogMetric handles `POST /runs/log-metric` endpoint.
 func (c Controller) LogMetric(ctx *fiber.Ctx) error {
 	var req request.LogMetricRequest
@@ -287,3 +335,24 @@ func (c Controller) LogBatch(ctx *fiber.Ctx) error {
 
 	return ctx.JSON(fiber.Map{})
 }
+
+// RunHeartbeat handles `POST /runs/heartbeat` endpoint.
+func (c Controller) RunHeartbeat(ctx *fiber.Ctx) error {
+	var req request.RunHeartbeatRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("runHeartbeat request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("runHeartbeat namespace: %s", ns.Code)
+
+	if err := c.runService.Heartbeat(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
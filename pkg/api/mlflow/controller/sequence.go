@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+)
+
+// LogImages handles `POST /runs/log-images` endpoint.
+func (c Controller) LogImages(ctx *fiber.Ctx) error {
+	var req request.LogImagesRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("logImages request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("logImages namespace: %s", ns.Code)
+
+	if err := c.sequenceService.LogImages(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// LogTexts handles `POST /runs/log-texts` endpoint.
+func (c Controller) LogTexts(ctx *fiber.Ctx) error {
+	var req request.LogTextsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("logTexts request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("logTexts namespace: %s", ns.Code)
+
+	if err := c.sequenceService.LogTexts(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// LogDistributions handles `POST /runs/log-distributions` endpoint.
+func (c Controller) LogDistributions(ctx *fiber.Ctx) error {
+	var req request.LogDistributionsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("logDistributions request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("logDistributions namespace: %s", ns.Code)
+
+	if err := c.sequenceService.LogDistributions(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
@@ -0,0 +1,206 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/trace"
+	"github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
+)
+
+// StartTrace handles `POST /traces` endpoint.
+func (c Controller) StartTrace(ctx *fiber.Ctx) error {
+	var req request.StartTraceRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("startTrace request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("startTrace namespace: %s", ns.Code)
+
+	traceInfo, err := c.traceService.StartTrace(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewTraceInfoResponse(traceInfo)
+	log.Debugf("startTrace response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// EndTrace handles `PATCH /traces/:request_id` endpoint.
+func (c Controller) EndTrace(ctx *fiber.Ctx) error {
+	var req request.EndTraceRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	req.RequestID = ctx.Params("request_id")
+	log.Debugf("endTrace request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("endTrace namespace: %s", ns.Code)
+
+	traceInfo, err := c.traceService.EndTrace(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewTraceInfoResponse(traceInfo)
+	log.Debugf("endTrace response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// GetTraceInfo handles `GET /traces/:request_id/info` endpoint.
+func (c Controller) GetTraceInfo(ctx *fiber.Ctx) error {
+	req := request.GetTraceInfoRequest{
+		RequestID: ctx.Params("request_id"),
+	}
+	log.Debugf("getTraceInfo request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getTraceInfo namespace: %s", ns.Code)
+
+	traceInfo, err := c.traceService.GetTraceInfo(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewTraceInfoResponse(traceInfo)
+	log.Debugf("getTraceInfo response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// SearchTraces handles `GET /traces` endpoint.
+func (c Controller) SearchTraces(ctx *fiber.Ctx) error {
+	var req request.SearchTracesRequest
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	log.Debugf("searchTraces request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("searchTraces namespace: %s", ns.Code)
+
+	traces, nextPageToken, err := c.traceService.SearchTraces(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := response.NewSearchTracesResponse(traces, nextPageToken)
+	if err != nil {
+		return api.NewInternalError("unable to build next_page_token: %s", err)
+	}
+	log.Debugf("searchTraces response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// DeleteTraces handles `POST /traces/delete-traces` endpoint.
+func (c Controller) DeleteTraces(ctx *fiber.Ctx) error {
+	var req request.DeleteTracesRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	log.Debugf("deleteTraces request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("deleteTraces namespace: %s", ns.Code)
+
+	tracesDeleted, err := c.traceService.DeleteTraces(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewDeleteTracesResponse(tracesDeleted)
+	log.Debugf("deleteTraces response: %#v", resp)
+	return ctx.JSON(resp)
+}
+
+// SetTraceTag handles `PATCH /traces/:request_id/tags` endpoint.
+func (c Controller) SetTraceTag(ctx *fiber.Ctx) error {
+	var req request.SetTraceTagRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return api.NewBadRequestError("Unable to decode request body: %s", err)
+	}
+	req.RequestID = ctx.Params("request_id")
+	log.Debugf("setTraceTag request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("setTraceTag namespace: %s", ns.Code)
+
+	if err := c.traceService.SetTraceTag(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// DeleteTraceTag handles `DELETE /traces/:request_id/tags` endpoint.
+// The key is accepted both in the request body and in the query.
+func (c Controller) DeleteTraceTag(ctx *fiber.Ctx) error {
+	var req request.DeleteTraceTagRequest
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&req); err != nil {
+			return api.NewBadRequestError("Unable to decode request body: %s", err)
+		}
+	} else if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	req.RequestID = ctx.Params("request_id")
+	log.Debugf("deleteTraceTag request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("deleteTraceTag namespace: %s", ns.Code)
+
+	if err := c.traceService.DeleteTraceTag(ctx.Context(), ns, &req); err != nil {
+		return err
+	}
+
+	return ctx.JSON(fiber.Map{})
+}
+
+// GetTraceArtifact handles `GET /get-trace-artifact` endpoint.
+func (c Controller) GetTraceArtifact(ctx *fiber.Ctx) error {
+	req := request.GetTraceArtifactRequest{}
+	if err := ctx.QueryParser(&req); err != nil {
+		return api.NewBadRequestError(err.Error())
+	}
+	log.Debugf("getTraceArtifact request: %#v", req)
+
+	ns, err := namespace.GetNamespaceFromContext(ctx.Context())
+	if err != nil {
+		return api.NewInternalError("error getting namespace from context")
+	}
+	log.Debugf("getTraceArtifact namespace: %s", ns.Code)
+
+	content, err := c.traceService.GetTraceArtifact(ctx.Context(), ns, &req)
+	if err != nil {
+		return err
+	}
+
+	return sendArtifact(ctx, trace.TraceDataFileName, content)
+}
@@ -3,6 +3,7 @@ package convertors
 // TODO:DSuhinin not fully sure about naming of this file. Any suggestions?
 
 import (
+	"database/sql"
 	"encoding/json"
 	"math"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/database"
 )
 
 // ConvertLogParamRequestToDBModel converts request.LogParamRequest into actual models.Param model.
@@ -51,6 +53,7 @@ func ConvertLogBatchRequestToDBModel(
 			Timestamp: metric.Timestamp,
 			Step:      metric.Step,
 			RunID:     runID,
+			ModelID:   sql.NullString{String: metric.ModelID, Valid: metric.ModelID != ""},
 		}
 		if v, ok := metric.Value.(float64); ok {
 			m.Value = v
@@ -84,3 +87,32 @@ func ConvertLogBatchRequestToDBModel(
 	}
 	return metrics, params, tags, nil
 }
+
+// ConvertLogInputsRequestToDBModel converts request.LogInputsRequest into actual []models.Input models.
+func ConvertLogInputsRequestToDBModel(req *request.LogInputsRequest) []models.Input {
+	inputs := make([]models.Input, len(req.Datasets))
+	for i, datasetInput := range req.Datasets {
+		inputID := database.NewUUID()
+		inputs[i] = models.Input{
+			ID: inputID,
+			Dataset: models.Dataset{
+				ID:         database.NewUUID(),
+				Name:       datasetInput.Dataset.Name,
+				Digest:     datasetInput.Dataset.Digest,
+				SourceType: datasetInput.Dataset.SourceType,
+				Source:     datasetInput.Dataset.Source,
+				Schema:     datasetInput.Dataset.Schema,
+				Profile:    datasetInput.Dataset.Profile,
+			},
+			Tags: make([]models.InputTag, len(datasetInput.Tags)),
+		}
+		for j, tag := range datasetInput.Tags {
+			inputs[i].Tags[j] = models.InputTag{
+				InputID: inputID,
+				Name:    tag.Key,
+				Value:   tag.Value,
+			}
+		}
+	}
+	return inputs
+}
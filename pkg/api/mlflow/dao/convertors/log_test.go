@@ -296,3 +296,32 @@ func TestConvertLogBatchRequestToDBModel_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertLogInputsRequestToDBModel_Ok(t *testing.T) {
+	inputs := ConvertLogInputsRequestToDBModel(&request.LogInputsRequest{
+		RunID: "run_id",
+		Datasets: []request.DatasetInputPartialRequest{
+			{
+				Tags: []request.InputTagPartialRequest{{Key: "key", Value: "value"}},
+				Dataset: request.DatasetPartialRequest{
+					Name:       "name",
+					Digest:     "digest",
+					SourceType: "local",
+					Source:     "source",
+					Schema:     "schema",
+					Profile:    "profile",
+				},
+			},
+		},
+	})
+	require.Len(t, inputs, 1)
+	assert.NotEmpty(t, inputs[0].ID)
+	assert.NotEmpty(t, inputs[0].Dataset.ID)
+	assert.Equal(t, "name", inputs[0].Dataset.Name)
+	assert.Equal(t, "digest", inputs[0].Dataset.Digest)
+	assert.Equal(t, "local", inputs[0].Dataset.SourceType)
+	assert.Equal(t, "source", inputs[0].Dataset.Source)
+	assert.Equal(t, "schema", inputs[0].Dataset.Schema)
+	assert.Equal(t, "profile", inputs[0].Dataset.Profile)
+	assert.Equal(t, []models.InputTag{{InputID: inputs[0].ID, Name: "key", Value: "value"}}, inputs[0].Tags)
+}
@@ -0,0 +1,57 @@
+package convertors
+
+import (
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// ConvertCreateLoggedModelRequestToDBModel converts request.CreateLoggedModelRequest
+// into actual models.LoggedModel model.
+func ConvertCreateLoggedModelRequestToDBModel(
+	experimentID int32, modelID string, req *request.CreateLoggedModelRequest,
+) *models.LoggedModel {
+	model := models.LoggedModel{
+		ID:             modelID,
+		ExperimentID:   experimentID,
+		Name:           req.Name,
+		Status:         models.LoggedModelStatusPending,
+		LifecycleStage: models.LifecycleStageActive,
+		ModelType:      req.ModelType,
+		SourceRunID:    req.SourceRunID,
+		Params:         ConvertLoggedModelParamsToDBModel(modelID, req.Params),
+		Tags:           ConvertLoggedModelTagsToDBModel(modelID, req.Tags),
+	}
+	return &model
+}
+
+// ConvertLoggedModelParamsToDBModel converts []request.LoggedModelParamPartialRequest
+// into actual []models.LoggedModelParam models.
+func ConvertLoggedModelParamsToDBModel(
+	modelID string, params []request.LoggedModelParamPartialRequest,
+) []models.LoggedModelParam {
+	result := make([]models.LoggedModelParam, len(params))
+	for n, param := range params {
+		result[n] = models.LoggedModelParam{
+			Key:     param.Key,
+			Value:   param.Value,
+			ModelID: modelID,
+		}
+	}
+	return result
+}
+
+// ConvertLoggedModelTagsToDBModel converts []request.LoggedModelTagPartialRequest
+// into actual []models.LoggedModelTag models.
+func ConvertLoggedModelTagsToDBModel(
+	modelID string, tags []request.LoggedModelTagPartialRequest,
+) []models.LoggedModelTag {
+	result := make([]models.LoggedModelTag, len(tags))
+	for n, tag := range tags {
+		result[n] = models.LoggedModelTag{
+			Key:     tag.Key,
+			Value:   tag.Value,
+			ModelID: modelID,
+		}
+	}
+	return result
+}
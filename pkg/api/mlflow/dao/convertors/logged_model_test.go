@@ -0,0 +1,41 @@
+package convertors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+func TestConvertCreateLoggedModelRequestToDBModel_Ok(t *testing.T) {
+	req := request.CreateLoggedModelRequest{
+		ExperimentID: "1",
+		Name:         "model",
+		ModelType:    "sklearn",
+		SourceRunID:  "run-id",
+		Params: []request.LoggedModelParamPartialRequest{
+			{Key: "alpha", Value: "0.5"},
+		},
+		Tags: []request.LoggedModelTagPartialRequest{
+			{Key: "env", Value: "test"},
+		},
+	}
+	result := ConvertCreateLoggedModelRequestToDBModel(1, "m-id", &req)
+	assert.Equal(t, &models.LoggedModel{
+		ID:             "m-id",
+		ExperimentID:   1,
+		Name:           "model",
+		Status:         models.LoggedModelStatusPending,
+		LifecycleStage: models.LifecycleStageActive,
+		ModelType:      "sklearn",
+		SourceRunID:    "run-id",
+		Params: []models.LoggedModelParam{
+			{Key: "alpha", Value: "0.5", ModelID: "m-id"},
+		},
+		Tags: []models.LoggedModelTag{
+			{Key: "env", Value: "test", ModelID: "m-id"},
+		},
+	}, result)
+}
@@ -1,6 +1,7 @@
 package convertors
 
 import (
+	"database/sql"
 	"encoding/json"
 	"math"
 
@@ -18,6 +19,7 @@ func ConvertLogMetricRequestToDBModel(runID string, req *request.LogMetricReques
 		Timestamp: req.Timestamp,
 		Step:      req.Step,
 		RunID:     runID,
+		ModelID:   sql.NullString{String: req.ModelID, Valid: req.ModelID != ""},
 	}
 	if req.Context == nil || len(req.Context) == 0 {
 		metric.Context = models.DefaultContext
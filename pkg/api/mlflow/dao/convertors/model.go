@@ -0,0 +1,99 @@
+package convertors
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// ConvertCreateRegisteredModelRequestToDBModel converts request.CreateRegisteredModelRequest
+// into actual models.RegisteredModel model.
+func ConvertCreateRegisteredModelRequestToDBModel(
+	namespaceID uint, req *request.CreateRegisteredModelRequest,
+) *models.RegisteredModel {
+	ts := time.Now().UTC().UnixMilli()
+	registeredModel := models.RegisteredModel{
+		Name:        req.Name,
+		Description: req.Description,
+		NamespaceID: namespaceID,
+		CreationTime: sql.NullInt64{
+			Int64: ts,
+			Valid: true,
+		},
+		LastUpdatedTime: sql.NullInt64{
+			Int64: ts,
+			Valid: true,
+		},
+		Tags: make([]models.RegisteredModelTag, len(req.Tags)),
+	}
+	for n, tag := range req.Tags {
+		registeredModel.Tags[n] = models.RegisteredModelTag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		}
+	}
+	return &registeredModel
+}
+
+// ConvertCreateModelVersionRequestToDBModel converts request.CreateModelVersionRequest
+// into actual models.ModelVersion model.
+func ConvertCreateModelVersionRequestToDBModel(
+	registeredModel *models.RegisteredModel, req *request.CreateModelVersionRequest,
+) *models.ModelVersion {
+	ts := time.Now().UTC().UnixMilli()
+	modelVersion := models.ModelVersion{
+		Description:       req.Description,
+		CurrentStage:      models.ModelVersionStageNone,
+		Source:            req.Source,
+		RunID:             req.RunID,
+		RunLink:           req.RunLink,
+		Status:            models.ModelVersionStatusReady,
+		RegisteredModelID: registeredModel.ID,
+		RegisteredModel:   *registeredModel,
+		CreationTime: sql.NullInt64{
+			Int64: ts,
+			Valid: true,
+		},
+		LastUpdatedTime: sql.NullInt64{
+			Int64: ts,
+			Valid: true,
+		},
+		Tags: make([]models.ModelVersionTag, len(req.Tags)),
+	}
+	for n, tag := range req.Tags {
+		modelVersion.Tags[n] = models.ModelVersionTag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		}
+	}
+	return &modelVersion
+}
+
+// ConvertCreateTransitionRequestRequestToDBModel converts request.CreateTransitionRequestRequest
+// into actual models.ModelVersionTransitionRequest model.
+func ConvertCreateTransitionRequestRequestToDBModel(
+	modelVersion *models.ModelVersion,
+	stage models.ModelVersionStage,
+	userID string,
+	req *request.CreateTransitionRequestRequest,
+) *models.ModelVersionTransitionRequest {
+	ts := time.Now().UTC().UnixMilli()
+	return &models.ModelVersionTransitionRequest{
+		ToStage: stage,
+		Status:  models.ModelVersionTransitionRequestStatusPending,
+		Comment: req.Comment,
+		UserID:  userID,
+		CreationTime: sql.NullInt64{
+			Int64: ts,
+			Valid: true,
+		},
+		LastUpdatedTime: sql.NullInt64{
+			Int64: ts,
+			Valid: true,
+		},
+		ModelVersionID: modelVersion.ID,
+		ModelVersion:   *modelVersion,
+	}
+}
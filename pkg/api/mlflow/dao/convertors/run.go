@@ -3,6 +3,7 @@ package convertors
 import (
 	"database/sql"
 	"net/url"
+	"time"
 
 	"github.com/rotisserie/eris"
 
@@ -13,10 +14,11 @@ import (
 
 // supported tag keys.
 const (
-	TagKeyUser       = "mlflow.user"
-	TagKeyRunName    = "mlflow.runName"
-	TagKeySourceName = "mlflow.source.name"
-	TagKeySourceType = "mlflow.source.type"
+	TagKeyUser        = "mlflow.user"
+	TagKeyRunName     = "mlflow.runName"
+	TagKeySourceName  = "mlflow.source.name"
+	TagKeySourceType  = "mlflow.source.type"
+	TagKeyStaleReason = "fasttrackml.staleReason"
 )
 
 // ConvertCreateRunRequestToDBModel converts request.CreateRunRequest into actual models.Run model.
@@ -38,6 +40,10 @@ func ConvertCreateRunRequestToDBModel(
 			Int64: req.StartTime,
 			Valid: true,
 		},
+		LastSeenTime: sql.NullInt64{
+			Int64: time.Now().UTC().UnixMilli(),
+			Valid: true,
+		},
 		ArtifactURI:    artifactURI,
 		ExperimentID:   *experiment.ID,
 		LifecycleStage: models.LifecycleStageActive,
@@ -82,6 +88,41 @@ func ConvertCreateRunRequestToDBModel(
 	return &run, nil
 }
 
+// ConvertCloneRunRequestToDBModel converts request.CloneRunRequest into the copy of the source models.Run model,
+// which belongs to the target experiment. The copy keeps the name of the source run, unless new one is requested.
+func ConvertCloneRunRequestToDBModel(
+	source *models.Run, experiment *models.Experiment, req *request.CloneRunRequest,
+) (*models.Run, error) {
+	runID := database.NewUUID()
+	artifactURI, err := url.JoinPath(experiment.ArtifactLocation, runID, "artifacts")
+	if err != nil {
+		return nil, eris.Wrap(err, "error constructing artifact_uri")
+	}
+	run := models.Run{
+		ID:             runID,
+		Name:           source.Name,
+		SourceType:     source.SourceType,
+		SourceName:     source.SourceName,
+		EntryPointName: source.EntryPointName,
+		UserID:         source.UserID,
+		Status:         source.Status,
+		StartTime:      source.StartTime,
+		EndTime:        source.EndTime,
+		SourceVersion:  source.SourceVersion,
+		LastSeenTime: sql.NullInt64{
+			Int64: time.Now().UTC().UnixMilli(),
+			Valid: true,
+		},
+		ArtifactURI:    artifactURI,
+		ExperimentID:   *experiment.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	}
+	if req.Name != "" {
+		run.Name = req.Name
+	}
+	return &run, nil
+}
+
 // ConvertUpdateRunRequestToDBModel converts request.UpdateRunRequest into actual models.Run model.
 func ConvertUpdateRunRequestToDBModel(run *models.Run, req *request.UpdateRunRequest) *models.Run {
 	run.Name = req.Name
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 )
 
@@ -38,6 +39,7 @@ func TestConvertCreateRunRequestToDBModel(t *testing.T) {
 				assert.Equal(t, "user_id", run.UserID)
 				assert.Equal(t, models.StatusRunning, run.Status)
 				assert.Equal(t, sql.NullInt64{Valid: true, Int64: 1234567890}, run.StartTime)
+				assert.True(t, run.LastSeenTime.Valid)
 				assert.Equal(t, models.LifecycleStageActive, run.LifecycleStage)
 				assert.Contains(t, run.ArtifactURI, "artifacts")
 				assert.Equal(t, []models.Tag{
@@ -191,6 +193,41 @@ func TestConvertCreateRunRequestToDBModel(t *testing.T) {
 	}
 }
 
+func TestConvertCloneRunRequestToDBModel(t *testing.T) {
+	source := models.Run{
+		ID:         "source",
+		Name:       "source",
+		SourceType: "JOB",
+		UserID:     "user_id",
+		Status:     models.StatusFinished,
+		StartTime:  sql.NullInt64{Valid: true, Int64: 1234567890},
+		EndTime:    sql.NullInt64{Valid: true, Int64: 1234567899},
+	}
+	experiment := models.Experiment{
+		ID:               common.GetPointer[int32](2),
+		ArtifactLocation: "s3://bucket/2",
+	}
+
+	result, err := ConvertCloneRunRequestToDBModel(&source, &experiment, &request.CloneRunRequest{})
+	require.Nil(t, err)
+	assert.NotEmpty(t, result.ID)
+	assert.NotEqual(t, source.ID, result.ID)
+	assert.Equal(t, "source", result.Name)
+	assert.Equal(t, int32(2), result.ExperimentID)
+	assert.Equal(t, "JOB", result.SourceType)
+	assert.Equal(t, "user_id", result.UserID)
+	assert.Equal(t, models.StatusFinished, result.Status)
+	assert.Equal(t, source.StartTime, result.StartTime)
+	assert.Equal(t, source.EndTime, result.EndTime)
+	assert.True(t, result.LastSeenTime.Valid)
+	assert.Equal(t, models.LifecycleStageActive, result.LifecycleStage)
+	assert.Equal(t, "s3://bucket/2/"+result.ID+"/artifacts", result.ArtifactURI)
+
+	result, err = ConvertCloneRunRequestToDBModel(&source, &experiment, &request.CloneRunRequest{Name: "clone"})
+	require.Nil(t, err)
+	assert.Equal(t, "clone", result.Name)
+}
+
 func TestConvertUpdateRunRequestToDBModel(t *testing.T) {
 	req := request.UpdateRunRequest{
 		Name:    "name",
@@ -0,0 +1,103 @@
+package convertors
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math"
+
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// ConvertLogImagesRequestToDBModel converts request.LogImagesRequest into actual []models.Image models.
+func ConvertLogImagesRequestToDBModel(runID string, req *request.LogImagesRequest) ([]models.Image, error) {
+	images := make([]models.Image, len(req.Images))
+	for i, image := range req.Images {
+		sequenceContext, err := convertSequenceContext(image.Context)
+		if err != nil {
+			return nil, err
+		}
+		images[i] = models.Image{
+			RunID:     runID,
+			Name:      image.Name,
+			Context:   sequenceContext,
+			Step:      image.Step,
+			Index:     image.Index,
+			Timestamp: image.Timestamp,
+			Caption:   image.Caption,
+			Format:    image.Format,
+			Width:     image.Width,
+			Height:    image.Height,
+			BlobURI:   image.BlobURI,
+		}
+	}
+	return images, nil
+}
+
+// ConvertLogTextsRequestToDBModel converts request.LogTextsRequest into actual []models.Text models.
+func ConvertLogTextsRequestToDBModel(runID string, req *request.LogTextsRequest) ([]models.Text, error) {
+	texts := make([]models.Text, len(req.Texts))
+	for i, text := range req.Texts {
+		sequenceContext, err := convertSequenceContext(text.Context)
+		if err != nil {
+			return nil, err
+		}
+		texts[i] = models.Text{
+			RunID:     runID,
+			Name:      text.Name,
+			Context:   sequenceContext,
+			Step:      text.Step,
+			Index:     text.Index,
+			Timestamp: text.Timestamp,
+			Data:      text.Data,
+		}
+	}
+	return texts, nil
+}
+
+// ConvertLogDistributionsRequestToDBModel converts request.LogDistributionsRequest into actual
+// []models.Distribution models.
+func ConvertLogDistributionsRequestToDBModel(
+	runID string, req *request.LogDistributionsRequest,
+) ([]models.Distribution, error) {
+	distributions := make([]models.Distribution, len(req.Distributions))
+	for i, distribution := range req.Distributions {
+		sequenceContext, err := convertSequenceContext(distribution.Context)
+		if err != nil {
+			return nil, err
+		}
+		distributions[i] = models.Distribution{
+			RunID:     runID,
+			Name:      distribution.Name,
+			Context:   sequenceContext,
+			Step:      distribution.Step,
+			Timestamp: distribution.Timestamp,
+			BinEdges:  encodeFloat64s(distribution.BinEdges),
+			Counts:    encodeFloat64s(distribution.Counts),
+		}
+	}
+	return distributions, nil
+}
+
+// encodeFloat64s encodes the values as little-endian float64 numbers, which is the layout of numpy float64 array.
+func encodeFloat64s(values []float64) []byte {
+	data := make([]byte, 8*len(values))
+	for i, v := range values {
+		binary.LittleEndian.PutUint64(data[8*i:], math.Float64bits(v))
+	}
+	return data
+}
+
+// convertSequenceContext converts the context of the sequence into models.Context.
+func convertSequenceContext(sequenceContext map[string]any) (models.Context, error) {
+	if len(sequenceContext) == 0 {
+		return models.DefaultContext, nil
+	}
+	contextJSON, err := json.Marshal(sequenceContext)
+	if err != nil {
+		return models.Context{}, eris.Wrap(err, "error marshalling context")
+	}
+	return models.Context{Json: contextJSON}, nil
+}
@@ -0,0 +1,137 @@
+package convertors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+func TestConvertLogImagesRequestToDBModel_Ok(t *testing.T) {
+	req := request.LogImagesRequest{
+		RunID: "run_id",
+		Images: []request.ImagePartialRequest{
+			{
+				Name:      "name",
+				Context:   map[string]any{"subset": "train"},
+				Step:      1,
+				Index:     2,
+				Timestamp: 1234567890,
+				Caption:   "caption",
+				Format:    "png",
+				Width:     32,
+				Height:    16,
+				BlobURI:   "images/name/0.png",
+			},
+			{
+				Name:      "name",
+				Timestamp: 1234567890,
+				Format:    "jpeg",
+				Width:     32,
+				Height:    16,
+				BlobURI:   "images/name/1.jpeg",
+			},
+		},
+	}
+	result, err := ConvertLogImagesRequestToDBModel("run_id", &req)
+	require.Nil(t, err)
+	assert.Equal(t, []models.Image{
+		{
+			RunID:     "run_id",
+			Name:      "name",
+			Context:   models.Context{Json: []byte(`{"subset":"train"}`)},
+			Step:      1,
+			Index:     2,
+			Timestamp: 1234567890,
+			Caption:   "caption",
+			Format:    "png",
+			Width:     32,
+			Height:    16,
+			BlobURI:   "images/name/0.png",
+		},
+		{
+			RunID:     "run_id",
+			Name:      "name",
+			Context:   models.DefaultContext,
+			Timestamp: 1234567890,
+			Format:    "jpeg",
+			Width:     32,
+			Height:    16,
+			BlobURI:   "images/name/1.jpeg",
+		},
+	}, result)
+}
+
+func TestConvertLogTextsRequestToDBModel_Ok(t *testing.T) {
+	req := request.LogTextsRequest{
+		RunID: "run_id",
+		Texts: []request.TextPartialRequest{
+			{
+				Name:      "name",
+				Context:   map[string]any{"subset": "train"},
+				Step:      1,
+				Index:     2,
+				Timestamp: 1234567890,
+				Data:      "sample",
+			},
+			{
+				Name:      "name",
+				Timestamp: 1234567890,
+			},
+		},
+	}
+	result, err := ConvertLogTextsRequestToDBModel("run_id", &req)
+	require.Nil(t, err)
+	assert.Equal(t, []models.Text{
+		{
+			RunID:     "run_id",
+			Name:      "name",
+			Context:   models.Context{Json: []byte(`{"subset":"train"}`)},
+			Step:      1,
+			Index:     2,
+			Timestamp: 1234567890,
+			Data:      "sample",
+		},
+		{
+			RunID:     "run_id",
+			Name:      "name",
+			Context:   models.DefaultContext,
+			Timestamp: 1234567890,
+		},
+	}, result)
+}
+
+func TestConvertLogDistributionsRequestToDBModel_Ok(t *testing.T) {
+	req := request.LogDistributionsRequest{
+		RunID: "run_id",
+		Distributions: []request.DistributionPartialRequest{
+			{
+				Name:      "weights",
+				Context:   map[string]any{"layer": "fc1"},
+				Step:      1,
+				Timestamp: 1234567890,
+				BinEdges:  []float64{-1, 0.5},
+				Counts:    []float64{2},
+			},
+		},
+	}
+	result, err := ConvertLogDistributionsRequestToDBModel("run_id", &req)
+	require.Nil(t, err)
+	assert.Equal(t, []models.Distribution{
+		{
+			RunID:     "run_id",
+			Name:      "weights",
+			Context:   models.Context{Json: []byte(`{"layer":"fc1"}`)},
+			Step:      1,
+			Timestamp: 1234567890,
+			BinEdges: []byte{
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0xbf,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe0, 0x3f,
+			},
+			Counts: []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40},
+		},
+	}, result)
+}
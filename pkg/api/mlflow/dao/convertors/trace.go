@@ -0,0 +1,95 @@
+package convertors
+
+import (
+	"database/sql"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// ConvertStartTraceRequestToDBModel converts request.StartTraceRequest into actual models.TraceInfo model.
+func ConvertStartTraceRequestToDBModel(
+	experimentID int32, requestID string, req *request.StartTraceRequest,
+) *models.TraceInfo {
+	trace := models.TraceInfo{
+		RequestID:       requestID,
+		ExperimentID:    experimentID,
+		TimestampMS:     req.TimestampMS,
+		Status:          models.TraceStatusInProgress,
+		Tags:            make([]models.TraceTag, len(req.Tags)),
+		RequestMetadata: make([]models.TraceRequestMetadata, len(req.RequestMetadata)),
+	}
+	for n, tag := range req.Tags {
+		trace.Tags[n] = models.TraceTag{
+			Key:       tag.Key,
+			Value:     tag.Value,
+			RequestID: requestID,
+		}
+	}
+	for n, metadata := range req.RequestMetadata {
+		trace.RequestMetadata[n] = models.TraceRequestMetadata{
+			Key:       metadata.Key,
+			Value:     metadata.Value,
+			RequestID: requestID,
+		}
+	}
+	return &trace
+}
+
+// ConvertEndTraceRequestToDBModel converts request.EndTraceRequest into actual models.TraceInfo model.
+// Tags and request metadata of the request are merged into the existing ones of the trace.
+func ConvertEndTraceRequestToDBModel(trace *models.TraceInfo, req *request.EndTraceRequest) *models.TraceInfo {
+	trace.Status = models.TraceStatus(req.Status)
+	trace.ExecutionTimeMS = sql.NullInt64{
+		Int64: req.TimestampMS - trace.TimestampMS,
+		Valid: true,
+	}
+	for _, tag := range req.Tags {
+		trace.Tags = mergeTraceTag(trace.Tags, models.TraceTag{
+			Key:       tag.Key,
+			Value:     tag.Value,
+			RequestID: trace.RequestID,
+		})
+	}
+	for _, metadata := range req.RequestMetadata {
+		trace.RequestMetadata = mergeTraceRequestMetadata(trace.RequestMetadata, models.TraceRequestMetadata{
+			Key:       metadata.Key,
+			Value:     metadata.Value,
+			RequestID: trace.RequestID,
+		})
+	}
+	return trace
+}
+
+// ConvertSetTraceTagRequestToDBModel converts request.SetTraceTagRequest into actual models.TraceTag model.
+func ConvertSetTraceTagRequestToDBModel(req *request.SetTraceTagRequest) *models.TraceTag {
+	return &models.TraceTag{
+		Key:       req.Key,
+		Value:     req.Value,
+		RequestID: req.RequestID,
+	}
+}
+
+// mergeTraceTag replaces the tag with the same key or appends the new one.
+func mergeTraceTag(tags []models.TraceTag, tag models.TraceTag) []models.TraceTag {
+	for n := range tags {
+		if tags[n].Key == tag.Key {
+			tags[n] = tag
+			return tags
+		}
+	}
+	return append(tags, tag)
+}
+
+// mergeTraceRequestMetadata replaces the request metadata with the same key or appends the new one.
+func mergeTraceRequestMetadata(
+	metadata []models.TraceRequestMetadata, item models.TraceRequestMetadata,
+) []models.TraceRequestMetadata {
+	for n := range metadata {
+		if metadata[n].Key == item.Key {
+			metadata[n] = item
+			return metadata
+		}
+	}
+	return append(metadata, item)
+}
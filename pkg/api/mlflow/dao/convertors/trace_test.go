@@ -0,0 +1,86 @@
+package convertors
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+func TestConvertStartTraceRequestToDBModel_Ok(t *testing.T) {
+	req := request.StartTraceRequest{
+		ExperimentID: "1",
+		TimestampMS:  1000,
+		RequestMetadata: []request.TraceRequestMetadataPartialRequest{
+			{Key: "mlflow.traceInputs", Value: "inputs"},
+		},
+		Tags: []request.TraceTagPartialRequest{
+			{Key: "mlflow.traceName", Value: "predict"},
+		},
+	}
+	result := ConvertStartTraceRequestToDBModel(1, "tr-id", &req)
+	assert.Equal(t, &models.TraceInfo{
+		RequestID:    "tr-id",
+		ExperimentID: 1,
+		TimestampMS:  1000,
+		Status:       models.TraceStatusInProgress,
+		Tags: []models.TraceTag{
+			{Key: "mlflow.traceName", Value: "predict", RequestID: "tr-id"},
+		},
+		RequestMetadata: []models.TraceRequestMetadata{
+			{Key: "mlflow.traceInputs", Value: "inputs", RequestID: "tr-id"},
+		},
+	}, result)
+}
+
+func TestConvertEndTraceRequestToDBModel_Ok(t *testing.T) {
+	trace := models.TraceInfo{
+		RequestID:   "tr-id",
+		TimestampMS: 1000,
+		Status:      models.TraceStatusInProgress,
+		Tags: []models.TraceTag{
+			{Key: "mlflow.traceName", Value: "predict", RequestID: "tr-id"},
+		},
+	}
+	req := request.EndTraceRequest{
+		RequestID:   "tr-id",
+		TimestampMS: 1500,
+		Status:      "OK",
+		RequestMetadata: []request.TraceRequestMetadataPartialRequest{
+			{Key: "mlflow.traceOutputs", Value: "outputs"},
+		},
+		Tags: []request.TraceTagPartialRequest{
+			{Key: "mlflow.traceName", Value: "predict_v2"},
+			{Key: "env", Value: "test"},
+		},
+	}
+	result := ConvertEndTraceRequestToDBModel(&trace, &req)
+	assert.Equal(t, &models.TraceInfo{
+		RequestID:       "tr-id",
+		TimestampMS:     1000,
+		ExecutionTimeMS: sql.NullInt64{Int64: 500, Valid: true},
+		Status:          models.TraceStatusOK,
+		Tags: []models.TraceTag{
+			{Key: "mlflow.traceName", Value: "predict_v2", RequestID: "tr-id"},
+			{Key: "env", Value: "test", RequestID: "tr-id"},
+		},
+		RequestMetadata: []models.TraceRequestMetadata{
+			{Key: "mlflow.traceOutputs", Value: "outputs", RequestID: "tr-id"},
+		},
+	}, result)
+}
+
+func TestConvertSetTraceTagRequestToDBModel_Ok(t *testing.T) {
+	req := request.SetTraceTagRequest{
+		RequestID: "tr-id",
+		Key:       "key",
+		Value:     "value",
+	}
+	result := ConvertSetTraceTagRequestToDBModel(&req)
+	assert.Equal(t, "key", result.Key)
+	assert.Equal(t, "value", result.Value)
+	assert.Equal(t, "tr-id", result.RequestID)
+}
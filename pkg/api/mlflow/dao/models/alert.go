@@ -0,0 +1,112 @@
+package models
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// AlertRuleCondition represents the condition, which fires the alert.
+type AlertRuleCondition string
+
+// Supported list of alert rule conditions.
+const (
+	AlertRuleConditionIsNan              AlertRuleCondition = "IS_NAN"
+	AlertRuleConditionLessThan           AlertRuleCondition = "LESS_THAN"
+	AlertRuleConditionLessThanOrEqual    AlertRuleCondition = "LESS_THAN_OR_EQUAL"
+	AlertRuleConditionGreaterThan        AlertRuleCondition = "GREATER_THAN"
+	AlertRuleConditionGreaterThanOrEqual AlertRuleCondition = "GREATER_THAN_OR_EQUAL"
+	AlertRuleConditionNotLogged          AlertRuleCondition = "NOT_LOGGED"
+)
+
+// AlertRuleConditions is the list of all the supported alert rule conditions.
+var AlertRuleConditions = []AlertRuleCondition{
+	AlertRuleConditionIsNan,
+	AlertRuleConditionLessThan,
+	AlertRuleConditionLessThanOrEqual,
+	AlertRuleConditionGreaterThan,
+	AlertRuleConditionGreaterThanOrEqual,
+	AlertRuleConditionNotLogged,
+}
+
+// AlertRule represents model to work with `alert_rules` table.
+type AlertRule struct {
+	ID             uint               `gorm:"primaryKey;autoIncrement"`
+	ExperimentID   int32              `gorm:"not null;index"`
+	Name           string             `gorm:"type:varchar(256);not null"`
+	Key            string             `gorm:"type:varchar(250);not null"`
+	Condition      AlertRuleCondition `gorm:"type:varchar(30);not null"`
+	Threshold      float64            `gorm:"not null"`
+	MinStep        int64              `gorm:"not null"`
+	WindowSeconds  int64              `gorm:"not null"`
+	Active         bool               `gorm:"not null"`
+	CreationTime   int64              `gorm:"not null"`
+	LastUpdateTime int64              `gorm:"not null"`
+}
+
+// IsEvaluatedAtIngest makes check that rule is evaluated when metrics are logged. Otherwise, the rule
+// is evaluated periodically, because it fires exactly when nothing is logged.
+func (r AlertRule) IsEvaluatedAtIngest() bool {
+	return r.Condition != AlertRuleConditionNotLogged
+}
+
+// Matches makes check that the latest metric fires the rule.
+func (r AlertRule) Matches(metric *LatestMetric) bool {
+	if !r.Active || metric.Key != r.Key || metric.Step < r.MinStep {
+		return false
+	}
+	if r.Condition == AlertRuleConditionIsNan {
+		return metric.IsNan
+	}
+	// NaN is never compared with the threshold.
+	if metric.IsNan {
+		return false
+	}
+	switch r.Condition {
+	case AlertRuleConditionLessThan:
+		return metric.Value < r.Threshold
+	case AlertRuleConditionLessThanOrEqual:
+		return metric.Value <= r.Threshold
+	case AlertRuleConditionGreaterThan:
+		return metric.Value > r.Threshold
+	case AlertRuleConditionGreaterThanOrEqual:
+		return metric.Value >= r.Threshold
+	default:
+		return false
+	}
+}
+
+// Describe returns human-readable description of the fired rule.
+func (r AlertRule) Describe() string {
+	switch r.Condition {
+	case AlertRuleConditionIsNan:
+		return fmt.Sprintf("metric '%s' is NaN", r.Key)
+	case AlertRuleConditionLessThan:
+		return fmt.Sprintf("metric '%s' < %v", r.Key, r.Threshold)
+	case AlertRuleConditionLessThanOrEqual:
+		return fmt.Sprintf("metric '%s' <= %v", r.Key, r.Threshold)
+	case AlertRuleConditionGreaterThan:
+		return fmt.Sprintf("metric '%s' > %v", r.Key, r.Threshold)
+	case AlertRuleConditionGreaterThanOrEqual:
+		return fmt.Sprintf("metric '%s' >= %v", r.Key, r.Threshold)
+	case AlertRuleConditionNotLogged:
+		return fmt.Sprintf("metric '%s' hasn't been logged for %d seconds", r.Key, r.WindowSeconds)
+	default:
+		return string(r.Condition)
+	}
+}
+
+// Alert represents model to work with `alerts` table.
+type Alert struct {
+	ID            string `gorm:"type:varchar(36);not null;primaryKey"`
+	AlertRuleID   uint   `gorm:"not null;index:,unique,composite:rule_run"`
+	AlertRule     AlertRule
+	RunID         string        `gorm:"column:run_uuid;type:varchar(32);not null;index:,unique,composite:rule_run"`
+	Key           string        `gorm:"type:varchar(250);not null"`
+	Value         float64       `gorm:"not null"`
+	IsNan         bool          `gorm:"not null"`
+	Step          int64         `gorm:"not null"`
+	Timestamp     int64         `gorm:"not null"`
+	Message       string        `gorm:"type:varchar(1000);not null"`
+	CreationTime  int64         `gorm:"not null;index"`
+	DeliveredTime sql.NullInt64 `gorm:"type:bigint;index"`
+}
@@ -0,0 +1,40 @@
+package models
+
+// InputTagContextKey is the key of the input tag, which describes context of the dataset usage (training, eval...).
+const InputTagContextKey = "mlflow.data.context"
+
+// Dataset represents model to work with `datasets` table.
+type Dataset struct {
+	ID           string `gorm:"column:dataset_uuid;type:varchar(36);not null;primaryKey"`
+	Name         string `gorm:"type:varchar(500);not null;index:,unique,composite:dataset"`
+	Digest       string `gorm:"type:varchar(36);not null;index:,unique,composite:dataset"`
+	SourceType   string `gorm:"column:dataset_source_type;type:varchar(36);not null"`
+	Source       string `gorm:"column:dataset_source;type:text;not null"`
+	Schema       string `gorm:"column:dataset_schema;type:text"`
+	Profile      string `gorm:"column:dataset_profile;type:text"`
+	ExperimentID int32  `gorm:"not null;index:,unique,composite:dataset"`
+}
+
+// Input represents model to work with `inputs` table, which links the dataset to the run.
+type Input struct {
+	ID        string     `gorm:"column:input_uuid;type:varchar(36);not null;primaryKey"`
+	DatasetID string     `gorm:"column:dataset_uuid;type:varchar(36);not null;index:,unique,composite:input"`
+	Dataset   Dataset    `gorm:"foreignKey:DatasetID"`
+	RunID     string     `gorm:"column:run_uuid;type:varchar(32);not null;index:,unique,composite:input"`
+	Tags      []InputTag `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// InputTag represents model to work with `input_tags` table.
+type InputTag struct {
+	InputID string `gorm:"column:input_uuid;type:varchar(36);not null;primaryKey"`
+	Name    string `gorm:"type:varchar(255);not null;primaryKey"`
+	Value   string `gorm:"type:varchar(500);not null"`
+}
+
+// DatasetSummary represents summary of the dataset used by runs of the experiment.
+type DatasetSummary struct {
+	ExperimentID int32
+	Name         string
+	Digest       string
+	Context      string
+}
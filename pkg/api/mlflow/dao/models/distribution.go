@@ -0,0 +1,14 @@
+package models
+
+// Distribution represents model to work with `distributions` table. BinEdges and Counts keep
+// little-endian float64 values, so that counts are served to Aim UI as numpy blob as they are.
+type Distribution struct {
+	RunID     string `gorm:"column:run_uuid;type:varchar(32);not null;primaryKey;index"`
+	Name      string `gorm:"type:varchar(250);not null;primaryKey"`
+	ContextID uint   `gorm:"not null;primaryKey"`
+	Context   Context
+	Step      int64  `gorm:"not null;primaryKey"`
+	Timestamp int64  `gorm:"not null"`
+	BinEdges  []byte `gorm:"not null"`
+	Counts    []byte `gorm:"not null"`
+}
@@ -22,6 +22,8 @@ type Experiment struct {
 	Namespace        Namespace
 	Tags             []ExperimentTag `gorm:"constraint:OnDelete:CASCADE"`
 	Runs             []Run           `gorm:"constraint:OnDelete:CASCADE"`
+	Traces           []TraceInfo     `gorm:"constraint:OnDelete:CASCADE"`
+	LoggedModels     []LoggedModel   `gorm:"constraint:OnDelete:CASCADE"`
 }
 
 // IsDefault makes check that Experiment is default.
@@ -0,0 +1,18 @@
+package models
+
+// Image represents model to work with `images` table. Image content itself is kept
+// in the artifact storage of the run, BlobURI is a path relative to the run artifact root.
+type Image struct {
+	RunID     string `gorm:"column:run_uuid;type:varchar(32);not null;primaryKey;index"`
+	Name      string `gorm:"type:varchar(250);not null;primaryKey"`
+	ContextID uint   `gorm:"not null;primaryKey"`
+	Context   Context
+	Step      int64  `gorm:"not null;primaryKey"`
+	Index     int64  `gorm:"column:idx;not null;primaryKey"`
+	Timestamp int64  `gorm:"not null"`
+	Caption   string `gorm:"type:varchar(1000);not null"`
+	Format    string `gorm:"type:varchar(20);not null"`
+	Width     int64  `gorm:"not null"`
+	Height    int64  `gorm:"not null"`
+	BlobURI   string `gorm:"type:varchar(1000);not null"`
+}
@@ -0,0 +1,44 @@
+package models
+
+// LoggedModelStatus represents status of the logged model.
+type LoggedModelStatus string
+
+// Supported list of logged model statuses.
+const (
+	LoggedModelStatusUnspecified  LoggedModelStatus = "LOGGED_MODEL_STATUS_UNSPECIFIED"
+	LoggedModelStatusPending      LoggedModelStatus = "LOGGED_MODEL_PENDING"
+	LoggedModelStatusReady        LoggedModelStatus = "LOGGED_MODEL_READY"
+	LoggedModelStatusUploadFailed LoggedModelStatus = "LOGGED_MODEL_UPLOAD_FAILED"
+)
+
+// LoggedModel represents model to work with `logged_models` table.
+type LoggedModel struct {
+	ID                     string             `gorm:"column:model_id;type:varchar(50);not null;primaryKey"`
+	ExperimentID           int32              `gorm:"not null;index"`
+	Name                   string             `gorm:"type:varchar(500);not null"`
+	ArtifactLocation       string             `gorm:"type:varchar(1000)"`
+	CreationTimestampMS    int64              `gorm:"column:creation_timestamp_ms;not null"`
+	LastUpdatedTimestampMS int64              `gorm:"column:last_updated_timestamp_ms;not null"`
+	Status                 LoggedModelStatus  `gorm:"type:varchar(50);not null"`
+	StatusMessage          string             `gorm:"type:varchar(1000)"`
+	LifecycleStage         LifecycleStage     `gorm:"type:varchar(32);check:lifecycle_stage IN ('active', 'deleted')"`
+	ModelType              string             `gorm:"type:varchar(500)"`
+	SourceRunID            string             `gorm:"type:varchar(32)"`
+	Params                 []LoggedModelParam `gorm:"foreignKey:ModelID;constraint:OnDelete:CASCADE"`
+	Tags                   []LoggedModelTag   `gorm:"foreignKey:ModelID;constraint:OnDelete:CASCADE"`
+	Metrics                []Metric           `gorm:"-"`
+}
+
+// LoggedModelParam represents model to work with `logged_model_params` table.
+type LoggedModelParam struct {
+	Key     string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value   string `gorm:"type:varchar(8000);not null"`
+	ModelID string `gorm:"type:varchar(50);not null;primaryKey;index"`
+}
+
+// LoggedModelTag represents model to work with `logged_model_tags` table.
+type LoggedModelTag struct {
+	Key     string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value   string `gorm:"type:varchar(8000)"`
+	ModelID string `gorm:"type:varchar(50);not null;primaryKey;index"`
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"crypto/sha256"
+	"database/sql"
 	"fmt"
 
 	"gorm.io/datatypes"
@@ -21,6 +22,7 @@ type Metric struct {
 	Iter      int64   `gorm:"index"`
 	ContextID uint    `gorm:"not null;primaryKey"`
 	Context   Context
+	ModelID   sql.NullString `gorm:"type:varchar(50);index"`
 }
 
 // UniqueKey is a compound unique key for this metric series.
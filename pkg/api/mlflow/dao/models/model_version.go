@@ -0,0 +1,54 @@
+package models
+
+import (
+	"database/sql"
+)
+
+// ModelVersionStage represents the stage of models.ModelVersion.
+type ModelVersionStage string
+
+// Supported list of model version stages.
+const (
+	ModelVersionStageNone       ModelVersionStage = "None"
+	ModelVersionStageStaging    ModelVersionStage = "Staging"
+	ModelVersionStageProduction ModelVersionStage = "Production"
+	ModelVersionStageArchived   ModelVersionStage = "Archived"
+)
+
+// ModelVersionStatus represents the status of models.ModelVersion.
+type ModelVersionStatus string
+
+// Supported list of model version statuses.
+const (
+	ModelVersionStatusPendingRegistration ModelVersionStatus = "PENDING_REGISTRATION"
+	ModelVersionStatusFailedRegistration  ModelVersionStatus = "FAILED_REGISTRATION"
+	ModelVersionStatusReady               ModelVersionStatus = "READY"
+)
+
+// ModelVersion represents model to work with `model_versions` table.
+//
+//nolint:lll
+type ModelVersion struct {
+	ID                uint               `gorm:"primaryKey;autoIncrement"`
+	Version           int64              `gorm:"not null;index:,unique,composite:version"`
+	Description       string             `gorm:"type:varchar(5000)"`
+	UserID            string             `gorm:"type:varchar(256)"`
+	CurrentStage      ModelVersionStage  `gorm:"type:varchar(20);not null;default:None"`
+	Source            string             `gorm:"type:varchar(500)"`
+	RunID             string             `gorm:"column:run_uuid;type:varchar(32);index"`
+	RunLink           string             `gorm:"type:varchar(500)"`
+	Status            ModelVersionStatus `gorm:"type:varchar(20);check:status IN ('PENDING_REGISTRATION', 'FAILED_REGISTRATION', 'READY')"`
+	StatusMessage     string             `gorm:"type:varchar(500)"`
+	CreationTime      sql.NullInt64      `gorm:"type:bigint"`
+	LastUpdatedTime   sql.NullInt64      `gorm:"type:bigint"`
+	RegisteredModelID uint               `gorm:"not null;index:,unique,composite:version"`
+	RegisteredModel   RegisteredModel
+	Tags              []ModelVersionTag `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// ModelVersionTag represents model to work with `model_version_tags` table.
+type ModelVersionTag struct {
+	Key            string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value          string `gorm:"type:varchar(5000)"`
+	ModelVersionID uint   `gorm:"not null;primaryKey"`
+}
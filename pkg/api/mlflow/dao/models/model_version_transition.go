@@ -0,0 +1,47 @@
+package models
+
+import (
+	"database/sql"
+)
+
+// ModelVersionTransitionRequestStatus represents the status of models.ModelVersionTransitionRequest.
+type ModelVersionTransitionRequestStatus string
+
+// Supported list of transition request statuses.
+const (
+	ModelVersionTransitionRequestStatusPending  ModelVersionTransitionRequestStatus = "PENDING"
+	ModelVersionTransitionRequestStatusApproved ModelVersionTransitionRequestStatus = "APPROVED"
+	ModelVersionTransitionRequestStatusRejected ModelVersionTransitionRequestStatus = "REJECTED"
+)
+
+// ModelVersionTransitionRequest represents model to work with `model_version_transition_requests` table.
+//
+//nolint:lll
+type ModelVersionTransitionRequest struct {
+	ID              uint                                `gorm:"primaryKey;autoIncrement"`
+	ToStage         ModelVersionStage                   `gorm:"type:varchar(20);not null"`
+	Status          ModelVersionTransitionRequestStatus `gorm:"type:varchar(20);not null;default:PENDING;check:status IN ('PENDING', 'APPROVED', 'REJECTED')"`
+	Comment         string                              `gorm:"type:varchar(5000)"`
+	UserID          string                              `gorm:"type:varchar(256)"`
+	ReviewerID      string                              `gorm:"type:varchar(256)"`
+	ReviewComment   string                              `gorm:"type:varchar(5000)"`
+	CreationTime    sql.NullInt64                       `gorm:"type:bigint"`
+	LastUpdatedTime sql.NullInt64                       `gorm:"type:bigint"`
+	ModelVersionID  uint                                `gorm:"not null;index"`
+	ModelVersion    ModelVersion
+}
+
+// ModelVersionTransition represents model to work with `model_version_transitions` table.
+// Each row is a single stage change of the model version and together they build its history.
+type ModelVersionTransition struct {
+	ID                  uint              `gorm:"primaryKey;autoIncrement"`
+	FromStage           ModelVersionStage `gorm:"type:varchar(20);not null"`
+	ToStage             ModelVersionStage `gorm:"type:varchar(20);not null"`
+	UserID              string            `gorm:"type:varchar(256)"`
+	Comment             string            `gorm:"type:varchar(5000)"`
+	CreationTime        sql.NullInt64     `gorm:"type:bigint"`
+	TransitionRequestID *uint
+	TransitionRequest   *ModelVersionTransitionRequest
+	ModelVersionID      uint `gorm:"not null;index"`
+	ModelVersion        ModelVersion
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"database/sql"
+)
+
+// RegisteredModel represents model to work with `registered_models` table.
+type RegisteredModel struct {
+	ID              uint          `gorm:"primaryKey;autoIncrement"`
+	Name            string        `gorm:"type:varchar(256);not null;index:,unique,composite:name"`
+	Description     string        `gorm:"type:varchar(5000)"`
+	UserID          string        `gorm:"type:varchar(256)"`
+	CreationTime    sql.NullInt64 `gorm:"type:bigint"`
+	LastUpdatedTime sql.NullInt64 `gorm:"type:bigint"`
+	NamespaceID     uint          `gorm:"not null;index:,unique,composite:name"`
+	Namespace       Namespace
+	Tags            []RegisteredModelTag   `gorm:"constraint:OnDelete:CASCADE"`
+	Aliases         []RegisteredModelAlias `gorm:"constraint:OnDelete:CASCADE"`
+	Versions        []ModelVersion         `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// RegisteredModelTag represents model to work with `registered_model_tags` table.
+type RegisteredModelTag struct {
+	Key               string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value             string `gorm:"type:varchar(5000)"`
+	RegisteredModelID uint   `gorm:"not null;primaryKey"`
+}
+
+// RegisteredModelAlias represents model to work with `registered_model_aliases` table.
+type RegisteredModelAlias struct {
+	Alias             string `gorm:"type:varchar(256);not null;primaryKey"`
+	Version           int64  `gorm:"not null"`
+	RegisteredModelID uint   `gorm:"not null;primaryKey"`
+}
@@ -0,0 +1,13 @@
+package models
+
+// Text represents model to work with `texts` table.
+type Text struct {
+	RunID     string `gorm:"column:run_uuid;type:varchar(32);not null;primaryKey;index"`
+	Name      string `gorm:"type:varchar(250);not null;primaryKey"`
+	ContextID uint   `gorm:"not null;primaryKey"`
+	Context   Context
+	Step      int64  `gorm:"not null;primaryKey"`
+	Index     int64  `gorm:"column:idx;not null;primaryKey"`
+	Timestamp int64  `gorm:"not null"`
+	Data      string `gorm:"type:text;not null"`
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"database/sql"
+)
+
+// TraceStatus represents status of the trace.
+type TraceStatus string
+
+// Supported list of trace statuses.
+const (
+	TraceStatusUnspecified TraceStatus = "TRACE_STATUS_UNSPECIFIED"
+	TraceStatusOK          TraceStatus = "OK"
+	TraceStatusError       TraceStatus = "ERROR"
+	TraceStatusInProgress  TraceStatus = "IN_PROGRESS"
+)
+
+// TraceTagArtifactLocation is the key of the trace tag, which holds location of the trace data artifacts.
+const TraceTagArtifactLocation = "mlflow.artifactLocation"
+
+// TraceInfo represents model to work with `trace_info` table.
+type TraceInfo struct {
+	RequestID       string                 `gorm:"type:varchar(50);not null;primaryKey"`
+	ExperimentID    int32                  `gorm:"not null;index"`
+	TimestampMS     int64                  `gorm:"column:timestamp_ms;not null;index"`
+	ExecutionTimeMS sql.NullInt64          `gorm:"column:execution_time_ms"`
+	Status          TraceStatus            `gorm:"type:varchar(50);not null"`
+	Tags            []TraceTag             `gorm:"foreignKey:RequestID;constraint:OnDelete:CASCADE"`
+	RequestMetadata []TraceRequestMetadata `gorm:"foreignKey:RequestID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName returns the name of `trace_info` table.
+func (TraceInfo) TableName() string {
+	return "trace_info"
+}
+
+// GetTag returns value of the trace tag and flag showing that the tag exists.
+func (t TraceInfo) GetTag(key string) (string, bool) {
+	for _, tag := range t.Tags {
+		if tag.Key == key {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
+
+// TraceTag represents model to work with `trace_tags` table.
+type TraceTag struct {
+	Key       string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value     string `gorm:"type:varchar(8000)"`
+	RequestID string `gorm:"type:varchar(50);not null;primaryKey;index"`
+}
+
+// TraceRequestMetadata represents model to work with `trace_request_metadata` table.
+type TraceRequestMetadata struct {
+	Key       string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value     string `gorm:"type:varchar(8000)"`
+	RequestID string `gorm:"type:varchar(50);not null;primaryKey;index"`
+}
+
+// TableName returns the name of `trace_request_metadata` table.
+func (TraceRequestMetadata) TableName() string {
+	return "trace_request_metadata"
+}
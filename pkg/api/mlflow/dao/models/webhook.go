@@ -0,0 +1,100 @@
+package models
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// WebhookEvent represents type of the event, which webhooks can be subscribed to.
+type WebhookEvent string
+
+// Supported list of webhook events.
+const (
+	WebhookEventRunFinished        WebhookEvent = "run.finished"
+	WebhookEventRunFailed          WebhookEvent = "run.failed"
+	WebhookEventRunKilled          WebhookEvent = "run.killed"
+	WebhookEventRunDeleted         WebhookEvent = "run.deleted"
+	WebhookEventRunRestored        WebhookEvent = "run.restored"
+	WebhookEventExperimentCreated  WebhookEvent = "experiment.created"
+	WebhookEventExperimentDeleted  WebhookEvent = "experiment.deleted"
+	WebhookEventExperimentRestored WebhookEvent = "experiment.restored"
+)
+
+// WebhookEvents is the list of all the supported webhook events.
+var WebhookEvents = []WebhookEvent{
+	WebhookEventRunFinished,
+	WebhookEventRunFailed,
+	WebhookEventRunKilled,
+	WebhookEventRunDeleted,
+	WebhookEventRunRestored,
+	WebhookEventExperimentCreated,
+	WebhookEventExperimentDeleted,
+	WebhookEventExperimentRestored,
+}
+
+// WebhookDeliveryStatus represents status of the webhook delivery.
+type WebhookDeliveryStatus string
+
+// Supported list of webhook delivery statuses.
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+// Webhook represents model to work with `webhooks` table.
+type Webhook struct {
+	ID          uint   `gorm:"primaryKey;autoIncrement"`
+	NamespaceID uint   `gorm:"not null;index"`
+	URL         string `gorm:"type:varchar(2000);not null"`
+	Secret      string `gorm:"type:varchar(500);not null"`
+	Events      string `gorm:"type:varchar(1000);not null"`
+	Description string `gorm:"type:varchar(1000)"`
+	Active      bool   `gorm:"not null"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// GetEvents returns the list of events, which webhook is subscribed to.
+func (w Webhook) GetEvents() []WebhookEvent {
+	if w.Events == "" {
+		return nil
+	}
+	parts := strings.Split(w.Events, ",")
+	events := make([]WebhookEvent, len(parts))
+	for i, part := range parts {
+		events[i] = WebhookEvent(part)
+	}
+	return events
+}
+
+// SetEvents sets the list of events, which webhook is subscribed to.
+func (w *Webhook) SetEvents(events []WebhookEvent) {
+	parts := make([]string, len(events))
+	for i, event := range events {
+		parts[i] = string(event)
+	}
+	w.Events = strings.Join(parts, ",")
+}
+
+// IsSubscribedTo makes check that webhook is active and subscribed to the event.
+func (w Webhook) IsSubscribedTo(event WebhookEvent) bool {
+	return w.Active && slices.Contains(w.GetEvents(), event)
+}
+
+// WebhookDelivery represents model to work with `webhook_deliveries` table.
+type WebhookDelivery struct {
+	ID            string `gorm:"type:varchar(36);not null;primaryKey"`
+	WebhookID     uint   `gorm:"not null;index"`
+	Webhook       Webhook
+	Event         WebhookEvent          `gorm:"type:varchar(100);not null"`
+	Payload       string                `gorm:"type:text;not null"`
+	Status        WebhookDeliveryStatus `gorm:"type:varchar(20);not null"`
+	Attempts      int                   `gorm:"not null"`
+	ResponseCode  int
+	Error         string    `gorm:"type:text"`
+	NextAttemptAt time.Time `gorm:"not null;index"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,263 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// AlertRepositoryProvider provides an interface to work with models.AlertRule and models.Alert entities.
+type AlertRepositoryProvider interface {
+	BaseRepositoryProvider
+	// CreateRule creates new models.AlertRule entity.
+	CreateRule(ctx context.Context, rule *models.AlertRule) error
+	// UpdateRule updates existing models.AlertRule entity.
+	UpdateRule(ctx context.Context, rule *models.AlertRule) error
+	// DeleteRule deletes existing models.AlertRule entity together with its alerts.
+	DeleteRule(ctx context.Context, rule *models.AlertRule) error
+	// GetRuleByNamespaceIDAndID returns models.AlertRule entity by Namespace ID and its ID.
+	GetRuleByNamespaceIDAndID(ctx context.Context, namespaceID, id uint) (*models.AlertRule, error)
+	// ListRulesByExperimentID returns all models.AlertRule entities of the experiment.
+	ListRulesByExperimentID(ctx context.Context, experimentID int32) ([]models.AlertRule, error)
+	// GetActiveRulesByCondition returns all active models.AlertRule entities with requested condition.
+	GetActiveRulesByCondition(
+		ctx context.Context, condition models.AlertRuleCondition,
+	) ([]models.AlertRule, error)
+	// GetActiveRulesByExperimentIDAndKeys returns all active models.AlertRule entities of the experiment,
+	// which watch one of requested metric keys.
+	GetActiveRulesByExperimentIDAndKeys(
+		ctx context.Context, experimentID int32, keys []string,
+	) ([]models.AlertRule, error)
+	// GetNotLoggedRunIDs returns IDs of the running runs of the rule experiment, which haven't logged
+	// the rule metric since provided time and haven't fired the rule yet.
+	GetNotLoggedRunIDs(ctx context.Context, rule *models.AlertRule, since int64) ([]string, error)
+	// CreateAlerts creates new models.Alert entities. Alerts, which have already been fired
+	// for the same rule and run, are skipped.
+	CreateAlerts(ctx context.Context, alerts []models.Alert) error
+	// ListAlerts returns the latest models.Alert entities of the experiment, optionally filtered by run.
+	ListAlerts(ctx context.Context, experimentID int32, runID string, limit int) ([]models.Alert, error)
+	// GetUndeliveredAlerts returns the oldest models.Alert entities, which haven't been delivered yet.
+	GetUndeliveredAlerts(ctx context.Context, limit int) ([]models.Alert, error)
+	// MarkAlertDelivered marks models.Alert entity as delivered.
+	MarkAlertDelivered(ctx context.Context, alert *models.Alert) error
+}
+
+// AlertRepository repository to work with models.AlertRule and models.Alert entities.
+type AlertRepository struct {
+	BaseRepository
+}
+
+// NewAlertRepository creates repository to work with models.AlertRule and models.Alert entities.
+func NewAlertRepository(db *gorm.DB) *AlertRepository {
+	return &AlertRepository{
+		BaseRepository{
+			db: db,
+		},
+	}
+}
+
+// CreateRule creates new models.AlertRule entity.
+func (r AlertRepository) CreateRule(ctx context.Context, rule *models.AlertRule) error {
+	if err := r.db.WithContext(ctx).Create(rule).Error; err != nil {
+		return eris.Wrap(err, "error creating alert rule entity")
+	}
+	return nil
+}
+
+// UpdateRule updates existing models.AlertRule entity.
+func (r AlertRepository) UpdateRule(ctx context.Context, rule *models.AlertRule) error {
+	if err := r.db.WithContext(ctx).Model(
+		rule,
+	).Select(
+		"name", "active", "last_update_time",
+	).Updates(rule).Error; err != nil {
+		return eris.Wrapf(err, "error updating alert rule with id: %d", rule.ID)
+	}
+	return nil
+}
+
+// DeleteRule deletes existing models.AlertRule entity together with its alerts.
+func (r AlertRepository) DeleteRule(ctx context.Context, rule *models.AlertRule) error {
+	if err := r.db.WithContext(ctx).Delete(rule).Error; err != nil {
+		return eris.Wrapf(err, "error deleting alert rule with id: %d", rule.ID)
+	}
+	return nil
+}
+
+// GetRuleByNamespaceIDAndID returns models.AlertRule entity by Namespace ID and its ID.
+func (r AlertRepository) GetRuleByNamespaceIDAndID(
+	ctx context.Context, namespaceID, id uint,
+) (*models.AlertRule, error) {
+	var rule models.AlertRule
+	if err := r.db.WithContext(ctx).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = alert_rules.experiment_id AND experiments.namespace_id = ?",
+		namespaceID,
+	).First(&rule, "alert_rules.id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(err, "error getting alert rule by id: %d", id)
+	}
+	return &rule, nil
+}
+
+// ListRulesByExperimentID returns all models.AlertRule entities of the experiment.
+func (r AlertRepository) ListRulesByExperimentID(
+	ctx context.Context, experimentID int32,
+) ([]models.AlertRule, error) {
+	var rules []models.AlertRule
+	if err := r.db.WithContext(ctx).Where(
+		"experiment_id = ?", experimentID,
+	).Order(
+		"id",
+	).Find(&rules).Error; err != nil {
+		return nil, eris.Wrapf(err, "error listing alert rules of experiment: %d", experimentID)
+	}
+	return rules, nil
+}
+
+// GetActiveRulesByCondition returns all active models.AlertRule entities with requested condition.
+func (r AlertRepository) GetActiveRulesByCondition(
+	ctx context.Context, condition models.AlertRuleCondition,
+) ([]models.AlertRule, error) {
+	var rules []models.AlertRule
+	if err := r.db.WithContext(ctx).Where(
+		"active = ? AND condition = ?", true, condition,
+	).Order(
+		"id",
+	).Find(&rules).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting active alert rules with condition: %s", condition)
+	}
+	return rules, nil
+}
+
+// GetActiveRulesByExperimentIDAndKeys returns all active models.AlertRule entities of the experiment,
+// which watch one of requested metric keys.
+func (r AlertRepository) GetActiveRulesByExperimentIDAndKeys(
+	ctx context.Context, experimentID int32, keys []string,
+) ([]models.AlertRule, error) {
+	var rules []models.AlertRule
+	if err := r.db.WithContext(ctx).Where(
+		"experiment_id = ? AND active = ? AND key IN ?", experimentID, true, keys,
+	).Order(
+		"id",
+	).Find(&rules).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting active alert rules of experiment: %d", experimentID)
+	}
+	return rules, nil
+}
+
+// GetNotLoggedRunIDs returns IDs of the running runs of the rule experiment, which haven't logged
+// the rule metric since provided time and haven't fired the rule yet. Runs, which haven't logged
+// the metric at all, are measured from their start time.
+func (r AlertRepository) GetNotLoggedRunIDs(
+	ctx context.Context, rule *models.AlertRule, since int64,
+) ([]string, error) {
+	var runIDs []string
+	if err := r.db.WithContext(ctx).Model(
+		&models.Run{},
+	).Where(
+		"runs.experiment_id = ?", rule.ExperimentID,
+	).Where(
+		"runs.status = ? AND runs.lifecycle_stage = ?", models.StatusRunning, models.LifecycleStageActive,
+	).Where(
+		"COALESCE((?), runs.start_time) < ?",
+		r.db.Model(
+			&models.LatestMetric{},
+		).Select(
+			"MAX(latest_metrics.timestamp)",
+		).Where(
+			"latest_metrics.run_uuid = runs.run_uuid AND latest_metrics.key = ?", rule.Key,
+		),
+		since,
+	).Where(
+		"NOT EXISTS (?)",
+		r.db.Model(
+			&models.Alert{},
+		).Select(
+			"1",
+		).Where(
+			"alerts.run_uuid = runs.run_uuid AND alerts.alert_rule_id = ?", rule.ID,
+		),
+	).Pluck("runs.run_uuid", &runIDs).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting runs, which haven't logged metric of alert rule: %d", rule.ID)
+	}
+	return runIDs, nil
+}
+
+// CreateAlerts creates new models.Alert entities. Alerts, which have already been fired
+// for the same rule and run, are skipped.
+func (r AlertRepository) CreateAlerts(ctx context.Context, alerts []models.Alert) error {
+	if len(alerts) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Omit(
+		clause.Associations,
+	).Clauses(
+		clause.OnConflict{DoNothing: true},
+	).Create(&alerts).Error; err != nil {
+		return eris.Wrap(err, "error creating alerts")
+	}
+	return nil
+}
+
+// ListAlerts returns the latest models.Alert entities of the experiment, optionally filtered by run.
+func (r AlertRepository) ListAlerts(
+	ctx context.Context, experimentID int32, runID string, limit int,
+) ([]models.Alert, error) {
+	query := r.db.WithContext(ctx).Joins(
+		"AlertRule",
+	).Where(
+		`"AlertRule".experiment_id = ?`, experimentID,
+	)
+	if runID != "" {
+		query = query.Where("alerts.run_uuid = ?", runID)
+	}
+	var alerts []models.Alert
+	if err := query.Order(
+		"alerts.creation_time DESC",
+	).Order(
+		"alerts.id",
+	).Limit(
+		limit,
+	).Find(&alerts).Error; err != nil {
+		return nil, eris.Wrapf(err, "error listing alerts of experiment: %d", experimentID)
+	}
+	return alerts, nil
+}
+
+// GetUndeliveredAlerts returns the oldest models.Alert entities, which haven't been delivered yet.
+func (r AlertRepository) GetUndeliveredAlerts(ctx context.Context, limit int) ([]models.Alert, error) {
+	var alerts []models.Alert
+	if err := r.db.WithContext(ctx).Joins(
+		"AlertRule",
+	).Where(
+		"alerts.delivered_time IS NULL",
+	).Order(
+		"alerts.creation_time",
+	).Order(
+		"alerts.id",
+	).Limit(
+		limit,
+	).Find(&alerts).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting undelivered alerts")
+	}
+	return alerts, nil
+}
+
+// MarkAlertDelivered marks models.Alert entity as delivered.
+func (r AlertRepository) MarkAlertDelivered(ctx context.Context, alert *models.Alert) error {
+	if err := r.db.WithContext(ctx).Model(
+		alert,
+	).Update(
+		"delivered_time", alert.DeliveredTime,
+	).Error; err != nil {
+		return eris.Wrapf(err, "error marking alert with id: %s as delivered", alert.ID)
+	}
+	return nil
+}
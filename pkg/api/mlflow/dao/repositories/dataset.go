@@ -0,0 +1,117 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// DatasetRepositoryProvider provides an interface to work with models.Dataset and models.Input entities.
+type DatasetRepositoryProvider interface {
+	BaseRepositoryProvider
+	// LogInputs creates models.Dataset entities, which don't exist yet, and links them to models.Run.
+	LogInputs(ctx context.Context, run *models.Run, inputs []models.Input) error
+	// SearchSummaries returns summaries of the datasets used by runs of provided experiments.
+	SearchSummaries(
+		ctx context.Context, namespaceID uint, experimentIDs []int32, limit int,
+	) ([]models.DatasetSummary, error)
+}
+
+// DatasetRepository repository to work with models.Dataset and models.Input entities.
+type DatasetRepository struct {
+	BaseRepository
+}
+
+// NewDatasetRepository creates repository to work with models.Dataset and models.Input entities.
+func NewDatasetRepository(db *gorm.DB) *DatasetRepository {
+	return &DatasetRepository{
+		BaseRepository{
+			db: db,
+		},
+	}
+}
+
+// LogInputs creates models.Dataset entities, which don't exist yet, and links them to models.Run.
+// Datasets are unique by experiment, name and digest, so the same dataset is shared between runs.
+// Inputs, which already link the dataset to the run, are left untouched.
+func (r DatasetRepository) LogInputs(ctx context.Context, run *models.Run, inputs []models.Input) error {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, input := range inputs {
+			dataset := models.Dataset{}
+			if err := tx.Where(
+				"experiment_id = ? AND name = ? AND digest = ?",
+				run.ExperimentID, input.Dataset.Name, input.Dataset.Digest,
+			).First(&dataset).Error; err != nil {
+				if !errors.Is(err, gorm.ErrRecordNotFound) {
+					return eris.Wrapf(err, "error getting dataset: %s", input.Dataset.Name)
+				}
+				dataset = input.Dataset
+				dataset.ExperimentID = run.ExperimentID
+				if err := tx.Create(&dataset).Error; err != nil {
+					return eris.Wrapf(err, "error creating dataset: %s", input.Dataset.Name)
+				}
+			}
+
+			var count int64
+			if err := tx.Model(
+				&models.Input{},
+			).Where(
+				"dataset_uuid = ? AND run_uuid = ?", dataset.ID, run.ID,
+			).Count(&count).Error; err != nil {
+				return eris.Wrapf(err, "error checking existing input of dataset: %s", dataset.Name)
+			}
+			if count > 0 {
+				continue
+			}
+
+			input.RunID = run.ID
+			input.DatasetID = dataset.ID
+			if err := tx.Omit("Dataset").Create(&input).Error; err != nil {
+				return eris.Wrapf(err, "error creating input of dataset: %s", dataset.Name)
+			}
+		}
+		return nil
+	}); err != nil {
+		return eris.Wrapf(err, "error logging inputs for run with id: %s", run.ID)
+	}
+	return nil
+}
+
+// SearchSummaries returns summaries of the datasets used by runs of provided experiments.
+func (r DatasetRepository) SearchSummaries(
+	ctx context.Context, namespaceID uint, experimentIDs []int32, limit int,
+) ([]models.DatasetSummary, error) {
+	var summaries []models.DatasetSummary
+	if err := r.db.WithContext(ctx).Model(
+		&models.Dataset{},
+	).Distinct(
+		"datasets.experiment_id", "datasets.name", "datasets.digest", "COALESCE(input_tags.value, '') AS context",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = datasets.experiment_id AND experiments.namespace_id = ?",
+		namespaceID,
+	).Joins(
+		"INNER JOIN inputs ON inputs.dataset_uuid = datasets.dataset_uuid",
+	).Joins(
+		"LEFT JOIN input_tags ON input_tags.input_uuid = inputs.input_uuid AND input_tags.name = ?",
+		models.InputTagContextKey,
+	).Where(
+		"datasets.experiment_id IN ?", experimentIDs,
+	).Order(
+		"datasets.experiment_id",
+	).Order(
+		"datasets.name",
+	).Order(
+		"datasets.digest",
+	).Order(
+		"context",
+	).Limit(
+		limit,
+	).Scan(&summaries).Error; err != nil {
+		return nil, eris.Wrapf(err, "error searching dataset summaries for experiments: %v", experimentIDs)
+	}
+	return summaries, nil
+}
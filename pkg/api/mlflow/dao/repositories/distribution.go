@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// DistributionRepositoryProvider provides an interface to work with models.Distribution entity.
+type DistributionRepositoryProvider interface {
+	BaseRepositoryProvider
+	// CreateBatch creates []models.Distribution entities in batch.
+	CreateBatch(ctx context.Context, batchSize int, distributions []models.Distribution) error
+}
+
+// DistributionRepository repository to work with models.Distribution entity.
+type DistributionRepository struct {
+	BaseRepository
+}
+
+// NewDistributionRepository creates repository to work with models.Distribution entity.
+func NewDistributionRepository(db *gorm.DB) *DistributionRepository {
+	return &DistributionRepository{
+		BaseRepository{
+			db: db,
+		},
+	}
+}
+
+// CreateBatch creates []models.Distribution entities in batch. Distribution, which has been already logged
+// with the same name, context and step, is replaced.
+func (r DistributionRepository) CreateBatch(
+	ctx context.Context, batchSize int, distributions []models.Distribution,
+) error {
+	if len(distributions) == 0 {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		contexts := make([]*models.Context, len(distributions))
+		for n := range distributions {
+			contexts[n] = &distributions[n].Context
+		}
+		if err := createContexts(tx, batchSize, contexts); err != nil {
+			return err
+		}
+		for n := range distributions {
+			distributions[n].ContextID = distributions[n].Context.ID
+		}
+
+		if err := tx.Omit(clause.Associations).Clauses(
+			clause.OnConflict{UpdateAll: true},
+		).CreateInBatches(&distributions, batchSize).Error; err != nil {
+			return eris.Wrap(err, "error creating distributions")
+		}
+		return nil
+	})
+}
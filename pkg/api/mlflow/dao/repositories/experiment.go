@@ -28,6 +28,8 @@ type ExperimentRepositoryProvider interface {
 	GetByNamespaceIDAndExperimentID(
 		ctx context.Context, namespaceID uint, experimentID int32,
 	) (*models.Experiment, error)
+	// GetDeletedBefore returns models.Experiment entities which were deleted before provided time.
+	GetDeletedBefore(ctx context.Context, lastUpdateTime int64) ([]models.Experiment, error)
 	// UpdateWithTransaction updates existing models.Experiment entity in scope of transaction.
 	UpdateWithTransaction(ctx context.Context, tx *gorm.DB, experiment *models.Experiment) error
 }
@@ -78,6 +80,32 @@ func (r ExperimentRepository) GetByNamespaceIDAndExperimentID(
 	return &experiment, nil
 }
 
+// GetDeletedBefore returns models.Experiment entities which were deleted before provided time.
+// Experiments have no deletion time, so the time of the last update, which is set on deletion, is used.
+// Runs, traces and logged models of each experiment are preloaded, so their artifacts could be removed
+// together with the experiment. Only the tag holding artifact location is preloaded for the traces.
+func (r ExperimentRepository) GetDeletedBefore(
+	ctx context.Context, lastUpdateTime int64,
+) ([]models.Experiment, error) {
+	var experiments []models.Experiment
+	if err := r.db.WithContext(ctx).Preload(
+		"Runs",
+	).Preload(
+		"Traces.Tags", "key = ?", models.TraceTagArtifactLocation,
+	).Preload(
+		"LoggedModels",
+	).Where(
+		"lifecycle_stage = ?", models.LifecycleStageDeleted,
+	).Where(
+		"last_update_time < ?", lastUpdateTime,
+	).Order(
+		"experiment_id",
+	).Find(&experiments).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting experiments deleted before: %d", lastUpdateTime)
+	}
+	return experiments, nil
+}
+
 // GetByNamespaceIDAndName returns experiment by Namespace ID and Experiment name.
 func (r ExperimentRepository) GetByNamespaceIDAndName(
 	ctx context.Context, namespaceID uint, name string,
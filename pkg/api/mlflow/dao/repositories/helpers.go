@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/rotisserie/eris"
 	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 )
@@ -68,3 +71,30 @@ func BuildJsonCondition(
 	sql = strings.Repeat(conditionTemplate+" AND ", len(jsonPathValueMap)-1) + conditionTemplate
 	return sql, args
 }
+
+// createContexts creates models.Context entities, which don't exist yet, and sets the ID of every provided context.
+// Equal contexts are created only once.
+func createContexts(tx *gorm.DB, batchSize int, contexts []*models.Context) error {
+	uniqueContexts := make([]*models.Context, 0, len(contexts))
+	contextProcessed := make(map[string]*models.Context, len(contexts))
+	for _, c := range contexts {
+		if _, ok := contextProcessed[c.GetJsonHash()]; !ok {
+			uniqueContexts = append(uniqueContexts, c)
+			contextProcessed[c.GetJsonHash()] = c
+		}
+	}
+
+	if err := tx.Clauses(
+		clause.OnConflict{
+			Columns:   []clause.Column{{Name: "json"}},
+			UpdateAll: true,
+		},
+	).CreateInBatches(&uniqueContexts, batchSize).Error; err != nil {
+		return eris.Wrap(err, "error creating contexts")
+	}
+
+	for _, c := range contexts {
+		c.ID = contextProcessed[c.GetJsonHash()].ID
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// ImageRepositoryProvider provides an interface to work with models.Image entity.
+type ImageRepositoryProvider interface {
+	BaseRepositoryProvider
+	// CreateBatch creates []models.Image entities in batch.
+	CreateBatch(ctx context.Context, batchSize int, images []models.Image) error
+}
+
+// ImageRepository repository to work with models.Image entity.
+type ImageRepository struct {
+	BaseRepository
+}
+
+// NewImageRepository creates repository to work with models.Image entity.
+func NewImageRepository(db *gorm.DB) *ImageRepository {
+	return &ImageRepository{
+		BaseRepository{
+			db: db,
+		},
+	}
+}
+
+// CreateBatch creates []models.Image entities in batch. Image, which has been already logged
+// with the same name, context, step and index, is replaced.
+func (r ImageRepository) CreateBatch(ctx context.Context, batchSize int, images []models.Image) error {
+	if len(images) == 0 {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		contexts := make([]*models.Context, len(images))
+		for n := range images {
+			contexts[n] = &images[n].Context
+		}
+		if err := createContexts(tx, batchSize, contexts); err != nil {
+			return err
+		}
+		for n := range images {
+			images[n].ContextID = images[n].Context.ID
+		}
+
+		if err := tx.Omit(clause.Associations).Clauses(
+			clause.OnConflict{UpdateAll: true},
+		).CreateInBatches(&images, batchSize).Error; err != nil {
+			return eris.Wrap(err, "error creating images")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,255 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// LoggedModelRepositoryProvider provides an interface to work with models.LoggedModel entity.
+type LoggedModelRepositoryProvider interface {
+	BaseRepositoryProvider
+	// Create creates new models.LoggedModel entity together with its params and tags.
+	Create(ctx context.Context, model *models.LoggedModel) error
+	// Update updates existing models.LoggedModel entity.
+	Update(ctx context.Context, model *models.LoggedModel) error
+	// GetByNamespaceIDAndModelID returns active models.LoggedModel entity by Namespace ID and Model ID.
+	GetByNamespaceIDAndModelID(ctx context.Context, namespaceID uint, modelID string) (*models.LoggedModel, error)
+	// Search returns active models.LoggedModel entities of provided experiments, which match the condition.
+	Search(
+		ctx context.Context,
+		namespaceID uint,
+		experimentIDs []int32,
+		condition clause.Expression,
+		orderBy []clause.OrderByColumn,
+		limit int,
+	) ([]models.LoggedModel, error)
+	// SetTags creates or updates models.LoggedModelTag entities.
+	SetTags(ctx context.Context, tags []models.LoggedModelTag) error
+	// DeleteTag deletes existing models.LoggedModelTag entity.
+	DeleteTag(ctx context.Context, tag *models.LoggedModelTag) error
+	// LogParams creates or updates models.LoggedModelParam entities.
+	LogParams(ctx context.Context, params []models.LoggedModelParam) error
+	// GetDeletedBefore returns models.LoggedModel entities which were deleted before provided time.
+	GetDeletedBefore(ctx context.Context, lastUpdatedTimestampMS int64) ([]models.LoggedModel, error)
+	// DeleteBatch removes models.LoggedModel entities together with their params and tags.
+	DeleteBatch(ctx context.Context, ids []string) error
+}
+
+// LoggedModelRepository repository to work with models.LoggedModel entity.
+type LoggedModelRepository struct {
+	BaseRepository
+}
+
+// NewLoggedModelRepository creates repository to work with models.LoggedModel entity.
+func NewLoggedModelRepository(db *gorm.DB) *LoggedModelRepository {
+	return &LoggedModelRepository{
+		BaseRepository{
+			db: db,
+		},
+	}
+}
+
+// Create creates new models.LoggedModel entity together with its params and tags.
+func (r LoggedModelRepository) Create(ctx context.Context, model *models.LoggedModel) error {
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return eris.Wrapf(err, "error creating logged model with id: %s", model.ID)
+	}
+	return nil
+}
+
+// Update updates existing models.LoggedModel entity.
+func (r LoggedModelRepository) Update(ctx context.Context, model *models.LoggedModel) error {
+	if err := r.db.WithContext(ctx).Model(
+		model,
+	).Omit(
+		clause.Associations,
+	).Select(
+		"status", "status_message", "lifecycle_stage", "last_updated_timestamp_ms",
+	).Updates(model).Error; err != nil {
+		return eris.Wrapf(err, "error updating logged model with id: %s", model.ID)
+	}
+	return nil
+}
+
+// GetByNamespaceIDAndModelID returns active models.LoggedModel entity by Namespace ID and Model ID.
+func (r LoggedModelRepository) GetByNamespaceIDAndModelID(
+	ctx context.Context, namespaceID uint, modelID string,
+) (*models.LoggedModel, error) {
+	var model models.LoggedModel
+	if err := r.db.WithContext(ctx).Preload(
+		"Params",
+	).Preload(
+		"Tags",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = logged_models.experiment_id AND experiments.namespace_id = ?",
+		namespaceID,
+	).Where(
+		"logged_models.model_id = ?", modelID,
+	).Where(
+		"logged_models.lifecycle_stage = ?", models.LifecycleStageActive,
+	).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(err, "error getting logged model by id: %s", modelID)
+	}
+
+	loggedModels := []models.LoggedModel{model}
+	if err := r.loadMetrics(ctx, loggedModels); err != nil {
+		return nil, err
+	}
+	return &loggedModels[0], nil
+}
+
+// Search returns active models.LoggedModel entities of provided experiments, which match the condition.
+func (r LoggedModelRepository) Search(
+	ctx context.Context,
+	namespaceID uint,
+	experimentIDs []int32,
+	condition clause.Expression,
+	orderBy []clause.OrderByColumn,
+	limit int,
+) ([]models.LoggedModel, error) {
+	query := r.db.WithContext(ctx).Preload(
+		"Params",
+	).Preload(
+		"Tags",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = logged_models.experiment_id AND experiments.namespace_id = ?",
+		namespaceID,
+	).Where(
+		"logged_models.experiment_id IN ?", experimentIDs,
+	).Where(
+		"logged_models.lifecycle_stage = ?", models.LifecycleStageActive,
+	)
+	if condition != nil {
+		query.Where(condition)
+	}
+	for _, column := range orderBy {
+		query.Order(column)
+	}
+
+	var loggedModels []models.LoggedModel
+	if err := query.Limit(limit).Find(&loggedModels).Error; err != nil {
+		return nil, eris.Wrapf(err, "error searching logged models of experiments: %v", experimentIDs)
+	}
+	if err := r.loadMetrics(ctx, loggedModels); err != nil {
+		return nil, err
+	}
+	return loggedModels, nil
+}
+
+// SetTags creates or updates models.LoggedModelTag entities.
+func (r LoggedModelRepository) SetTags(ctx context.Context, tags []models.LoggedModelTag) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		UpdateAll: true,
+	}).Create(&tags).Error; err != nil {
+		return eris.Wrapf(err, "error setting tags of logged model with id: %s", tags[0].ModelID)
+	}
+	return nil
+}
+
+// DeleteTag deletes existing models.LoggedModelTag entity.
+func (r LoggedModelRepository) DeleteTag(ctx context.Context, tag *models.LoggedModelTag) error {
+	if err := r.db.WithContext(ctx).Where(
+		"model_id = ? AND key = ?", tag.ModelID, tag.Key,
+	).Delete(&models.LoggedModelTag{}).Error; err != nil {
+		return eris.Wrapf(err, "error deleting tag '%s' of logged model with id: %s", tag.Key, tag.ModelID)
+	}
+	return nil
+}
+
+// LogParams creates or updates models.LoggedModelParam entities.
+func (r LoggedModelRepository) LogParams(ctx context.Context, params []models.LoggedModelParam) error {
+	if len(params) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		UpdateAll: true,
+	}).Create(&params).Error; err != nil {
+		return eris.Wrapf(err, "error logging params of logged model with id: %s", params[0].ModelID)
+	}
+	return nil
+}
+
+// GetDeletedBefore returns models.LoggedModel entities which were deleted before provided time.
+// Logged models have no deletion time, so the time of the last update, which is set on deletion, is used.
+func (r LoggedModelRepository) GetDeletedBefore(
+	ctx context.Context, lastUpdatedTimestampMS int64,
+) ([]models.LoggedModel, error) {
+	var loggedModels []models.LoggedModel
+	if err := r.db.WithContext(ctx).Where(
+		"lifecycle_stage = ?", models.LifecycleStageDeleted,
+	).Where(
+		"last_updated_timestamp_ms < ?", lastUpdatedTimestampMS,
+	).Order(
+		"model_id",
+	).Find(&loggedModels).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting logged models deleted before: %d", lastUpdatedTimestampMS)
+	}
+	return loggedModels, nil
+}
+
+// DeleteBatch removes models.LoggedModel entities together with their params and tags.
+func (r LoggedModelRepository) DeleteBatch(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("model_id IN ?", ids).Delete(&models.LoggedModelParam{}).Error; err != nil {
+			return eris.Wrap(err, "error deleting logged model params")
+		}
+		if err := tx.Where("model_id IN ?", ids).Delete(&models.LoggedModelTag{}).Error; err != nil {
+			return eris.Wrap(err, "error deleting logged model tags")
+		}
+		if err := tx.Where("model_id IN ?", ids).Delete(&models.LoggedModel{}).Error; err != nil {
+			return eris.Wrap(err, "error deleting logged models")
+		}
+		return nil
+	}); err != nil {
+		return eris.Wrapf(err, "error deleting logged models with ids: %v", ids)
+	}
+	return nil
+}
+
+// loadMetrics loads metrics, which have been logged with ids of provided models.
+func (r LoggedModelRepository) loadMetrics(ctx context.Context, loggedModels []models.LoggedModel) error {
+	if len(loggedModels) == 0 {
+		return nil
+	}
+	modelIDs := make([]string, len(loggedModels))
+	for i, model := range loggedModels {
+		modelIDs[i] = model.ID
+	}
+
+	var metrics []models.Metric
+	if err := r.db.WithContext(ctx).Where(
+		"model_id IN ?", modelIDs,
+	).Order(
+		"key",
+	).Order(
+		"step",
+	).Order(
+		"timestamp",
+	).Find(&metrics).Error; err != nil {
+		return eris.Wrapf(err, "error getting metrics of logged models: %v", modelIDs)
+	}
+
+	modelMetrics := make(map[string][]models.Metric, len(loggedModels))
+	for _, metric := range metrics {
+		modelMetrics[metric.ModelID.String] = append(modelMetrics[metric.ModelID.String], metric)
+	}
+	for i := range loggedModels {
+		loggedModels[i].Metrics = modelMetrics[loggedModels[i].ID]
+	}
+	return nil
+}
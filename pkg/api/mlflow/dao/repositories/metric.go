@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/rotisserie/eris"
 	"gorm.io/gorm"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/filter"
 	"github.com/G-Research/fasttrackml/pkg/database"
 )
 
@@ -18,11 +20,27 @@ const (
 	MetricHistoryBulkDefaultLimit = 25000
 )
 
+// ErrPageKeysMismatch is returned, when the page keys don't match the ordering of the metrics history.
+var ErrPageKeysMismatch = eris.New("page keys don't match metrics history ordering")
+
+// metricHistoryColumns and metricHistoryBulkColumns are total orderings of the metrics history,
+// which are used by keyset pagination.
+var (
+	metricHistoryColumns = []string{
+		"metrics.step", "metrics.timestamp", "metrics.value", "metrics.is_nan", "metrics.context_id",
+	}
+	metricHistoryBulkColumns = []string{
+		"metrics.run_uuid", "metrics.timestamp", "metrics.step", "metrics.value", "metrics.is_nan", "metrics.context_id",
+	}
+)
+
 // MetricRepositoryProvider provides an interface to work with models.Metric entity.
 type MetricRepositoryProvider interface {
 	BaseRepositoryProvider
-	// CreateBatch creates []models.Metric entities in batch.
-	CreateBatch(ctx context.Context, run *models.Run, batchSize int, params []models.Metric) error
+	// CreateBatch creates []models.Metric entities in batch and returns the updated latest metrics of the run.
+	CreateBatch(
+		ctx context.Context, run *models.Run, batchSize int, params []models.Metric,
+	) ([]models.LatestMetric, error)
 	// GetMetricHistories returns metric histories by request parameters.
 	GetMetricHistories(
 		ctx context.Context,
@@ -32,12 +50,42 @@ type MetricRepositoryProvider interface {
 		limit int32,
 		jsonPathValueMap map[string]string,
 	) (*sql.Rows, func(*sql.Rows, interface{}) error, error)
-	// GetMetricHistoryBulk returns metrics history bulk.
+	// GetMetricHistoryBulk returns the page of metrics history bulk, which starts after provided page keys.
 	GetMetricHistoryBulk(
-		ctx context.Context, namespaceID uint, runIDs []string, key string, limit int,
+		ctx context.Context,
+		namespaceID uint,
+		runIDs []string,
+		key string,
+		metricContext map[string]string,
+		pageKeys []any,
+		limit int,
+	) ([]models.Metric, []any, error)
+	// GetMetricHistoryBulkInterval returns sampled metrics history of the runs between provided steps.
+	GetMetricHistoryBulkInterval(
+		ctx context.Context,
+		namespaceID uint,
+		runIDs []string,
+		key string,
+		startStep, endStep *int64,
+		maxResults int,
 	) ([]models.Metric, error)
-	// GetMetricHistoryByRunIDAndKey returns metrics history by RunID and Key.
-	GetMetricHistoryByRunIDAndKey(ctx context.Context, runID, key string) ([]models.Metric, error)
+	// GetMetricHistoryByRunIDAndKey returns the page of metrics history by RunID and Key,
+	// which starts after provided page keys.
+	GetMetricHistoryByRunIDAndKey(
+		ctx context.Context,
+		runID, key string,
+		metricContext map[string]string,
+		pageKeys []any,
+		limit int,
+	) ([]models.Metric, []any, error)
+	// DeleteHistory deletes metrics history of the run, optionally filtered by the key,
+	// the context and only after provided step.
+	DeleteHistory(
+		ctx context.Context,
+		runID, key string,
+		metricContext map[string]string,
+		afterStep *int64,
+	) error
 }
 
 // MetricRepository repository to work with models.Metric entity.
@@ -54,15 +102,15 @@ func NewMetricRepository(db *gorm.DB) *MetricRepository {
 	}
 }
 
-// CreateBatch creates []models.Metric entities in batch.
+// CreateBatch creates []models.Metric entities in batch and returns the updated latest metrics of the run.
 // TODO:get back and fix `gocyclo` problem.
 //
 //nolint:gocyclo
 func (r MetricRepository) CreateBatch(
 	ctx context.Context, run *models.Run, batchSize int, metrics []models.Metric,
-) error {
+) ([]models.LatestMetric, error) {
 	if len(metrics) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	metricKeysMap := make(map[string]any)
@@ -77,7 +125,7 @@ func (r MetricRepository) CreateBatch(
 	// get the latest metrics by requested Run ID and metric keys.
 	lastMetrics, err := r.getLatestMetricsByRunIDAndKeys(ctx, run.ID, metricKeys)
 	if err != nil {
-		return eris.Wrap(err, "error getting latest metrics")
+		return nil, eris.Wrap(err, "error getting latest metrics")
 	}
 
 	lastIters := make(map[string]int64)
@@ -106,7 +154,7 @@ func (r MetricRepository) CreateBatch(
 			UpdateAll: true,
 		},
 	).CreateInBatches(&uniqueContexts, batchSize).Error; err != nil {
-		return eris.Wrapf(err, "error creating contexts")
+		return nil, eris.Wrapf(err, "error creating contexts")
 	}
 
 	for n := range metrics {
@@ -136,7 +184,7 @@ func (r MetricRepository) CreateBatch(
 	if err := r.db.WithContext(ctx).Clauses(
 		clause.OnConflict{DoNothing: true},
 	).CreateInBatches(&metrics, batchSize).Error; err != nil {
-		return eris.Wrapf(err, "error creating metrics for run: %s", run.ID)
+		return nil, eris.Wrapf(err, "error creating metrics for run: %s", run.ID)
 	}
 
 	// TODO update latest metrics in the background?
@@ -164,10 +212,10 @@ func (r MetricRepository) CreateBatch(
 			Columns:   []clause.Column{{Name: "run_uuid"}, {Name: "key"}, {Name: "context_id"}},
 			UpdateAll: true,
 		}).Create(&updatedLatestMetrics).Error; err != nil {
-			return eris.Wrapf(err, "error updating latest metrics for run: %s", run.ID)
+			return nil, eris.Wrapf(err, "error updating latest metrics for run: %s", run.ID)
 		}
 	}
-	return nil
+	return updatedLatestMetrics, nil
 }
 
 // GetMetricHistories returns metric histories by request parameters.
@@ -283,26 +331,38 @@ func (r MetricRepository) getLatestMetricsByRunIDAndKeys(
 	return metrics, nil
 }
 
-// GetMetricHistoryByRunIDAndKey returns metrics history by RunID and Key.
+// GetMetricHistoryByRunIDAndKey returns the page of metrics history by RunID and Key,
+// which starts after provided page keys.
 func (r MetricRepository) GetMetricHistoryByRunIDAndKey(
-	ctx context.Context, runID, key string,
-) ([]models.Metric, error) {
-	var metrics []models.Metric
-	if err := r.db.WithContext(ctx).Preload("Context").Where(
-		"run_uuid = ?", runID,
+	ctx context.Context,
+	runID, key string,
+	metricContext map[string]string,
+	pageKeys []any,
+	limit int,
+) ([]models.Metric, []any, error) {
+	query := r.db.WithContext(ctx).Preload("Context").Where(
+		"metrics.run_uuid = ?", runID,
 	).Where(
-		"key = ?", key,
-	).Find(&metrics).Error; err != nil {
-		return nil, eris.Wrapf(err, "error getting metric history by run id: %s and key: %s", runID, key)
+		"metrics.key = ?", key,
+	)
+
+	metrics, nextPageKeys, err := getMetricHistoryPage(query, metricHistoryColumns, metricContext, pageKeys, limit)
+	if err != nil {
+		return nil, nil, eris.Wrapf(err, "error getting metric history by run id: %s and key: %s", runID, key)
 	}
-	return metrics, nil
+	return metrics, nextPageKeys, nil
 }
 
-// GetMetricHistoryBulk returns metrics history bulk.
+// GetMetricHistoryBulk returns the page of metrics history bulk, which starts after provided page keys.
 func (r MetricRepository) GetMetricHistoryBulk(
-	ctx context.Context, namespaceID uint, runIDs []string, key string, limit int,
-) ([]models.Metric, error) {
-	var metrics []models.Metric
+	ctx context.Context,
+	namespaceID uint,
+	runIDs []string,
+	key string,
+	metricContext map[string]string,
+	pageKeys []any,
+	limit int,
+) ([]models.Metric, []any, error) {
 	query := r.db.WithContext(ctx).Where(
 		"runs.run_uuid IN ?", runIDs,
 	).Joins(
@@ -311,26 +371,265 @@ func (r MetricRepository) GetMetricHistoryBulk(
 		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
 		namespaceID,
 	).Where(
-		"key = ?", key,
-	).Order(
+		"metrics.key = ?", key,
+	)
+
+	if limit == 0 {
+		limit = MetricHistoryBulkDefaultLimit
+	}
+
+	metrics, nextPageKeys, err := getMetricHistoryPage(query, metricHistoryBulkColumns, metricContext, pageKeys, limit)
+	if err != nil {
+		return nil, nil, eris.Wrapf(err, "error getting metric history by run ids: %v and key: %s", runIDs, key)
+	}
+	return metrics, nextPageKeys, nil
+}
+
+// getMetricHistoryPage orders the metrics history query by provided columns, filters it by the metric context
+// and returns the page of the metrics, which starts after provided page keys. Keys of the next page are returned
+// only when the page is full, so there might be more metrics.
+func getMetricHistoryPage(
+	query *gorm.DB,
+	columns []string,
+	metricContext map[string]string,
+	pageKeys []any,
+	limit int,
+) ([]models.Metric, []any, error) {
+	if len(metricContext) > 0 {
+		query.Joins("LEFT JOIN contexts ON metrics.context_id = contexts.id")
+		sql, args := BuildJsonCondition(query.Dialector.Name(), "contexts.json", metricContext)
+		query.Where(sql, args...)
+	}
+
+	if pageKeys != nil {
+		if len(pageKeys) != len(columns) {
+			return nil, nil, ErrPageKeysMismatch
+		}
+		keys := make([]filter.SortKey, len(columns))
+		for i, column := range columns {
+			keys[i] = filter.SortKey{Column: column, Value: pageKeys[i]}
+		}
+		query.Where(filter.BuildKeysetCondition(query.Dialector.Name(), keys))
+	}
+
+	for _, column := range columns {
+		query.Order(column)
+	}
+	if limit > 0 {
+		query.Limit(limit)
+	}
+
+	var metrics []models.Metric
+	if err := query.Find(&metrics).Error; err != nil {
+		return nil, nil, err
+	}
+	if limit <= 0 || len(metrics) < limit {
+		return metrics, nil, nil
+	}
+
+	last := metrics[len(metrics)-1]
+	values := map[string]any{
+		"metrics.run_uuid":   last.RunID,
+		"metrics.step":       last.Step,
+		"metrics.timestamp":  last.Timestamp,
+		"metrics.value":      last.Value,
+		"metrics.is_nan":     last.IsNan,
+		"metrics.context_id": last.ContextID,
+	}
+	nextPageKeys := make([]any, len(columns))
+	for i, column := range columns {
+		nextPageKeys[i] = values[column]
+	}
+	return metrics, nextPageKeys, nil
+}
+
+// GetMetricHistoryBulkInterval returns sampled metrics history of the runs between provided steps.
+// Every metric series of the run is sampled by the `iter` column, so at most `maxResults` evenly
+// distributed metrics are returned for each series, including the first and the last one in the interval.
+func (r MetricRepository) GetMetricHistoryBulkInterval(
+	ctx context.Context,
+	namespaceID uint,
+	runIDs []string,
+	key string,
+	startStep, endStep *int64,
+	maxResults int,
+) ([]models.Metric, error) {
+	// find the range of iterations of every metric series and the sampling interval,
+	// which is never less than 1, so all the metrics are returned for the short series.
+	steps := float64(max(maxResults-1, 1))
+	bounds := r.db.WithContext(ctx).Select(
 		"metrics.run_uuid",
+		"metrics.context_id",
+		"MIN(metrics.iter) AS min_iter",
+		"MAX(metrics.iter) AS max_iter",
+		fmt.Sprintf(
+			"CASE WHEN MAX(metrics.iter) - MIN(metrics.iter) < %f THEN 1 "+
+				"ELSE (MAX(metrics.iter) - MIN(metrics.iter)) / %f END AS interval",
+			steps, steps,
+		),
+	).Table(
+		"metrics",
+	).Joins(
+		"INNER JOIN runs ON runs.run_uuid = metrics.run_uuid",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
+		namespaceID,
+	).Where(
+		"metrics.run_uuid IN ?", runIDs,
+	).Where(
+		"metrics.key = ?", key,
+	).Group(
+		"metrics.run_uuid",
+	).Group(
+		"metrics.context_id",
+	)
+	if startStep != nil && endStep != nil {
+		bounds.Where("metrics.step BETWEEN ? AND ?", *startStep, *endStep)
+	}
+
+	query := r.db.WithContext(ctx).Joins(
+		"INNER JOIN (?) AS bounds ON bounds.run_uuid = metrics.run_uuid AND bounds.context_id = metrics.context_id",
+		bounds,
+	).Where(
+		"metrics.key = ?", key,
+	).Where(
+		"metrics.iter BETWEEN bounds.min_iter AND bounds.max_iter",
+	).Where(
+		"(MOD(metrics.iter - bounds.min_iter, bounds.interval) < 1 OR metrics.iter = bounds.max_iter)",
 	).Order(
-		"metrics.timestamp",
+		"metrics.run_uuid",
 	).Order(
 		"metrics.step",
 	).Order(
-		"metrics.value",
+		"metrics.timestamp",
+	).Order(
+		"metrics.iter",
 	)
+	if startStep != nil && endStep != nil {
+		query.Where("metrics.step BETWEEN ? AND ?", *startStep, *endStep)
+	}
 
-	if limit == 0 {
-		limit = MetricHistoryBulkDefaultLimit
+	var metrics []models.Metric
+	if err := query.Find(&metrics).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting metric history interval by run ids: %v and key: %s", runIDs, key)
 	}
-	query.Limit(limit)
+	return metrics, nil
+}
+
+// metricSeries identifies a single metric series of the run.
+type metricSeries struct {
+	Key       string
+	ContextID uint
+}
 
-	if err := query.Find(
-		&metrics,
+// DeleteHistory deletes metrics history of the run, optionally filtered by the key,
+// the context and only after provided step. The remaining metrics of every affected series are renumbered,
+// so `iter` stays contiguous, and the latest metric of the series is recomputed the same way as on ingestion.
+func (r MetricRepository) DeleteHistory(
+	ctx context.Context,
+	runID, key string,
+	metricContext map[string]string,
+	afterStep *int64,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		filterMetrics := func() *gorm.DB {
+			query := tx.Model(&models.Metric{}).Where("metrics.run_uuid = ?", runID)
+			if key != "" {
+				query = query.Where("metrics.key = ?", key)
+			}
+			if afterStep != nil {
+				query = query.Where("metrics.step > ?", *afterStep)
+			}
+			if len(metricContext) > 0 {
+				sql, args := BuildJsonCondition(tx.Dialector.Name(), "contexts.json", metricContext)
+				query = query.Where(
+					fmt.Sprintf("metrics.context_id IN (SELECT contexts.id FROM contexts WHERE %s)", sql), args...,
+				)
+			}
+			return query
+		}
+
+		var series []metricSeries
+		if err := filterMetrics().Distinct("key", "context_id").Find(&series).Error; err != nil {
+			return eris.Wrapf(err, "error getting metric series of run: %s", runID)
+		}
+		if len(series) == 0 {
+			return nil
+		}
+
+		if err := filterMetrics().Delete(&models.Metric{}).Error; err != nil {
+			return eris.Wrapf(err, "error deleting metrics of run: %s", runID)
+		}
+
+		for _, s := range series {
+			if err := updateMetricSeries(tx, runID, s); err != nil {
+				return eris.Wrapf(err, "error updating metric '%s' of run: %s", s.Key, runID)
+			}
+		}
+		return nil
+	})
+}
+
+// updateMetricSeries renumbers `iter` of the remaining metrics in the series and recomputes its latest metric.
+func updateMetricSeries(tx *gorm.DB, runID string, series metricSeries) error {
+	var count int64
+	if err := tx.Model(&models.Metric{}).Where(
+		"run_uuid = ? AND key = ? AND context_id = ?", runID, series.Key, series.ContextID,
+	).Count(&count).Error; err != nil {
+		return eris.Wrap(err, "error counting metrics")
+	}
+
+	if count == 0 {
+		if err := tx.Where(
+			"run_uuid = ? AND key = ? AND context_id = ?", runID, series.Key, series.ContextID,
+		).Delete(&models.LatestMetric{}).Error; err != nil {
+			return eris.Wrap(err, "error deleting latest metric")
+		}
+		return nil
+	}
+
+	if err := tx.Exec(
+		`UPDATE metrics
+		 SET iter = rows.new_iter
+		 FROM (
+		   SELECT iter, ROW_NUMBER() OVER (ORDER BY iter) AS new_iter
+		   FROM metrics
+		   WHERE run_uuid = ? AND key = ? AND context_id = ?
+		 ) AS rows
+		 WHERE metrics.run_uuid = ? AND metrics.key = ? AND metrics.context_id = ? AND metrics.iter = rows.iter`,
+		runID, series.Key, series.ContextID,
+		runID, series.Key, series.ContextID,
 	).Error; err != nil {
-		return nil, eris.Wrapf(err, "error getting metric history by run ids: %v and key: %s", runIDs, key)
+		return eris.Wrap(err, "error updating metrics.iter")
 	}
-	return metrics, nil
+
+	var latest models.Metric
+	if err := tx.Where(
+		"run_uuid = ? AND key = ? AND context_id = ?", runID, series.Key, series.ContextID,
+	).Order(
+		"step DESC",
+	).Order(
+		"timestamp DESC",
+	).Order(
+		"value DESC",
+	).First(&latest).Error; err != nil {
+		return eris.Wrap(err, "error getting latest metric")
+	}
+
+	if err := tx.Omit(clause.Associations).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "run_uuid"}, {Name: "key"}, {Name: "context_id"}},
+		UpdateAll: true,
+	}).Create(&models.LatestMetric{
+		RunID:     latest.RunID,
+		Key:       latest.Key,
+		Value:     latest.Value,
+		Timestamp: latest.Timestamp,
+		Step:      latest.Step,
+		IsNan:     latest.IsNan,
+		LastIter:  count,
+		ContextID: latest.ContextID,
+	}).Error; err != nil {
+		return eris.Wrap(err, "error updating latest metric")
+	}
+	return nil
 }
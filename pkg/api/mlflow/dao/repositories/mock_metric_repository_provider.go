@@ -22,12 +22,38 @@ type MockMetricRepositoryProvider struct {
 }
 
 // CreateBatch provides a mock function with given fields: ctx, run, batchSize, params
-func (_m *MockMetricRepositoryProvider) CreateBatch(ctx context.Context, run *models.Run, batchSize int, params []models.Metric) error {
+func (_m *MockMetricRepositoryProvider) CreateBatch(ctx context.Context, run *models.Run, batchSize int, params []models.Metric) ([]models.LatestMetric, error) {
 	ret := _m.Called(ctx, run, batchSize, params)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.Run, int, []models.Metric) error); ok {
+	var r0 []models.LatestMetric
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Run, int, []models.Metric) ([]models.LatestMetric, error)); ok {
+		return rf(ctx, run, batchSize, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Run, int, []models.Metric) []models.LatestMetric); ok {
 		r0 = rf(ctx, run, batchSize, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.LatestMetric)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Run, int, []models.Metric) error); ok {
+		r1 = rf(ctx, run, batchSize, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteHistory provides a mock function with given fields: ctx, runID, key, metricContext, afterStep
+func (_m *MockMetricRepositoryProvider) DeleteHistory(ctx context.Context, runID string, key string, metricContext map[string]string, afterStep *int64) error {
+	ret := _m.Called(ctx, runID, key, metricContext, afterStep)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, map[string]string, *int64) error); ok {
+		r0 = rf(ctx, runID, key, metricContext, afterStep)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -86,51 +112,60 @@ func (_m *MockMetricRepositoryProvider) GetMetricHistories(ctx context.Context,
 	return r0, r1, r2
 }
 
-// GetMetricHistoryBulk provides a mock function with given fields: ctx, namespaceID, runIDs, key, limit
-func (_m *MockMetricRepositoryProvider) GetMetricHistoryBulk(ctx context.Context, namespaceID uint, runIDs []string, key string, limit int) ([]models.Metric, error) {
-	ret := _m.Called(ctx, namespaceID, runIDs, key, limit)
+// GetMetricHistoryBulk provides a mock function with given fields: ctx, namespaceID, runIDs, key, metricContext, pageKeys, limit
+func (_m *MockMetricRepositoryProvider) GetMetricHistoryBulk(ctx context.Context, namespaceID uint, runIDs []string, key string, metricContext map[string]string, pageKeys []any, limit int) ([]models.Metric, []any, error) {
+	ret := _m.Called(ctx, namespaceID, runIDs, key, metricContext, pageKeys, limit)
 
 	var r0 []models.Metric
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, string, int) ([]models.Metric, error)); ok {
-		return rf(ctx, namespaceID, runIDs, key, limit)
+	var r1 []any
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, string, map[string]string, []any, int) ([]models.Metric, []any, error)); ok {
+		return rf(ctx, namespaceID, runIDs, key, metricContext, pageKeys, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, string, int) []models.Metric); ok {
-		r0 = rf(ctx, namespaceID, runIDs, key, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, string, map[string]string, []any, int) []models.Metric); ok {
+		r0 = rf(ctx, namespaceID, runIDs, key, metricContext, pageKeys, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Metric)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint, []string, string, int) error); ok {
-		r1 = rf(ctx, namespaceID, runIDs, key, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, uint, []string, string, map[string]string, []any, int) []any); ok {
+		r1 = rf(ctx, namespaceID, runIDs, key, metricContext, pageKeys, limit)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]any)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, uint, []string, string, map[string]string, []any, int) error); ok {
+		r2 = rf(ctx, namespaceID, runIDs, key, metricContext, pageKeys, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// GetMetricHistoryByRunIDAndKey provides a mock function with given fields: ctx, runID, key
-func (_m *MockMetricRepositoryProvider) GetMetricHistoryByRunIDAndKey(ctx context.Context, runID string, key string) ([]models.Metric, error) {
-	ret := _m.Called(ctx, runID, key)
+// GetMetricHistoryBulkInterval provides a mock function with given fields: ctx, namespaceID, runIDs, key, startStep, endStep, maxResults
+func (_m *MockMetricRepositoryProvider) GetMetricHistoryBulkInterval(ctx context.Context, namespaceID uint, runIDs []string, key string, startStep *int64, endStep *int64, maxResults int) ([]models.Metric, error) {
+	ret := _m.Called(ctx, namespaceID, runIDs, key, startStep, endStep, maxResults)
 
 	var r0 []models.Metric
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]models.Metric, error)); ok {
-		return rf(ctx, runID, key)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, string, *int64, *int64, int) ([]models.Metric, error)); ok {
+		return rf(ctx, namespaceID, runIDs, key, startStep, endStep, maxResults)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) []models.Metric); ok {
-		r0 = rf(ctx, runID, key)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, []string, string, *int64, *int64, int) []models.Metric); ok {
+		r0 = rf(ctx, namespaceID, runIDs, key, startStep, endStep, maxResults)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Metric)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, runID, key)
+	if rf, ok := ret.Get(1).(func(context.Context, uint, []string, string, *int64, *int64, int) error); ok {
+		r1 = rf(ctx, namespaceID, runIDs, key, startStep, endStep, maxResults)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -138,6 +173,41 @@ func (_m *MockMetricRepositoryProvider) GetMetricHistoryByRunIDAndKey(ctx contex
 	return r0, r1
 }
 
+// GetMetricHistoryByRunIDAndKey provides a mock function with given fields: ctx, runID, key, metricContext, pageKeys, limit
+func (_m *MockMetricRepositoryProvider) GetMetricHistoryByRunIDAndKey(ctx context.Context, runID string, key string, metricContext map[string]string, pageKeys []any, limit int) ([]models.Metric, []any, error) {
+	ret := _m.Called(ctx, runID, key, metricContext, pageKeys, limit)
+
+	var r0 []models.Metric
+	var r1 []any
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, map[string]string, []any, int) ([]models.Metric, []any, error)); ok {
+		return rf(ctx, runID, key, metricContext, pageKeys, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, map[string]string, []any, int) []models.Metric); ok {
+		r0 = rf(ctx, runID, key, metricContext, pageKeys, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Metric)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, map[string]string, []any, int) []any); ok {
+		r1 = rf(ctx, runID, key, metricContext, pageKeys, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]any)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, map[string]string, []any, int) error); ok {
+		r2 = rf(ctx, runID, key, metricContext, pageKeys, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // NewMockMetricRepositoryProvider creates a new instance of MockMetricRepositoryProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockMetricRepositoryProvider(t interface {
@@ -45,6 +45,20 @@ func (_m *MockRunRepositoryProvider) ArchiveBatch(ctx context.Context, namespace
 	return r0
 }
 
+// Clone provides a mock function with given fields: ctx, source, clone
+func (_m *MockRunRepositoryProvider) Clone(ctx context.Context, source *models.Run, clone *models.Run) error {
+	ret := _m.Called(ctx, source, clone)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Run, *models.Run) error); ok {
+		r0 = rf(ctx, source, clone)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: ctx, run
 func (_m *MockRunRepositoryProvider) Create(ctx context.Context, run *models.Run) error {
 	ret := _m.Called(ctx, run)
@@ -181,6 +195,72 @@ func (_m *MockRunRepositoryProvider) GetDB() *gorm.DB {
 	return r0
 }
 
+// GetDeletedBefore provides a mock function with given fields: ctx, deletedTime
+func (_m *MockRunRepositoryProvider) GetDeletedBefore(ctx context.Context, deletedTime int64) ([]models.Run, error) {
+	ret := _m.Called(ctx, deletedTime)
+
+	var r0 []models.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]models.Run, error)); ok {
+		return rf(ctx, deletedTime)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []models.Run); ok {
+		r0 = rf(ctx, deletedTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, deletedTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStale provides a mock function with given fields: ctx, lastSeenBefore
+func (_m *MockRunRepositoryProvider) GetStale(ctx context.Context, lastSeenBefore int64) ([]models.Run, error) {
+	ret := _m.Called(ctx, lastSeenBefore)
+
+	var r0 []models.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]models.Run, error)); ok {
+		return rf(ctx, lastSeenBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []models.Run); ok {
+		r0 = rf(ctx, lastSeenBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, lastSeenBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Move provides a mock function with given fields: ctx, run
+func (_m *MockRunRepositoryProvider) Move(ctx context.Context, run *models.Run) error {
+	ret := _m.Called(ctx, run)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Run) error); ok {
+		r0 = rf(ctx, run)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Restore provides a mock function with given fields: ctx, run
 func (_m *MockRunRepositoryProvider) Restore(ctx context.Context, run *models.Run) error {
 	ret := _m.Called(ctx, run)
@@ -223,6 +303,30 @@ func (_m *MockRunRepositoryProvider) SetRunTagsBatch(ctx context.Context, run *m
 	return r0
 }
 
+// TerminateStale provides a mock function with given fields: ctx, run, lastSeenBefore, tag
+func (_m *MockRunRepositoryProvider) TerminateStale(ctx context.Context, run *models.Run, lastSeenBefore int64, tag *models.Tag) (bool, error) {
+	ret := _m.Called(ctx, run, lastSeenBefore, tag)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Run, int64, *models.Tag) (bool, error)); ok {
+		return rf(ctx, run, lastSeenBefore, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Run, int64, *models.Tag) bool); ok {
+		r0 = rf(ctx, run, lastSeenBefore, tag)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Run, int64, *models.Tag) error); ok {
+		r1 = rf(ctx, run, lastSeenBefore, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, run
 func (_m *MockRunRepositoryProvider) Update(ctx context.Context, run *models.Run) error {
 	ret := _m.Called(ctx, run)
@@ -237,6 +341,20 @@ func (_m *MockRunRepositoryProvider) Update(ctx context.Context, run *models.Run
 	return r0
 }
 
+// UpdateLastSeenTime provides a mock function with given fields: ctx, id, lastSeenTime
+func (_m *MockRunRepositoryProvider) UpdateLastSeenTime(ctx context.Context, id string, lastSeenTime int64) error {
+	ret := _m.Called(ctx, id, lastSeenTime)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, id, lastSeenTime)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateWithTransaction provides a mock function with given fields: ctx, tx, run
 func (_m *MockRunRepositoryProvider) UpdateWithTransaction(ctx context.Context, tx *gorm.DB, run *models.Run) error {
 	ret := _m.Called(ctx, tx, run)
@@ -0,0 +1,233 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// ModelVersionRepositoryProvider provides an interface to work with models.ModelVersion entity.
+type ModelVersionRepositoryProvider interface {
+	BaseRepositoryProvider
+	// Create creates new models.ModelVersion entity with the next available version number.
+	Create(ctx context.Context, modelVersion *models.ModelVersion) error
+	// Update updates existing models.ModelVersion entity.
+	Update(ctx context.Context, modelVersion *models.ModelVersion) error
+	// Delete removes existing models.ModelVersion entity together with its tags, stage transitions
+	// and the aliases pointing to it.
+	Delete(ctx context.Context, modelVersion *models.ModelVersion) error
+	// GetByRegisteredModelIDAndVersion returns models.ModelVersion by Registered Model ID and version number.
+	GetByRegisteredModelIDAndVersion(
+		ctx context.Context, registeredModelID uint, version int64,
+	) (*models.ModelVersion, error)
+	// GetLatestByRegisteredModelID returns the latest models.ModelVersion of each requested stage.
+	GetLatestByRegisteredModelID(
+		ctx context.Context, registeredModelID uint, stages []models.ModelVersionStage,
+	) ([]models.ModelVersion, error)
+	// Search returns models.ModelVersion entities of the namespace, which match the condition.
+	Search(
+		ctx context.Context,
+		namespaceID uint,
+		condition clause.Expression,
+		orderBy []clause.OrderByColumn,
+		limit int,
+	) ([]models.ModelVersion, error)
+	// SetTag creates or updates models.ModelVersionTag entity.
+	SetTag(ctx context.Context, tag *models.ModelVersionTag) error
+	// DeleteTag deletes existing models.ModelVersionTag entity.
+	DeleteTag(ctx context.Context, tag *models.ModelVersionTag) error
+}
+
+// ModelVersionRepository repository to work with models.ModelVersion entity.
+type ModelVersionRepository struct {
+	BaseRepository
+}
+
+// NewModelVersionRepository creates repository to work with models.ModelVersion entity.
+func NewModelVersionRepository(db *gorm.DB) *ModelVersionRepository {
+	return &ModelVersionRepository{
+		BaseRepository{
+			db: db,
+		},
+	}
+}
+
+// Create creates new models.ModelVersion entity with the next available version number.
+func (r ModelVersionRepository) Create(ctx context.Context, modelVersion *models.ModelVersion) error {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var version int64
+		if err := tx.Model(
+			&models.ModelVersion{},
+		).Where(
+			"registered_model_id = ?", modelVersion.RegisteredModelID,
+		).Select(
+			"COALESCE(MAX(version), 0)",
+		).Scan(&version).Error; err != nil {
+			return eris.Wrap(err, "error getting latest version number")
+		}
+		modelVersion.Version = version + 1
+
+		if err := tx.Omit("RegisteredModel").Create(modelVersion).Error; err != nil {
+			return eris.Wrap(err, "error creating model version")
+		}
+		if err := tx.Model(
+			&models.RegisteredModel{ID: modelVersion.RegisteredModelID},
+		).Update(
+			"LastUpdatedTime", modelVersion.CreationTime,
+		).Error; err != nil {
+			return eris.Wrap(err, "error updating registered model")
+		}
+		return nil
+	}); err != nil {
+		return eris.Wrapf(
+			err, "error creating model version for registered model with id: %d", modelVersion.RegisteredModelID,
+		)
+	}
+	return nil
+}
+
+// Update updates existing models.ModelVersion entity.
+func (r ModelVersionRepository) Update(ctx context.Context, modelVersion *models.ModelVersion) error {
+	if err := r.db.WithContext(ctx).Model(
+		modelVersion,
+	).Select(
+		"Description", "CurrentStage", "LastUpdatedTime",
+	).Updates(modelVersion).Error; err != nil {
+		return eris.Wrapf(err, "error updating model version with id: %d", modelVersion.ID)
+	}
+	return nil
+}
+
+// Delete removes existing models.ModelVersion entity together with its tags, stage transitions
+// and the aliases pointing to it.
+func (r ModelVersionRepository) Delete(ctx context.Context, modelVersion *models.ModelVersion) error {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(
+			"registered_model_id = ? AND version = ?", modelVersion.RegisteredModelID, modelVersion.Version,
+		).Delete(&models.RegisteredModelAlias{}).Error; err != nil {
+			return eris.Wrap(err, "error deleting model version aliases")
+		}
+		for _, entity := range []any{
+			&models.ModelVersionTag{}, &models.ModelVersionTransition{}, &models.ModelVersionTransitionRequest{},
+		} {
+			if err := tx.Where("model_version_id = ?", modelVersion.ID).Delete(entity).Error; err != nil {
+				return eris.Wrap(err, "error deleting model version dependencies")
+			}
+		}
+		return tx.Delete(modelVersion).Error
+	}); err != nil {
+		return eris.Wrapf(err, "error deleting model version with id: %d", modelVersion.ID)
+	}
+	return nil
+}
+
+// GetByRegisteredModelIDAndVersion returns models.ModelVersion by Registered Model ID and version number.
+func (r ModelVersionRepository) GetByRegisteredModelIDAndVersion(
+	ctx context.Context, registeredModelID uint, version int64,
+) (*models.ModelVersion, error) {
+	var modelVersion models.ModelVersion
+	if err := r.db.WithContext(ctx).Preload(
+		"Tags",
+	).Preload(
+		"RegisteredModel.Aliases",
+	).Where(
+		"model_versions.registered_model_id = ?", registeredModelID,
+	).Where(
+		"model_versions.version = ?", version,
+	).First(&modelVersion).Error; err != nil {
+		if eris.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(
+			err, "error getting model version by registered model id: %d and version: %d", registeredModelID, version,
+		)
+	}
+	return &modelVersion, nil
+}
+
+// GetLatestByRegisteredModelID returns the latest models.ModelVersion of each requested stage.
+func (r ModelVersionRepository) GetLatestByRegisteredModelID(
+	ctx context.Context, registeredModelID uint, stages []models.ModelVersionStage,
+) ([]models.ModelVersion, error) {
+	query := r.db.WithContext(ctx).Model(
+		&models.ModelVersion{},
+	).Select(
+		"MAX(version)",
+	).Where(
+		"registered_model_id = ?", registeredModelID,
+	).Group("current_stage")
+	if len(stages) > 0 {
+		query = query.Where("current_stage IN ?", stages)
+	}
+
+	var modelVersions []models.ModelVersion
+	if err := r.db.WithContext(ctx).Preload(
+		"Tags",
+	).Preload(
+		"RegisteredModel.Aliases",
+	).Where(
+		"registered_model_id = ?", registeredModelID,
+	).Where(
+		"version IN (?)", query,
+	).Order(
+		"version",
+	).Find(&modelVersions).Error; err != nil {
+		return nil, eris.Wrapf(
+			err, "error getting latest model versions for registered model id: %d", registeredModelID,
+		)
+	}
+	return modelVersions, nil
+}
+
+// Search returns models.ModelVersion entities of the namespace, which match the condition.
+func (r ModelVersionRepository) Search(
+	ctx context.Context,
+	namespaceID uint,
+	condition clause.Expression,
+	orderBy []clause.OrderByColumn,
+	limit int,
+) ([]models.ModelVersion, error) {
+	query := r.db.WithContext(ctx).Preload(
+		"Tags",
+	).Preload(
+		"RegisteredModel.Aliases",
+	).Joins(
+		"INNER JOIN registered_models ON registered_models.id = model_versions.registered_model_id "+
+			"AND registered_models.namespace_id = ?",
+		namespaceID,
+	)
+	if condition != nil {
+		query.Where(condition)
+	}
+	for _, column := range orderBy {
+		query.Order(column)
+	}
+
+	var modelVersions []models.ModelVersion
+	if err := query.Limit(limit).Find(&modelVersions).Error; err != nil {
+		return nil, eris.Wrapf(err, "error searching model versions of namespace: %d", namespaceID)
+	}
+	return modelVersions, nil
+}
+
+// SetTag creates or updates models.ModelVersionTag entity.
+func (r ModelVersionRepository) SetTag(ctx context.Context, tag *models.ModelVersionTag) error {
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		UpdateAll: true,
+	}).Create(tag).Error; err != nil {
+		return eris.Wrapf(err, "error setting tag for model version with id: %d", tag.ModelVersionID)
+	}
+	return nil
+}
+
+// DeleteTag deletes existing models.ModelVersionTag entity.
+func (r ModelVersionRepository) DeleteTag(ctx context.Context, tag *models.ModelVersionTag) error {
+	if err := r.db.WithContext(ctx).Delete(tag).Error; err != nil {
+		return eris.Wrapf(err, "error deleting tag for model version with id: %d", tag.ModelVersionID)
+	}
+	return nil
+}
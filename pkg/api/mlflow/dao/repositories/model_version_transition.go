@@ -0,0 +1,215 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// ModelVersionTransitionRepositoryProvider provides an interface to work with models.ModelVersionTransition
+// and models.ModelVersionTransitionRequest entities.
+type ModelVersionTransitionRepositoryProvider interface {
+	BaseRepositoryProvider
+	// Transition moves models.ModelVersion to the requested stage and records the change in the history.
+	Transition(ctx context.Context, transition *models.ModelVersionTransition, archiveExistingVersions bool) error
+	// GetByRegisteredModelID returns the history of stage transitions of the Registered Model versions.
+	GetByRegisteredModelID(
+		ctx context.Context, registeredModelID uint, version int64,
+	) ([]models.ModelVersionTransition, error)
+	// CreateRequest creates new models.ModelVersionTransitionRequest entity.
+	CreateRequest(ctx context.Context, request *models.ModelVersionTransitionRequest) error
+	// UpdateRequest updates existing models.ModelVersionTransitionRequest entity.
+	UpdateRequest(ctx context.Context, request *models.ModelVersionTransitionRequest) error
+	// GetPendingRequest returns pending models.ModelVersionTransitionRequest by Model Version ID and stage.
+	GetPendingRequest(
+		ctx context.Context, modelVersionID uint, stage models.ModelVersionStage,
+	) (*models.ModelVersionTransitionRequest, error)
+	// GetRequestsByModelVersionID returns the list of models.ModelVersionTransitionRequest by Model Version ID.
+	GetRequestsByModelVersionID(
+		ctx context.Context, modelVersionID uint,
+	) ([]models.ModelVersionTransitionRequest, error)
+}
+
+// ModelVersionTransitionRepository repository to work with models.ModelVersionTransition entity.
+type ModelVersionTransitionRepository struct {
+	BaseRepository
+}
+
+// NewModelVersionTransitionRepository creates repository to work with models.ModelVersionTransition entity.
+func NewModelVersionTransitionRepository(db *gorm.DB) *ModelVersionTransitionRepository {
+	return &ModelVersionTransitionRepository{
+		BaseRepository{
+			db: db,
+		},
+	}
+}
+
+// Transition moves models.ModelVersion to the requested stage and records the change in the history.
+// When `archiveExistingVersions` is set, other versions in the same stage are moved to `Archived` and
+// recorded in the history as well. Approved or rejected transition request is updated in the same transaction.
+func (r ModelVersionTransitionRepository) Transition(
+	ctx context.Context, transition *models.ModelVersionTransition, archiveExistingVersions bool,
+) error {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if transition.TransitionRequest != nil {
+			if err := r.updateRequest(tx, transition.TransitionRequest); err != nil {
+				return err
+			}
+			transition.TransitionRequestID = &transition.TransitionRequest.ID
+		}
+
+		transitions := []models.ModelVersionTransition{*transition}
+		if archiveExistingVersions {
+			var existing []models.ModelVersion
+			if err := tx.Where(
+				"registered_model_id = ?", transition.ModelVersion.RegisteredModelID,
+			).Where(
+				"current_stage = ?", transition.ToStage,
+			).Where(
+				"id != ?", transition.ModelVersionID,
+			).Find(&existing).Error; err != nil {
+				return eris.Wrap(err, "error getting existing model versions")
+			}
+			for _, modelVersion := range existing {
+				transitions = append(transitions, models.ModelVersionTransition{
+					FromStage:           modelVersion.CurrentStage,
+					ToStage:             models.ModelVersionStageArchived,
+					UserID:              transition.UserID,
+					Comment:             transition.Comment,
+					CreationTime:        transition.CreationTime,
+					TransitionRequestID: transition.TransitionRequestID,
+					ModelVersionID:      modelVersion.ID,
+				})
+			}
+		}
+
+		for _, t := range transitions {
+			if err := tx.Model(
+				&models.ModelVersion{ID: t.ModelVersionID},
+			).Updates(map[string]any{
+				"current_stage":     t.ToStage,
+				"last_updated_time": t.CreationTime,
+			}).Error; err != nil {
+				return eris.Wrapf(err, "error updating stage of model version with id: %d", t.ModelVersionID)
+			}
+		}
+		if err := tx.Omit("ModelVersion", "TransitionRequest").Create(&transitions).Error; err != nil {
+			return eris.Wrap(err, "error creating model version transitions")
+		}
+		transition.ID = transitions[0].ID
+		return nil
+	}); err != nil {
+		return eris.Wrapf(err, "error transitioning model version with id: %d", transition.ModelVersionID)
+	}
+	return nil
+}
+
+// GetByRegisteredModelID returns the history of stage transitions of the Registered Model versions.
+// If `version` is not zero then only transitions of this version are returned.
+func (r ModelVersionTransitionRepository) GetByRegisteredModelID(
+	ctx context.Context, registeredModelID uint, version int64,
+) ([]models.ModelVersionTransition, error) {
+	query := r.db.WithContext(ctx).Model(
+		&models.ModelVersion{},
+	).Select(
+		"id",
+	).Where(
+		"registered_model_id = ?", registeredModelID,
+	)
+	if version != 0 {
+		query = query.Where("version = ?", version)
+	}
+
+	var transitions []models.ModelVersionTransition
+	if err := r.db.WithContext(ctx).Preload(
+		"ModelVersion.RegisteredModel",
+	).Where(
+		"model_version_id IN (?)", query,
+	).Order(
+		"creation_time",
+	).Order(
+		"id",
+	).Find(&transitions).Error; err != nil {
+		return nil, eris.Wrapf(
+			err, "error getting model version transitions by registered model id: %d", registeredModelID,
+		)
+	}
+	return transitions, nil
+}
+
+// CreateRequest creates new models.ModelVersionTransitionRequest entity.
+func (r ModelVersionTransitionRepository) CreateRequest(
+	ctx context.Context, request *models.ModelVersionTransitionRequest,
+) error {
+	if err := r.db.WithContext(ctx).Omit("ModelVersion").Create(request).Error; err != nil {
+		return eris.Wrapf(err, "error creating transition request for model version with id: %d", request.ModelVersionID)
+	}
+	return nil
+}
+
+// UpdateRequest updates existing models.ModelVersionTransitionRequest entity.
+func (r ModelVersionTransitionRepository) UpdateRequest(
+	ctx context.Context, request *models.ModelVersionTransitionRequest,
+) error {
+	return r.updateRequest(r.db.WithContext(ctx), request)
+}
+
+// GetPendingRequest returns pending models.ModelVersionTransitionRequest by Model Version ID and stage.
+func (r ModelVersionTransitionRepository) GetPendingRequest(
+	ctx context.Context, modelVersionID uint, stage models.ModelVersionStage,
+) (*models.ModelVersionTransitionRequest, error) {
+	var request models.ModelVersionTransitionRequest
+	if err := r.db.WithContext(ctx).Where(
+		"model_version_id = ?", modelVersionID,
+	).Where(
+		"to_stage = ?", stage,
+	).Where(
+		"status = ?", models.ModelVersionTransitionRequestStatusPending,
+	).First(&request).Error; err != nil {
+		if eris.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(
+			err, "error getting pending transition request for model version with id: %d", modelVersionID,
+		)
+	}
+	return &request, nil
+}
+
+// GetRequestsByModelVersionID returns the list of models.ModelVersionTransitionRequest by Model Version ID.
+func (r ModelVersionTransitionRepository) GetRequestsByModelVersionID(
+	ctx context.Context, modelVersionID uint,
+) ([]models.ModelVersionTransitionRequest, error) {
+	var requests []models.ModelVersionTransitionRequest
+	if err := r.db.WithContext(ctx).Preload(
+		"ModelVersion.RegisteredModel",
+	).Where(
+		"model_version_id = ?", modelVersionID,
+	).Order(
+		"creation_time",
+	).Order(
+		"id",
+	).Find(&requests).Error; err != nil {
+		return nil, eris.Wrapf(
+			err, "error getting transition requests for model version with id: %d", modelVersionID,
+		)
+	}
+	return requests, nil
+}
+
+// updateRequest updates status and review details of models.ModelVersionTransitionRequest.
+func (r ModelVersionTransitionRepository) updateRequest(
+	tx *gorm.DB, request *models.ModelVersionTransitionRequest,
+) error {
+	if err := tx.Model(
+		request,
+	).Select(
+		"Status", "ReviewerID", "ReviewComment", "LastUpdatedTime",
+	).Updates(request).Error; err != nil {
+		return eris.Wrapf(err, "error updating transition request with id: %d", request.ID)
+	}
+	return nil
+}
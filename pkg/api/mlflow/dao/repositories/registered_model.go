@@ -0,0 +1,192 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// RegisteredModelRepositoryProvider provides an interface to work with models.RegisteredModel entity.
+type RegisteredModelRepositoryProvider interface {
+	BaseRepositoryProvider
+	// Create creates new models.RegisteredModel entity together with its tags.
+	Create(ctx context.Context, registeredModel *models.RegisteredModel) error
+	// Update updates existing models.RegisteredModel entity.
+	Update(ctx context.Context, registeredModel *models.RegisteredModel) error
+	// Delete removes existing models.RegisteredModel entity together with its versions.
+	Delete(ctx context.Context, registeredModel *models.RegisteredModel) error
+	// GetByNamespaceIDAndName returns models.RegisteredModel by Namespace ID and Registered Model name.
+	GetByNamespaceIDAndName(ctx context.Context, namespaceID uint, name string) (*models.RegisteredModel, error)
+	// Search returns models.RegisteredModel entities of the namespace, which match the condition.
+	Search(
+		ctx context.Context,
+		namespaceID uint,
+		condition clause.Expression,
+		orderBy []clause.OrderByColumn,
+		limit int,
+	) ([]models.RegisteredModel, error)
+	// SetTag creates or updates models.RegisteredModelTag entity.
+	SetTag(ctx context.Context, tag *models.RegisteredModelTag) error
+	// DeleteTag deletes existing models.RegisteredModelTag entity.
+	DeleteTag(ctx context.Context, tag *models.RegisteredModelTag) error
+	// SetAlias creates or updates models.RegisteredModelAlias entity.
+	SetAlias(ctx context.Context, alias *models.RegisteredModelAlias) error
+	// DeleteAlias deletes existing models.RegisteredModelAlias entity.
+	DeleteAlias(ctx context.Context, alias *models.RegisteredModelAlias) error
+}
+
+// RegisteredModelRepository repository to work with models.RegisteredModel entity.
+type RegisteredModelRepository struct {
+	BaseRepository
+}
+
+// NewRegisteredModelRepository creates repository to work with models.RegisteredModel entity.
+func NewRegisteredModelRepository(db *gorm.DB) *RegisteredModelRepository {
+	return &RegisteredModelRepository{
+		BaseRepository{
+			db: db,
+		},
+	}
+}
+
+// Create creates new models.RegisteredModel entity together with its tags.
+func (r RegisteredModelRepository) Create(ctx context.Context, registeredModel *models.RegisteredModel) error {
+	if err := r.db.WithContext(ctx).Create(registeredModel).Error; err != nil {
+		return eris.Wrapf(err, "error creating registered model with name: %s", registeredModel.Name)
+	}
+	return nil
+}
+
+// Update updates existing models.RegisteredModel entity.
+func (r RegisteredModelRepository) Update(ctx context.Context, registeredModel *models.RegisteredModel) error {
+	if err := r.db.WithContext(ctx).Model(
+		registeredModel,
+	).Select(
+		"Name", "Description", "LastUpdatedTime",
+	).Updates(registeredModel).Error; err != nil {
+		return eris.Wrapf(err, "error updating registered model with id: %d", registeredModel.ID)
+	}
+	return nil
+}
+
+// Delete removes existing models.RegisteredModel entity together with its versions.
+func (r RegisteredModelRepository) Delete(ctx context.Context, registeredModel *models.RegisteredModel) error {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, entity := range []any{
+			&models.ModelVersionTag{}, &models.ModelVersionTransition{}, &models.ModelVersionTransitionRequest{},
+		} {
+			if err := tx.Where(
+				"model_version_id IN (?)",
+				tx.Model(&models.ModelVersion{}).Select("id").Where("registered_model_id = ?", registeredModel.ID),
+			).Delete(entity).Error; err != nil {
+				return eris.Wrap(err, "error deleting model version dependencies")
+			}
+		}
+		for _, entity := range []any{
+			&models.ModelVersion{}, &models.RegisteredModelAlias{}, &models.RegisteredModelTag{},
+		} {
+			if err := tx.Where("registered_model_id = ?", registeredModel.ID).Delete(entity).Error; err != nil {
+				return eris.Wrap(err, "error deleting registered model dependencies")
+			}
+		}
+		return tx.Delete(registeredModel).Error
+	}); err != nil {
+		return eris.Wrapf(err, "error deleting registered model with id: %d", registeredModel.ID)
+	}
+	return nil
+}
+
+// GetByNamespaceIDAndName returns models.RegisteredModel by Namespace ID and Registered Model name.
+func (r RegisteredModelRepository) GetByNamespaceIDAndName(
+	ctx context.Context, namespaceID uint, name string,
+) (*models.RegisteredModel, error) {
+	var registeredModel models.RegisteredModel
+	if err := r.db.WithContext(ctx).Preload(
+		"Tags",
+	).Preload(
+		"Aliases",
+	).Preload(
+		"Versions",
+	).Where(
+		"registered_models.namespace_id = ?", namespaceID,
+	).Where(
+		"registered_models.name = ?", name,
+	).First(&registeredModel).Error; err != nil {
+		if eris.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(err, "error getting registered model by name: %s", name)
+	}
+	return &registeredModel, nil
+}
+
+// Search returns models.RegisteredModel entities of the namespace, which match the condition.
+func (r RegisteredModelRepository) Search(
+	ctx context.Context,
+	namespaceID uint,
+	condition clause.Expression,
+	orderBy []clause.OrderByColumn,
+	limit int,
+) ([]models.RegisteredModel, error) {
+	query := r.db.WithContext(ctx).Preload(
+		"Tags",
+	).Preload(
+		"Aliases",
+	).Preload(
+		"Versions",
+	).Where(
+		"registered_models.namespace_id = ?", namespaceID,
+	)
+	if condition != nil {
+		query.Where(condition)
+	}
+	for _, column := range orderBy {
+		query.Order(column)
+	}
+
+	var registeredModels []models.RegisteredModel
+	if err := query.Limit(limit).Find(&registeredModels).Error; err != nil {
+		return nil, eris.Wrapf(err, "error searching registered models of namespace: %d", namespaceID)
+	}
+	return registeredModels, nil
+}
+
+// SetTag creates or updates models.RegisteredModelTag entity.
+func (r RegisteredModelRepository) SetTag(ctx context.Context, tag *models.RegisteredModelTag) error {
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		UpdateAll: true,
+	}).Create(tag).Error; err != nil {
+		return eris.Wrapf(err, "error setting tag for registered model with id: %d", tag.RegisteredModelID)
+	}
+	return nil
+}
+
+// DeleteTag deletes existing models.RegisteredModelTag entity.
+func (r RegisteredModelRepository) DeleteTag(ctx context.Context, tag *models.RegisteredModelTag) error {
+	if err := r.db.WithContext(ctx).Delete(tag).Error; err != nil {
+		return eris.Wrapf(err, "error deleting tag for registered model with id: %d", tag.RegisteredModelID)
+	}
+	return nil
+}
+
+// SetAlias creates or updates models.RegisteredModelAlias entity.
+func (r RegisteredModelRepository) SetAlias(ctx context.Context, alias *models.RegisteredModelAlias) error {
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		UpdateAll: true,
+	}).Create(alias).Error; err != nil {
+		return eris.Wrapf(err, "error setting alias for registered model with id: %d", alias.RegisteredModelID)
+	}
+	return nil
+}
+
+// DeleteAlias deletes existing models.RegisteredModelAlias entity.
+func (r RegisteredModelRepository) DeleteAlias(ctx context.Context, alias *models.RegisteredModelAlias) error {
+	if err := r.db.WithContext(ctx).Delete(alias).Error; err != nil {
+		return eris.Wrapf(err, "error deleting alias for registered model with id: %d", alias.RegisteredModelID)
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/database"
 )
@@ -27,6 +28,8 @@ type RunRepositoryProvider interface {
 	GetByNamespaceIDAndRunID(
 		ctx context.Context, namespaceID uint, runID string,
 	) (*models.Run, error)
+	// GetDeletedBefore returns models.Run entities which were deleted before provided time.
+	GetDeletedBefore(ctx context.Context, deletedTime int64) ([]models.Run, error)
 	// Create creates new models.Run entity.
 	Create(ctx context.Context, run *models.Run) error
 	// Update updates existing models.Experiment entity.
@@ -47,6 +50,16 @@ type RunRepositoryProvider interface {
 	SetRunTagsBatch(ctx context.Context, run *models.Run, batchSize int, tags []models.Tag) error
 	// UpdateWithTransaction updates existing models.Run entity in scope of transaction.
 	UpdateWithTransaction(ctx context.Context, tx *gorm.DB, run *models.Run) error
+	// UpdateLastSeenTime updates the time, when models.Run entity reported any activity last time.
+	UpdateLastSeenTime(ctx context.Context, id string, lastSeenTime int64) error
+	// GetStale returns running models.Run entities, which haven't been seen since provided time.
+	GetStale(ctx context.Context, lastSeenBefore int64) ([]models.Run, error)
+	// TerminateStale updates status and end time of the stale models.Run entity and sets the tag.
+	TerminateStale(ctx context.Context, run *models.Run, lastSeenBefore int64, tag *models.Tag) (bool, error)
+	// Move moves existing models.Run entity into the experiment and artifact location set on the entity.
+	Move(ctx context.Context, run *models.Run) error
+	// Clone creates new models.Run entity as a copy of the source run with its params, tags and metrics.
+	Clone(ctx context.Context, source, clone *models.Run) error
 }
 
 // RunRepository repository to work with models.Run entity.
@@ -120,6 +133,10 @@ func (r RunRepository) GetByNamespaceIDAndRunID(
 		"Params",
 	).Preload(
 		"Tags",
+	).Preload(
+		"Inputs.Dataset",
+	).Preload(
+		"Inputs.Tags",
 	).Joins(
 		"INNER JOIN experiments ON experiments.experiment_id = runs.experiment_id AND experiments.namespace_id = ?",
 		namespaceID,
@@ -132,6 +149,26 @@ func (r RunRepository) GetByNamespaceIDAndRunID(
 	return &run, nil
 }
 
+// GetDeletedBefore returns models.Run entities which were deleted before provided time.
+// Experiment of each run is preloaded, so the caller knows the namespace of the run.
+func (r RunRepository) GetDeletedBefore(ctx context.Context, deletedTime int64) ([]models.Run, error) {
+	var runs []models.Run
+	if err := r.db.WithContext(
+		ctx,
+	).Preload(
+		"Experiment",
+	).Where(
+		"lifecycle_stage = ?", models.LifecycleStageDeleted,
+	).Where(
+		"deleted_time < ?", deletedTime,
+	).Order(
+		"row_num",
+	).Find(&runs).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting runs deleted before: %d", deletedTime)
+	}
+	return runs, nil
+}
+
 // Create creates new models.Run entity.
 func (r RunRepository) Create(ctx context.Context, run *models.Run) error {
 	// Lock need to calculate row_num
@@ -295,6 +332,176 @@ func (r RunRepository) UpdateWithTransaction(ctx context.Context, tx *gorm.DB, r
 	return nil
 }
 
+// UpdateLastSeenTime updates the time, when models.Run entity reported any activity last time.
+func (r RunRepository) UpdateLastSeenTime(ctx context.Context, id string, lastSeenTime int64) error {
+	if err := r.db.WithContext(ctx).Model(
+		&models.Run{},
+	).Where(
+		"run_uuid = ?", id,
+	).UpdateColumn(
+		"last_seen_time", lastSeenTime,
+	).Error; err != nil {
+		return eris.Wrapf(err, "error updating last seen time of run with id: %s", id)
+	}
+	return nil
+}
+
+// GetStale returns running models.Run entities, which haven't been seen since provided time.
+// Runs created before the last seen time has been tracked fall back to their start time.
+// Runs are returned together with the namespace of their experiment. It is joined rather than preloaded,
+// because preload skips the default experiment, which id is zero.
+func (r RunRepository) GetStale(ctx context.Context, lastSeenBefore int64) ([]models.Run, error) {
+	var runs []models.Run
+	if err := r.db.WithContext(
+		ctx,
+	).Joins(
+		"Experiment.Namespace",
+	).Where(
+		"runs.status = ?", models.StatusRunning,
+	).Where(
+		"runs.lifecycle_stage = ?", models.LifecycleStageActive,
+	).Where(
+		"COALESCE(runs.last_seen_time, runs.start_time) < ?", lastSeenBefore,
+	).Order(
+		"runs.row_num",
+	).Find(&runs).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting runs not seen since: %d", lastSeenBefore)
+	}
+	return runs, nil
+}
+
+// TerminateStale updates status and end time of the stale models.Run entity and sets the tag.
+// Run is updated only if it is still running and hasn't been seen since provided time, otherwise
+// nothing is changed and false is returned.
+func (r RunRepository) TerminateStale(
+	ctx context.Context, run *models.Run, lastSeenBefore int64, tag *models.Tag,
+) (bool, error) {
+	terminated := false
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(
+			&models.Run{},
+		).Where(
+			"run_uuid = ?", run.ID,
+		).Where(
+			"status = ?", models.StatusRunning,
+		).Where(
+			"COALESCE(last_seen_time, start_time) < ?", lastSeenBefore,
+		).Updates(map[string]any{
+			"status":   run.Status,
+			"end_time": run.EndTime,
+		})
+		if result.Error != nil {
+			return eris.Wrap(result.Error, "error updating run status")
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			UpdateAll: true,
+		}).Create(tag).Error; err != nil {
+			return eris.Wrap(err, "error setting run tag")
+		}
+		terminated = true
+		return nil
+	}); err != nil {
+		return false, eris.Wrapf(err, "error terminating stale run with id: %s", run.ID)
+	}
+	return terminated, nil
+}
+
+// Move moves existing models.Run entity into the experiment and artifact location set on the entity.
+// Params, tags and metrics reference the run itself, so they follow it without being copied.
+func (r RunRepository) Move(ctx context.Context, run *models.Run) error {
+	// `artifact_uri` is writable only on create for the model, so the table is updated directly.
+	if err := r.db.WithContext(ctx).Table(
+		"runs",
+	).Where(
+		"run_uuid = ?", run.ID,
+	).UpdateColumns(map[string]any{
+		"experiment_id": run.ExperimentID,
+		"artifact_uri":  run.ArtifactURI,
+	}).Error; err != nil {
+		return eris.Wrapf(err, "error moving run with id: %s", run.ID)
+	}
+	return nil
+}
+
+// Clone creates new models.Run entity as a copy of the source run with its params, tags and metrics.
+// Contexts are shared between the runs, so the copied metrics keep referencing the same contexts.
+// The clone keeps the start time of the source run, so the rows are renumbered to keep
+// `row_num` ordered by start time.
+func (r RunRepository) Clone(ctx context.Context, source, clone *models.Run) error {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if tx.Dialector.Name() == "postgres" {
+			if err := tx.Exec("LOCK TABLE runs").Error; err != nil {
+				return eris.Wrap(err, "unable to lock table")
+			}
+		}
+		if err := tx.Omit(clause.Associations).Create(clone).Error; err != nil {
+			return eris.Wrap(err, "error creating run")
+		}
+
+		if err := tx.Exec(
+			`INSERT INTO params (key, value, run_uuid)
+			 SELECT key, value, ? FROM params WHERE run_uuid = ?`,
+			clone.ID, source.ID,
+		).Error; err != nil {
+			return eris.Wrap(err, "error copying params")
+		}
+		if err := tx.Exec(
+			`INSERT INTO tags (key, value, run_uuid)
+			 SELECT key, value, ? FROM tags WHERE run_uuid = ? AND key <> ?`,
+			clone.ID, source.ID, convertors.TagKeyRunName,
+		).Error; err != nil {
+			return eris.Wrap(err, "error copying tags")
+		}
+		if err := tx.Create(&models.Tag{
+			Key:   convertors.TagKeyRunName,
+			Value: clone.Name,
+			RunID: clone.ID,
+		}).Error; err != nil {
+			return eris.Wrap(err, "error setting run name tag")
+		}
+		if err := tx.Exec(
+			`INSERT INTO metrics (key, value, timestamp, run_uuid, step, is_nan, iter, context_id, model_id)
+			 SELECT key, value, timestamp, ?, step, is_nan, iter, context_id, model_id
+			 FROM metrics WHERE run_uuid = ?`,
+			clone.ID, source.ID,
+		).Error; err != nil {
+			return eris.Wrap(err, "error copying metrics")
+		}
+		if err := tx.Exec(
+			`INSERT INTO latest_metrics (key, value, timestamp, step, is_nan, run_uuid, last_iter, context_id)
+			 SELECT key, value, timestamp, step, is_nan, ?, last_iter, context_id
+			 FROM latest_metrics WHERE run_uuid = ?`,
+			clone.ID, source.ID,
+		).Error; err != nil {
+			return eris.Wrap(err, "error copying latest metrics")
+		}
+
+		// the clone has been appended to the end, so renumber it together with the runs started later.
+		var startWith sql.NullInt64
+		if err := tx.Model(
+			&models.Run{},
+		).Select(
+			"MIN(row_num)",
+		).Where(
+			"start_time > ? AND run_uuid <> ?", clone.StartTime, clone.ID,
+		).Scan(&startWith).Error; err != nil {
+			return eris.Wrap(err, "error getting row_num of runs started after the clone")
+		}
+		if startWith.Valid {
+			if err := r.renumberRows(tx, models.RowNum(startWith.Int64)); err != nil {
+				return eris.Wrap(err, "error renumbering runs.row_num")
+			}
+		}
+		return nil
+	}); err != nil {
+		return eris.Wrapf(err, "error cloning run with id: %s", source.ID)
+	}
+	return nil
+}
+
 // SetRunTagsBatch sets Run tags in batch.
 func (r RunRepository) SetRunTagsBatch(ctx context.Context, run *models.Run, batchSize int, tags []models.Tag) error {
 	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// TextRepositoryProvider provides an interface to work with models.Text entity.
+type TextRepositoryProvider interface {
+	BaseRepositoryProvider
+	// CreateBatch creates []models.Text entities in batch.
+	CreateBatch(ctx context.Context, batchSize int, texts []models.Text) error
+}
+
+// TextRepository repository to work with models.Text entity.
+type TextRepository struct {
+	BaseRepository
+}
+
+// NewTextRepository creates repository to work with models.Text entity.
+func NewTextRepository(db *gorm.DB) *TextRepository {
+	return &TextRepository{
+		BaseRepository{
+			db: db,
+		},
+	}
+}
+
+// CreateBatch creates []models.Text entities in batch. Text, which has been already logged
+// with the same name, context, step and index, is replaced.
+func (r TextRepository) CreateBatch(ctx context.Context, batchSize int, texts []models.Text) error {
+	if len(texts) == 0 {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		contexts := make([]*models.Context, len(texts))
+		for n := range texts {
+			contexts[n] = &texts[n].Context
+		}
+		if err := createContexts(tx, batchSize, contexts); err != nil {
+			return err
+		}
+		for n := range texts {
+			texts[n].ContextID = texts[n].Context.ID
+		}
+
+		if err := tx.Omit(clause.Associations).Clauses(
+			clause.OnConflict{UpdateAll: true},
+		).CreateInBatches(&texts, batchSize).Error; err != nil {
+			return eris.Wrap(err, "error creating texts")
+		}
+		return nil
+	})
+}
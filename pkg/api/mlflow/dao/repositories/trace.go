@@ -0,0 +1,241 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// TraceRepositoryProvider provides an interface to work with models.TraceInfo entity.
+type TraceRepositoryProvider interface {
+	BaseRepositoryProvider
+	// Create creates new models.TraceInfo entity together with its tags and request metadata.
+	Create(ctx context.Context, trace *models.TraceInfo) error
+	// Update updates existing models.TraceInfo entity and upserts its tags and request metadata.
+	Update(ctx context.Context, trace *models.TraceInfo) error
+	// GetByNamespaceIDAndRequestID returns models.TraceInfo entity by Namespace ID and Request ID.
+	GetByNamespaceIDAndRequestID(ctx context.Context, namespaceID uint, requestID string) (*models.TraceInfo, error)
+	// Search returns models.TraceInfo entities of provided experiments, which match the condition.
+	Search(
+		ctx context.Context,
+		namespaceID uint,
+		experimentIDs []int32,
+		condition clause.Expression,
+		orderBy []clause.OrderByColumn,
+		limit int,
+	) ([]models.TraceInfo, error)
+	// GetForDeletion returns models.TraceInfo entities of the experiment, which could be deleted.
+	// Traces are selected either by request ids, or by max timestamp starting from the oldest ones.
+	GetForDeletion(
+		ctx context.Context,
+		namespaceID uint,
+		experimentID int32,
+		requestIDs []string,
+		maxTimestampMS int64,
+		maxTraces int,
+	) ([]models.TraceInfo, error)
+	// DeleteBatch deletes models.TraceInfo entities by Request IDs.
+	DeleteBatch(ctx context.Context, requestIDs []string) error
+	// SetTag creates or updates models.TraceTag entity.
+	SetTag(ctx context.Context, tag *models.TraceTag) error
+	// DeleteTag deletes existing models.TraceTag entity.
+	DeleteTag(ctx context.Context, tag *models.TraceTag) error
+}
+
+// TraceRepository repository to work with models.TraceInfo entity.
+type TraceRepository struct {
+	BaseRepository
+}
+
+// NewTraceRepository creates repository to work with models.TraceInfo entity.
+func NewTraceRepository(db *gorm.DB) *TraceRepository {
+	return &TraceRepository{
+		BaseRepository{
+			db: db,
+		},
+	}
+}
+
+// Create creates new models.TraceInfo entity together with its tags and request metadata.
+func (r TraceRepository) Create(ctx context.Context, trace *models.TraceInfo) error {
+	if err := r.db.WithContext(ctx).Create(trace).Error; err != nil {
+		return eris.Wrapf(err, "error creating trace with request id: %s", trace.RequestID)
+	}
+	return nil
+}
+
+// Update updates existing models.TraceInfo entity and upserts its tags and request metadata.
+func (r TraceRepository) Update(ctx context.Context, trace *models.TraceInfo) error {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(
+			trace,
+		).Omit(
+			clause.Associations,
+		).Select(
+			"timestamp_ms", "execution_time_ms", "status",
+		).Updates(trace).Error; err != nil {
+			return eris.Wrap(err, "error updating trace info")
+		}
+		if len(trace.Tags) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				UpdateAll: true,
+			}).Create(&trace.Tags).Error; err != nil {
+				return eris.Wrap(err, "error updating trace tags")
+			}
+		}
+		if len(trace.RequestMetadata) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				UpdateAll: true,
+			}).Create(&trace.RequestMetadata).Error; err != nil {
+				return eris.Wrap(err, "error updating trace request metadata")
+			}
+		}
+		return nil
+	}); err != nil {
+		return eris.Wrapf(err, "error updating trace with request id: %s", trace.RequestID)
+	}
+	return nil
+}
+
+// GetByNamespaceIDAndRequestID returns models.TraceInfo entity by Namespace ID and Request ID.
+func (r TraceRepository) GetByNamespaceIDAndRequestID(
+	ctx context.Context, namespaceID uint, requestID string,
+) (*models.TraceInfo, error) {
+	var trace models.TraceInfo
+	if err := r.db.WithContext(ctx).Preload(
+		"Tags",
+	).Preload(
+		"RequestMetadata",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = trace_info.experiment_id AND experiments.namespace_id = ?",
+		namespaceID,
+	).Where(
+		"trace_info.request_id = ?", requestID,
+	).First(&trace).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(err, "error getting trace by request id: %s", requestID)
+	}
+	return &trace, nil
+}
+
+// Search returns models.TraceInfo entities of provided experiments, which match the condition.
+func (r TraceRepository) Search(
+	ctx context.Context,
+	namespaceID uint,
+	experimentIDs []int32,
+	condition clause.Expression,
+	orderBy []clause.OrderByColumn,
+	limit int,
+) ([]models.TraceInfo, error) {
+	query := r.db.WithContext(ctx).Preload(
+		"Tags",
+	).Preload(
+		"RequestMetadata",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = trace_info.experiment_id AND experiments.namespace_id = ?",
+		namespaceID,
+	).Where(
+		"trace_info.experiment_id IN ?", experimentIDs,
+	)
+	if condition != nil {
+		query.Where(condition)
+	}
+	for _, column := range orderBy {
+		query.Order(column)
+	}
+
+	var traces []models.TraceInfo
+	if err := query.Limit(limit).Find(&traces).Error; err != nil {
+		return nil, eris.Wrapf(err, "error searching traces of experiments: %v", experimentIDs)
+	}
+	return traces, nil
+}
+
+// GetForDeletion returns models.TraceInfo entities of the experiment, which could be deleted.
+// Traces are selected either by request ids, or by max timestamp starting from the oldest ones.
+func (r TraceRepository) GetForDeletion(
+	ctx context.Context,
+	namespaceID uint,
+	experimentID int32,
+	requestIDs []string,
+	maxTimestampMS int64,
+	maxTraces int,
+) ([]models.TraceInfo, error) {
+	query := r.db.WithContext(ctx).Preload(
+		"Tags",
+	).Joins(
+		"INNER JOIN experiments ON experiments.experiment_id = trace_info.experiment_id AND experiments.namespace_id = ?",
+		namespaceID,
+	).Where(
+		"trace_info.experiment_id = ?", experimentID,
+	)
+	if len(requestIDs) > 0 {
+		query.Where("trace_info.request_id IN ?", requestIDs)
+	} else {
+		query.Where(
+			"trace_info.timestamp_ms <= ?", maxTimestampMS,
+		).Order(
+			"trace_info.timestamp_ms",
+		).Order(
+			"trace_info.request_id",
+		)
+		if maxTraces > 0 {
+			query.Limit(maxTraces)
+		}
+	}
+
+	var traces []models.TraceInfo
+	if err := query.Find(&traces).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting traces of experiment with id: %d", experimentID)
+	}
+	return traces, nil
+}
+
+// DeleteBatch deletes models.TraceInfo entities by Request IDs.
+func (r TraceRepository) DeleteBatch(ctx context.Context, requestIDs []string) error {
+	if len(requestIDs) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("request_id IN ?", requestIDs).Delete(&models.TraceTag{}).Error; err != nil {
+			return eris.Wrap(err, "error deleting trace tags")
+		}
+		if err := tx.Where("request_id IN ?", requestIDs).Delete(&models.TraceRequestMetadata{}).Error; err != nil {
+			return eris.Wrap(err, "error deleting trace request metadata")
+		}
+		if err := tx.Where("request_id IN ?", requestIDs).Delete(&models.TraceInfo{}).Error; err != nil {
+			return eris.Wrap(err, "error deleting trace info")
+		}
+		return nil
+	}); err != nil {
+		return eris.Wrapf(err, "error deleting traces with request ids: %v", requestIDs)
+	}
+	return nil
+}
+
+// SetTag creates or updates models.TraceTag entity.
+func (r TraceRepository) SetTag(ctx context.Context, tag *models.TraceTag) error {
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		UpdateAll: true,
+	}).Create(tag).Error; err != nil {
+		return eris.Wrapf(err, "error setting tag '%s' of trace with request id: %s", tag.Key, tag.RequestID)
+	}
+	return nil
+}
+
+// DeleteTag deletes existing models.TraceTag entity.
+func (r TraceRepository) DeleteTag(ctx context.Context, tag *models.TraceTag) error {
+	if err := r.db.WithContext(ctx).Where(
+		"request_id = ? AND key = ?", tag.RequestID, tag.Key,
+	).Delete(&models.TraceTag{}).Error; err != nil {
+		return eris.Wrapf(err, "error deleting tag '%s' of trace with request id: %s", tag.Key, tag.RequestID)
+	}
+	return nil
+}
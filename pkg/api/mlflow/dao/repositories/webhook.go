@@ -0,0 +1,196 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// WebhookRepositoryProvider provides an interface to work with models.Webhook entity.
+type WebhookRepositoryProvider interface {
+	BaseRepositoryProvider
+	// Create creates new models.Webhook entity.
+	Create(ctx context.Context, webhook *models.Webhook) error
+	// Update updates existing models.Webhook entity.
+	Update(ctx context.Context, webhook *models.Webhook) error
+	// Delete deletes existing models.Webhook entity together with its deliveries.
+	Delete(ctx context.Context, webhook *models.Webhook) error
+	// GetByNamespaceIDAndID returns models.Webhook entity by Namespace ID and its ID.
+	GetByNamespaceIDAndID(ctx context.Context, namespaceID, id uint) (*models.Webhook, error)
+	// ListByNamespaceID returns all models.Webhook entities of the namespace.
+	ListByNamespaceID(ctx context.Context, namespaceID uint) ([]models.Webhook, error)
+	// CreateDelivery creates new models.WebhookDelivery entity.
+	CreateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	// UpdateDelivery updates existing models.WebhookDelivery entity.
+	UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	// ListDeliveries returns the latest models.WebhookDelivery entities of the webhook.
+	ListDeliveries(ctx context.Context, webhookID uint, limit int) ([]models.WebhookDelivery, error)
+	// GetPendingDeliveries returns pending models.WebhookDelivery entities, which are due at provided time,
+	// the earliest due first.
+	GetPendingDeliveries(ctx context.Context, now time.Time, limit int) ([]models.WebhookDelivery, error)
+	// ClaimDelivery postpones the next attempt of pending models.WebhookDelivery entity till provided time,
+	// unless it has been already claimed by another worker. It returns whether the delivery has been claimed.
+	ClaimDelivery(ctx context.Context, delivery *models.WebhookDelivery, until time.Time) (bool, error)
+}
+
+// WebhookRepository repository to work with models.Webhook entity.
+type WebhookRepository struct {
+	BaseRepository
+}
+
+// NewWebhookRepository creates repository to work with models.Webhook entity.
+func NewWebhookRepository(db *gorm.DB) *WebhookRepository {
+	return &WebhookRepository{
+		BaseRepository{
+			db: db,
+		},
+	}
+}
+
+// Create creates new models.Webhook entity.
+func (r WebhookRepository) Create(ctx context.Context, webhook *models.Webhook) error {
+	if err := r.db.WithContext(ctx).Create(webhook).Error; err != nil {
+		return eris.Wrap(err, "error creating webhook entity")
+	}
+	return nil
+}
+
+// Update updates existing models.Webhook entity.
+func (r WebhookRepository) Update(ctx context.Context, webhook *models.Webhook) error {
+	if err := r.db.WithContext(ctx).Model(
+		webhook,
+	).Select(
+		"url", "secret", "events", "description", "active", "updated_at",
+	).Updates(webhook).Error; err != nil {
+		return eris.Wrapf(err, "error updating webhook with id: %d", webhook.ID)
+	}
+	return nil
+}
+
+// Delete deletes existing models.Webhook entity together with its deliveries.
+func (r WebhookRepository) Delete(ctx context.Context, webhook *models.Webhook) error {
+	if err := r.db.WithContext(ctx).Delete(webhook).Error; err != nil {
+		return eris.Wrapf(err, "error deleting webhook with id: %d", webhook.ID)
+	}
+	return nil
+}
+
+// GetByNamespaceIDAndID returns models.Webhook entity by Namespace ID and its ID.
+func (r WebhookRepository) GetByNamespaceIDAndID(
+	ctx context.Context, namespaceID, id uint,
+) (*models.Webhook, error) {
+	var webhook models.Webhook
+	if err := r.db.WithContext(ctx).Where(
+		"namespace_id = ?", namespaceID,
+	).First(&webhook, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, eris.Wrapf(err, "error getting webhook by id: %d", id)
+	}
+	return &webhook, nil
+}
+
+// ListByNamespaceID returns all models.Webhook entities of the namespace.
+func (r WebhookRepository) ListByNamespaceID(ctx context.Context, namespaceID uint) ([]models.Webhook, error) {
+	var webhooks []models.Webhook
+	if err := r.db.WithContext(ctx).Where(
+		"namespace_id = ?", namespaceID,
+	).Order(
+		"id",
+	).Find(&webhooks).Error; err != nil {
+		return nil, eris.Wrapf(err, "error listing webhooks of namespace: %d", namespaceID)
+	}
+	return webhooks, nil
+}
+
+// CreateDelivery creates new models.WebhookDelivery entity.
+func (r WebhookRepository) CreateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	if err := r.db.WithContext(ctx).Omit(clause.Associations).Create(delivery).Error; err != nil {
+		return eris.Wrapf(err, "error creating delivery of webhook with id: %d", delivery.WebhookID)
+	}
+	return nil
+}
+
+// UpdateDelivery updates existing models.WebhookDelivery entity.
+func (r WebhookRepository) UpdateDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	if err := r.db.WithContext(ctx).Model(
+		delivery,
+	).Select(
+		"status", "attempts", "response_code", "error", "next_attempt_at", "updated_at",
+	).Updates(delivery).Error; err != nil {
+		return eris.Wrapf(err, "error updating webhook delivery with id: %s", delivery.ID)
+	}
+	return nil
+}
+
+// ListDeliveries returns the latest models.WebhookDelivery entities of the webhook.
+func (r WebhookRepository) ListDeliveries(
+	ctx context.Context, webhookID uint, limit int,
+) ([]models.WebhookDelivery, error) {
+	var deliveries []models.WebhookDelivery
+	if err := r.db.WithContext(ctx).Where(
+		"webhook_id = ?", webhookID,
+	).Order(
+		"created_at DESC",
+	).Order(
+		"id",
+	).Limit(
+		limit,
+	).Find(&deliveries).Error; err != nil {
+		return nil, eris.Wrapf(err, "error listing deliveries of webhook with id: %d", webhookID)
+	}
+	return deliveries, nil
+}
+
+// GetPendingDeliveries returns pending models.WebhookDelivery entities, which are due at provided time,
+// the earliest due first, together with their webhooks.
+func (r WebhookRepository) GetPendingDeliveries(
+	ctx context.Context, now time.Time, limit int,
+) ([]models.WebhookDelivery, error) {
+	var deliveries []models.WebhookDelivery
+	if err := r.db.WithContext(ctx).Preload(
+		"Webhook",
+	).Where(
+		"status = ? AND next_attempt_at <= ?", models.WebhookDeliveryStatusPending, now,
+	).Order(
+		"next_attempt_at",
+	).Order(
+		"id",
+	).Limit(
+		limit,
+	).Find(&deliveries).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting pending webhook deliveries")
+	}
+	return deliveries, nil
+}
+
+// ClaimDelivery postpones the next attempt of pending models.WebhookDelivery entity till provided time,
+// unless it has been already claimed by another worker. The delivery is only updated, when its next
+// attempt time is still the same as it was read, so exactly one of the concurrent workers claims it.
+func (r WebhookRepository) ClaimDelivery(
+	ctx context.Context, delivery *models.WebhookDelivery, until time.Time,
+) (bool, error) {
+	result := r.db.WithContext(ctx).Model(
+		&models.WebhookDelivery{},
+	).Where(
+		"id = ? AND status = ? AND next_attempt_at = ?",
+		delivery.ID, models.WebhookDeliveryStatusPending, delivery.NextAttemptAt,
+	).UpdateColumn(
+		"next_attempt_at", until,
+	)
+	if result.Error != nil {
+		return false, eris.Wrapf(result.Error, "error claiming webhook delivery with id: %s", delivery.ID)
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	delivery.NextAttemptAt = until
+	return true, nil
+}
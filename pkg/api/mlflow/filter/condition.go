@@ -0,0 +1,28 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// BuildCondition builds SQL condition, which compares the column with the value using the comparison operator.
+// Value is ignored by `IS NULL` and `IS NOT NULL`, and has to be a slice for `IN` and `NOT IN` operators.
+// ILIKE isn't supported by sqlite, so it is emulated by LIKE of the lower-cased column and value.
+func BuildCondition(dialector, column, operator string, value any) clause.Expression {
+	switch operator {
+	case IsNullOperator, IsNotNullOperator:
+		return clause.Expr{SQL: fmt.Sprintf("%s %s", column, operator)}
+	case ILikeOperator:
+		if dialector == database.SQLiteDialectorName {
+			return clause.Expr{
+				SQL:  fmt.Sprintf("LOWER(%s) LIKE ?", column),
+				Vars: []any{strings.ToLower(fmt.Sprint(value))},
+			}
+		}
+	}
+	return clause.Expr{SQL: fmt.Sprintf("%s %s ?", column, operator), Vars: []any{value}}
+}
@@ -0,0 +1,37 @@
+package filter
+
+import (
+	"fmt"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+)
+
+// SyntaxError represents error of the filter parsing. Position is 1-based position
+// of the character in the filter, where the problem has been found.
+type SyntaxError struct {
+	Filter   string
+	Position int
+	Reason   string
+}
+
+// newSyntaxError creates new SyntaxError for the provided byte offset of the filter.
+func newSyntaxError(filter string, offset int, reason string, args ...any) *SyntaxError {
+	return &SyntaxError{
+		Filter:   filter,
+		Position: offset + 1,
+		Reason:   fmt.Sprintf(reason, args...),
+	}
+}
+
+// Error implements error interface.
+func (e SyntaxError) Error() string {
+	return fmt.Sprintf("malformed filter '%s': %s at position %d", e.Filter, e.Reason, e.Position)
+}
+
+// NewSemanticError creates INVALID_PARAMETER_VALUE error for the filter, which is well-formed, but can't be
+// compiled into the query, e.g. because of unknown attribute. Position is 1-based position of the problem.
+func NewSemanticError(filter string, position int, reason string, args ...any) *api.ErrorResponse {
+	return api.NewInvalidParameterValueError(
+		"invalid filter '%s': %s at position %d", filter, fmt.Sprintf(reason, args...), position,
+	)
+}
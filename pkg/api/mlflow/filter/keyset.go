@@ -0,0 +1,72 @@
+package filter
+
+import (
+	"fmt"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// SortKey represents single column of the search ordering together with
+// its value in the last row of the previous page.
+type SortKey struct {
+	Column string
+	Desc   bool
+	Value  any
+}
+
+// BuildKeysetCondition builds condition, which selects rows placed strictly after the row with provided
+// values of the sort columns. The last key has to be unique, e.g. primary key, to make the order total.
+// NULLs are the largest values on postgres and the smallest ones on sqlite, as in the ORDER BY clause.
+func BuildKeysetCondition(dialector string, keys []SortKey) clause.Expression {
+	var (
+		equal      []clause.Expression
+		conditions []clause.Expression
+	)
+	for _, key := range keys {
+		// the row is after the key row, when all the previous columns are equal and the current one is after.
+		if after := buildAfterCondition(dialector, key); after != nil {
+			operands := make([]clause.Expression, 0, len(equal)+1)
+			operands = append(operands, equal...)
+			conditions = append(conditions, clause.And(append(operands, after)...))
+		}
+		if key.Value == nil {
+			equal = append(equal, clause.Expr{SQL: fmt.Sprintf("%s IS NULL", key.Column)})
+		} else {
+			equal = append(equal, clause.Expr{SQL: fmt.Sprintf("%s = ?", key.Column), Vars: []any{key.Value}})
+		}
+	}
+	switch len(conditions) {
+	case 0:
+		// nothing could be placed after the row.
+		return clause.Expr{SQL: "1 = 0"}
+	case 1:
+		// gorm joins single OR condition with the previous ones of the query using OR.
+		return conditions[0]
+	default:
+		return clause.Or(conditions...)
+	}
+}
+
+// buildAfterCondition builds condition, which selects values of the single column placed after the key value.
+// Result is nil, when there are no such values.
+func buildAfterCondition(dialector string, key SortKey) clause.Expression {
+	nullsAtEnd := (dialector == database.PostgresDialectorName) != key.Desc
+	if key.Value == nil {
+		if nullsAtEnd {
+			return nil
+		}
+		return clause.Expr{SQL: fmt.Sprintf("%s IS NOT NULL", key.Column)}
+	}
+
+	operator := ">"
+	if key.Desc {
+		operator = "<"
+	}
+	after := clause.Expr{SQL: fmt.Sprintf("%s %s ?", key.Column, operator), Vars: []any{key.Value}}
+	if nullsAtEnd {
+		return clause.Or(after, clause.Expr{SQL: fmt.Sprintf("%s IS NULL", key.Column)})
+	}
+	return after
+}
@@ -0,0 +1,94 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+func TestBuildKeysetCondition_Ok(t *testing.T) {
+	mockedDB, _, err := sqlmock.New()
+	require.Nil(t, err)
+	db, err := gorm.Open(postgres.New(postgres.Config{
+		Conn:       mockedDB,
+		DriverName: "postgres",
+	}), &gorm.Config{DryRun: true})
+	require.Nil(t, err)
+
+	testData := []struct {
+		name         string
+		dialector    string
+		keys         []SortKey
+		expectedSQL  string
+		expectedVars []any
+	}{
+		{
+			name:      "PostgresWithValues",
+			dialector: database.PostgresDialectorName,
+			keys: []SortKey{
+				{Column: "start_time", Desc: true, Value: 10},
+				{Column: "run_uuid", Value: "id"},
+			},
+			expectedSQL: `SELECT * FROM "runs" WHERE (start_time < $1 OR ` +
+				`(start_time = $2 AND (run_uuid > $3 OR run_uuid IS NULL)))`,
+			expectedVars: []any{10, 10, "id"},
+		},
+		{
+			name:      "SqliteWithValues",
+			dialector: database.SQLiteDialectorName,
+			keys: []SortKey{
+				{Column: "start_time", Desc: true, Value: 10},
+				{Column: "run_uuid", Value: "id"},
+			},
+			expectedSQL: `SELECT * FROM "runs" WHERE ((start_time < $1 OR start_time IS NULL) OR ` +
+				`(start_time = $2 AND run_uuid > $3))`,
+			expectedVars: []any{10, 10, "id"},
+		},
+		{
+			name:      "PostgresWithNullValue",
+			dialector: database.PostgresDialectorName,
+			keys: []SortKey{
+				{Column: "start_time", Value: nil},
+				{Column: "run_uuid", Value: "id"},
+			},
+			expectedSQL: `SELECT * FROM "runs" WHERE (start_time IS NULL AND ` +
+				`(run_uuid > $1 OR run_uuid IS NULL))`,
+			expectedVars: []any{"id"},
+		},
+		{
+			name:      "SqliteWithNullValue",
+			dialector: database.SQLiteDialectorName,
+			keys: []SortKey{
+				{Column: "start_time", Value: nil},
+				{Column: "run_uuid", Value: "id"},
+			},
+			expectedSQL:  `SELECT * FROM "runs" WHERE (start_time IS NOT NULL OR (start_time IS NULL AND run_uuid > $1))`,
+			expectedVars: []any{"id"},
+		},
+		{
+			name:      "SqliteWithNullValueDesc",
+			dialector: database.SQLiteDialectorName,
+			keys: []SortKey{
+				{Column: "start_time", Desc: true, Value: nil},
+				{Column: "run_uuid", Value: "id"},
+			},
+			expectedSQL:  `SELECT * FROM "runs" WHERE (start_time IS NULL AND run_uuid > $1)`,
+			expectedVars: []any{"id"},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := db.Table("runs").Where(BuildKeysetCondition(tt.dialector, tt.keys)).Find(&[]map[string]any{})
+			require.Nil(t, tx.Error)
+			assert.Equal(t, tt.expectedSQL, tx.Statement.SQL.String())
+			assert.Equal(t, tt.expectedVars, tx.Statement.Vars)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package filter
+
+import (
+	"strings"
+)
+
+// tokenKind represents kind of the filter token.
+type tokenKind int
+
+// Supported list of token kinds.
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+)
+
+// token represents single lexical token of the filter.
+type token struct {
+	kind tokenKind
+	// raw is the token exactly as it is written in the filter.
+	raw string
+	// text is the token without surrounding quotes.
+	text string
+	// quote is the quote character of the string token.
+	quote byte
+	// pos is the byte offset of the token in the filter.
+	pos int
+}
+
+// is checks that token is the provided keyword. Keywords are case-insensitive.
+func (t token) is(keyword string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.raw, keyword)
+}
+
+// describe returns description of the token used in the error messages.
+func (t token) describe() string {
+	if t.kind == tokenEOF {
+		return "end of filter"
+	}
+	if t.kind == tokenString {
+		return "string " + t.raw
+	}
+	return "'" + t.raw + "'"
+}
+
+// tokenize splits the filter into the list of tokens. The last token is always tokenEOF.
+func tokenize(filter string) ([]token, error) {
+	var tokens []token
+	for pos := 0; pos < len(filter); {
+		c := filter[pos]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			pos++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLeftParen, raw: "(", text: "(", pos: pos})
+			pos++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRightParen, raw: ")", text: ")", pos: pos})
+			pos++
+		case c == ',':
+			tokens = append(tokens, token{kind: tokenComma, raw: ",", text: ",", pos: pos})
+			pos++
+		case c == '\'' || c == '"' || c == '`':
+			end := strings.IndexByte(filter[pos+1:], c)
+			if end == -1 {
+				return nil, newSyntaxError(filter, pos, "unterminated quoted string")
+			}
+			end += pos + 2
+			tokens = append(tokens, token{
+				kind:  tokenString,
+				raw:   filter[pos:end],
+				text:  filter[pos+1 : end-1],
+				quote: c,
+				pos:   pos,
+			})
+			pos = end
+		case c == '=' || c == '!' || c == '<' || c == '>':
+			end := pos + 1
+			if end < len(filter) && (filter[end] == '=' || (c == '<' && filter[end] == '>')) {
+				end++
+			}
+			operator := filter[pos:end]
+			if operator == "!" {
+				return nil, newSyntaxError(filter, pos, "unexpected character '!'")
+			}
+			tokens = append(tokens, token{kind: tokenOperator, raw: operator, text: operator, pos: pos})
+			pos = end
+		case isWordCharacter(c) || (c == '-' && pos+1 < len(filter) && isWordCharacter(filter[pos+1])):
+			end := pos + 1
+			for end < len(filter) && isWordCharacter(filter[end]) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenWord, raw: filter[pos:end], text: filter[pos:end], pos: pos})
+			pos = end
+		default:
+			return nil, newSyntaxError(filter, pos, "unexpected character '%c'", c)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(filter)}), nil
+}
+
+// isWordCharacter checks that character could be a part of identifier or bare literal.
+func isWordCharacter(c byte) bool {
+	return c == '_' || c == '.' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
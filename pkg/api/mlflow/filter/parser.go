@@ -0,0 +1,315 @@
+// Package filter implements parser of the MLflow search filters, like
+// `metrics.accuracy > 0.9 AND (params.model IN ('cnn', 'rnn') OR tags.stage IS NULL)`.
+// Filter is parsed into the expression tree, which is compiled into the SQL by the services.
+package filter
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Supported list of logical operators.
+const (
+	AndOperator = "AND"
+	OrOperator  = "OR"
+)
+
+// Supported list of comparison operators. Operators are normalized by the parser,
+// so `<>` is reported as `!=` and keywords are always upper case.
+const (
+	EqualOperator          = "="
+	NotEqualOperator       = "!="
+	LessOperator           = "<"
+	LessOrEqualOperator    = "<="
+	GreaterOperator        = ">"
+	GreaterOrEqualOperator = ">="
+	LikeOperator           = "LIKE"
+	ILikeOperator          = "ILIKE"
+	InOperator             = "IN"
+	NotInOperator          = "NOT IN"
+	IsNullOperator         = "IS NULL"
+	IsNotNullOperator      = "IS NOT NULL"
+)
+
+// Expression represents node of the filter expression tree.
+// It is either *LogicalExpression or *Comparison.
+type Expression interface {
+	// Position returns 1-based position of the expression in the filter.
+	Position() int
+}
+
+// LogicalExpression represents conjunction or disjunction of two or more expressions.
+type LogicalExpression struct {
+	Operator string
+	Operands []Expression
+}
+
+// Position returns 1-based position of the expression in the filter.
+func (e LogicalExpression) Position() int {
+	return e.Operands[0].Position()
+}
+
+// Comparison represents single condition of the filter, e.g. `params.model = 'cnn'`.
+type Comparison struct {
+	// Entity is the part of identifier before the first dot, e.g. `params`. It is empty for `start_time`.
+	Entity string
+	// Key is the rest of identifier without quotes, e.g. `model` or `mlflow.runName`.
+	Key      string
+	Operator string
+	// Value is the right side of the binary comparison. It is nil for `IN` and `IS NULL` comparisons.
+	Value *Value
+	// Values is the list of `IN` and `NOT IN` comparisons.
+	Values []Value
+	pos    int
+}
+
+// Position returns 1-based position of the expression in the filter.
+func (c Comparison) Position() int {
+	return c.pos + 1
+}
+
+// Value represents literal value of the comparison.
+type Value struct {
+	// Raw is the value exactly as it is written in the filter, e.g. `'cnn'` or `0.9`.
+	Raw string
+	// Text is the value without quotes.
+	Text string
+	// Quoted is true for the single- or double-quoted string values.
+	Quoted bool
+	pos    int
+}
+
+// Position returns 1-based position of the value in the filter.
+func (v Value) Position() int {
+	return v.pos + 1
+}
+
+// Identifier splits unquoted value like `metrics.loss` into the entity and the key, so the value
+// could be used as reference to another column. Numbers, e.g. `0.9`, aren't identifiers.
+func (v Value) Identifier() (string, string, bool) {
+	if v.Quoted {
+		return "", "", false
+	}
+	entity, key, found := strings.Cut(v.Text, ".")
+	if !found || entity == "" || key == "" || !unicode.IsLetter(rune(entity[0])) {
+		return "", "", false
+	}
+	return entity, key, true
+}
+
+// parser holds state of the filter parsing.
+type parser struct {
+	filter string
+	tokens []token
+	pos    int
+}
+
+// Parse parses the filter into the expression tree. Empty filter results in nil expression.
+func Parse(filter string) (Expression, error) {
+	tokens, err := tokenize(filter)
+	if err != nil {
+		return nil, err
+	}
+	p := parser{filter: filter, tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, nil
+	}
+	expression, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if next := p.peek(); next.kind != tokenEOF {
+		return nil, p.errorf(next, "unexpected %s, expected AND, OR or end of filter", next.describe())
+	}
+	return expression, nil
+}
+
+// parseOr parses `and_expression (OR and_expression)*`.
+func (p *parser) parseOr() (Expression, error) {
+	return p.parseLogical(OrOperator, p.parseAnd)
+}
+
+// parseAnd parses `primary_expression (AND primary_expression)*`.
+func (p *parser) parseAnd() (Expression, error) {
+	return p.parseLogical(AndOperator, p.parsePrimary)
+}
+
+// parseLogical parses the chain of operands joined by the provided logical operator.
+func (p *parser) parseLogical(operator string, parseOperand func() (Expression, error)) (Expression, error) {
+	operand, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	operands := []Expression{operand}
+	for p.peek().is(operator) {
+		p.next()
+		operand, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return &LogicalExpression{Operator: operator, Operands: operands}, nil
+}
+
+// parsePrimary parses either the parenthesized expression or the single comparison.
+func (p *parser) parsePrimary() (Expression, error) {
+	if p.peek().kind != tokenLeftParen {
+		return p.parseComparison()
+	}
+	p.next()
+	expression, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if next := p.next(); next.kind != tokenRightParen {
+		return nil, p.errorf(next, "unexpected %s, expected ')'", next.describe())
+	}
+	return expression, nil
+}
+
+// parseComparison parses `identifier operator value`, `identifier [NOT] IN (value, ...)`
+// or `identifier IS [NOT] NULL`.
+func (p *parser) parseComparison() (Expression, error) {
+	comparison, err := p.parseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	next := p.next()
+	switch {
+	case next.kind == tokenOperator:
+		comparison.Operator = next.text
+		if comparison.Operator == "<>" {
+			comparison.Operator = NotEqualOperator
+		}
+	case next.is(LikeOperator), next.is(ILikeOperator):
+		comparison.Operator = strings.ToUpper(next.text)
+	case next.is(InOperator):
+		comparison.Operator = InOperator
+		return p.parseValues(comparison)
+	case next.is("NOT"):
+		if in := p.next(); !in.is(InOperator) {
+			return nil, p.errorf(in, "unexpected %s, expected IN", in.describe())
+		}
+		comparison.Operator = NotInOperator
+		return p.parseValues(comparison)
+	case next.is("IS"):
+		comparison.Operator = IsNullOperator
+		if p.peek().is("NOT") {
+			p.next()
+			comparison.Operator = IsNotNullOperator
+		}
+		if null := p.next(); !null.is("NULL") {
+			return nil, p.errorf(null, "unexpected %s, expected NULL", null.describe())
+		}
+		return comparison, nil
+	default:
+		return nil, p.errorf(next, "unexpected %s, expected comparison operator", next.describe())
+	}
+
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	comparison.Value = value
+	return comparison, nil
+}
+
+// parseIdentifier parses left side of the comparison. Identifier is either `key`, `entity.key`,
+// or the same with the quoted key, e.g. `tags."my key"` or "`my key`".
+func (p *parser) parseIdentifier() (*Comparison, error) {
+	start := p.next()
+	comparison := Comparison{pos: start.pos}
+	switch {
+	case start.kind == tokenString && start.quote != '\'':
+		comparison.Key = start.text
+	case start.kind == tokenWord && !isKeyword(start):
+		entity, key, found := strings.Cut(start.text, ".")
+		if !found {
+			comparison.Key = start.text
+			break
+		}
+		comparison.Entity = entity
+		comparison.Key = key
+		if key != "" {
+			break
+		}
+		// quoted key has to follow the entity without any space, e.g. `tags."my key"`.
+		quoted := p.peek()
+		if quoted.kind != tokenString || quoted.quote == '\'' || quoted.pos != start.pos+len(start.raw) {
+			return nil, p.errorf(quoted, "unexpected %s, expected quoted key", quoted.describe())
+		}
+		p.next()
+		comparison.Key = quoted.text
+	default:
+		return nil, p.errorf(start, "unexpected %s, expected identifier", start.describe())
+	}
+	return &comparison, nil
+}
+
+// parseValues parses list of values `(value, ...)` of `IN` and `NOT IN` comparisons.
+func (p *parser) parseValues(comparison *Comparison) (Expression, error) {
+	if next := p.next(); next.kind != tokenLeftParen {
+		return nil, p.errorf(next, "unexpected %s, expected '('", next.describe())
+	}
+	for {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		comparison.Values = append(comparison.Values, *value)
+		next := p.next()
+		if next.kind == tokenRightParen {
+			return comparison, nil
+		}
+		if next.kind != tokenComma {
+			return nil, p.errorf(next, "unexpected %s, expected ',' or ')'", next.describe())
+		}
+	}
+}
+
+// parseValue parses literal value, which is either quoted string or bare word, like `0.9` or `RUNNING`.
+func (p *parser) parseValue() (*Value, error) {
+	next := p.next()
+	switch {
+	case next.kind == tokenString && next.quote != '`':
+		return &Value{Raw: next.raw, Text: next.text, Quoted: true, pos: next.pos}, nil
+	case next.kind == tokenWord:
+		return &Value{Raw: next.raw, Text: next.text, pos: next.pos}, nil
+	default:
+		return nil, p.errorf(next, "unexpected %s, expected value", next.describe())
+	}
+}
+
+// peek returns the current token without consuming it.
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// next consumes and returns the current token. tokenEOF is never consumed.
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+// errorf creates SyntaxError pointing to the provided token.
+func (p *parser) errorf(t token, reason string, args ...any) error {
+	return newSyntaxError(p.filter, t.pos, reason, args...)
+}
+
+// isKeyword checks that the word token is one of the reserved keywords, which can't be used as identifier.
+func isKeyword(t token) bool {
+	for _, keyword := range []string{"AND", "OR", "NOT", "IN", "IS", "NULL", "LIKE", "ILIKE"} {
+		if t.is(keyword) {
+			return true
+		}
+	}
+	return false
+}
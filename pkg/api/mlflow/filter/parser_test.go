@@ -0,0 +1,262 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Ok(t *testing.T) {
+	testData := []struct {
+		name       string
+		filter     string
+		expression Expression
+	}{
+		{
+			name:       "EmptyFilter",
+			filter:     "  ",
+			expression: nil,
+		},
+		{
+			name:   "AttributeWithoutEntity",
+			filter: "start_time >= 1000",
+			expression: &Comparison{
+				Key:      "start_time",
+				Operator: GreaterOrEqualOperator,
+				Value:    &Value{Raw: "1000", Text: "1000", pos: 14},
+			},
+		},
+		{
+			name:   "QuotedKeysAndValues",
+			filter: "tags.\"my key\" != 'a b' and params.`lr` <> \"0.1\"",
+			expression: &LogicalExpression{
+				Operator: AndOperator,
+				Operands: []Expression{
+					&Comparison{
+						Entity:   "tags",
+						Key:      "my key",
+						Operator: NotEqualOperator,
+						Value:    &Value{Raw: "'a b'", Text: "a b", Quoted: true, pos: 17},
+					},
+					&Comparison{
+						Entity:   "params",
+						Key:      "lr",
+						Operator: NotEqualOperator,
+						Value:    &Value{Raw: `"0.1"`, Text: "0.1", Quoted: true, pos: 42},
+						pos:      27,
+					},
+				},
+			},
+		},
+		{
+			name:   "KeyWithDots",
+			filter: "metrics.loss.val < -0.5",
+			expression: &Comparison{
+				Entity:   "metrics",
+				Key:      "loss.val",
+				Operator: LessOperator,
+				Value:    &Value{Raw: "-0.5", Text: "-0.5", pos: 19},
+			},
+		},
+		{
+			name:   "OrHasLowerPrecedenceThanAnd",
+			filter: "a = 1 OR b = 2 AND c = 3",
+			expression: &LogicalExpression{
+				Operator: OrOperator,
+				Operands: []Expression{
+					&Comparison{Key: "a", Operator: EqualOperator, Value: &Value{Raw: "1", Text: "1", pos: 4}},
+					&LogicalExpression{
+						Operator: AndOperator,
+						Operands: []Expression{
+							&Comparison{
+								Key: "b", Operator: EqualOperator, Value: &Value{Raw: "2", Text: "2", pos: 13}, pos: 9,
+							},
+							&Comparison{
+								Key: "c", Operator: EqualOperator, Value: &Value{Raw: "3", Text: "3", pos: 23}, pos: 19,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "Parentheses",
+			filter: "(a = 1 OR b = 2) AND c = 3",
+			expression: &LogicalExpression{
+				Operator: AndOperator,
+				Operands: []Expression{
+					&LogicalExpression{
+						Operator: OrOperator,
+						Operands: []Expression{
+							&Comparison{
+								Key: "a", Operator: EqualOperator, Value: &Value{Raw: "1", Text: "1", pos: 5}, pos: 1,
+							},
+							&Comparison{
+								Key: "b", Operator: EqualOperator, Value: &Value{Raw: "2", Text: "2", pos: 14}, pos: 10,
+							},
+						},
+					},
+					&Comparison{
+						Key: "c", Operator: EqualOperator, Value: &Value{Raw: "3", Text: "3", pos: 25}, pos: 21,
+					},
+				},
+			},
+		},
+		{
+			name:   "NotInAndIlike",
+			filter: "params.model not in ('cnn', 'rnn') AND tags.stage ilike '%prod%'",
+			expression: &LogicalExpression{
+				Operator: AndOperator,
+				Operands: []Expression{
+					&Comparison{
+						Entity:   "params",
+						Key:      "model",
+						Operator: NotInOperator,
+						Values: []Value{
+							{Raw: "'cnn'", Text: "cnn", Quoted: true, pos: 21},
+							{Raw: "'rnn'", Text: "rnn", Quoted: true, pos: 28},
+						},
+					},
+					&Comparison{
+						Entity:   "tags",
+						Key:      "stage",
+						Operator: ILikeOperator,
+						Value:    &Value{Raw: "'%prod%'", Text: "%prod%", Quoted: true, pos: 56},
+						pos:      39,
+					},
+				},
+			},
+		},
+		{
+			name:   "IsNullAndIsNotNull",
+			filter: "tags.stage IS NULL OR params.lr is not null",
+			expression: &LogicalExpression{
+				Operator: OrOperator,
+				Operands: []Expression{
+					&Comparison{Entity: "tags", Key: "stage", Operator: IsNullOperator},
+					&Comparison{Entity: "params", Key: "lr", Operator: IsNotNullOperator, pos: 22},
+				},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			expression, err := Parse(tt.filter)
+			require.Nil(t, err)
+			assert.Equal(t, tt.expression, expression)
+		})
+	}
+}
+
+func TestParse_Error(t *testing.T) {
+	testData := []struct {
+		name   string
+		filter string
+		error  string
+	}{
+		{
+			name:   "MissingOperator",
+			filter: "invalid_filter",
+			error: "malformed filter 'invalid_filter': " +
+				"unexpected end of filter, expected comparison operator at position 15",
+		},
+		{
+			name:   "MissingValue",
+			filter: "params.lr =",
+			error:  "malformed filter 'params.lr =': unexpected end of filter, expected value at position 12",
+		},
+		{
+			name:   "UnterminatedString",
+			filter: "tags.a = 'value",
+			error:  "malformed filter 'tags.a = 'value': unterminated quoted string at position 10",
+		},
+		{
+			name:   "UnexpectedCharacter",
+			filter: "tags.a = 1 & tags.b = 2",
+			error:  "malformed filter 'tags.a = 1 & tags.b = 2': unexpected character '&' at position 12",
+		},
+		{
+			name:   "UnbalancedParentheses",
+			filter: "(tags.a = 1 OR tags.b = 2",
+			error: "malformed filter '(tags.a = 1 OR tags.b = 2': " +
+				"unexpected end of filter, expected ')' at position 26",
+		},
+		{
+			name:   "MissingLogicalOperator",
+			filter: "tags.a = 1 tags.b = 2",
+			error: "malformed filter 'tags.a = 1 tags.b = 2': " +
+				"unexpected 'tags.b', expected AND, OR or end of filter at position 12",
+		},
+		{
+			name:   "InWithoutList",
+			filter: "tags.a IN 'b'",
+			error:  "malformed filter 'tags.a IN 'b'': unexpected string 'b', expected '(' at position 11",
+		},
+		{
+			name:   "IsWithoutNull",
+			filter: "tags.a IS 'b'",
+			error:  "malformed filter 'tags.a IS 'b'': unexpected string 'b', expected NULL at position 11",
+		},
+		{
+			name:   "KeywordAsIdentifier",
+			filter: "AND = 1",
+			error:  "malformed filter 'AND = 1': unexpected 'AND', expected identifier at position 1",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			expression, err := Parse(tt.filter)
+			assert.Nil(t, expression)
+			assert.EqualError(t, err, tt.error)
+		})
+	}
+}
+
+func TestValue_Identifier(t *testing.T) {
+	testData := []struct {
+		name   string
+		value  Value
+		entity string
+		key    string
+		ok     bool
+	}{
+		{
+			name:   "MetricReference",
+			value:  Value{Raw: "metrics.loss", Text: "metrics.loss"},
+			entity: "metrics",
+			key:    "loss",
+			ok:     true,
+		},
+		{
+			name:   "DottedKey",
+			value:  Value{Raw: "metric.train.loss", Text: "metric.train.loss"},
+			entity: "metric",
+			key:    "train.loss",
+			ok:     true,
+		},
+		{
+			name:  "Number",
+			value: Value{Raw: "0.9", Text: "0.9"},
+		},
+		{
+			name:  "QuotedString",
+			value: Value{Raw: "'metrics.loss'", Text: "metrics.loss", Quoted: true},
+		},
+		{
+			name:  "WordWithoutEntity",
+			value: Value{Raw: "RUNNING", Text: "RUNNING"},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			entity, key, ok := tt.value.Identifier()
+			assert.Equal(t, tt.entity, entity)
+			assert.Equal(t, tt.key, key)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
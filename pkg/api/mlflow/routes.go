@@ -9,16 +9,48 @@ import (
 
 // List of route prefixes.
 const (
-	RunsRoutePrefix        = "/runs"
-	MetricsRoutePrefix     = "/metrics"
-	ArtifactsRoutePrefix   = "/artifacts"
-	ExperimentsRoutePrefix = "/experiments"
+	RunsRoutePrefix               = "/runs"
+	MetricsRoutePrefix            = "/metrics"
+	ArtifactsRoutePrefix          = "/artifacts"
+	ExperimentsRoutePrefix        = "/experiments"
+	ModelVersionsRoutePrefix      = "/model-versions"
+	RegisteredModelsRoutePrefix   = "/registered-models"
+	TransitionRequestsRoutePrefix = "/transition-requests"
+	TracesRoutePrefix             = "/traces"
+	LoggedModelsRoutePrefix       = "/logged-models"
+	AlertRulesRoutePrefix         = "/alert-rules"
+	AlertsRoutePrefix             = "/alerts"
+)
+
+// List of `/mlflow-artifacts/*` routes.
+const (
+	ProxiedArtifactsRoute        = "/artifacts"
+	ProxiedArtifactsPathRoute    = "/artifacts/*"
+	CreateMultipartUploadRoute   = "/mpu/create/*"
+	CompleteMultipartUploadRoute = "/mpu/complete/*"
+	AbortMultipartUploadRoute    = "/mpu/abort/*"
+	PresignedDownloadRoute       = "/presigned-download/*"
 )
 
 // List of `/artifact/*` routes.
 const (
-	ArtifactsGetRoute  = "/get"
-	ArtifactsListRoute = "/list"
+	ArtifactsGetRoute      = "/get"
+	ArtifactsListRoute     = "/list"
+	ArtifactsDownloadRoute = "/download"
+)
+
+// List of `/alert-rules/*` routes.
+const (
+	AlertRulesGetRoute    = "/get"
+	AlertRulesListRoute   = "/list"
+	AlertRulesCreateRoute = "/create"
+	AlertRulesDeleteRoute = "/delete"
+	AlertRulesUpdateRoute = "/update"
+)
+
+// List of `/alerts/*` routes.
+const (
+	AlertsListRoute = "/list"
 )
 
 // List of `/experiments/*` routes.
@@ -32,34 +64,107 @@ const (
 	ExperimentsUpdateRoute      = "/update"
 	ExperimentsGetByNameRoute   = "/get-by-name"
 	ExperimentsSetExperimentTag = "/set-experiment-tag"
+	ExperimentsSearchDatasets   = "/search-datasets"
+)
+
+// List of `/model-versions/*` routes.
+const (
+	ModelVersionsGetRoute               = "/get"
+	ModelVersionsCreateRoute            = "/create"
+	ModelVersionsDeleteRoute            = "/delete"
+	ModelVersionsSearchRoute            = "/search"
+	ModelVersionsUpdateRoute            = "/update"
+	ModelVersionsSetTagRoute            = "/set-tag"
+	ModelVersionsDeleteTagRoute         = "/delete-tag"
+	ModelVersionsGetDownloadURIRoute    = "/get-download-uri"
+	ModelVersionsTransitionStageRoute   = "/transition-stage"
+	ModelVersionsTransitionHistoryRoute = "/transition-history"
+)
+
+// List of `/registered-models/*` routes.
+const (
+	RegisteredModelsGetRoute               = "/get"
+	RegisteredModelsAliasRoute             = "/alias"
+	RegisteredModelsCreateRoute            = "/create"
+	RegisteredModelsDeleteRoute            = "/delete"
+	RegisteredModelsRenameRoute            = "/rename"
+	RegisteredModelsSearchRoute            = "/search"
+	RegisteredModelsSetTagRoute            = "/set-tag"
+	RegisteredModelsUpdateRoute            = "/update"
+	RegisteredModelsDeleteTagRoute         = "/delete-tag"
+	RegisteredModelsGetLatestVersionsRoute = "/get-latest-versions"
+)
+
+// List of `/transition-requests/*` routes.
+const (
+	TransitionRequestsListRoute   = "/list"
+	TransitionRequestsCreateRoute = "/create"
 )
 
 // List of `/metrics/*` routes.
 const (
-	MetricsGetHistoriesRoute   = "/get-histories"
-	MetricsGetHistoryRoute     = "/get-history"
-	MetricsGetHistoryBulkRoute = "/get-history-bulk"
+	MetricsGetHistoriesRoute           = "/get-histories"
+	MetricsGetHistoryRoute             = "/get-history"
+	MetricsGetHistoryBulkRoute         = "/get-history-bulk"
+	MetricsGetHistoryBulkIntervalRoute = "/get-history-bulk-interval"
+	MetricsDeleteRoute                 = "/delete"
+	MetricsTruncateRoute               = "/truncate"
 )
 
 // List of `/runs/*` routes.
 const (
-	RunsGetRoute          = "/get"
-	RunsCreateRoute       = "/create"
-	RunsDeleteRoute       = "/delete"
-	RunsSearchRoute       = "/search"
-	RunsSetTagRoute       = "/set-tag"
-	RunsUpdateRoute       = "/update"
-	RunsRestoreRoute      = "/restore"
-	RunsDeleteTagRoute    = "/delete-tag"
-	RunsLogBatchRoute     = "/log-batch"
-	RunsLogMetricRoute    = "/log-metric"
-	RunsLogParameterRoute = "/log-parameter"
+	RunsGetRoute              = "/get"
+	RunsMoveRoute             = "/move"
+	RunsCloneRoute            = "/clone"
+	RunsCreateRoute           = "/create"
+	RunsDeleteRoute           = "/delete"
+	RunsSearchRoute           = "/search"
+	RunsSetTagRoute           = "/set-tag"
+	RunsUpdateRoute           = "/update"
+	RunsRestoreRoute          = "/restore"
+	RunsDeleteTagRoute        = "/delete-tag"
+	RunsLogBatchRoute         = "/log-batch"
+	RunsLogInputsRoute        = "/log-inputs"
+	RunsLogImagesRoute        = "/log-images"
+	RunsLogTextsRoute         = "/log-texts"
+	RunsLogDistributionsRoute = "/log-distributions"
+	RunsLogMetricRoute        = "/log-metric"
+	RunsLogParameterRoute     = "/log-parameter"
+	RunsHeartbeatRoute        = "/heartbeat"
+)
+
+// List of `/traces/*` routes.
+const (
+	TracesStartRoute        = "/"
+	TracesSearchRoute       = "/"
+	TracesEndRoute          = "/:request_id"
+	TracesGetInfoRoute      = "/:request_id/info"
+	TracesTagsRoute         = "/:request_id/tags"
+	TracesDeleteTracesRoute = "/delete-traces"
+)
+
+// List of `/logged-models/*` routes.
+const (
+	LoggedModelsCreateRoute    = "/"
+	LoggedModelsGetRoute       = "/:model_id"
+	LoggedModelsFinalizeRoute  = "/:model_id"
+	LoggedModelsDeleteRoute    = "/:model_id"
+	LoggedModelsTagsRoute      = "/:model_id/tags"
+	LoggedModelsDeleteTagRoute = "/:model_id/tags/:tag_key"
+	LoggedModelsParamsRoute    = "/:model_id/params"
+	LoggedModelsSearchRoute    = "/search"
+)
+
+// List of other `/mlflow/*` routes.
+const (
+	GetTraceArtifactRoute = "/get-trace-artifact"
 )
 
 // Router represents `mlflow` router.
 type Router struct {
-	prefixList []string
-	controller *controller.Controller
+	prefixList          []string
+	artifactsPrefixList []string
+	controller          *controller.Controller
 }
 
 // NewRouter creates new instance of `mlflow` router.
@@ -69,18 +174,50 @@ func NewRouter(controller *controller.Controller) *Router {
 			"/api/2.0/mlflow/",
 			"/ajax-api/2.0/mlflow/",
 		},
+		artifactsPrefixList: []string{
+			"/api/2.0/mlflow-artifacts/",
+			"/ajax-api/2.0/mlflow-artifacts/",
+		},
 		controller: controller,
 	}
 }
 
 // Init makes initialization of all `mlflow` routes.
 func (r Router) Init(server fiber.Router) {
+	// `mlflow-artifacts` routes have to be registered first, otherwise they are caught by `mlflow` routes.
+	for _, prefix := range r.artifactsPrefixList {
+		artifactsGroup := server.Group(prefix)
+		artifactsGroup.Get(ProxiedArtifactsRoute, r.controller.ListProxiedArtifacts)
+		artifactsGroup.Get(ProxiedArtifactsPathRoute, r.controller.DownloadProxiedArtifact)
+		artifactsGroup.Put(ProxiedArtifactsPathRoute, r.controller.UploadProxiedArtifact)
+		artifactsGroup.Delete(ProxiedArtifactsPathRoute, r.controller.DeleteProxiedArtifact)
+		artifactsGroup.Post(CreateMultipartUploadRoute, r.controller.CreateMultipartUpload)
+		artifactsGroup.Post(CompleteMultipartUploadRoute, r.controller.CompleteMultipartUpload)
+		artifactsGroup.Post(AbortMultipartUploadRoute, r.controller.AbortMultipartUpload)
+		artifactsGroup.Get(PresignedDownloadRoute, r.controller.GetPresignedDownloadURL)
+
+		artifactsGroup.Use(func(c *fiber.Ctx) error {
+			return api.NewEndpointNotFound("Not found")
+		})
+	}
+
 	for _, prefix := range r.prefixList {
 		mainGroup := server.Group(prefix)
 
+		alertRules := mainGroup.Group(AlertRulesRoutePrefix)
+		alertRules.Post(AlertRulesCreateRoute, r.controller.CreateAlertRule)
+		alertRules.Post(AlertRulesDeleteRoute, r.controller.DeleteAlertRule)
+		alertRules.Get(AlertRulesGetRoute, r.controller.GetAlertRule)
+		alertRules.Get(AlertRulesListRoute, r.controller.ListAlertRules)
+		alertRules.Post(AlertRulesUpdateRoute, r.controller.UpdateAlertRule)
+
+		alerts := mainGroup.Group(AlertsRoutePrefix)
+		alerts.Get(AlertsListRoute, r.controller.ListAlerts)
+
 		artifacts := mainGroup.Group(ArtifactsRoutePrefix)
 		artifacts.Get(ArtifactsGetRoute, r.controller.GetArtifact)
 		artifacts.Get(ArtifactsListRoute, r.controller.ListArtifacts)
+		artifacts.Get(ArtifactsDownloadRoute, r.controller.DownloadArtifacts)
 
 		experiments := mainGroup.Group(ExperimentsRoutePrefix)
 		experiments.Post(ExperimentsCreateRoute, r.controller.CreateExperiment)
@@ -91,29 +228,89 @@ func (r Router) Init(server fiber.Router) {
 		experiments.Post(ExperimentsRestoreRoute, r.controller.RestoreExperiment)
 		experiments.Get(ExperimentsSearchRoute, r.controller.SearchExperiments)
 		experiments.Post(ExperimentsSearchRoute, r.controller.SearchExperiments)
+		experiments.Post(ExperimentsSearchDatasets, r.controller.SearchDatasets)
 		experiments.Post(ExperimentsSetExperimentTag, r.controller.SetExperimentTag)
 		experiments.Post(ExperimentsUpdateRoute, r.controller.UpdateExperiment)
 
 		metrics := mainGroup.Group(MetricsRoutePrefix)
 		metrics.Get(MetricsGetHistoryRoute, r.controller.GetMetricHistory)
 		metrics.Get(MetricsGetHistoryBulkRoute, r.controller.GetMetricHistoryBulk)
+		metrics.Get(MetricsGetHistoryBulkIntervalRoute, r.controller.GetMetricHistoryBulkInterval)
 		metrics.Post(MetricsGetHistoriesRoute, r.controller.GetMetricHistories)
+		metrics.Post(MetricsDeleteRoute, r.controller.DeleteMetric)
+		metrics.Post(MetricsTruncateRoute, r.controller.TruncateMetrics)
 
 		runs := mainGroup.Group(RunsRoutePrefix)
+		runs.Post(RunsCloneRoute, r.controller.CloneRun)
 		runs.Post(RunsCreateRoute, r.controller.CreateRun)
 		runs.Post(RunsDeleteRoute, r.controller.DeleteRun)
 		runs.Post(RunsDeleteTagRoute, r.controller.DeleteRunTag)
 		runs.Get(RunsGetRoute, r.controller.GetRun)
+		runs.Post(RunsHeartbeatRoute, r.controller.RunHeartbeat)
 		runs.Post(RunsLogBatchRoute, r.controller.LogBatch)
+		runs.Post(RunsLogInputsRoute, r.controller.LogInputs)
+		runs.Post(RunsLogImagesRoute, r.controller.LogImages)
+		runs.Post(RunsLogTextsRoute, r.controller.LogTexts)
+		runs.Post(RunsLogDistributionsRoute, r.controller.LogDistributions)
 		runs.Post(RunsLogMetricRoute, r.controller.LogMetric)
 		runs.Post(RunsLogParameterRoute, r.controller.LogParam)
+		runs.Post(RunsMoveRoute, r.controller.MoveRun)
 		runs.Post(RunsRestoreRoute, r.controller.RestoreRun)
 		runs.Post(RunsSearchRoute, r.controller.SearchRuns)
 		runs.Post(RunsSetTagRoute, r.controller.SetRunTag)
 		runs.Post(RunsUpdateRoute, r.controller.UpdateRun)
 
-		mainGroup.Get("/model-versions/search", r.controller.SearchModelVersions)
-		mainGroup.Get("/registered-models/search", r.controller.SearchRegisteredModels)
+		modelVersions := mainGroup.Group(ModelVersionsRoutePrefix)
+		modelVersions.Post(ModelVersionsCreateRoute, r.controller.CreateModelVersion)
+		modelVersions.Delete(ModelVersionsDeleteRoute, r.controller.DeleteModelVersion)
+		modelVersions.Delete(ModelVersionsDeleteTagRoute, r.controller.DeleteModelVersionTag)
+		modelVersions.Get(ModelVersionsGetRoute, r.controller.GetModelVersion)
+		modelVersions.Get(ModelVersionsGetDownloadURIRoute, r.controller.GetModelVersionDownloadURI)
+		modelVersions.Get(ModelVersionsSearchRoute, r.controller.SearchModelVersions)
+		modelVersions.Post(ModelVersionsSetTagRoute, r.controller.SetModelVersionTag)
+		modelVersions.Get(ModelVersionsTransitionHistoryRoute, r.controller.GetModelVersionTransitionHistory)
+		modelVersions.Post(ModelVersionsTransitionStageRoute, r.controller.TransitionModelVersionStage)
+		modelVersions.Patch(ModelVersionsUpdateRoute, r.controller.UpdateModelVersion)
+
+		registeredModels := mainGroup.Group(RegisteredModelsRoutePrefix)
+		registeredModels.Delete(RegisteredModelsAliasRoute, r.controller.DeleteRegisteredModelAlias)
+		registeredModels.Get(RegisteredModelsAliasRoute, r.controller.GetModelVersionByAlias)
+		registeredModels.Post(RegisteredModelsAliasRoute, r.controller.SetRegisteredModelAlias)
+		registeredModels.Post(RegisteredModelsCreateRoute, r.controller.CreateRegisteredModel)
+		registeredModels.Delete(RegisteredModelsDeleteRoute, r.controller.DeleteRegisteredModel)
+		registeredModels.Delete(RegisteredModelsDeleteTagRoute, r.controller.DeleteRegisteredModelTag)
+		registeredModels.Get(RegisteredModelsGetRoute, r.controller.GetRegisteredModel)
+		registeredModels.Get(RegisteredModelsGetLatestVersionsRoute, r.controller.GetLatestVersions)
+		registeredModels.Post(RegisteredModelsGetLatestVersionsRoute, r.controller.GetLatestVersions)
+		registeredModels.Post(RegisteredModelsRenameRoute, r.controller.RenameRegisteredModel)
+		registeredModels.Get(RegisteredModelsSearchRoute, r.controller.SearchRegisteredModels)
+		registeredModels.Post(RegisteredModelsSetTagRoute, r.controller.SetRegisteredModelTag)
+		registeredModels.Patch(RegisteredModelsUpdateRoute, r.controller.UpdateRegisteredModel)
+
+		transitionRequests := mainGroup.Group(TransitionRequestsRoutePrefix)
+		transitionRequests.Post(TransitionRequestsCreateRoute, r.controller.CreateTransitionRequest)
+		transitionRequests.Get(TransitionRequestsListRoute, r.controller.ListTransitionRequests)
+
+		traces := mainGroup.Group(TracesRoutePrefix)
+		traces.Post(TracesDeleteTracesRoute, r.controller.DeleteTraces)
+		traces.Post(TracesStartRoute, r.controller.StartTrace)
+		traces.Get(TracesSearchRoute, r.controller.SearchTraces)
+		traces.Patch(TracesEndRoute, r.controller.EndTrace)
+		traces.Get(TracesGetInfoRoute, r.controller.GetTraceInfo)
+		traces.Patch(TracesTagsRoute, r.controller.SetTraceTag)
+		traces.Delete(TracesTagsRoute, r.controller.DeleteTraceTag)
+
+		mainGroup.Get(GetTraceArtifactRoute, r.controller.GetTraceArtifact)
+
+		loggedModels := mainGroup.Group(LoggedModelsRoutePrefix)
+		loggedModels.Post(LoggedModelsSearchRoute, r.controller.SearchLoggedModels)
+		loggedModels.Post(LoggedModelsCreateRoute, r.controller.CreateLoggedModel)
+		loggedModels.Get(LoggedModelsGetRoute, r.controller.GetLoggedModel)
+		loggedModels.Patch(LoggedModelsFinalizeRoute, r.controller.FinalizeLoggedModel)
+		loggedModels.Delete(LoggedModelsDeleteRoute, r.controller.DeleteLoggedModel)
+		loggedModels.Patch(LoggedModelsTagsRoute, r.controller.SetLoggedModelTags)
+		loggedModels.Delete(LoggedModelsDeleteTagRoute, r.controller.DeleteLoggedModelTag)
+		loggedModels.Post(LoggedModelsParamsRoute, r.controller.LogLoggedModelParams)
 
 		mainGroup.Use(func(c *fiber.Ctx) error {
 			return api.NewEndpointNotFound("Not found")
@@ -0,0 +1,77 @@
+package alert
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+// EvaluatorProvider provides an interface to evaluate alert rules against logged metrics.
+type EvaluatorProvider interface {
+	// Evaluate fires the alerts of the rules, which match just updated latest metrics of the run.
+	Evaluate(ctx context.Context, run *models.Run, latestMetrics []models.LatestMetric) ([]models.Alert, error)
+}
+
+// Evaluator evaluates the alert rules, which can be checked as soon as metrics are logged.
+type Evaluator struct {
+	alertRepository repositories.AlertRepositoryProvider
+}
+
+// NewEvaluator creates new Evaluator instance.
+func NewEvaluator(alertRepository repositories.AlertRepositoryProvider) *Evaluator {
+	return &Evaluator{
+		alertRepository: alertRepository,
+	}
+}
+
+// Evaluate fires the alerts of the active rules of the run experiment, which match just updated
+// latest metrics of the run. Rules, which expect the metric to be logged, never match and are checked by Notifier.
+func (e Evaluator) Evaluate(
+	ctx context.Context, run *models.Run, latestMetrics []models.LatestMetric,
+) ([]models.Alert, error) {
+	if len(latestMetrics) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(latestMetrics))
+	for _, metric := range latestMetrics {
+		keys = append(keys, metric.Key)
+	}
+	rules, err := e.alertRepository.GetActiveRulesByExperimentIDAndKeys(ctx, run.ExperimentID, keys)
+	if err != nil {
+		return nil, eris.Wrapf(err, "error getting alert rules of experiment '%d'", run.ExperimentID)
+	}
+
+	var alerts []models.Alert
+	now := time.Now().UTC().UnixMilli()
+	for _, rule := range rules {
+		for _, metric := range latestMetrics {
+			if rule.Matches(&metric) {
+				alerts = append(alerts, models.Alert{
+					ID:           uuid.New().String(),
+					AlertRuleID:  rule.ID,
+					RunID:        run.ID,
+					Key:          metric.Key,
+					Value:        metric.Value,
+					IsNan:        metric.IsNan,
+					Step:         metric.Step,
+					Timestamp:    metric.Timestamp,
+					Message:      rule.Describe(),
+					CreationTime: now,
+				})
+				// the rule fires only once per run, so other contexts of the metric don't matter.
+				break
+			}
+		}
+	}
+
+	if err := e.alertRepository.CreateAlerts(ctx, alerts); err != nil {
+		return nil, eris.Wrap(err, "error creating alerts")
+	}
+	return alerts, nil
+}
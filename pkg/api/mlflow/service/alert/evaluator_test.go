@@ -0,0 +1,71 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+func TestEvaluator_Evaluate_Ok(t *testing.T) {
+	// init repository mocks.
+	alertRepository := repositories.MockAlertRepositoryProvider{}
+	alertRepository.On(
+		"GetActiveRulesByExperimentIDAndKeys", context.TODO(), int32(2), []string{"loss", "loss", "accuracy"},
+	).Return([]models.AlertRule{
+		{ID: 1, Key: "loss", Condition: models.AlertRuleConditionGreaterThan, Threshold: 1, Active: true},
+		{ID: 2, Key: "loss", Condition: models.AlertRuleConditionNotLogged, WindowSeconds: 60, Active: true},
+		{ID: 3, Key: "accuracy", Condition: models.AlertRuleConditionIsNan, Active: true},
+	}, nil)
+	alertRepository.On(
+		"CreateAlerts", context.TODO(), mock.MatchedBy(func(alerts []models.Alert) bool {
+			return len(alerts) == 1 && alerts[0].AlertRuleID == 1
+		}),
+	).Return(nil)
+
+	// call service under testing.
+	alerts, err := NewEvaluator(&alertRepository).Evaluate(
+		context.TODO(),
+		&models.Run{ID: "run", ExperimentID: 2},
+		[]models.LatestMetric{
+			{RunID: "run", Key: "loss", Value: 0.5, Step: 1, ContextID: 1},
+			{RunID: "run", Key: "loss", Value: 1.5, Step: 2, ContextID: 2},
+			{RunID: "run", Key: "accuracy", Value: 0.9, Step: 2},
+		},
+	)
+
+	// compare results.
+	require.Nil(t, err)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, "run", alerts[0].RunID)
+	assert.Equal(t, "loss", alerts[0].Key)
+	assert.Equal(t, 1.5, alerts[0].Value)
+	assert.Equal(t, int64(2), alerts[0].Step)
+	assert.Equal(t, "metric 'loss' > 1", alerts[0].Message)
+	alertRepository.AssertExpectations(t)
+}
+
+func TestEvaluator_Evaluate_Error(t *testing.T) {
+	// init repository mocks.
+	alertRepository := repositories.MockAlertRepositoryProvider{}
+	alertRepository.On(
+		"GetActiveRulesByExperimentIDAndKeys", context.TODO(), int32(2), []string{"loss"},
+	).Return(nil, errors.New("database error"))
+
+	// call service under testing.
+	alerts, err := NewEvaluator(&alertRepository).Evaluate(
+		context.TODO(),
+		&models.Run{ID: "run", ExperimentID: 2},
+		[]models.LatestMetric{{RunID: "run", Key: "loss", Value: 0.5}},
+	)
+
+	// compare results.
+	assert.Nil(t, alerts)
+	assert.ErrorContains(t, err, "error getting alert rules of experiment '2': database error")
+}
@@ -0,0 +1,187 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/version"
+)
+
+const (
+	// deliveryBatchSize is the maximum number of alerts, which are delivered during one check.
+	deliveryBatchSize = 100
+	// deliveryTimeout is the timeout of a single alert delivery.
+	deliveryTimeout = 10 * time.Second
+)
+
+// Payload represents the body of alert delivery request.
+type Payload struct {
+	ID            string  `json:"alert_id"`
+	AlertRuleID   uint    `json:"alert_rule_id"`
+	AlertRuleName string  `json:"alert_rule_name"`
+	ExperimentID  int32   `json:"experiment_id"`
+	RunID         string  `json:"run_id"`
+	Key           string  `json:"key"`
+	Value         float64 `json:"value"`
+	IsNan         bool    `json:"is_nan"`
+	Step          int64   `json:"step"`
+	Timestamp     int64   `json:"timestamp"`
+	Message       string  `json:"message"`
+	CreationTime  int64   `json:"creation_time"`
+}
+
+// NewPayload creates new Payload object.
+func NewPayload(alert *models.Alert) *Payload {
+	return &Payload{
+		ID:            alert.ID,
+		AlertRuleID:   alert.AlertRuleID,
+		AlertRuleName: alert.AlertRule.Name,
+		ExperimentID:  alert.AlertRule.ExperimentID,
+		RunID:         alert.RunID,
+		Key:           alert.Key,
+		Value:         alert.Value,
+		IsNan:         alert.IsNan,
+		Step:          alert.Step,
+		Timestamp:     alert.Timestamp,
+		Message:       alert.Message,
+		CreationTime:  alert.CreationTime,
+	}
+}
+
+// Notifier periodically checks the alert rules, which can't be evaluated when metrics are logged,
+// and delivers fired alerts to the configured endpoint.
+type Notifier struct {
+	config          *config.ServiceConfig
+	client          *http.Client
+	alertRepository repositories.AlertRepositoryProvider
+}
+
+// NewNotifier creates new Notifier instance.
+func NewNotifier(config *config.ServiceConfig, alertRepository repositories.AlertRepositoryProvider) *Notifier {
+	return &Notifier{
+		config: config,
+		client: &http.Client{
+			Timeout: deliveryTimeout,
+		},
+		alertRepository: alertRepository,
+	}
+}
+
+// Start periodically checks the alert rules and delivers the alerts until the context is cancelled.
+func (n Notifier) Start(ctx context.Context) {
+	ticker := time.NewTicker(n.config.AlertInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := n.Check(ctx); err != nil {
+				log.Errorf("error checking alert rules: %+v", err)
+			}
+			if n.config.AlertEndpoint == "" {
+				continue
+			}
+			if _, err := n.Deliver(ctx); err != nil {
+				log.Errorf("error delivering alerts: %+v", err)
+			}
+		}
+	}
+}
+
+// Check fires the alerts of the rules, which expect the metric to be logged at least once per window,
+// for the running runs, which haven't logged the metric within the window.
+func (n Notifier) Check(ctx context.Context) ([]models.Alert, error) {
+	rules, err := n.alertRepository.GetActiveRulesByCondition(ctx, models.AlertRuleConditionNotLogged)
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting alert rules")
+	}
+
+	var alerts []models.Alert
+	now := time.Now().UTC()
+	for _, rule := range rules {
+		since := now.Add(-time.Duration(rule.WindowSeconds) * time.Second).UnixMilli()
+		runIDs, err := n.alertRepository.GetNotLoggedRunIDs(ctx, &rule, since)
+		if err != nil {
+			return nil, eris.Wrapf(err, "error getting runs of alert rule '%d'", rule.ID)
+		}
+		for _, runID := range runIDs {
+			alerts = append(alerts, models.Alert{
+				ID:           uuid.New().String(),
+				AlertRuleID:  rule.ID,
+				RunID:        runID,
+				Key:          rule.Key,
+				Timestamp:    now.UnixMilli(),
+				Message:      rule.Describe(),
+				CreationTime: now.UnixMilli(),
+			})
+		}
+	}
+
+	if err := n.alertRepository.CreateAlerts(ctx, alerts); err != nil {
+		return nil, eris.Wrap(err, "error creating alerts")
+	}
+	return alerts, nil
+}
+
+// Deliver sends undelivered alerts to the configured endpoint, the oldest first. Delivery stops
+// at the first failure, so the remaining alerts are retried in order during the next check.
+func (n Notifier) Deliver(ctx context.Context) (int, error) {
+	alerts, err := n.alertRepository.GetUndeliveredAlerts(ctx, deliveryBatchSize)
+	if err != nil {
+		return 0, eris.Wrap(err, "error getting undelivered alerts")
+	}
+
+	for i, alert := range alerts {
+		if err := n.send(ctx, &alert); err != nil {
+			return i, eris.Wrapf(err, "error delivering alert '%s'", alert.ID)
+		}
+		alert.DeliveredTime = sql.NullInt64{Int64: time.Now().UTC().UnixMilli(), Valid: true}
+		if err := n.alertRepository.MarkAlertDelivered(ctx, &alert); err != nil {
+			return i, eris.Wrapf(err, "error marking alert '%s' as delivered", alert.ID)
+		}
+	}
+	return len(alerts), nil
+}
+
+// send posts the alert to the configured endpoint.
+func (n Notifier) send(ctx context.Context, alert *models.Alert) error {
+	body, err := json.Marshal(NewPayload(alert))
+	if err != nil {
+		return eris.Wrap(err, "error marshaling payload")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.config.AlertEndpoint, bytes.NewReader(body))
+	if err != nil {
+		return eris.Wrap(err, "error creating request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", fmt.Sprintf("FastTrackML/%s", version.Version))
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return eris.Wrap(err, "error sending request")
+	}
+	//nolint:errcheck
+	defer resp.Body.Close()
+	//nolint:errcheck
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return eris.Errorf("unexpected response status: %d", resp.StatusCode)
+	}
+	return nil
+}
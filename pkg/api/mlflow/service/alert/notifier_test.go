@@ -0,0 +1,104 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+func TestNotifier_Check_Ok(t *testing.T) {
+	// init repository mocks.
+	rule := models.AlertRule{
+		ID:            1,
+		Key:           "loss",
+		Condition:     models.AlertRuleConditionNotLogged,
+		WindowSeconds: 1800,
+		Active:        true,
+	}
+	alertRepository := repositories.MockAlertRepositoryProvider{}
+	alertRepository.On(
+		"GetActiveRulesByCondition", context.TODO(), models.AlertRuleConditionNotLogged,
+	).Return([]models.AlertRule{rule}, nil)
+	alertRepository.On(
+		"GetNotLoggedRunIDs", context.TODO(), &rule, mock.MatchedBy(func(since int64) bool {
+			return since <= time.Now().Add(-30*time.Minute).UnixMilli()
+		}),
+	).Return([]string{"run1", "run2"}, nil)
+	alertRepository.On(
+		"CreateAlerts", context.TODO(), mock.MatchedBy(func(alerts []models.Alert) bool {
+			return len(alerts) == 2 && alerts[0].RunID == "run1" && alerts[1].RunID == "run2"
+		}),
+	).Return(nil)
+
+	// call service under testing.
+	alerts, err := NewNotifier(&config.ServiceConfig{}, &alertRepository).Check(context.TODO())
+
+	// compare results.
+	require.Nil(t, err)
+	require.Len(t, alerts, 2)
+	assert.Equal(t, uint(1), alerts[0].AlertRuleID)
+	assert.Equal(t, "loss", alerts[0].Key)
+	assert.Equal(t, "metric 'loss' hasn't been logged for 1800 seconds", alerts[0].Message)
+	alertRepository.AssertExpectations(t)
+}
+
+func TestNotifier_Deliver_Ok(t *testing.T) {
+	// init receiver, which fails the second alert.
+	var payloads []Payload
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.Nil(t, err)
+		var payload Payload
+		require.Nil(t, json.Unmarshal(body, &payload))
+		payloads = append(payloads, payload)
+		if payload.ID == "2" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer receiver.Close()
+
+	// init repository mocks.
+	alertRepository := repositories.MockAlertRepositoryProvider{}
+	alertRepository.On(
+		"GetUndeliveredAlerts", context.TODO(), deliveryBatchSize,
+	).Return([]models.Alert{
+		{ID: "1", AlertRuleID: 1, AlertRule: models.AlertRule{Name: "rule", ExperimentID: 2}, IsNan: true},
+		{ID: "2", AlertRuleID: 1},
+		{ID: "3", AlertRuleID: 1},
+	}, nil)
+	alertRepository.On(
+		"MarkAlertDelivered", context.TODO(), mock.MatchedBy(func(alert *models.Alert) bool {
+			return alert.ID == "1" && alert.DeliveredTime.Valid
+		}),
+	).Return(nil)
+
+	// call service under testing.
+	delivered, err := NewNotifier(
+		&config.ServiceConfig{AlertEndpoint: receiver.URL}, &alertRepository,
+	).Deliver(context.TODO())
+
+	// compare results.
+	assert.EqualError(t, err, "error delivering alert '2': unexpected response status: 500")
+	assert.Equal(t, 1, delivered)
+	require.Len(t, payloads, 2)
+	assert.Equal(t, Payload{
+		ID:            "1",
+		AlertRuleID:   1,
+		AlertRuleName: "rule",
+		ExperimentID:  2,
+		IsNan:         true,
+	}, payloads[0])
+	alertRepository.AssertExpectations(t)
+}
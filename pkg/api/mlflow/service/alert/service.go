@@ -0,0 +1,194 @@
+package alert
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+// Service provides service layer to work with `alert` business logic.
+type Service struct {
+	alertRepository      repositories.AlertRepositoryProvider
+	experimentRepository repositories.ExperimentRepositoryProvider
+}
+
+// NewService creates new Service instance.
+func NewService(
+	alertRepository repositories.AlertRepositoryProvider,
+	experimentRepository repositories.ExperimentRepositoryProvider,
+) *Service {
+	return &Service{
+		alertRepository:      alertRepository,
+		experimentRepository: experimentRepository,
+	}
+}
+
+// CreateAlertRule creates new alert rule for the experiment.
+func (s Service) CreateAlertRule(
+	ctx context.Context, namespace *models.Namespace, req *request.CreateAlertRuleRequest,
+) (*models.AlertRule, error) {
+	if err := ValidateCreateAlertRuleRequest(req); err != nil {
+		return nil, err
+	}
+
+	experiment, err := s.getExperiment(ctx, namespace, req.ExperimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC().UnixMilli()
+	rule := models.AlertRule{
+		ExperimentID:   *experiment.ID,
+		Name:           req.Name,
+		Key:            req.Key,
+		Condition:      models.AlertRuleCondition(req.Condition),
+		Threshold:      req.Threshold,
+		MinStep:        req.MinStep,
+		WindowSeconds:  req.WindowSeconds,
+		Active:         true,
+		CreationTime:   now,
+		LastUpdateTime: now,
+	}
+	if err := s.alertRepository.CreateRule(ctx, &rule); err != nil {
+		return nil, api.NewInternalError("unable to create alert rule: %s", err)
+	}
+	return &rule, nil
+}
+
+// UpdateAlertRule updates the name of existing alert rule or (de)activates it.
+func (s Service) UpdateAlertRule(
+	ctx context.Context, namespace *models.Namespace, req *request.UpdateAlertRuleRequest,
+) (*models.AlertRule, error) {
+	if err := ValidateUpdateAlertRuleRequest(req); err != nil {
+		return nil, err
+	}
+
+	rule, err := s.getAlertRule(ctx, namespace, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != "" {
+		rule.Name = req.Name
+	}
+	if req.Active != nil {
+		rule.Active = *req.Active
+	}
+	rule.LastUpdateTime = time.Now().UTC().UnixMilli()
+	if err := s.alertRepository.UpdateRule(ctx, rule); err != nil {
+		return nil, api.NewInternalError("unable to update alert rule '%d': %s", rule.ID, err)
+	}
+	return rule, nil
+}
+
+// GetAlertRule returns existing alert rule.
+func (s Service) GetAlertRule(
+	ctx context.Context, namespace *models.Namespace, req *request.GetAlertRuleRequest,
+) (*models.AlertRule, error) {
+	if err := ValidateGetAlertRuleRequest(req); err != nil {
+		return nil, err
+	}
+	return s.getAlertRule(ctx, namespace, req.ID)
+}
+
+// DeleteAlertRule deletes existing alert rule together with its alerts.
+func (s Service) DeleteAlertRule(
+	ctx context.Context, namespace *models.Namespace, req *request.DeleteAlertRuleRequest,
+) error {
+	if err := ValidateDeleteAlertRuleRequest(req); err != nil {
+		return err
+	}
+
+	rule, err := s.getAlertRule(ctx, namespace, req.ID)
+	if err != nil {
+		return err
+	}
+	if err := s.alertRepository.DeleteRule(ctx, rule); err != nil {
+		return api.NewInternalError("unable to delete alert rule '%d': %s", rule.ID, err)
+	}
+	return nil
+}
+
+// ListAlertRules returns all alert rules of the experiment.
+func (s Service) ListAlertRules(
+	ctx context.Context, namespace *models.Namespace, req *request.ListAlertRulesRequest,
+) ([]models.AlertRule, error) {
+	if err := ValidateListAlertRulesRequest(req); err != nil {
+		return nil, err
+	}
+
+	experiment, err := s.getExperiment(ctx, namespace, req.ExperimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.alertRepository.ListRulesByExperimentID(ctx, *experiment.ID)
+	if err != nil {
+		return nil, api.NewInternalError("unable to list alert rules: %s", err)
+	}
+	return rules, nil
+}
+
+// ListAlerts returns the latest fired alerts of the experiment.
+func (s Service) ListAlerts(
+	ctx context.Context, namespace *models.Namespace, req *request.ListAlertsRequest,
+) ([]models.Alert, error) {
+	if err := ValidateListAlertsRequest(req); err != nil {
+		return nil, err
+	}
+
+	experiment, err := s.getExperiment(ctx, namespace, req.ExperimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.MaxResults
+	if limit == 0 {
+		limit = DefaultAlertsResults
+	}
+	alerts, err := s.alertRepository.ListAlerts(ctx, *experiment.ID, req.RunID, limit)
+	if err != nil {
+		return nil, api.NewInternalError("unable to list alerts: %s", err)
+	}
+	return alerts, nil
+}
+
+// getExperiment returns the experiment of the namespace by its ID.
+func (s Service) getExperiment(
+	ctx context.Context, namespace *models.Namespace, id string,
+) (*models.Experiment, error) {
+	parsedID, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		return nil, api.NewBadRequestError("unable to parse experiment id '%s': %s", id, err)
+	}
+
+	experiment, err := s.experimentRepository.GetByNamespaceIDAndExperimentID(ctx, namespace.ID, int32(parsedID))
+	if err != nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find experiment '%d': %s", parsedID, err)
+	}
+	return experiment, nil
+}
+
+// getAlertRule returns the alert rule of the namespace by its ID.
+func (s Service) getAlertRule(
+	ctx context.Context, namespace *models.Namespace, id string,
+) (*models.AlertRule, error) {
+	parsedID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return nil, api.NewBadRequestError("unable to parse alert rule id '%s': %s", id, err)
+	}
+
+	rule, err := s.alertRepository.GetRuleByNamespaceIDAndID(ctx, namespace.ID, uint(parsedID))
+	if err != nil {
+		return nil, api.NewInternalError("unable to find alert rule '%d': %s", parsedID, err)
+	}
+	if rule == nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find alert rule '%d'", parsedID)
+	}
+	return rule, nil
+}
@@ -0,0 +1,113 @@
+package alert
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+func TestService_CreateAlertRule_Ok(t *testing.T) {
+	// init repository mocks.
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+	experimentRepository.On(
+		"GetByNamespaceIDAndExperimentID", context.TODO(), uint(1), int32(2),
+	).Return(&models.Experiment{ID: common.GetPointer[int32](2)}, nil)
+
+	alertRepository := repositories.MockAlertRepositoryProvider{}
+	alertRepository.On(
+		"CreateRule", context.TODO(), mock.AnythingOfType("*models.AlertRule"),
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(&alertRepository, &experimentRepository)
+	rule, err := service.CreateAlertRule(context.TODO(), &models.Namespace{ID: 1}, &request.CreateAlertRuleRequest{
+		ExperimentID: "2",
+		Name:         "val_acc is too low",
+		Key:          "val_acc",
+		Condition:    "LESS_THAN",
+		Threshold:    0.5,
+		MinStep:      1000,
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, int32(2), rule.ExperimentID)
+	assert.Equal(t, models.AlertRuleConditionLessThan, rule.Condition)
+	assert.Equal(t, 0.5, rule.Threshold)
+	assert.Equal(t, int64(1000), rule.MinStep)
+	assert.True(t, rule.Active)
+	assert.Equal(t, rule.CreationTime, rule.LastUpdateTime)
+	alertRepository.AssertExpectations(t)
+}
+
+func TestService_UpdateAlertRule_Ok(t *testing.T) {
+	// init repository mocks.
+	alertRepository := repositories.MockAlertRepositoryProvider{}
+	alertRepository.On(
+		"GetRuleByNamespaceIDAndID", context.TODO(), uint(1), uint(3),
+	).Return(&models.AlertRule{ID: 3, Name: "rule", Active: true, LastUpdateTime: 1000}, nil)
+	alertRepository.On(
+		"UpdateRule", context.TODO(), mock.MatchedBy(func(rule *models.AlertRule) bool {
+			return rule.Name == "rule" && !rule.Active && rule.LastUpdateTime > 1000
+		}),
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(&alertRepository, &repositories.MockExperimentRepositoryProvider{})
+	_, err := service.UpdateAlertRule(context.TODO(), &models.Namespace{ID: 1}, &request.UpdateAlertRuleRequest{
+		ID:     "3",
+		Active: common.GetPointer(false),
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	alertRepository.AssertExpectations(t)
+}
+
+func TestService_GetAlertRule_Error(t *testing.T) {
+	// init repository mocks.
+	alertRepository := repositories.MockAlertRepositoryProvider{}
+	alertRepository.On(
+		"GetRuleByNamespaceIDAndID", context.TODO(), uint(1), uint(3),
+	).Return(nil, nil)
+
+	// call service under testing.
+	service := NewService(&alertRepository, &repositories.MockExperimentRepositoryProvider{})
+	_, err := service.GetAlertRule(context.TODO(), &models.Namespace{ID: 1}, &request.GetAlertRuleRequest{ID: "3"})
+
+	// compare results.
+	assert.Equal(t, api.NewResourceDoesNotExistError("unable to find alert rule '3'"), err)
+}
+
+func TestService_ListAlerts_Ok(t *testing.T) {
+	// init repository mocks.
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+	experimentRepository.On(
+		"GetByNamespaceIDAndExperimentID", context.TODO(), uint(1), int32(2),
+	).Return(&models.Experiment{ID: common.GetPointer[int32](2)}, nil)
+
+	alertRepository := repositories.MockAlertRepositoryProvider{}
+	alertRepository.On(
+		"ListAlerts", context.TODO(), int32(2), "run", DefaultAlertsResults,
+	).Return([]models.Alert{{ID: "1", RunID: "run"}}, nil)
+
+	// call service under testing.
+	service := NewService(&alertRepository, &experimentRepository)
+	alerts, err := service.ListAlerts(context.TODO(), &models.Namespace{ID: 1}, &request.ListAlertsRequest{
+		ExperimentID: "2",
+		RunID:        "run",
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, []models.Alert{{ID: "1", RunID: "run"}}, alerts)
+}
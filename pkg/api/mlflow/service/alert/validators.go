@@ -0,0 +1,85 @@
+package alert
+
+import (
+	"slices"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+const (
+	DefaultAlertsResults = 100
+	MaxAlertsResults     = 1000
+)
+
+// ValidateCreateAlertRuleRequest validates `POST /mlflow/alert-rules/create` request.
+func ValidateCreateAlertRuleRequest(req *request.CreateAlertRuleRequest) error {
+	if req.ExperimentID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'")
+	}
+	if req.Name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if req.Key == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'key'")
+	}
+	if !slices.Contains(models.AlertRuleConditions, models.AlertRuleCondition(req.Condition)) {
+		return api.NewInvalidParameterValueError("Unsupported alert rule condition '%s'", req.Condition)
+	}
+	if req.MinStep < 0 {
+		return api.NewInvalidParameterValueError("Invalid value for parameter 'min_step' supplied. It must not be negative")
+	}
+	if models.AlertRuleCondition(req.Condition) == models.AlertRuleConditionNotLogged && req.WindowSeconds <= 0 {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'window_seconds' supplied. It must be positive for '%s' condition",
+			models.AlertRuleConditionNotLogged,
+		)
+	}
+	return nil
+}
+
+// ValidateUpdateAlertRuleRequest validates `POST /mlflow/alert-rules/update` request.
+func ValidateUpdateAlertRuleRequest(req *request.UpdateAlertRuleRequest) error {
+	if req.ID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'alert_rule_id'")
+	}
+	return nil
+}
+
+// ValidateGetAlertRuleRequest validates `GET /mlflow/alert-rules/get` request.
+func ValidateGetAlertRuleRequest(req *request.GetAlertRuleRequest) error {
+	if req.ID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'alert_rule_id'")
+	}
+	return nil
+}
+
+// ValidateDeleteAlertRuleRequest validates `POST /mlflow/alert-rules/delete` request.
+func ValidateDeleteAlertRuleRequest(req *request.DeleteAlertRuleRequest) error {
+	if req.ID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'alert_rule_id'")
+	}
+	return nil
+}
+
+// ValidateListAlertRulesRequest validates `GET /mlflow/alert-rules/list` request.
+func ValidateListAlertRulesRequest(req *request.ListAlertRulesRequest) error {
+	if req.ExperimentID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'")
+	}
+	return nil
+}
+
+// ValidateListAlertsRequest validates `GET /mlflow/alerts/list` request.
+func ValidateListAlertsRequest(req *request.ListAlertsRequest) error {
+	if req.ExperimentID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'")
+	}
+	if req.MaxResults < 0 || req.MaxResults > MaxAlertsResults {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'max_results' supplied. It must be at most %d", MaxAlertsResults,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package alert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+)
+
+func TestValidateCreateAlertRuleRequest_Ok(t *testing.T) {
+	err := ValidateCreateAlertRuleRequest(&request.CreateAlertRuleRequest{
+		ExperimentID:  "1",
+		Name:          "loss is not logged",
+		Key:           "loss",
+		Condition:     "NOT_LOGGED",
+		WindowSeconds: 1800,
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateCreateAlertRuleRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.CreateAlertRuleRequest
+	}{
+		{
+			name:    "EmptyExperimentID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'"),
+			request: &request.CreateAlertRuleRequest{},
+		},
+		{
+			name:    "EmptyName",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: &request.CreateAlertRuleRequest{ExperimentID: "1"},
+		},
+		{
+			name:    "EmptyKey",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'key'"),
+			request: &request.CreateAlertRuleRequest{ExperimentID: "1", Name: "rule"},
+		},
+		{
+			name:  "UnsupportedCondition",
+			error: api.NewInvalidParameterValueError("Unsupported alert rule condition 'EQUAL'"),
+			request: &request.CreateAlertRuleRequest{
+				ExperimentID: "1", Name: "rule", Key: "loss", Condition: "EQUAL",
+			},
+		},
+		{
+			name: "NegativeMinStep",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'min_step' supplied. It must not be negative",
+			),
+			request: &request.CreateAlertRuleRequest{
+				ExperimentID: "1", Name: "rule", Key: "loss", Condition: "LESS_THAN", MinStep: -1,
+			},
+		},
+		{
+			name: "NotLoggedWithoutWindow",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'window_seconds' supplied. It must be positive for 'NOT_LOGGED' condition",
+			),
+			request: &request.CreateAlertRuleRequest{
+				ExperimentID: "1", Name: "rule", Key: "loss", Condition: "NOT_LOGGED",
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.error, ValidateCreateAlertRuleRequest(tt.request))
+		})
+	}
+}
+
+func TestValidateListAlertsRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.ListAlertsRequest
+	}{
+		{
+			name:    "EmptyExperimentID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'"),
+			request: &request.ListAlertsRequest{},
+		},
+		{
+			name: "TooManyResults",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'max_results' supplied. It must be at most 1000",
+			),
+			request: &request.ListAlertsRequest{ExperimentID: "1", MaxResults: MaxAlertsResults + 1},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.error, ValidateListAlertsRequest(tt.request))
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package artifact
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+)
+
+// Supported formats of the artifact directory archive.
+const (
+	ArchiveFormatZip   = "zip"
+	ArchiveFormatTarGz = "tar.gz"
+)
+
+// Archive represents archive of the artifact directory. Archive is built on the fly while it is written,
+// and files are read from the storage one by one, so the whole archive is never kept in memory.
+type Archive struct {
+	Name        string
+	Format      string
+	path        string
+	artifactURI string
+	objects     []storage.ArtifactObject
+	storage     storage.ArtifactStorageProvider
+}
+
+// ContentType returns content type of the archive.
+func (a Archive) ContentType() string {
+	if a.Format == ArchiveFormatTarGz {
+		return "application/gzip"
+	}
+	return "application/zip"
+}
+
+// Write writes the archive into provided writer.
+func (a Archive) Write(ctx context.Context, w io.Writer) error {
+	if a.Format == ArchiveFormatTarGz {
+		return a.writeTarGz(ctx, w)
+	}
+	return a.writeZip(ctx, w)
+}
+
+// writeZip writes files as zip archive.
+func (a Archive) writeZip(ctx context.Context, w io.Writer) error {
+	zipWriter := zip.NewWriter(w)
+	modified := time.Now()
+	for _, object := range a.objects {
+		entry, err := zipWriter.CreateHeader(&zip.FileHeader{
+			Name:     a.getEntryName(object),
+			Method:   zip.Deflate,
+			Modified: modified,
+		})
+		if err != nil {
+			return eris.Wrapf(err, "error creating zip entry for object: %s", object.Path)
+		}
+		if err := a.copyObject(ctx, entry, object); err != nil {
+			return err
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		return eris.Wrap(err, "error closing zip archive")
+	}
+	return nil
+}
+
+// writeTarGz writes files as gzipped tar archive.
+func (a Archive) writeTarGz(ctx context.Context, w io.Writer) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+	modified := time.Now()
+	for _, object := range a.objects {
+		if err := tarWriter.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     a.getEntryName(object),
+			Mode:     0o644,
+			Size:     object.Size,
+			ModTime:  modified,
+		}); err != nil {
+			return eris.Wrapf(err, "error writing tar header for object: %s", object.Path)
+		}
+		if err := a.copyObject(ctx, tarWriter, object); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return eris.Wrap(err, "error closing tar archive")
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return eris.Wrap(err, "error closing gzip stream")
+	}
+	return nil
+}
+
+// copyObject copies content of the artifact object into provided writer.
+func (a Archive) copyObject(ctx context.Context, w io.Writer, object storage.ArtifactObject) error {
+	reader, err := a.storage.Get(ctx, a.artifactURI, object.Path)
+	if err != nil {
+		return eris.Wrapf(err, "error getting artifact object: %s", object.Path)
+	}
+	//nolint:errcheck
+	defer reader.Close()
+
+	if _, err := io.Copy(w, reader); err != nil {
+		return eris.Wrapf(err, "error copying artifact object: %s", object.Path)
+	}
+	return nil
+}
+
+// getEntryName returns name of the archive entry relative to the archived directory.
+func (a Archive) getEntryName(object storage.ArtifactObject) string {
+	name, err := filepath.Rel(a.path, object.Path)
+	if err != nil || name == "." {
+		// the single file has been archived instead of the directory.
+		name = filepath.Base(object.Path)
+	}
+	return filepath.ToSlash(name)
+}
@@ -0,0 +1,65 @@
+package artifact
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+)
+
+// Content represents content of the single artifact file. Content is opened lazily,
+// so it could be read entirely or partially depending on the request.
+type Content struct {
+	Size         int64
+	path         string
+	artifactURI  string
+	errorMessage string
+	storage      storage.ArtifactStorageProvider
+}
+
+// NewContent creates new Content instance of the file under provided path.
+func NewContent(
+	ctx context.Context, artifactStorage storage.ArtifactStorageProvider, artifactURI, path, errorMessage string,
+) (*Content, error) {
+	content := Content{
+		path:         path,
+		artifactURI:  artifactURI,
+		errorMessage: errorMessage,
+		storage:      artifactStorage,
+	}
+	object, err := artifactStorage.Stat(ctx, artifactURI, path)
+	if err != nil {
+		return nil, content.convertError(err)
+	}
+	content.Size = object.Size
+	return &content, nil
+}
+
+// Open returns reader of the whole artifact content.
+func (c Content) Open(ctx context.Context) (io.ReadCloser, error) {
+	reader, err := c.storage.Get(ctx, c.artifactURI, c.path)
+	if err != nil {
+		return nil, c.convertError(err)
+	}
+	return reader, nil
+}
+
+// OpenRange returns reader of `length` bytes of the artifact content starting from `offset`.
+func (c Content) OpenRange(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	reader, err := c.storage.GetRange(ctx, c.artifactURI, c.path, offset, length)
+	if err != nil {
+		return nil, c.convertError(err)
+	}
+	return reader, nil
+}
+
+// convertError converts storage error into the API error.
+func (c Content) convertError(err error) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return api.NewResourceDoesNotExistError("%s", c.errorMessage)
+	}
+	return api.NewInternalError("%s", c.errorMessage)
+}
@@ -17,6 +17,12 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
 )
 
+// mlflowArtifactsRootURI is a root uri of the artifacts proxied through the tracking server.
+const mlflowArtifactsRootURI = storage.MlflowArtifactsStorageName + ":/"
+
+// MaxMultipartUploadParts is the maximum number of parts of the single multipart upload.
+const MaxMultipartUploadParts = 10000
+
 // Service provides service layer to work with `artifact` business logic.
 type Service struct {
 	runRepository          repositories.RunRepositoryProvider
@@ -37,27 +43,34 @@ func NewService(
 // ListArtifacts handles business logic of `GET /artifacts/list` endpoint.
 func (s Service) ListArtifacts(
 	ctx context.Context, namespace *models.Namespace, req *request.ListArtifactsRequest,
-) (string, []storage.ArtifactObject, error) {
+) (string, []storage.ArtifactObject, string, error) {
 	if err := ValidateListArtifactsRequest(req); err != nil {
-		return "", nil, err
+		return "", nil, "", err
 	}
 
 	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.GetRunID())
 	if err != nil {
-		return "", nil, api.NewInternalError("unable to find run '%s': %s", req.GetRunID(), err)
+		return "", nil, "", api.NewInternalError("unable to find run '%s': %s", req.GetRunID(), err)
 	}
 	if run == nil {
-		return "", nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
+		return "", nil, "", api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
 	}
 
 	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, run.ArtifactURI)
 	if err != nil {
-		return "", nil, api.NewInternalError("run with id '%s' has unsupported artifact storage", run.ID)
+		return "", nil, "", api.NewInternalError("run with id '%s' has unsupported artifact storage", run.ID)
 	}
 
-	artifacts, err := artifactStorage.List(ctx, run.ArtifactURI, req.Path)
+	artifacts, nextPageToken, err := artifactStorage.List(ctx, run.ArtifactURI, req.Path, storage.ListOptions{
+		PageToken:  req.PageToken,
+		MaxResults: req.MaxResults,
+		Recursive:  req.Recursive,
+	})
 	if err != nil {
-		return "", nil, api.NewInternalError("error getting artifact list from storage")
+		if errors.Is(err, storage.ErrInvalidPageToken) {
+			return "", nil, "", api.NewInvalidParameterValueError("Invalid page_token")
+		}
+		return "", nil, "", api.NewInternalError("error getting artifact list from storage")
 	}
 
 	// sort artifacts by path
@@ -65,13 +78,13 @@ func (s Service) ListArtifacts(
 		return cmp.Compare(a.Path, b.Path)
 	})
 
-	return run.ArtifactURI, artifacts, nil
+	return run.ArtifactURI, artifacts, nextPageToken, nil
 }
 
 // GetArtifact handles business logic of `GET /artifacts/get` endpoint.
 func (s Service) GetArtifact(
 	ctx context.Context, namespace *models.Namespace, req *request.GetArtifactRequest,
-) (io.ReadCloser, error) {
+) (*Content, error) {
 	if err := ValidateGetArtifactRequest(req); err != nil {
 		return nil, err
 	}
@@ -88,15 +101,289 @@ func (s Service) GetArtifact(
 		return nil, api.NewInternalError("run with id '%s' has unsupported artifact storage", run.ID)
 	}
 
-	artifactReader, err := artifactStorage.Get(
-		ctx, run.ArtifactURI, req.Path,
+	return NewContent(
+		ctx,
+		artifactStorage,
+		run.ArtifactURI,
+		req.Path,
+		fmt.Sprintf("error getting artifact object for URI: %s", filepath.Join(run.ArtifactURI, req.Path)),
+	)
+}
+
+// DownloadArtifacts handles business logic of `GET /artifacts/download` endpoint.
+func (s Service) DownloadArtifacts(
+	ctx context.Context, namespace *models.Namespace, req *request.DownloadArtifactsRequest,
+) (*Archive, error) {
+	if err := ValidateDownloadArtifactsRequest(req); err != nil {
+		return nil, err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.GetRunID())
+	if err != nil {
+		return nil, api.NewInternalError("unable to find run '%s': %s", req.GetRunID(), err)
+	}
+	if run == nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
+	}
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, run.ArtifactURI)
+	if err != nil {
+		return nil, api.NewInternalError("run with id '%s' has unsupported artifact storage", run.ID)
+	}
+
+	artifacts, _, err := artifactStorage.List(ctx, run.ArtifactURI, req.Path, storage.ListOptions{
+		Recursive: true,
+	})
+	if err != nil {
+		return nil, api.NewInternalError("error getting artifact list from storage")
+	}
+	if len(artifacts) == 0 {
+		return nil, api.NewResourceDoesNotExistError(
+			"unable to find artifacts for URI: %s", filepath.Join(run.ArtifactURI, req.Path),
+		)
+	}
+
+	// sort artifacts by path
+	slices.SortFunc(artifacts, func(a, b storage.ArtifactObject) int {
+		return cmp.Compare(a.Path, b.Path)
+	})
+
+	format := req.Format
+	if format == "" {
+		format = ArchiveFormatZip
+	}
+	name := run.ID
+	if req.Path != "" {
+		name = filepath.Base(req.Path)
+	}
+	return &Archive{
+		Name:        fmt.Sprintf("%s.%s", name, format),
+		Format:      format,
+		path:        req.Path,
+		artifactURI: run.ArtifactURI,
+		objects:     artifacts,
+		storage:     artifactStorage,
+	}, nil
+}
+
+// ListProxiedArtifacts handles business logic of `GET /mlflow-artifacts/artifacts` endpoint.
+func (s Service) ListProxiedArtifacts(
+	ctx context.Context, req *request.ListProxiedArtifactsRequest,
+) ([]storage.ArtifactObject, error) {
+	if err := ValidateListProxiedArtifactsRequest(req); err != nil {
+		return nil, err
+	}
+
+	artifactStorage, err := s.getProxiedArtifactStorage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts, _, err := artifactStorage.List(ctx, mlflowArtifactsRootURI, req.Path, storage.ListOptions{})
+	if err != nil {
+		return nil, api.NewInternalError("error getting artifact list from storage")
+	}
+
+	// sort artifacts by path
+	slices.SortFunc(artifacts, func(a, b storage.ArtifactObject) int {
+		return cmp.Compare(a.Path, b.Path)
+	})
+
+	return artifacts, nil
+}
+
+// DownloadProxiedArtifact handles business logic of `GET /mlflow-artifacts/artifacts/*` endpoint.
+func (s Service) DownloadProxiedArtifact(
+	ctx context.Context, req *request.DownloadProxiedArtifactRequest,
+) (*Content, error) {
+	if err := ValidateDownloadProxiedArtifactRequest(req); err != nil {
+		return nil, err
+	}
+
+	artifactStorage, err := s.getProxiedArtifactStorage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewContent(
+		ctx,
+		artifactStorage,
+		mlflowArtifactsRootURI,
+		req.Path,
+		fmt.Sprintf("error getting artifact object for path: %s", req.Path),
 	)
+}
+
+// UploadProxiedArtifact handles business logic of `PUT /mlflow-artifacts/artifacts/*` endpoint.
+func (s Service) UploadProxiedArtifact(
+	ctx context.Context, req *request.UploadProxiedArtifactRequest, reader io.Reader,
+) error {
+	if err := ValidateUploadProxiedArtifactRequest(req); err != nil {
+		return err
+	}
+
+	artifactStorage, err := s.getProxiedArtifactStorage(ctx)
 	if err != nil {
-		msg := fmt.Sprintf("error getting artifact object for URI: %s", filepath.Join(run.ArtifactURI, req.Path))
+		return err
+	}
+
+	if err := artifactStorage.Put(ctx, mlflowArtifactsRootURI, req.Path, reader); err != nil {
+		return api.NewInternalError("error uploading artifact object for path: %s", req.Path)
+	}
+	return nil
+}
+
+// DeleteProxiedArtifact handles business logic of `DELETE /mlflow-artifacts/artifacts/*` endpoint.
+func (s Service) DeleteProxiedArtifact(ctx context.Context, req *request.DeleteProxiedArtifactRequest) error {
+	if err := ValidateDeleteProxiedArtifactRequest(req); err != nil {
+		return err
+	}
+
+	artifactStorage, err := s.getProxiedArtifactStorage(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := artifactStorage.Delete(ctx, mlflowArtifactsRootURI, req.Path); err != nil {
+		msg := fmt.Sprintf("error deleting artifact object for path: %s", req.Path)
 		if errors.Is(err, fs.ErrNotExist) {
-			return nil, api.NewResourceDoesNotExistError(msg)
+			return api.NewResourceDoesNotExistError("%s", msg)
+		}
+		return api.NewInternalError("%s", msg)
+	}
+	return nil
+}
+
+// CreateMultipartUpload handles business logic of `POST /mlflow-artifacts/mpu/create/*` endpoint.
+func (s Service) CreateMultipartUpload(
+	ctx context.Context, req *request.CreateMultipartUploadRequest,
+) (*storage.MultipartUpload, error) {
+	if err := ValidateCreateMultipartUploadRequest(req); err != nil {
+		return nil, err
+	}
+
+	artifactStorage, err := s.getProxiedPresignedArtifactStorage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path := getMultipartUploadPath(req.ArtifactPath, req.Path)
+	upload, err := artifactStorage.CreateMultipartUpload(ctx, mlflowArtifactsRootURI, path, req.NumParts)
+	if err != nil {
+		return nil, convertPresignedStorageError(err, "error creating multipart upload for path: %s", path)
+	}
+	return upload, nil
+}
+
+// CompleteMultipartUpload handles business logic of `POST /mlflow-artifacts/mpu/complete/*` endpoint.
+func (s Service) CompleteMultipartUpload(
+	ctx context.Context, req *request.CompleteMultipartUploadRequest,
+) error {
+	if err := ValidateCompleteMultipartUploadRequest(req); err != nil {
+		return err
+	}
+
+	artifactStorage, err := s.getProxiedPresignedArtifactStorage(ctx)
+	if err != nil {
+		return err
+	}
+
+	parts := make([]storage.MultipartUploadPart, len(req.Parts))
+	for i, part := range req.Parts {
+		parts[i] = storage.MultipartUploadPart{
+			PartNumber: part.PartNumber,
+			ETag:       part.ETag,
 		}
-		return nil, api.NewInternalError(msg)
 	}
-	return artifactReader, nil
+
+	path := getMultipartUploadPath(req.ArtifactPath, req.Path)
+	if err := artifactStorage.CompleteMultipartUpload(
+		ctx, mlflowArtifactsRootURI, path, req.UploadID, parts,
+	); err != nil {
+		return convertPresignedStorageError(err, "error completing multipart upload for path: %s", path)
+	}
+	return nil
+}
+
+// AbortMultipartUpload handles business logic of `POST /mlflow-artifacts/mpu/abort/*` endpoint.
+func (s Service) AbortMultipartUpload(
+	ctx context.Context, req *request.AbortMultipartUploadRequest,
+) error {
+	if err := ValidateAbortMultipartUploadRequest(req); err != nil {
+		return err
+	}
+
+	artifactStorage, err := s.getProxiedPresignedArtifactStorage(ctx)
+	if err != nil {
+		return err
+	}
+
+	path := getMultipartUploadPath(req.ArtifactPath, req.Path)
+	if err := artifactStorage.AbortMultipartUpload(ctx, mlflowArtifactsRootURI, path, req.UploadID); err != nil {
+		return convertPresignedStorageError(err, "error aborting multipart upload for path: %s", path)
+	}
+	return nil
+}
+
+// GetPresignedDownloadURL handles business logic of `GET /mlflow-artifacts/presigned-download/*` endpoint.
+func (s Service) GetPresignedDownloadURL(
+	ctx context.Context, req *request.GetPresignedDownloadURLRequest,
+) (string, error) {
+	if err := ValidateGetPresignedDownloadURLRequest(req); err != nil {
+		return "", err
+	}
+
+	artifactStorage, err := s.getProxiedPresignedArtifactStorage(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	url, err := artifactStorage.GetPresignedDownloadURL(ctx, mlflowArtifactsRootURI, req.Path)
+	if err != nil {
+		return "", convertPresignedStorageError(err, "error getting presigned download url for path: %s", req.Path)
+	}
+	return url, nil
+}
+
+// getProxiedPresignedArtifactStorage returns storage of the artifacts proxied through the tracking server,
+// which is able to issue presigned urls.
+func (s Service) getProxiedPresignedArtifactStorage(
+	ctx context.Context,
+) (storage.ArtifactPresignedStorageProvider, error) {
+	artifactStorage, err := s.getProxiedArtifactStorage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	presignedStorage, ok := artifactStorage.(storage.ArtifactPresignedStorageProvider)
+	if !ok {
+		return nil, api.NewBadRequestError("presigned urls are not supported by the artifacts destination")
+	}
+	return presignedStorage, nil
+}
+
+// getMultipartUploadPath returns path of the uploaded object. Like in MLflow, only the base name
+// of the local file is appended to the artifact path.
+func getMultipartUploadPath(artifactPath, path string) string {
+	return filepath.Join(artifactPath, filepath.Base(path))
+}
+
+// convertPresignedStorageError converts error of the presigned storage into api error.
+func convertPresignedStorageError(err error, format string, args ...any) error {
+	msg := fmt.Sprintf(format, args...)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return api.NewResourceDoesNotExistError("%s", msg)
+	case errors.Is(err, storage.ErrUnsupportedOperation):
+		return api.NewBadRequestError("presigned urls are not supported by the artifacts destination")
+	default:
+		return api.NewInternalError("%s", msg)
+	}
+}
+
+// getProxiedArtifactStorage returns storage of the artifacts proxied through the tracking server.
+func (s Service) getProxiedArtifactStorage(ctx context.Context) (storage.ArtifactStorageProvider, error) {
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, mlflowArtifactsRootURI)
+	if err != nil {
+		return nil, api.NewInternalError("unable to initialize proxied artifact storage: %s", err)
+	}
+	return artifactStorage, nil
 }
@@ -1,13 +1,17 @@
 package artifact
 
 import (
+	"archive/tar"
+	"archive/zip"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"errors"
 	"io"
 	"strings"
 	"testing"
 
+	"github.com/rotisserie/eris"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -21,20 +25,20 @@ import (
 func TestService_ListArtifacts_Ok(t *testing.T) {
 	artifactStorage := storage.MockArtifactStorageProvider{}
 	artifactStorage.On(
-		"List", context.TODO(), "/artifact/uri", "",
+		"List", context.TODO(), "/artifact/uri", "", storage.ListOptions{MaxResults: 2, Recursive: true},
 	).Return(
 		[]storage.ArtifactObject{
-			{
-				Path:  "path1",
-				Size:  1234567890,
-				IsDir: false,
-			},
 			{
 				Path:  "path2",
 				Size:  123456788,
 				IsDir: true,
 			},
-		}, nil,
+			{
+				Path:  "path1",
+				Size:  1234567890,
+				IsDir: false,
+			},
+		}, "token", nil,
 	)
 
 	artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
@@ -56,18 +60,21 @@ func TestService_ListArtifacts_Ok(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(&runRepository, &artifactStorageFactory)
-	rootURI, artifacts, err := service.ListArtifacts(
+	rootURI, artifacts, nextPageToken, err := service.ListArtifacts(
 		context.TODO(),
 		&models.Namespace{
 			ID: 1,
 		},
 		&request.ListArtifactsRequest{
-			RunID: "id",
+			RunID:      "id",
+			MaxResults: 2,
+			Recursive:  true,
 		},
 	)
 
 	require.Nil(t, err)
 	assert.Equal(t, "/artifact/uri", rootURI)
+	assert.Equal(t, "token", nextPageToken)
 	assert.Equal(t, []storage.ArtifactObject{
 		{
 			Path:  "path1",
@@ -134,6 +141,42 @@ func TestService_ListArtifacts_Error(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:  "InvalidPageToken",
+			error: api.NewInvalidParameterValueError("Invalid page_token"),
+			request: &request.ListArtifactsRequest{
+				RunID:     "id",
+				PageToken: "invalid",
+			},
+			service: func() *Service {
+				artifactStorage := storage.MockArtifactStorageProvider{}
+				artifactStorage.On(
+					"List", context.TODO(), "/artifact/uri", "", storage.ListOptions{PageToken: "invalid"},
+				).Return(
+					nil, "", eris.Wrap(storage.ErrInvalidPageToken, "error parsing page token"),
+				)
+
+				artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+				artifactStorageFactory.On(
+					"GetStorage", context.TODO(), "/artifact/uri",
+				).Return(&artifactStorage, nil)
+
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID",
+					context.TODO(),
+					uint(1),
+					"id",
+				).Return(&models.Run{
+					ID:          "id",
+					ArtifactURI: "/artifact/uri",
+				}, nil)
+				return NewService(
+					&runRepository,
+					&artifactStorageFactory,
+				)
+			},
+		},
 		{
 			name:  "StorageError",
 			error: api.NewInternalError("error getting artifact list from storage"),
@@ -143,9 +186,9 @@ func TestService_ListArtifacts_Error(t *testing.T) {
 			service: func() *Service {
 				artifactStorage := storage.MockArtifactStorageProvider{}
 				artifactStorage.On(
-					"List", context.TODO(), "/artifact/uri", "",
+					"List", context.TODO(), "/artifact/uri", "", storage.ListOptions{},
 				).Return(
-					nil, errors.New("storage error"),
+					nil, "", errors.New("storage error"),
 				)
 
 				artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
@@ -174,7 +217,7 @@ func TestService_ListArtifacts_Error(t *testing.T) {
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
 			// call service under testing.
-			_, _, err := tt.service().ListArtifacts(context.TODO(), &models.Namespace{
+			_, _, _, err := tt.service().ListArtifacts(context.TODO(), &models.Namespace{
 				ID: 1,
 			}, tt.request)
 			assert.Equal(t, tt.error, err)
@@ -184,11 +227,21 @@ func TestService_ListArtifacts_Error(t *testing.T) {
 
 func TestService_GetArtifact_Ok(t *testing.T) {
 	artifactStorage := storage.MockArtifactStorageProvider{}
+	artifactStorage.On(
+		"Stat", context.TODO(), "/artifact/uri", "",
+	).Return(
+		storage.ArtifactObject{Size: 7}, nil,
+	)
 	artifactStorage.On(
 		"Get", context.TODO(), "/artifact/uri", "",
 	).Return(
 		io.NopCloser(strings.NewReader("content")), nil,
 	)
+	artifactStorage.On(
+		"GetRange", context.TODO(), "/artifact/uri", "", int64(2), int64(3),
+	).Return(
+		io.NopCloser(strings.NewReader("nte")), nil,
+	)
 
 	artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
 	artifactStorageFactory.On(
@@ -209,7 +262,7 @@ func TestService_GetArtifact_Ok(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(&runRepository, &artifactStorageFactory)
-	data, err := service.GetArtifact(
+	content, err := service.GetArtifact(
 		context.TODO(),
 		&models.Namespace{
 			ID: 1,
@@ -218,12 +271,24 @@ func TestService_GetArtifact_Ok(t *testing.T) {
 			RunID: "id",
 		},
 	)
+	require.Nil(t, err)
+	assert.Equal(t, int64(7), content.Size)
 
+	// read the whole content.
+	data, err := content.Open(context.TODO())
 	require.Nil(t, err)
 	result := new(bytes.Buffer)
 	_, err = result.ReadFrom(data)
 	require.Nil(t, err)
 	assert.Equal(t, "content", result.String())
+
+	// read the part of content.
+	data, err = content.OpenRange(context.TODO(), 2, 3)
+	require.Nil(t, err)
+	result = new(bytes.Buffer)
+	_, err = result.ReadFrom(data)
+	require.Nil(t, err)
+	assert.Equal(t, "nte", result.String())
 }
 
 func TestService_GetArtifact_Error(t *testing.T) {
@@ -287,9 +352,9 @@ func TestService_GetArtifact_Error(t *testing.T) {
 			service: func() *Service {
 				artifactStorage := storage.MockArtifactStorageProvider{}
 				artifactStorage.On(
-					"Get", context.TODO(), "/artifact/uri", "",
+					"Stat", context.TODO(), "/artifact/uri", "",
 				).Return(
-					nil, errors.New("storage error"),
+					storage.ArtifactObject{}, errors.New("storage error"),
 				)
 
 				artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
@@ -320,13 +385,6 @@ func TestService_GetArtifact_Error(t *testing.T) {
 				RunID: "id",
 			},
 			service: func() *Service {
-				artifactStorage := storage.MockArtifactStorageProvider{}
-				artifactStorage.On(
-					"Get", context.TODO(), "/artifact/uri", "",
-				).Return(
-					nil, errors.New("storage error"),
-				)
-
 				artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
 				artifactStorageFactory.On(
 					"GetStorage", context.TODO(), "/artifact/uri",
@@ -360,3 +418,148 @@ func TestService_GetArtifact_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestService_DownloadArtifacts_Ok(t *testing.T) {
+	artifactStorage := storage.MockArtifactStorageProvider{}
+	artifactStorage.On(
+		"List", context.TODO(), "/artifact/uri", "dir", storage.ListOptions{Recursive: true},
+	).Return(
+		[]storage.ArtifactObject{
+			{Path: "dir/sub/file2", Size: 8},
+			{Path: "dir/file1", Size: 8},
+		}, "", nil,
+	)
+	artifactStorage.On(
+		"Get", context.TODO(), "/artifact/uri", "dir/file1",
+	).Return(
+		func(context.Context, string, string) io.ReadCloser {
+			return io.NopCloser(strings.NewReader("content1"))
+		}, nil,
+	)
+	artifactStorage.On(
+		"Get", context.TODO(), "/artifact/uri", "dir/sub/file2",
+	).Return(
+		func(context.Context, string, string) io.ReadCloser {
+			return io.NopCloser(strings.NewReader("content2"))
+		}, nil,
+	)
+
+	artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+	artifactStorageFactory.On(
+		"GetStorage", context.TODO(), "/artifact/uri",
+	).Return(&artifactStorage, nil)
+
+	// init repository mocks.
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDAndRunID",
+		context.TODO(),
+		uint(1),
+		"id",
+	).Return(&models.Run{
+		ID:          "id",
+		ArtifactURI: "/artifact/uri",
+	}, nil)
+	service := NewService(&runRepository, &artifactStorageFactory)
+
+	// call service under testing with zip format.
+	archive, err := service.DownloadArtifacts(
+		context.TODO(),
+		&models.Namespace{
+			ID: 1,
+		},
+		&request.DownloadArtifactsRequest{
+			RunID: "id",
+			Path:  "dir",
+		},
+	)
+	require.Nil(t, err)
+	assert.Equal(t, "dir.zip", archive.Name)
+	assert.Equal(t, "application/zip", archive.ContentType())
+
+	buffer := new(bytes.Buffer)
+	require.Nil(t, archive.Write(context.TODO(), buffer))
+	zipReader, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	require.Nil(t, err)
+	files := map[string]string{}
+	for _, file := range zipReader.File {
+		reader, err := file.Open()
+		require.Nil(t, err)
+		content, err := io.ReadAll(reader)
+		require.Nil(t, err)
+		files[file.Name] = string(content)
+	}
+	assert.Equal(t, map[string]string{"file1": "content1", "sub/file2": "content2"}, files)
+
+	// call service under testing with tar.gz format.
+	archive, err = service.DownloadArtifacts(
+		context.TODO(),
+		&models.Namespace{
+			ID: 1,
+		},
+		&request.DownloadArtifactsRequest{
+			RunID:  "id",
+			Path:   "dir",
+			Format: ArchiveFormatTarGz,
+		},
+	)
+	require.Nil(t, err)
+	assert.Equal(t, "dir.tar.gz", archive.Name)
+	assert.Equal(t, "application/gzip", archive.ContentType())
+
+	buffer = new(bytes.Buffer)
+	require.Nil(t, archive.Write(context.TODO(), buffer))
+	gzipReader, err := gzip.NewReader(buffer)
+	require.Nil(t, err)
+	tarReader := tar.NewReader(gzipReader)
+	files = map[string]string{}
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.Nil(t, err)
+		content, err := io.ReadAll(tarReader)
+		require.Nil(t, err)
+		files[header.Name] = string(content)
+	}
+	assert.Equal(t, map[string]string{"file1": "content1", "sub/file2": "content2"}, files)
+}
+
+func TestService_DownloadArtifacts_Error(t *testing.T) {
+	artifactStorage := storage.MockArtifactStorageProvider{}
+	artifactStorage.On(
+		"List", context.TODO(), "/artifact/uri", "dir", storage.ListOptions{Recursive: true},
+	).Return(
+		[]storage.ArtifactObject{}, "", nil,
+	)
+
+	artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+	artifactStorageFactory.On(
+		"GetStorage", context.TODO(), "/artifact/uri",
+	).Return(&artifactStorage, nil)
+
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDAndRunID",
+		context.TODO(),
+		uint(1),
+		"id",
+	).Return(&models.Run{
+		ID:          "id",
+		ArtifactURI: "/artifact/uri",
+	}, nil)
+
+	// call service under testing.
+	_, err := NewService(&runRepository, &artifactStorageFactory).DownloadArtifacts(
+		context.TODO(),
+		&models.Namespace{
+			ID: 1,
+		},
+		&request.DownloadArtifactsRequest{
+			RunID: "id",
+			Path:  "dir",
+		},
+	)
+	assert.Equal(t, api.NewResourceDoesNotExistError("unable to find artifacts for URI: /artifact/uri/dir"), err)
+}
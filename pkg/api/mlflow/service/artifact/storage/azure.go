@@ -0,0 +1,326 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+)
+
+// Azure Blob Storage names. `abfss` uris of Data Lake Storage Gen2 accounts
+// are served through the blob endpoint of the same account.
+const (
+	AzureBlobStorageName     = "wasbs"
+	AzureDataLakeStorageName = "abfss"
+)
+
+// Azure represents adapter to work with Azure Blob Storage artifacts.
+type Azure struct {
+	config  *config.ServiceConfig
+	clients sync.Map
+}
+
+// NewAzure creates new Azure Blob Storage instance.
+func NewAzure(config *config.ServiceConfig) (*Azure, error) {
+	if config.AzureStorageConnectionString == "" && config.AzureStorageAccessKey == "" {
+		return nil, eris.New("neither connection string nor access key of azure storage is configured")
+	}
+	return &Azure{
+		config: config,
+	}, nil
+}
+
+// List implements ArtifactStorageProvider interface.
+func (s *Azure) List(
+	ctx context.Context, artifactURI, path string, options ListOptions,
+) ([]ArtifactObject, string, error) {
+	// 1. process input parameters.
+	client, containerName, rootPrefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return nil, "", err
+	}
+	prefix := filepath.Join(rootPrefix, path)
+	if prefix != "" {
+		prefix = prefix + "/"
+	}
+	var marker, nextMarker *string
+	if options.PageToken != "" {
+		marker = to.Ptr(options.PageToken)
+	}
+	var maxResults *int32
+	if options.MaxResults > 0 {
+		maxResults = to.Ptr(options.MaxResults)
+	}
+
+	// 2. read data from azure storage. recursive listing uses flat pager, which returns blobs only.
+	artifactList := []ArtifactObject{}
+	if options.Recursive {
+		pager := client.NewListBlobsFlatPager(containerName, &azblob.ListBlobsFlatOptions{
+			Prefix:     to.Ptr(prefix),
+			Marker:     marker,
+			MaxResults: maxResults,
+		})
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				return nil, "", eris.Wrap(err, "error getting blob information")
+			}
+			for _, blob := range page.Segment.BlobItems {
+				artifact, ok, err := newAzureArtifactObject(rootPrefix, path, *blob.Name, blob.Properties)
+				if err != nil {
+					return nil, "", err
+				}
+				if ok {
+					artifactList = append(artifactList, artifact)
+				}
+			}
+			if options.MaxResults > 0 {
+				nextMarker = page.NextMarker
+				break
+			}
+		}
+	} else {
+		pager := client.ServiceClient().NewContainerClient(containerName).NewListBlobsHierarchyPager(
+			"/", &container.ListBlobsHierarchyOptions{
+				Prefix:     to.Ptr(prefix),
+				Marker:     marker,
+				MaxResults: maxResults,
+			},
+		)
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				return nil, "", eris.Wrap(err, "error getting blob information")
+			}
+
+			for _, blobPrefix := range page.Segment.BlobPrefixes {
+				relPath, err := filepath.Rel(rootPrefix, *blobPrefix.Name)
+				if err != nil {
+					return nil, "", eris.Wrapf(err, "error getting relative path for blob prefix: %s", *blobPrefix.Name)
+				}
+				artifactList = append(artifactList, ArtifactObject{
+					Path:  relPath,
+					Size:  0,
+					IsDir: true,
+				})
+			}
+
+			for _, blob := range page.Segment.BlobItems {
+				artifact, ok, err := newAzureArtifactObject(rootPrefix, path, *blob.Name, blob.Properties)
+				if err != nil {
+					return nil, "", err
+				}
+				if ok {
+					artifactList = append(artifactList, artifact)
+				}
+			}
+			if options.MaxResults > 0 {
+				nextMarker = page.NextMarker
+				break
+			}
+		}
+	}
+
+	if nextMarker != nil {
+		return artifactList, *nextMarker, nil
+	}
+	return artifactList, "", nil
+}
+
+// Get returns file content at the storage location.
+func (s *Azure) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, error) {
+	// 1. process input parameters.
+	client, containerName, prefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. get blob from azure storage.
+	resp, err := client.DownloadStream(ctx, containerName, filepath.Join(prefix, path), nil)
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound, bloberror.ContainerNotFound) {
+			return nil, eris.Wrap(fs.ErrNotExist, "blob does not exist")
+		}
+		return nil, eris.Wrap(err, "error getting blob")
+	}
+
+	return resp.Body, nil
+}
+
+// GetRange implements ArtifactStorageProvider interface.
+func (s *Azure) GetRange(
+	ctx context.Context, artifactURI, path string, offset, length int64,
+) (io.ReadCloser, error) {
+	client, containerName, prefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.DownloadStream(ctx, containerName, filepath.Join(prefix, path), &azblob.DownloadStreamOptions{
+		Range: azblob.HTTPRange{
+			Offset: offset,
+			Count:  length,
+		},
+	})
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound, bloberror.ContainerNotFound) {
+			return nil, eris.Wrap(fs.ErrNotExist, "blob does not exist")
+		}
+		return nil, eris.Wrap(err, "error getting blob range")
+	}
+
+	return resp.Body, nil
+}
+
+// Stat implements ArtifactStorageProvider interface.
+func (s *Azure) Stat(ctx context.Context, artifactURI, path string) (ArtifactObject, error) {
+	client, containerName, prefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return ArtifactObject{}, err
+	}
+
+	resp, err := client.ServiceClient().NewContainerClient(containerName).NewBlobClient(
+		filepath.Join(prefix, path),
+	).GetProperties(ctx, nil)
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound, bloberror.ContainerNotFound) {
+			return ArtifactObject{}, eris.Wrap(fs.ErrNotExist, "blob does not exist")
+		}
+		return ArtifactObject{}, eris.Wrap(err, "error getting blob properties")
+	}
+
+	var size int64
+	if resp.ContentLength != nil {
+		size = *resp.ContentLength
+	}
+	return ArtifactObject{
+		Path: path,
+		Size: size,
+	}, nil
+}
+
+// Put streams content of provided io.Reader into the blob at the storage location.
+func (s *Azure) Put(ctx context.Context, artifactURI, path string, reader io.Reader) error {
+	client, containerName, prefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.UploadStream(ctx, containerName, filepath.Join(prefix, path), reader, nil); err != nil {
+		return eris.Wrap(err, "error uploading blob")
+	}
+
+	return nil
+}
+
+// Delete deletes the blob or all the blobs under the directory at the storage location.
+func (s *Azure) Delete(ctx context.Context, artifactURI, path string) error {
+	// 1. process input parameters.
+	client, containerName, prefix, err := s.getClient(artifactURI)
+	if err != nil {
+		return err
+	}
+	key := filepath.Join(prefix, path)
+
+	// 2. find the blob itself and all the blobs under the directory.
+	var blobNames []string
+	pager := client.NewListBlobsFlatPager(containerName, &azblob.ListBlobsFlatOptions{
+		Prefix: to.Ptr(key),
+	})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return eris.Wrap(err, "error listing blobs")
+		}
+		for _, blob := range page.Segment.BlobItems {
+			if *blob.Name == key || strings.HasPrefix(*blob.Name, key+"/") {
+				blobNames = append(blobNames, *blob.Name)
+			}
+		}
+	}
+	if len(blobNames) == 0 {
+		return eris.Wrap(fs.ErrNotExist, "blob does not exist")
+	}
+
+	// 3. delete found blobs.
+	for _, blobName := range blobNames {
+		if _, err := client.DeleteBlob(ctx, containerName, blobName, nil); err != nil {
+			return eris.Wrapf(err, "error deleting blob: %s", blobName)
+		}
+	}
+
+	return nil
+}
+
+// getClient returns client of the storage account, container name and prefix from provided uri.
+// Clients are created lazily, because the storage account is a part of the artifact uri.
+func (s *Azure) getClient(artifactURI string) (*azblob.Client, string, string, error) {
+	containerName, account, prefix, err := ExtractAzureContainerAccountAndPrefix(artifactURI)
+	if err != nil {
+		return nil, "", "", eris.Wrap(err, "error extracting container, account and prefix from provided uri")
+	}
+
+	// connection string contains the account itself, so the single client serves all the uris.
+	if s.config.AzureStorageConnectionString != "" {
+		account = ""
+	}
+	if client, ok := s.clients.Load(account); ok {
+		return client.(*azblob.Client), containerName, prefix, nil
+	}
+
+	var client *azblob.Client
+	if s.config.AzureStorageConnectionString != "" {
+		client, err = azblob.NewClientFromConnectionString(s.config.AzureStorageConnectionString, nil)
+		if err != nil {
+			return nil, "", "", eris.Wrap(err, "error creating azure storage client from connection string")
+		}
+	} else {
+		credential, err := azblob.NewSharedKeyCredential(account, s.config.AzureStorageAccessKey)
+		if err != nil {
+			return nil, "", "", eris.Wrap(err, "error creating azure storage shared key credential")
+		}
+		client, err = azblob.NewClientWithSharedKeyCredential(
+			fmt.Sprintf("https://%s.blob.core.windows.net/", account), credential, nil,
+		)
+		if err != nil {
+			return nil, "", "", eris.Wrap(err, "error creating azure storage client")
+		}
+	}
+
+	actual, _ := s.clients.LoadOrStore(account, client)
+	return actual.(*azblob.Client), containerName, prefix, nil
+}
+
+// newAzureArtifactObject converts blob into ArtifactObject. Blob, which represents the directory itself, is skipped.
+func newAzureArtifactObject(
+	rootPrefix, path, name string, properties *container.BlobProperties,
+) (ArtifactObject, bool, error) {
+	relPath, err := filepath.Rel(rootPrefix, name)
+	if err != nil {
+		return ArtifactObject{}, false, eris.Wrapf(err, "error getting relative path for blob: %s", name)
+	}
+	// filter current directory from the result set.
+	if relPath == path || strings.HasSuffix(name, "/") {
+		return ArtifactObject{}, false, nil
+	}
+	var size int64
+	if properties != nil && properties.ContentLength != nil {
+		size = *properties.ContentLength
+	}
+	return ArtifactObject{
+		Path:  relPath,
+		Size:  size,
+		IsDir: false,
+	}, true, nil
+}
@@ -3,12 +3,18 @@ package storage
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"io/fs"
+	"net/http"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"cloud.google.com/go/storage"
+	"github.com/google/uuid"
 	"github.com/rotisserie/eris"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 
@@ -20,6 +26,9 @@ const (
 	GSStorageName = "gs"
 )
 
+// gsComposeMaxSources is a maximum number of objects which could be composed with a single request.
+const gsComposeMaxSources = 32
+
 // GS represents adapter to work with GS storage artifacts.
 type GS struct {
 	client *storage.Client
@@ -43,32 +52,52 @@ func NewGS(ctx context.Context, config *config.ServiceConfig) (*GS, error) {
 }
 
 // List implements ArtifactStorageProvider interface.
-func (s GS) List(ctx context.Context, artifactURI, path string) ([]ArtifactObject, error) {
+func (s GS) List(
+	ctx context.Context, artifactURI, path string, options ListOptions,
+) ([]ArtifactObject, string, error) {
 	// 1. process input parameters.
 	bucket, rootPrefix, err := ExtractBucketAndPrefix(artifactURI)
 	if err != nil {
-		return nil, eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+		return nil, "", eris.Wrap(err, "error extracting bucket and prefix from provided uri")
 	}
 	prefix := filepath.Join(rootPrefix, path)
 	if prefix != "" {
 		prefix = prefix + "/"
 	}
-
-	// 2. read data from gs storage.
-	var artifactList []ArtifactObject
-	it := s.client.Bucket(bucket).Objects(ctx, &storage.Query{
+	query := storage.Query{
 		Prefix:    prefix,
 		Delimiter: "/",
-	})
-	for {
-		object, err := it.Next()
-		if errors.Is(err, iterator.Done) {
-			break
-		}
+	}
+	// recursive listing has no delimiter, so only objects are returned.
+	if options.Recursive {
+		query.Delimiter = ""
+	}
+
+	// 2. read data from gs storage.
+	var objects []*storage.ObjectAttrs
+	nextPageToken := ""
+	it := s.client.Bucket(bucket).Objects(ctx, &query)
+	if options.MaxResults > 0 {
+		nextPageToken, err = iterator.NewPager(it, int(options.MaxResults), options.PageToken).NextPage(&objects)
 		if err != nil {
-			return nil, eris.Wrap(err, "error getting object information")
+			return nil, "", eris.Wrap(err, "error getting object information")
 		}
+	} else {
+		it.PageInfo().Token = options.PageToken
+		for {
+			object, err := it.Next()
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			if err != nil {
+				return nil, "", eris.Wrap(err, "error getting object information")
+			}
+			objects = append(objects, object)
+		}
+	}
 
+	artifactList := make([]ArtifactObject, 0, len(objects))
+	for _, object := range objects {
 		objectName := object.Name
 		if object.Name == "" {
 			objectName = object.Prefix
@@ -76,13 +105,24 @@ func (s GS) List(ctx context.Context, artifactURI, path string) ([]ArtifactObjec
 
 		relPath, err := filepath.Rel(rootPrefix, objectName)
 		if err != nil {
-			return nil, eris.Wrapf(err, "error getting relative path for object: %s", object.Name)
+			return nil, "", eris.Wrapf(err, "error getting relative path for object: %s", object.Name)
 		}
 
 		// filter current directory from the result set.
 		if relPath == path {
 			continue
 		}
+		if options.Recursive {
+			// skip placeholder objects of directories.
+			if strings.HasSuffix(objectName, "/") {
+				continue
+			}
+			artifactList = append(artifactList, ArtifactObject{
+				Path: relPath,
+				Size: object.Size,
+			})
+			continue
+		}
 		artifactList = append(artifactList, ArtifactObject{
 			Path:  relPath,
 			Size:  object.Size,
@@ -90,7 +130,7 @@ func (s GS) List(ctx context.Context, artifactURI, path string) ([]ArtifactObjec
 		})
 	}
 
-	return artifactList, nil
+	return artifactList, nextPageToken, nil
 }
 
 // Get returns file content at the storage location.
@@ -112,3 +152,262 @@ func (s GS) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, e
 
 	return reader, nil
 }
+
+// GetRange implements ArtifactStorageProvider interface.
+func (s GS) GetRange(ctx context.Context, artifactURI, path string, offset, length int64) (io.ReadCloser, error) {
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	reader, err := s.client.Bucket(bucketName).Object(filepath.Join(prefix, path)).NewRangeReader(
+		ctx, offset, length,
+	)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, eris.Wrap(fs.ErrNotExist, "object does not exist")
+		}
+		return nil, eris.Wrap(err, "error getting object range")
+	}
+
+	return reader, nil
+}
+
+// Stat implements ArtifactStorageProvider interface.
+func (s GS) Stat(ctx context.Context, artifactURI, path string) (ArtifactObject, error) {
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return ArtifactObject{}, eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	attrs, err := s.client.Bucket(bucketName).Object(filepath.Join(prefix, path)).Attrs(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return ArtifactObject{}, eris.Wrap(fs.ErrNotExist, "object does not exist")
+		}
+		return ArtifactObject{}, eris.Wrap(err, "error getting object attributes")
+	}
+
+	return ArtifactObject{
+		Path: path,
+		Size: attrs.Size,
+	}, nil
+}
+
+// Put streams content of provided io.Reader into the object at the storage location.
+func (s GS) Put(ctx context.Context, artifactURI, path string, reader io.Reader) error {
+	// 1. process input parameters.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	// 2. stream the content into gcp storage.
+	writer := s.client.Bucket(bucketName).Object(filepath.Join(prefix, path)).NewWriter(ctx)
+	if _, err := io.Copy(writer, reader); err != nil {
+		//nolint:errcheck,gosec
+		writer.Close()
+		return eris.Wrap(err, "error writing object")
+	}
+	if err := writer.Close(); err != nil {
+		return eris.Wrap(err, "error closing object writer")
+	}
+
+	return nil
+}
+
+// Delete deletes the object or all the objects under the path at the storage location.
+func (s GS) Delete(ctx context.Context, artifactURI, path string) error {
+	// 1. process input parameters.
+	bucketName, rootPrefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+	key := filepath.Join(rootPrefix, path)
+	prefix := key
+	if prefix != "" {
+		prefix = prefix + "/"
+	}
+
+	// 2. delete the object itself and all the objects under the path.
+	deleted := 0
+	bucket := s.client.Bucket(bucketName)
+	it := bucket.Objects(ctx, &storage.Query{
+		Prefix: key,
+	})
+	for {
+		object, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return eris.Wrap(err, "error getting object information")
+		}
+		if object.Name != key && !strings.HasPrefix(object.Name, prefix) {
+			continue
+		}
+		if err := bucket.Object(object.Name).Delete(ctx); err != nil {
+			return eris.Wrapf(err, "error deleting object: %s", object.Name)
+		}
+		deleted++
+	}
+	if deleted == 0 {
+		return eris.Wrap(fs.ErrNotExist, "object does not exist")
+	}
+
+	return nil
+}
+
+// CreateMultipartUpload implements ArtifactPresignedStorageProvider interface.
+// Google Storage has no multipart upload in its JSON api, so every part is uploaded as a temporary object
+// and all of them are composed into the artifact object when the upload is completed.
+func (s GS) CreateMultipartUpload(
+	ctx context.Context, artifactURI, path string, numParts int32,
+) (*MultipartUpload, error) {
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	uploadID := uuid.NewString()
+	key := filepath.Join(prefix, path)
+	credentials := make([]MultipartUploadCredential, numParts)
+	for i := range credentials {
+		partNumber := int32(i + 1)
+		url, err := s.client.Bucket(bucketName).SignedURL(getGSPartName(key, uploadID, partNumber), &storage.SignedURLOptions{
+			Method:  http.MethodPut,
+			Expires: time.Now().Add(presignedURLExpiration),
+			Scheme:  storage.SigningSchemeV4,
+		})
+		if err != nil {
+			return nil, eris.Wrapf(err, "error presigning upload of part %d", partNumber)
+		}
+		credentials[i] = MultipartUploadCredential{
+			URL:        url,
+			PartNumber: partNumber,
+			Headers:    map[string]string{},
+		}
+	}
+
+	return &MultipartUpload{
+		UploadID:    uploadID,
+		Credentials: credentials,
+	}, nil
+}
+
+// CompleteMultipartUpload implements ArtifactPresignedStorageProvider interface.
+func (s GS) CompleteMultipartUpload(
+	ctx context.Context, artifactURI, path, uploadID string, parts []MultipartUploadPart,
+) error {
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+	key := filepath.Join(prefix, path)
+
+	// 1. compose uploaded parts into the artifact object.
+	// only limited number of objects could be composed at once, so bigger uploads are composed in several rounds.
+	sources := make([]string, len(parts))
+	for i, part := range parts {
+		sources[i] = getGSPartName(key, uploadID, part.PartNumber)
+	}
+	bucket := s.client.Bucket(bucketName)
+	for round := 0; len(sources) > gsComposeMaxSources; round++ {
+		var composed []string
+		for start := 0; start < len(sources); start += gsComposeMaxSources {
+			name := fmt.Sprintf("%s/composed-%d-%d", getGSUploadPrefix(key, uploadID), round, start)
+			if err := s.compose(ctx, bucket, name, sources[start:min(start+gsComposeMaxSources, len(sources))]); err != nil {
+				return err
+			}
+			composed = append(composed, name)
+		}
+		sources = composed
+	}
+	if err := s.compose(ctx, bucket, key, sources); err != nil {
+		return err
+	}
+
+	// 2. remove temporary objects.
+	return s.AbortMultipartUpload(ctx, artifactURI, path, uploadID)
+}
+
+// AbortMultipartUpload implements ArtifactPresignedStorageProvider interface.
+func (s GS) AbortMultipartUpload(ctx context.Context, artifactURI, path, uploadID string) error {
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	bucket := s.client.Bucket(bucketName)
+	it := bucket.Objects(ctx, &storage.Query{
+		Prefix: getGSUploadPrefix(filepath.Join(prefix, path), uploadID) + "/",
+	})
+	for {
+		object, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return eris.Wrap(err, "error getting object information")
+		}
+		if err := bucket.Object(object.Name).Delete(ctx); err != nil {
+			return eris.Wrapf(err, "error deleting object: %s", object.Name)
+		}
+	}
+
+	return nil
+}
+
+// GetPresignedDownloadURL implements ArtifactPresignedStorageProvider interface.
+func (s GS) GetPresignedDownloadURL(ctx context.Context, artifactURI, path string) (string, error) {
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return "", eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	// check that the object exists, so the client does not get url to nowhere.
+	key := filepath.Join(prefix, path)
+	if _, err := s.client.Bucket(bucketName).Object(key).Attrs(ctx); err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return "", eris.Wrap(fs.ErrNotExist, "object does not exist")
+		}
+		return "", eris.Wrap(err, "error getting object")
+	}
+
+	url, err := s.client.Bucket(bucketName).SignedURL(key, &storage.SignedURLOptions{
+		Method:  http.MethodGet,
+		Expires: time.Now().Add(presignedURLExpiration),
+		Scheme:  storage.SigningSchemeV4,
+	})
+	if err != nil {
+		return "", eris.Wrap(err, "error presigning object download")
+	}
+
+	return url, nil
+}
+
+// compose composes source objects into the destination object.
+func (s GS) compose(ctx context.Context, bucket *storage.BucketHandle, destination string, sources []string) error {
+	objects := make([]*storage.ObjectHandle, len(sources))
+	for i, source := range sources {
+		objects[i] = bucket.Object(source)
+	}
+	if _, err := bucket.Object(destination).ComposerFrom(objects...).Run(ctx); err != nil {
+		var apiErr *googleapi.Error
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+			return eris.Wrap(fs.ErrNotExist, "uploaded part does not exist")
+		}
+		return eris.Wrapf(err, "error composing object: %s", destination)
+	}
+	return nil
+}
+
+// getGSUploadPrefix returns prefix of the temporary objects of the multipart upload.
+func getGSUploadPrefix(key, uploadID string) string {
+	return fmt.Sprintf("%s.mpu/%s", key, uploadID)
+}
+
+// getGSPartName returns name of the temporary object of the uploaded part.
+func getGSPartName(key, uploadID string, partNumber int32) string {
+	return fmt.Sprintf("%s/part-%05d", getGSUploadPrefix(key, uploadID), partNumber)
+}
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"net/url"
+	"path"
 	"strings"
 
 	"github.com/rotisserie/eris"
@@ -20,3 +21,40 @@ func ExtractBucketAndPrefix(uri string) (string, string, error) {
 
 	return u.Host, strings.TrimLeft(u.Path, "/"), nil
 }
+
+// ExtractAzureContainerAccountAndPrefix extracts container name, storage account name and prefix path
+// from provided Azure Blob Storage or Azure Data Lake Storage Gen2 uri.
+// after processing of uri wasbs://fasttrackml@account.blob.core.windows.net/2/30357ed2eaac4f2cacdbcd0e06e9e48a/artifacts
+// result will be:
+// - container = fasttrackml
+// - account = account
+// - prefix = 2/30357ed2eaac4f2cacdbcd0e06e9e48a/artifacts
+func ExtractAzureContainerAccountAndPrefix(uri string) (string, string, string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", "", "", eris.Wrapf(err, "error parsing provided uri: %s", uri)
+	}
+	if u.User == nil || u.User.Username() == "" || u.Hostname() == "" {
+		return "", "", "", eris.Errorf(
+			"uri has to be in format %s://<container>@<account>.<endpoint>/<path>: %s", u.Scheme, uri,
+		)
+	}
+
+	account, _, _ := strings.Cut(u.Hostname(), ".")
+	return u.User.Username(), account, strings.TrimLeft(u.Path, "/"), nil
+}
+
+// isDestinationObject makes check that the name, joined onto the path of the uri, stays under this path
+// and doesn't name the root of the destination itself.
+func isDestinationObject(uri, name string) bool {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+	root := path.Join("/", u.Path)
+	joined := path.Join(root, name)
+	if joined == "/" {
+		return false
+	}
+	return joined == root || strings.HasPrefix(joined, strings.TrimSuffix(root, "/")+"/")
+}
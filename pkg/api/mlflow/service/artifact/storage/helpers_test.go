@@ -14,3 +14,39 @@ func TestExtractS3BucketAndPrefix_Ok(t *testing.T) {
 	assert.Equal(t, "fasttrackml", bucket)
 	assert.Equal(t, "2/30357ed2eaac4f2cacdbcd0e06e9e48a/artifacts", prefix)
 }
+
+func TestExtractAzureContainerAccountAndPrefix_Ok(t *testing.T) {
+	tests := []struct {
+		name string
+		uri  string
+	}{
+		{
+			name: "BlobStorage",
+			uri:  "wasbs://fasttrackml@account.blob.core.windows.net/2/30357ed2eaac4f2cacdbcd0e06e9e48a/artifacts",
+		},
+		{
+			name: "DataLakeStorage",
+			uri:  "abfss://fasttrackml@account.dfs.core.windows.net/2/30357ed2eaac4f2cacdbcd0e06e9e48a/artifacts",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			container, account, prefix, err := ExtractAzureContainerAccountAndPrefix(tt.uri)
+			require.Nil(t, err)
+			assert.Equal(t, "fasttrackml", container)
+			assert.Equal(t, "account", account)
+			assert.Equal(t, "2/30357ed2eaac4f2cacdbcd0e06e9e48a/artifacts", prefix)
+		})
+	}
+}
+
+func TestExtractAzureContainerAccountAndPrefix_Error(t *testing.T) {
+	_, _, _, err := ExtractAzureContainerAccountAndPrefix("wasbs://account.blob.core.windows.net/path")
+	assert.EqualError(
+		t,
+		err,
+		"uri has to be in format wasbs://<container>@<account>.<endpoint>/<path>: "+
+			"wasbs://account.blob.core.windows.net/path",
+	)
+}
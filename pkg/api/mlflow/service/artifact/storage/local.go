@@ -7,6 +7,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/rotisserie/eris"
@@ -29,14 +30,52 @@ func NewLocal(config *config.ServiceConfig) (*Local, error) {
 }
 
 // List implements ArtifactStorageProvider interface.
-func (s Local) List(ctx context.Context, artifactURI, path string) ([]ArtifactObject, error) {
+// File system has no native page tokens, so the token is the offset of the next page in the sorted listing.
+func (s Local) List(
+	ctx context.Context, artifactURI, path string, options ListOptions,
+) ([]ArtifactObject, string, error) {
 	// 1. trim the `file://` prefix if it exists.
 	artifactURI = strings.TrimPrefix(artifactURI, "file://")
 
-	// 2. process search `path` parameter.
+	// 2. process page token.
+	offset := 0
+	if options.PageToken != "" {
+		var err error
+		offset, err = strconv.Atoi(options.PageToken)
+		if err != nil || offset < 0 {
+			return nil, "", eris.Wrapf(ErrInvalidPageToken, "error parsing page token: %s", options.PageToken)
+		}
+	}
+
+	// 3. process search `path` parameter.
 	absPath := filepath.Join(artifactURI, path)
 
-	// 3. read data from local storage.
+	// 4. read data from local storage.
+	var artifactList []ArtifactObject
+	var err error
+	if options.Recursive {
+		artifactList, err = s.listRecursive(absPath, path)
+	} else {
+		artifactList, err = s.listDirectory(absPath, path)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	log.Debugf("got %d objects from local storage for path %q", len(artifactList), absPath)
+
+	// 5. cut the requested page.
+	if offset >= len(artifactList) {
+		return []ArtifactObject{}, "", nil
+	}
+	artifactList = artifactList[offset:]
+	if options.MaxResults > 0 && len(artifactList) > int(options.MaxResults) {
+		return artifactList[:options.MaxResults], strconv.Itoa(offset + int(options.MaxResults)), nil
+	}
+	return artifactList, "", nil
+}
+
+// listDirectory returns objects of the single directory level.
+func (s Local) listDirectory(absPath, path string) ([]ArtifactObject, error) {
 	objects, err := os.ReadDir(absPath)
 	if err != nil {
 		if errors.Is(err, fs.ErrNotExist) {
@@ -45,9 +84,8 @@ func (s Local) List(ctx context.Context, artifactURI, path string) ([]ArtifactOb
 		return nil, eris.Wrapf(err, "error reading object from local storage")
 	}
 
-	log.Debugf("got %d objects from local storage for path %q", len(objects), absPath)
-	artifactList := make([]ArtifactObject, len(objects))
-	for i, object := range objects {
+	artifactList := make([]ArtifactObject, 0, len(objects))
+	for _, object := range objects {
 		info, err := object.Info()
 		if err != nil {
 			if errors.Is(err, fs.ErrNotExist) {
@@ -56,42 +94,165 @@ func (s Local) List(ctx context.Context, artifactURI, path string) ([]ArtifactOb
 			}
 			return nil, eris.Wrapf(err, "error getting info for object: %s", object.Name())
 		}
-		artifactList[i] = ArtifactObject{
+		artifact := ArtifactObject{
 			Path:  filepath.Join(path, info.Name()),
 			IsDir: object.IsDir(),
 		}
 		if !object.IsDir() {
-			artifactList[i].Size = info.Size()
+			artifact.Size = info.Size()
 		}
+		artifactList = append(artifactList, artifact)
 	}
+	return artifactList, nil
+}
 
+// listRecursive returns all the files under the directory.
+func (s Local) listRecursive(absPath, path string) ([]ArtifactObject, error) {
+	artifactList := []ArtifactObject{}
+	if err := filepath.WalkDir(absPath, func(objectPath string, object fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// directory doesn't exist or file has been removed since we read the directory
+				return nil
+			}
+			return err
+		}
+		if object.IsDir() {
+			return nil
+		}
+		info, err := object.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return eris.Wrapf(err, "error getting info for object: %s", object.Name())
+		}
+		relPath, err := filepath.Rel(absPath, objectPath)
+		if err != nil {
+			return eris.Wrapf(err, "error getting relative path for object: %s", objectPath)
+		}
+		artifactList = append(artifactList, ArtifactObject{
+			Path: filepath.Join(path, relPath),
+			Size: info.Size(),
+		})
+		return nil
+	}); err != nil {
+		return nil, eris.Wrapf(err, "error reading objects from local storage")
+	}
 	return artifactList, nil
 }
 
 // Get returns actual file content at the storage location.
 func (s Local) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, error) {
-	// 1. trim the `file://` prefix if it exists.
-	artifactURI = strings.TrimPrefix(artifactURI, "file://")
+	return s.open(artifactURI, path)
+}
 
-	// 2. process `path` parameter.
-	absPath := filepath.Join(artifactURI, path)
+// GetRange implements ArtifactStorageProvider interface.
+func (s Local) GetRange(
+	ctx context.Context, artifactURI, path string, offset, length int64,
+) (io.ReadCloser, error) {
+	file, err := s.open(artifactURI, path)
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{
+		Reader: io.NewSectionReader(file, offset, length),
+		Closer: file,
+	}, nil
+}
 
-	// 3. check that the file exists and is not a directory.
-	fileInfo, err := os.Stat(absPath)
+// Stat implements ArtifactStorageProvider interface.
+func (s Local) Stat(ctx context.Context, artifactURI, path string) (ArtifactObject, error) {
+	fileInfo, err := s.stat(artifactURI, path)
+	if err != nil {
+		return ArtifactObject{}, err
+	}
+	return ArtifactObject{
+		Path: path,
+		Size: fileInfo.Size(),
+	}, nil
+}
+
+// stat checks that the file exists and is not a directory.
+func (s Local) stat(artifactURI, path string) (fs.FileInfo, error) {
+	fileInfo, err := os.Stat(filepath.Join(strings.TrimPrefix(artifactURI, "file://"), path))
 	if err != nil {
 		return nil, eris.Wrap(err, "path could not be opened")
 	}
 	if fileInfo.IsDir() {
 		return nil, eris.Wrap(fs.ErrNotExist, "path is a directory")
 	}
+	return fileInfo, nil
+}
 
-	// 4. open the file.
+// open opens the file at the storage location.
+func (s Local) open(artifactURI, path string) (*os.File, error) {
+	// 1. check that the file exists and is not a directory.
+	if _, err := s.stat(artifactURI, path); err != nil {
+		return nil, err
+	}
+
+	// 2. open the file.
 	// artifactURI and path are validated by the caller
 	// #nosec G304
-	file, err := os.Open(absPath)
+	file, err := os.Open(filepath.Join(strings.TrimPrefix(artifactURI, "file://"), path))
 	if err != nil {
 		return nil, eris.Wrap(err, "unable to open file")
 	}
 
 	return file, nil
 }
+
+// Put writes content of provided io.Reader into the file at the storage location.
+func (s Local) Put(ctx context.Context, artifactURI, path string, reader io.Reader) error {
+	// 1. trim the `file://` prefix if it exists.
+	artifactURI = strings.TrimPrefix(artifactURI, "file://")
+
+	// 2. process `path` parameter and create parent directories.
+	absPath := filepath.Join(artifactURI, path)
+	if err := os.MkdirAll(filepath.Dir(absPath), os.ModePerm); err != nil {
+		return eris.Wrap(err, "unable to create parent directories")
+	}
+
+	// 3. stream the content into the file.
+	// artifactURI and path are validated by the caller
+	// #nosec G304
+	file, err := os.Create(absPath)
+	if err != nil {
+		return eris.Wrap(err, "unable to create file")
+	}
+	if _, err := io.Copy(file, reader); err != nil {
+		//nolint:errcheck,gosec
+		file.Close()
+		return eris.Wrap(err, "error writing file content")
+	}
+	if err := file.Close(); err != nil {
+		return eris.Wrap(err, "error closing file")
+	}
+
+	return nil
+}
+
+// Delete deletes the file or the whole directory at the storage location.
+func (s Local) Delete(ctx context.Context, artifactURI, path string) error {
+	// 1. trim the `file://` prefix if it exists.
+	artifactURI = strings.TrimPrefix(artifactURI, "file://")
+
+	// 2. process `path` parameter.
+	absPath := filepath.Join(artifactURI, path)
+
+	// 3. check that the path exists.
+	if _, err := os.Stat(absPath); err != nil {
+		return eris.Wrap(err, "path could not be opened")
+	}
+
+	// 4. remove the file or the directory.
+	if err := os.RemoveAll(absPath); err != nil {
+		return eris.Wrap(err, "unable to delete path")
+	}
+
+	return nil
+}
@@ -2,9 +2,11 @@ package storage
 
 import (
 	"context"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -66,6 +68,49 @@ func TestGetArtifact_Error(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestLocal_GetRange_Ok(t *testing.T) {
+	runArtifactRoot := t.TempDir()
+	require.Nil(t, os.WriteFile(filepath.Join(runArtifactRoot, "file.txt"), []byte("artifact content"), fs.ModePerm))
+
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	file, err := storage.GetRange(context.Background(), runArtifactRoot, "file.txt", 9, 4)
+	require.Nil(t, err)
+	//nolint:errcheck
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	require.Nil(t, err)
+	assert.Equal(t, "cont", string(content))
+}
+
+func TestLocal_Stat_Ok(t *testing.T) {
+	runArtifactRoot := t.TempDir()
+	require.Nil(t, os.WriteFile(filepath.Join(runArtifactRoot, "file.txt"), []byte("artifact content"), fs.ModePerm))
+
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	object, err := storage.Stat(context.Background(), "file://"+runArtifactRoot, "file.txt")
+	require.Nil(t, err)
+	assert.Equal(t, ArtifactObject{Path: "file.txt", Size: 16}, object)
+}
+
+func TestLocal_Stat_Error(t *testing.T) {
+	runArtifactRoot := t.TempDir()
+	require.Nil(t, os.Mkdir(filepath.Join(runArtifactRoot, "subdir"), fs.ModePerm))
+
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	_, err = storage.Stat(context.Background(), runArtifactRoot, "non-existent-file")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	_, err = storage.Stat(context.Background(), runArtifactRoot, "subdir")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
 func TestLocal_ListArtifacts_Ok(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -99,7 +144,7 @@ func TestLocal_ListArtifacts_Ok(t *testing.T) {
 			require.Nil(t, err)
 
 			// 3. list artifacts for root dir.
-			rootDirResp, err := storage.List(context.Background(), runArtifactURI, "")
+			rootDirResp, _, err := storage.List(context.Background(), runArtifactURI, "", ListOptions{})
 			assert.Equal(t, 2, len(rootDirResp))
 			assert.Equal(t, []ArtifactObject{
 				{
@@ -116,7 +161,7 @@ func TestLocal_ListArtifacts_Ok(t *testing.T) {
 			require.Nil(t, err)
 
 			// 4. list artifacts for sub dir.
-			subDirResp, err := storage.List(context.Background(), runArtifactURI, "artifact.dir")
+			subDirResp, _, err := storage.List(context.Background(), runArtifactURI, "artifact.dir", ListOptions{})
 			assert.Equal(t, 1, len(subDirResp))
 			assert.Equal(t, ArtifactObject{
 				Path:  "artifact.dir/artifact.file2",
@@ -126,9 +171,143 @@ func TestLocal_ListArtifacts_Ok(t *testing.T) {
 			require.Nil(t, err)
 
 			// 5. list artifacts for non-existing dir.
-			nonExistingDirResp, err := storage.List(context.Background(), runArtifactURI, "non-existing-dir")
+			nonExistingDirResp, _, err := storage.List(
+				context.Background(), runArtifactURI, "non-existing-dir", ListOptions{},
+			)
 			assert.Equal(t, 0, len(nonExistingDirResp))
 			require.Nil(t, err)
 		})
 	}
 }
+
+func TestLocal_ListArtifacts_Paginated_Ok(t *testing.T) {
+	runArtifactDir := t.TempDir()
+
+	// 1. create test artifacts.
+	for _, name := range []string{"artifact.file1", "artifact.file2", "artifact.file3"} {
+		require.Nil(t, os.WriteFile(filepath.Join(runArtifactDir, name), []byte("content"), fs.ModePerm))
+	}
+
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	// 2. list the first page.
+	artifacts, nextPageToken, err := storage.List(
+		context.Background(), runArtifactDir, "", ListOptions{MaxResults: 2},
+	)
+	require.Nil(t, err)
+	assert.Equal(t, []ArtifactObject{
+		{Path: "artifact.file1", Size: 7},
+		{Path: "artifact.file2", Size: 7},
+	}, artifacts)
+	assert.NotEmpty(t, nextPageToken)
+
+	// 3. list the last page.
+	artifacts, nextPageToken, err = storage.List(
+		context.Background(), runArtifactDir, "", ListOptions{MaxResults: 2, PageToken: nextPageToken},
+	)
+	require.Nil(t, err)
+	assert.Equal(t, []ArtifactObject{
+		{Path: "artifact.file3", Size: 7},
+	}, artifacts)
+	assert.Empty(t, nextPageToken)
+}
+
+func TestLocal_ListArtifacts_Recursive_Ok(t *testing.T) {
+	runArtifactDir := t.TempDir()
+
+	// 1. create test artifacts.
+	require.Nil(t, os.MkdirAll(filepath.Join(runArtifactDir, "dir1", "dir2"), fs.ModePerm))
+	require.Nil(t, os.WriteFile(filepath.Join(runArtifactDir, "artifact.file1"), []byte("c"), fs.ModePerm))
+	require.Nil(t, os.WriteFile(filepath.Join(runArtifactDir, "dir1", "artifact.file2"), []byte("cc"), fs.ModePerm))
+	require.Nil(t, os.WriteFile(
+		filepath.Join(runArtifactDir, "dir1", "dir2", "artifact.file3"), []byte("ccc"), fs.ModePerm,
+	))
+
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	// 2. list all the files under root dir.
+	artifacts, nextPageToken, err := storage.List(
+		context.Background(), runArtifactDir, "", ListOptions{Recursive: true},
+	)
+	require.Nil(t, err)
+	assert.Equal(t, []ArtifactObject{
+		{Path: "artifact.file1", Size: 1},
+		{Path: "dir1/artifact.file2", Size: 2},
+		{Path: "dir1/dir2/artifact.file3", Size: 3},
+	}, artifacts)
+	assert.Empty(t, nextPageToken)
+
+	// 3. list all the files under sub dir.
+	artifacts, _, err = storage.List(
+		context.Background(), runArtifactDir, "dir1", ListOptions{Recursive: true},
+	)
+	require.Nil(t, err)
+	assert.Equal(t, []ArtifactObject{
+		{Path: "dir1/artifact.file2", Size: 2},
+		{Path: "dir1/dir2/artifact.file3", Size: 3},
+	}, artifacts)
+}
+
+func TestLocal_ListArtifacts_Error(t *testing.T) {
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	_, _, err = storage.List(context.Background(), t.TempDir(), "", ListOptions{PageToken: "invalid"})
+	assert.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+func TestLocal_PutArtifact_Ok(t *testing.T) {
+	// setup
+	artifactRoot := t.TempDir()
+
+	// invoke
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	err = storage.Put(
+		context.Background(), "file://"+artifactRoot, "artifact.dir/artifact.file", strings.NewReader("content"),
+	)
+	require.Nil(t, err)
+
+	// verify
+	// #nosec G304
+	content, err := os.ReadFile(filepath.Join(artifactRoot, "artifact.dir", "artifact.file"))
+	require.Nil(t, err)
+	assert.Equal(t, "content", string(content))
+}
+
+func TestLocal_DeleteArtifact_Ok(t *testing.T) {
+	// setup
+	artifactRoot := t.TempDir()
+	err := os.Mkdir(filepath.Join(artifactRoot, "artifact.dir"), fs.ModePerm)
+	require.Nil(t, err)
+	err = os.WriteFile(filepath.Join(artifactRoot, "artifact.dir", "artifact.file"), []byte("content"), fs.ModePerm)
+	require.Nil(t, err)
+	err = os.WriteFile(filepath.Join(artifactRoot, "artifact.file"), []byte("content"), fs.ModePerm)
+	require.Nil(t, err)
+
+	// invoke
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	require.Nil(t, storage.Delete(context.Background(), artifactRoot, "artifact.file"))
+	require.Nil(t, storage.Delete(context.Background(), artifactRoot, "artifact.dir"))
+
+	// verify
+	objects, err := os.ReadDir(artifactRoot)
+	require.Nil(t, err)
+	assert.Empty(t, objects)
+}
+
+func TestLocal_DeleteArtifact_Error(t *testing.T) {
+	// invoke
+	storage, err := NewLocal(nil)
+	require.Nil(t, err)
+
+	err = storage.Delete(context.Background(), t.TempDir(), "non-existent-file")
+
+	// verify
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
@@ -0,0 +1,176 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/rotisserie/eris"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+)
+
+// MlflowArtifactsStorageName is a name of the storage proxied through the tracking server.
+const (
+	MlflowArtifactsStorageName = "mlflow-artifacts"
+)
+
+// MlflowArtifacts represents adapter to work with artifacts proxied through the tracking server.
+// It maps `mlflow-artifacts:/<path>` uri onto the configured artifacts destination
+// and delegates all the calls to the storage of this destination.
+type MlflowArtifacts struct {
+	destination string
+	storage     ArtifactStorageProvider
+}
+
+// NewMlflowArtifacts creates new MlflowArtifacts instance.
+func NewMlflowArtifacts(config *config.ServiceConfig, storage ArtifactStorageProvider) *MlflowArtifacts {
+	return &MlflowArtifacts{
+		destination: config.ArtifactsDestination,
+		storage:     storage,
+	}
+}
+
+// List implements ArtifactStorageProvider interface.
+func (s MlflowArtifacts) List(
+	ctx context.Context, artifactURI, path string, options ListOptions,
+) ([]ArtifactObject, string, error) {
+	destinationURI, err := s.resolveURI(artifactURI)
+	if err != nil {
+		return nil, "", err
+	}
+	return s.storage.List(ctx, destinationURI, path, options)
+}
+
+// Get implements ArtifactStorageProvider interface.
+func (s MlflowArtifacts) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, error) {
+	destinationURI, err := s.resolveObjectURI(artifactURI, path)
+	if err != nil {
+		return nil, err
+	}
+	return s.storage.Get(ctx, destinationURI, path)
+}
+
+// GetRange implements ArtifactStorageProvider interface.
+func (s MlflowArtifacts) GetRange(
+	ctx context.Context, artifactURI, path string, offset, length int64,
+) (io.ReadCloser, error) {
+	destinationURI, err := s.resolveObjectURI(artifactURI, path)
+	if err != nil {
+		return nil, err
+	}
+	return s.storage.GetRange(ctx, destinationURI, path, offset, length)
+}
+
+// Stat implements ArtifactStorageProvider interface.
+func (s MlflowArtifacts) Stat(ctx context.Context, artifactURI, path string) (ArtifactObject, error) {
+	destinationURI, err := s.resolveObjectURI(artifactURI, path)
+	if err != nil {
+		return ArtifactObject{}, err
+	}
+	return s.storage.Stat(ctx, destinationURI, path)
+}
+
+// Put implements ArtifactStorageProvider interface.
+func (s MlflowArtifacts) Put(ctx context.Context, artifactURI, path string, reader io.Reader) error {
+	destinationURI, err := s.resolveObjectURI(artifactURI, path)
+	if err != nil {
+		return err
+	}
+	return s.storage.Put(ctx, destinationURI, path, reader)
+}
+
+// Delete implements ArtifactStorageProvider interface.
+func (s MlflowArtifacts) Delete(ctx context.Context, artifactURI, path string) error {
+	destinationURI, err := s.resolveObjectURI(artifactURI, path)
+	if err != nil {
+		return err
+	}
+	return s.storage.Delete(ctx, destinationURI, path)
+}
+
+// CreateMultipartUpload implements ArtifactPresignedStorageProvider interface.
+func (s MlflowArtifacts) CreateMultipartUpload(
+	ctx context.Context, artifactURI, path string, numParts int32,
+) (*MultipartUpload, error) {
+	storage, destinationURI, err := s.getPresignedStorage(artifactURI, path)
+	if err != nil {
+		return nil, err
+	}
+	return storage.CreateMultipartUpload(ctx, destinationURI, path, numParts)
+}
+
+// CompleteMultipartUpload implements ArtifactPresignedStorageProvider interface.
+func (s MlflowArtifacts) CompleteMultipartUpload(
+	ctx context.Context, artifactURI, path, uploadID string, parts []MultipartUploadPart,
+) error {
+	storage, destinationURI, err := s.getPresignedStorage(artifactURI, path)
+	if err != nil {
+		return err
+	}
+	return storage.CompleteMultipartUpload(ctx, destinationURI, path, uploadID, parts)
+}
+
+// AbortMultipartUpload implements ArtifactPresignedStorageProvider interface.
+func (s MlflowArtifacts) AbortMultipartUpload(ctx context.Context, artifactURI, path, uploadID string) error {
+	storage, destinationURI, err := s.getPresignedStorage(artifactURI, path)
+	if err != nil {
+		return err
+	}
+	return storage.AbortMultipartUpload(ctx, destinationURI, path, uploadID)
+}
+
+// GetPresignedDownloadURL implements ArtifactPresignedStorageProvider interface.
+func (s MlflowArtifacts) GetPresignedDownloadURL(ctx context.Context, artifactURI, path string) (string, error) {
+	storage, destinationURI, err := s.getPresignedStorage(artifactURI, path)
+	if err != nil {
+		return "", err
+	}
+	return storage.GetPresignedDownloadURL(ctx, destinationURI, path)
+}
+
+// getPresignedStorage returns destination storage, if it supports presigned urls, and destination uri.
+func (s MlflowArtifacts) getPresignedStorage(
+	artifactURI, path string,
+) (ArtifactPresignedStorageProvider, string, error) {
+	storage, ok := s.storage.(ArtifactPresignedStorageProvider)
+	if !ok {
+		return nil, "", eris.Wrap(ErrUnsupportedOperation, "artifacts destination has no support of presigned urls")
+	}
+	destinationURI, err := s.resolveObjectURI(artifactURI, path)
+	if err != nil {
+		return nil, "", err
+	}
+	return storage, destinationURI, nil
+}
+
+// resolveObjectURI converts `mlflow-artifacts:/<path>` uri into the uri of the artifacts destination and
+// makes check that the object path stays under the uri, so the object is never the whole destination.
+func (s MlflowArtifacts) resolveObjectURI(artifactURI, path string) (string, error) {
+	destinationURI, err := s.resolveURI(artifactURI)
+	if err != nil {
+		return "", err
+	}
+	if !isDestinationObject(artifactURI, path) {
+		return "", eris.Wrapf(ErrInvalidPath, "object path is outside of the artifact location: %s", path)
+	}
+	return destinationURI, nil
+}
+
+// resolveURI converts `mlflow-artifacts:/<path>` uri into the uri of the artifacts destination.
+func (s MlflowArtifacts) resolveURI(artifactURI string) (string, error) {
+	u, err := url.Parse(artifactURI)
+	if err != nil {
+		return "", eris.Wrapf(err, "error parsing artifact uri: %s", artifactURI)
+	}
+	if u.Scheme != MlflowArtifactsStorageName {
+		return "", eris.Errorf("unsupported schema has been provided: %s", u.Scheme)
+	}
+	// paths are joined as plain strings, because local destination is not url-encoded.
+	destinationURI := strings.TrimSuffix(s.destination, "/")
+	if path := strings.Trim(u.Path, "/"); path != "" {
+		destinationURI = destinationURI + "/" + path
+	}
+	return destinationURI, nil
+}
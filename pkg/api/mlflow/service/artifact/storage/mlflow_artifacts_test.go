@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+)
+
+func TestMlflowArtifacts_Ok(t *testing.T) {
+	tests := []struct {
+		name           string
+		destination    string
+		artifactURI    string
+		destinationURI string
+	}{
+		{
+			name:           "RootURI",
+			destination:    "s3://bucket/prefix",
+			artifactURI:    "mlflow-artifacts:/",
+			destinationURI: "s3://bucket/prefix",
+		},
+		{
+			name:           "RunArtifactURI",
+			destination:    "file:///path/to/artifacts/",
+			artifactURI:    "mlflow-artifacts:/1/run_id/artifacts",
+			destinationURI: "file:///path/to/artifacts/1/run_id/artifacts",
+		},
+		{
+			name:           "RunArtifactURIWithHost",
+			destination:    "gs://bucket",
+			artifactURI:    "mlflow-artifacts://localhost:5000/1/run_id/artifacts",
+			destinationURI: "gs://bucket/1/run_id/artifacts",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := strings.NewReader("content")
+			destination := MockArtifactStorageProvider{}
+			destination.On(
+				"List", context.TODO(), tt.destinationURI, "path", ListOptions{},
+			).Return([]ArtifactObject{}, "", nil)
+			destination.On("Put", context.TODO(), tt.destinationURI, "path", mock.Anything).Return(nil)
+			destination.On("Delete", context.TODO(), tt.destinationURI, "path").Return(nil)
+
+			storage := NewMlflowArtifacts(&config.ServiceConfig{ArtifactsDestination: tt.destination}, &destination)
+			_, _, err := storage.List(context.TODO(), tt.artifactURI, "path", ListOptions{})
+			require.Nil(t, err)
+			require.Nil(t, storage.Put(context.TODO(), tt.artifactURI, "path", reader))
+			require.Nil(t, storage.Delete(context.TODO(), tt.artifactURI, "path"))
+			destination.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMlflowArtifacts_Error(t *testing.T) {
+	storage := NewMlflowArtifacts(
+		&config.ServiceConfig{ArtifactsDestination: "s3://bucket"}, &MockArtifactStorageProvider{},
+	)
+	_, _, err := storage.List(context.TODO(), "s3://bucket/1/run_id/artifacts", "path", ListOptions{})
+	assert.EqualError(t, err, "unsupported schema has been provided: s3")
+}
+
+func TestMlflowArtifacts_InvalidPath(t *testing.T) {
+	tests := []struct {
+		name        string
+		artifactURI string
+		path        string
+	}{
+		{name: "DestinationRoot", artifactURI: "mlflow-artifacts:/", path: ""},
+		{name: "DestinationRootDot", artifactURI: "mlflow-artifacts:/", path: "."},
+		{name: "DestinationRootDotSlash", artifactURI: "mlflow-artifacts:/", path: "./"},
+		{name: "DestinationRootParent", artifactURI: "mlflow-artifacts:/", path: "a/.."},
+		{name: "OutsideArtifactURI", artifactURI: "mlflow-artifacts:/1/run_id/artifacts", path: "../../other"},
+	}
+
+	// destination storage is never called for invalid paths.
+	storage := NewMlflowArtifacts(
+		&config.ServiceConfig{ArtifactsDestination: "/tmp/artifacts"}, &MockArtifactStorageProvider{},
+	)
+	presignedStorage := NewMlflowArtifacts(
+		&config.ServiceConfig{ArtifactsDestination: "s3://bucket"},
+		struct {
+			*MockArtifactStorageProvider
+			*MockArtifactPresignedStorageProvider
+		}{&MockArtifactStorageProvider{}, &MockArtifactPresignedStorageProvider{}},
+	)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, storage.Delete(context.TODO(), tt.artifactURI, tt.path), ErrInvalidPath)
+			assert.ErrorIs(t, storage.Put(context.TODO(), tt.artifactURI, tt.path, strings.NewReader("")), ErrInvalidPath)
+			_, err := storage.Get(context.TODO(), tt.artifactURI, tt.path)
+			assert.ErrorIs(t, err, ErrInvalidPath)
+			_, err = storage.GetRange(context.TODO(), tt.artifactURI, tt.path, 0, 1)
+			assert.ErrorIs(t, err, ErrInvalidPath)
+			_, err = storage.Stat(context.TODO(), tt.artifactURI, tt.path)
+			assert.ErrorIs(t, err, ErrInvalidPath)
+			_, err = presignedStorage.GetPresignedDownloadURL(context.TODO(), tt.artifactURI, tt.path)
+			assert.ErrorIs(t, err, ErrInvalidPath)
+		})
+	}
+}
@@ -4,6 +4,7 @@ package storage
 
 import (
 	context "context"
+
 	io "io"
 
 	mock "github.com/stretchr/testify/mock"
@@ -14,6 +15,20 @@ type MockArtifactStorageProvider struct {
 	mock.Mock
 }
 
+// Delete provides a mock function with given fields: ctx, artifactURI, path
+func (_m *MockArtifactStorageProvider) Delete(ctx context.Context, artifactURI string, path string) error {
+	ret := _m.Called(ctx, artifactURI, path)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, artifactURI, path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: ctx, artifactURI, path
 func (_m *MockArtifactStorageProvider) Get(ctx context.Context, artifactURI string, path string) (io.ReadCloser, error) {
 	ret := _m.Called(ctx, artifactURI, path)
@@ -40,23 +55,94 @@ func (_m *MockArtifactStorageProvider) Get(ctx context.Context, artifactURI stri
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx, artifactURI, path
-func (_m *MockArtifactStorageProvider) List(ctx context.Context, artifactURI string, path string) ([]ArtifactObject, error) {
-	ret := _m.Called(ctx, artifactURI, path)
+// GetRange provides a mock function with given fields: ctx, artifactURI, path, offset, length
+func (_m *MockArtifactStorageProvider) GetRange(ctx context.Context, artifactURI string, path string, offset int64, length int64) (io.ReadCloser, error) {
+	ret := _m.Called(ctx, artifactURI, path, offset, length)
 
-	var r0 []ArtifactObject
+	var r0 io.ReadCloser
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]ArtifactObject, error)); ok {
-		return rf(ctx, artifactURI, path)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64) (io.ReadCloser, error)); ok {
+		return rf(ctx, artifactURI, path, offset, length)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) []ArtifactObject); ok {
-		r0 = rf(ctx, artifactURI, path)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64) io.ReadCloser); ok {
+		r0 = rf(ctx, artifactURI, path, offset, length)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, int64) error); ok {
+		r1 = rf(ctx, artifactURI, path, offset, length)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, artifactURI, path, options
+func (_m *MockArtifactStorageProvider) List(ctx context.Context, artifactURI string, path string, options ListOptions) ([]ArtifactObject, string, error) {
+	ret := _m.Called(ctx, artifactURI, path, options)
+
+	var r0 []ArtifactObject
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ListOptions) ([]ArtifactObject, string, error)); ok {
+		return rf(ctx, artifactURI, path, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ListOptions) []ArtifactObject); ok {
+		r0 = rf(ctx, artifactURI, path, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]ArtifactObject)
 		}
 	}
 
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, ListOptions) string); ok {
+		r1 = rf(ctx, artifactURI, path, options)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, ListOptions) error); ok {
+		r2 = rf(ctx, artifactURI, path, options)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Put provides a mock function with given fields: ctx, artifactURI, path, reader
+func (_m *MockArtifactStorageProvider) Put(ctx context.Context, artifactURI string, path string, reader io.Reader) error {
+	ret := _m.Called(ctx, artifactURI, path, reader)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, io.Reader) error); ok {
+		r0 = rf(ctx, artifactURI, path, reader)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Stat provides a mock function with given fields: ctx, artifactURI, path
+func (_m *MockArtifactStorageProvider) Stat(ctx context.Context, artifactURI string, path string) (ArtifactObject, error) {
+	ret := _m.Called(ctx, artifactURI, path)
+
+	var r0 ArtifactObject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (ArtifactObject, error)); ok {
+		return rf(ctx, artifactURI, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ArtifactObject); ok {
+		r0 = rf(ctx, artifactURI, path)
+	} else {
+		r0 = ret.Get(0).(ArtifactObject)
+	}
+
 	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
 		r1 = rf(ctx, artifactURI, path)
 	} else {
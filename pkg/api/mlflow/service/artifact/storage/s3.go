@@ -1,11 +1,14 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"io/fs"
 	"path/filepath"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsConfig "github.com/aws/aws-sdk-go-v2/config"
@@ -22,9 +25,17 @@ const (
 	S3StorageName = "s3"
 )
 
+// s3UploadPartSize is a size of the single part of multipart upload.
+// objects which are smaller than this size are uploaded with a single request.
+const s3UploadPartSize = 8 * 1024 * 1024
+
+// s3DeleteBatchSize is a maximum number of objects which could be deleted with a single request.
+const s3DeleteBatchSize = 1000
+
 // S3 represents S3 adapter to work with artifacts.
 type S3 struct {
-	client *s3.Client
+	client        *s3.Client
+	presignClient *s3.PresignClient
 }
 
 // NewS3 creates new S3 instance.
@@ -55,21 +66,34 @@ func NewS3(ctx context.Context, config *config.ServiceConfig) (*S3, error) {
 		return nil, eris.Wrap(err, "error loading configuration for S3 client")
 	}
 
+	client := s3.NewFromConfig(cfg, clientOptions...)
 	return &S3{
-		s3.NewFromConfig(cfg, clientOptions...),
+		client:        client,
+		presignClient: s3.NewPresignClient(client, s3.WithPresignExpires(presignedURLExpiration)),
 	}, nil
 }
 
 // List implements ArtifactStorageProvider interface.
-func (s S3) List(ctx context.Context, artifactURI, path string) ([]ArtifactObject, error) {
+func (s S3) List(
+	ctx context.Context, artifactURI, path string, options ListOptions,
+) ([]ArtifactObject, string, error) {
 	// 1. create s3 request input.
 	bucket, rootPrefix, err := ExtractBucketAndPrefix(artifactURI)
 	if err != nil {
-		return nil, eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+		return nil, "", eris.Wrap(err, "error extracting bucket and prefix from provided uri")
 	}
 	input := s3.ListObjectsV2Input{
-		Bucket:    aws.String(bucket),
-		Delimiter: aws.String("/"),
+		Bucket: aws.String(bucket),
+	}
+	// recursive listing has no delimiter, so only objects are returned.
+	if !options.Recursive {
+		input.Delimiter = aws.String("/")
+	}
+	if options.PageToken != "" {
+		input.ContinuationToken = aws.String(options.PageToken)
+	}
+	if options.MaxResults > 0 {
+		input.MaxKeys = aws.Int32(options.MaxResults)
 	}
 
 	// 2. process search `path` parameter.
@@ -79,20 +103,20 @@ func (s S3) List(ctx context.Context, artifactURI, path string) ([]ArtifactObjec
 	}
 	input.Prefix = aws.String(prefix)
 
-	// 3. read data from s3 storage.
-	var artifactList []ArtifactObject
+	// 3. read data from s3 storage. only the single page is read, when the page size is limited.
+	artifactList := []ArtifactObject{}
 	paginator := s3.NewListObjectsV2Paginator(s.client, &input)
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, eris.Wrap(err, "error getting s3 page objects")
+			return nil, "", eris.Wrap(err, "error getting s3 page objects")
 		}
 
 		log.Debugf("got %d directories from S3 storage for bucket %q and prefix %q", len(page.CommonPrefixes), bucket, prefix)
 		for _, dir := range page.CommonPrefixes {
 			relPath, err := filepath.Rel(rootPrefix, *dir.Prefix)
 			if err != nil {
-				return nil, eris.Wrapf(err, "error getting relative path for dir: %s", *dir.Prefix)
+				return nil, "", eris.Wrapf(err, "error getting relative path for dir: %s", *dir.Prefix)
 			}
 			artifactList = append(artifactList, ArtifactObject{
 				Path:  relPath,
@@ -103,9 +127,13 @@ func (s S3) List(ctx context.Context, artifactURI, path string) ([]ArtifactObjec
 
 		log.Debugf("got %d objects from S3 storage for bucket %q and prefix %q", len(page.Contents), bucket, prefix)
 		for _, object := range page.Contents {
+			// skip placeholder objects of directories.
+			if options.Recursive && strings.HasSuffix(*object.Key, "/") {
+				continue
+			}
 			relPath, err := filepath.Rel(rootPrefix, *object.Key)
 			if err != nil {
-				return nil, eris.Wrapf(err, "error getting relative path for object: %s", *object.Key)
+				return nil, "", eris.Wrapf(err, "error getting relative path for object: %s", *object.Key)
 			}
 			artifactList = append(artifactList, ArtifactObject{
 				Path:  relPath,
@@ -113,9 +141,13 @@ func (s S3) List(ctx context.Context, artifactURI, path string) ([]ArtifactObjec
 				IsDir: false,
 			})
 		}
+
+		if options.MaxResults > 0 {
+			return artifactList, aws.ToString(page.NextContinuationToken), nil
+		}
 	}
 
-	return artifactList, nil
+	return artifactList, "", nil
 }
 
 // Get returns file content at the storage location.
@@ -144,3 +176,330 @@ func (s S3) Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, e
 
 	return resp.Body, nil
 }
+
+// GetRange implements ArtifactStorageProvider interface.
+func (s S3) GetRange(ctx context.Context, artifactURI, path string, offset, length int64) (io.ReadCloser, error) {
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	resp, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(filepath.Join(prefix, path)),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)),
+	})
+	if err != nil {
+		var s3NoSuchKey *types.NoSuchKey
+		if errors.As(err, &s3NoSuchKey) {
+			return nil, eris.Wrap(fs.ErrNotExist, "object does not exist")
+		}
+		return nil, eris.Wrap(err, "error getting object range")
+	}
+
+	return resp.Body, nil
+}
+
+// Stat implements ArtifactStorageProvider interface.
+func (s S3) Stat(ctx context.Context, artifactURI, path string) (ArtifactObject, error) {
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return ArtifactObject{}, eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	resp, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(filepath.Join(prefix, path)),
+	})
+	if err != nil {
+		// HeadObject has no body, so the missing object is reported as NotFound instead of NoSuchKey.
+		var s3NotFound *types.NotFound
+		if errors.As(err, &s3NotFound) {
+			return ArtifactObject{}, eris.Wrap(fs.ErrNotExist, "object does not exist")
+		}
+		return ArtifactObject{}, eris.Wrap(err, "error getting object metadata")
+	}
+
+	return ArtifactObject{
+		Path: path,
+		Size: aws.ToInt64(resp.ContentLength),
+	}, nil
+}
+
+// Put streams content of provided io.Reader into the object at the storage location.
+// Content is buffered by parts, so the whole object is never kept in memory.
+func (s S3) Put(ctx context.Context, artifactURI, path string, reader io.Reader) error {
+	// 1. process input parameters.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+	key := filepath.Join(prefix, path)
+
+	// 2. read the first part. if the whole content fits into it, then upload it with a single request.
+	buffer := make([]byte, s3UploadPartSize)
+	n, err := io.ReadFull(reader, buffer)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		if _, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(key),
+			Body:   bytes.NewReader(buffer[:n]),
+		}); err != nil {
+			return eris.Wrap(err, "error putting object")
+		}
+		return nil
+	}
+	if err != nil {
+		return eris.Wrap(err, "error reading object content")
+	}
+
+	// 3. otherwise upload the content part by part.
+	upload, err := s.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return eris.Wrap(err, "error creating multipart upload")
+	}
+	parts, err := s.uploadParts(ctx, upload, reader, buffer, n)
+	if err != nil {
+		if _, abortErr := s.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+			Bucket:   upload.Bucket,
+			Key:      upload.Key,
+			UploadId: upload.UploadId,
+		}); abortErr != nil {
+			log.Errorf("error aborting multipart upload for key %q: %s", key, abortErr)
+		}
+		return err
+	}
+	if _, err := s.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:   upload.Bucket,
+		Key:      upload.Key,
+		UploadId: upload.UploadId,
+		MultipartUpload: &types.CompletedMultipartUpload{
+			Parts: parts,
+		},
+	}); err != nil {
+		return eris.Wrap(err, "error completing multipart upload")
+	}
+
+	return nil
+}
+
+// uploadParts uploads content of provided io.Reader as parts of the multipart upload.
+// buffer already contains first `n` bytes of the content.
+func (s S3) uploadParts(
+	ctx context.Context, upload *s3.CreateMultipartUploadOutput, reader io.Reader, buffer []byte, n int,
+) ([]types.CompletedPart, error) {
+	var parts []types.CompletedPart
+	for partNumber := int32(1); n > 0; partNumber++ {
+		part, err := s.client.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:     upload.Bucket,
+			Key:        upload.Key,
+			UploadId:   upload.UploadId,
+			PartNumber: aws.Int32(partNumber),
+			Body:       bytes.NewReader(buffer[:n]),
+		})
+		if err != nil {
+			return nil, eris.Wrapf(err, "error uploading part %d", partNumber)
+		}
+		parts = append(parts, types.CompletedPart{
+			ETag:       part.ETag,
+			PartNumber: aws.Int32(partNumber),
+		})
+
+		n, err = io.ReadFull(reader, buffer)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, eris.Wrap(err, "error reading object content")
+		}
+	}
+	return parts, nil
+}
+
+// Delete deletes the object or all the objects under the path at the storage location.
+func (s S3) Delete(ctx context.Context, artifactURI, path string) error {
+	// 1. process input parameters.
+	bucketName, rootPrefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+	key := filepath.Join(rootPrefix, path)
+	prefix := key
+	if prefix != "" {
+		prefix = prefix + "/"
+	}
+
+	// 2. collect the object itself and all the objects under the path.
+	var objects []types.ObjectIdentifier
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(key),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return eris.Wrap(err, "error getting s3 page objects")
+		}
+		for _, object := range page.Contents {
+			if *object.Key == key || strings.HasPrefix(*object.Key, prefix) {
+				objects = append(objects, types.ObjectIdentifier{Key: object.Key})
+			}
+		}
+	}
+	if len(objects) == 0 {
+		return eris.Wrap(fs.ErrNotExist, "object does not exist")
+	}
+
+	// 3. delete objects by batches.
+	log.Debugf("deleting %d objects from S3 storage for bucket %q and prefix %q", len(objects), bucketName, key)
+	for start := 0; start < len(objects); start += s3DeleteBatchSize {
+		output, err := s.client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucketName),
+			Delete: &types.Delete{
+				Objects: objects[start:min(start+s3DeleteBatchSize, len(objects))],
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return eris.Wrap(err, "error deleting objects")
+		}
+		if len(output.Errors) > 0 {
+			return eris.Errorf("error deleting object %q: %s", *output.Errors[0].Key, *output.Errors[0].Message)
+		}
+	}
+
+	return nil
+}
+
+// CreateMultipartUpload implements ArtifactPresignedStorageProvider interface.
+func (s S3) CreateMultipartUpload(
+	ctx context.Context, artifactURI, path string, numParts int32,
+) (*MultipartUpload, error) {
+	// 1. process input parameters.
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return nil, eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+	key := filepath.Join(prefix, path)
+
+	// 2. start multipart upload.
+	upload, err := s.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, eris.Wrap(err, "error creating multipart upload")
+	}
+
+	// 3. presign upload of each part.
+	credentials := make([]MultipartUploadCredential, numParts)
+	for i := range credentials {
+		partNumber := int32(i + 1)
+		request, err := s.presignClient.PresignUploadPart(ctx, &s3.UploadPartInput{
+			Bucket:     upload.Bucket,
+			Key:        upload.Key,
+			UploadId:   upload.UploadId,
+			PartNumber: aws.Int32(partNumber),
+		})
+		if err != nil {
+			return nil, eris.Wrapf(err, "error presigning upload of part %d", partNumber)
+		}
+		credentials[i] = MultipartUploadCredential{
+			URL:        request.URL,
+			PartNumber: partNumber,
+			Headers:    map[string]string{},
+		}
+	}
+
+	return &MultipartUpload{
+		UploadID:    *upload.UploadId,
+		Credentials: credentials,
+	}, nil
+}
+
+// CompleteMultipartUpload implements ArtifactPresignedStorageProvider interface.
+func (s S3) CompleteMultipartUpload(
+	ctx context.Context, artifactURI, path, uploadID string, parts []MultipartUploadPart,
+) error {
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	completedParts := make([]types.CompletedPart, len(parts))
+	for i, part := range parts {
+		completedParts[i] = types.CompletedPart{
+			ETag:       aws.String(part.ETag),
+			PartNumber: aws.Int32(part.PartNumber),
+		}
+	}
+	if _, err := s.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:   aws.String(bucketName),
+		Key:      aws.String(filepath.Join(prefix, path)),
+		UploadId: aws.String(uploadID),
+		MultipartUpload: &types.CompletedMultipartUpload{
+			Parts: completedParts,
+		},
+	}); err != nil {
+		var s3NoSuchUpload *types.NoSuchUpload
+		if errors.As(err, &s3NoSuchUpload) {
+			return eris.Wrap(fs.ErrNotExist, "multipart upload does not exist")
+		}
+		return eris.Wrap(err, "error completing multipart upload")
+	}
+
+	return nil
+}
+
+// AbortMultipartUpload implements ArtifactPresignedStorageProvider interface.
+func (s S3) AbortMultipartUpload(ctx context.Context, artifactURI, path, uploadID string) error {
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	if _, err := s.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(bucketName),
+		Key:      aws.String(filepath.Join(prefix, path)),
+		UploadId: aws.String(uploadID),
+	}); err != nil {
+		var s3NoSuchUpload *types.NoSuchUpload
+		if errors.As(err, &s3NoSuchUpload) {
+			return eris.Wrap(fs.ErrNotExist, "multipart upload does not exist")
+		}
+		return eris.Wrap(err, "error aborting multipart upload")
+	}
+
+	return nil
+}
+
+// GetPresignedDownloadURL implements ArtifactPresignedStorageProvider interface.
+func (s S3) GetPresignedDownloadURL(ctx context.Context, artifactURI, path string) (string, error) {
+	bucketName, prefix, err := ExtractBucketAndPrefix(artifactURI)
+	if err != nil {
+		return "", eris.Wrap(err, "error extracting bucket and prefix from provided uri")
+	}
+
+	// check that the object exists, so the client does not get url to nowhere.
+	key := filepath.Join(prefix, path)
+	if _, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	}); err != nil {
+		var s3NotFound *types.NotFound
+		if errors.As(err, &s3NotFound) {
+			return "", eris.Wrap(fs.ErrNotExist, "object does not exist")
+		}
+		return "", eris.Wrap(err, "error getting object")
+	}
+
+	request, err := s.presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return "", eris.Wrap(err, "error presigning object download")
+	}
+
+	return request.URL, nil
+}
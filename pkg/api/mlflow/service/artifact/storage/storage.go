@@ -2,9 +2,11 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/url"
 	"sync"
+	"time"
 
 	"github.com/rotisserie/eris"
 
@@ -33,12 +35,78 @@ func (o ArtifactObject) IsDirectory() bool {
 	return o.IsDir
 }
 
+// ListOptions represents options of artifact objects listing.
+type ListOptions struct {
+	// PageToken is the token of the page to start from, returned together with the previous page.
+	PageToken string
+	// MaxResults is the maximum number of objects on the page. Zero means no limit.
+	MaxResults int32
+	// Recursive lists all the files under the path instead of a single directory level.
+	// Directories themselves are not returned in this mode.
+	Recursive bool
+}
+
+// ErrInvalidPageToken is returned when provided page token can't be used by the storage.
+var ErrInvalidPageToken = errors.New("page token is invalid")
+
+// ErrInvalidPath is returned when provided path doesn't name an object under the artifact location.
+var ErrInvalidPath = errors.New("path is invalid")
+
 // ArtifactStorageProvider provides an interface to work with artifact storage.
 type ArtifactStorageProvider interface {
 	// Get returns an io.ReadCloser for specific artifact.
 	Get(ctx context.Context, artifactURI, path string) (io.ReadCloser, error)
-	// List lists all artifact object under provided path.
-	List(ctx context.Context, artifactURI, path string) ([]ArtifactObject, error)
+	// GetRange returns an io.ReadCloser for `length` bytes of specific artifact starting from `offset`.
+	GetRange(ctx context.Context, artifactURI, path string, offset, length int64) (io.ReadCloser, error)
+	// Stat returns the artifact object of the single file. Directories are reported as not existing objects.
+	Stat(ctx context.Context, artifactURI, path string) (ArtifactObject, error)
+	// List lists artifact objects under provided path. Token of the next page is returned
+	// when there are more objects than `options.MaxResults`.
+	List(ctx context.Context, artifactURI, path string, options ListOptions) ([]ArtifactObject, string, error)
+	// Put streams the content of provided io.Reader into the artifact object under provided path.
+	Put(ctx context.Context, artifactURI, path string, reader io.Reader) error
+	// Delete deletes the artifact object or all artifact objects under provided path.
+	Delete(ctx context.Context, artifactURI, path string) error
+}
+
+// ErrUnsupportedOperation is returned when operation is not supported by the selected storage.
+var ErrUnsupportedOperation = errors.New("operation is not supported by the storage")
+
+// presignedURLExpiration is the lifetime of presigned urls issued by the storages.
+const presignedURLExpiration = 1 * time.Hour
+
+// MultipartUploadCredential represents presigned url to upload the single part of the artifact object.
+type MultipartUploadCredential struct {
+	URL        string
+	PartNumber int32
+	Headers    map[string]string
+}
+
+// MultipartUpload represents started multipart upload of the artifact object.
+type MultipartUpload struct {
+	UploadID    string
+	Credentials []MultipartUploadCredential
+}
+
+// MultipartUploadPart represents uploaded part of the artifact object.
+type MultipartUploadPart struct {
+	PartNumber int32
+	ETag       string
+}
+
+// ArtifactPresignedStorageProvider provides an interface to transfer artifact objects
+// directly to and from the storage, using presigned urls.
+type ArtifactPresignedStorageProvider interface {
+	// CreateMultipartUpload starts multipart upload and returns presigned url for each part.
+	CreateMultipartUpload(ctx context.Context, artifactURI, path string, numParts int32) (*MultipartUpload, error)
+	// CompleteMultipartUpload completes multipart upload from the uploaded parts.
+	CompleteMultipartUpload(
+		ctx context.Context, artifactURI, path, uploadID string, parts []MultipartUploadPart,
+	) error
+	// AbortMultipartUpload aborts multipart upload and removes already uploaded parts.
+	AbortMultipartUpload(ctx context.Context, artifactURI, path, uploadID string) error
+	// GetPresignedDownloadURL returns presigned url to download the artifact object.
+	GetPresignedDownloadURL(ctx context.Context, artifactURI, path string) (string, error)
 }
 
 // ArtifactStorageFactoryProvider provides an interface provider to work with Artifact Storage.
@@ -90,6 +158,21 @@ func (s *ArtifactStorageFactory) GetStorage(
 		if err != nil {
 			return nil, eris.Wrap(err, "error initializing s3 artifact storage")
 		}
+	case AzureBlobStorageName, AzureDataLakeStorageName:
+		var err error
+		storage, err = NewAzure(s.config)
+		if err != nil {
+			return nil, eris.Wrap(err, "error initializing azure artifact storage")
+		}
+	case MlflowArtifactsStorageName:
+		if s.config.ArtifactsDestination == "" {
+			return nil, eris.New("artifacts destination is not configured")
+		}
+		destination, err := s.GetStorage(ctx, s.config.ArtifactsDestination)
+		if err != nil {
+			return nil, eris.Wrap(err, "error initializing artifacts destination storage")
+		}
+		storage = NewMlflowArtifacts(s.config, destination)
 	case "", LocalStorageName:
 		var err error
 		storage, err = NewLocal(s.config)
@@ -2,6 +2,7 @@ package artifact
 
 import (
 	"net/url"
+	"path"
 	"path/filepath"
 	"slices"
 	"strings"
@@ -16,6 +17,10 @@ func ValidateListArtifactsRequest(req *request.ListArtifactsRequest) error {
 		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
 	}
 
+	if req.MaxResults < 0 {
+		return api.NewInvalidParameterValueError("Invalid value for parameter 'max_results' supplied")
+	}
+
 	return validatePath(req.Path)
 }
 
@@ -28,6 +33,111 @@ func ValidateGetArtifactRequest(req *request.GetArtifactRequest) error {
 	return validatePath(req.Path)
 }
 
+// ValidateDownloadArtifactsRequest validates `GET /mlflow/artifacts/download` request.
+func ValidateDownloadArtifactsRequest(req *request.DownloadArtifactsRequest) error {
+	if req.RunID == "" && req.RunUUID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+
+	if req.Format != "" && req.Format != ArchiveFormatZip && req.Format != ArchiveFormatTarGz {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'format' supplied. It must be one of: %s, %s",
+			ArchiveFormatZip, ArchiveFormatTarGz,
+		)
+	}
+
+	return validatePath(req.Path)
+}
+
+// ValidateListProxiedArtifactsRequest validates `GET /mlflow-artifacts/artifacts` request.
+func ValidateListProxiedArtifactsRequest(req *request.ListProxiedArtifactsRequest) error {
+	return validatePath(req.Path)
+}
+
+// ValidateDownloadProxiedArtifactRequest validates `GET /mlflow-artifacts/artifacts/*` request.
+func ValidateDownloadProxiedArtifactRequest(req *request.DownloadProxiedArtifactRequest) error {
+	return validateRequiredPath(req.Path)
+}
+
+// ValidateUploadProxiedArtifactRequest validates `PUT /mlflow-artifacts/artifacts/*` request.
+func ValidateUploadProxiedArtifactRequest(req *request.UploadProxiedArtifactRequest) error {
+	return validateRequiredPath(req.Path)
+}
+
+// ValidateDeleteProxiedArtifactRequest validates `DELETE /mlflow-artifacts/artifacts/*` request.
+func ValidateDeleteProxiedArtifactRequest(req *request.DeleteProxiedArtifactRequest) error {
+	return validateRequiredPath(req.Path)
+}
+
+// ValidateCreateMultipartUploadRequest validates `POST /mlflow-artifacts/mpu/create/*` request.
+func ValidateCreateMultipartUploadRequest(req *request.CreateMultipartUploadRequest) error {
+	if req.NumParts < 1 || req.NumParts > MaxMultipartUploadParts {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'num_parts' supplied. It must be between 1 and %d", MaxMultipartUploadParts,
+		)
+	}
+	return validateMultipartUploadPath(req.ArtifactPath, req.Path)
+}
+
+// ValidateCompleteMultipartUploadRequest validates `POST /mlflow-artifacts/mpu/complete/*` request.
+func ValidateCompleteMultipartUploadRequest(req *request.CompleteMultipartUploadRequest) error {
+	if req.UploadID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'upload_id'")
+	}
+	if len(req.Parts) == 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'parts'")
+	}
+	for _, part := range req.Parts {
+		if part.PartNumber < 1 || part.PartNumber > MaxMultipartUploadParts {
+			return api.NewInvalidParameterValueError("Invalid value for parameter 'part_number' supplied")
+		}
+		if part.ETag == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'etag'")
+		}
+	}
+	return validateMultipartUploadPath(req.ArtifactPath, req.Path)
+}
+
+// ValidateAbortMultipartUploadRequest validates `POST /mlflow-artifacts/mpu/abort/*` request.
+func ValidateAbortMultipartUploadRequest(req *request.AbortMultipartUploadRequest) error {
+	if req.UploadID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'upload_id'")
+	}
+	return validateMultipartUploadPath(req.ArtifactPath, req.Path)
+}
+
+// ValidateGetPresignedDownloadURLRequest validates `GET /mlflow-artifacts/presigned-download/*` request.
+func ValidateGetPresignedDownloadURLRequest(req *request.GetPresignedDownloadURLRequest) error {
+	return validateRequiredPath(req.Path)
+}
+
+// validateMultipartUploadPath validates artifact path and local path of the uploaded file. The base name
+// of the local file has to name a file, so that the uploaded object stays under the artifact path.
+func validateMultipartUploadPath(artifactPath, path string) error {
+	if path == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'path'")
+	}
+	if err := validatePath(artifactPath); err != nil {
+		return err
+	}
+	if base := filepath.Base(path); base == "." || base == ".." || base == string(filepath.Separator) {
+		return api.NewInvalidParameterValueError("Invalid path")
+	}
+	return validatePath(getMultipartUploadPath(artifactPath, path))
+}
+
+// validateRequiredPath validates path parameter which must name an object under the artifact location.
+// The path is checked as it is passed to the storage, so it must not be the location itself once cleaned.
+func validateRequiredPath(p string) error {
+	if p == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'path'")
+	}
+	if cleaned := path.Clean(p); cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return api.NewInvalidParameterValueError("Invalid path")
+	}
+	return validatePath(p)
+}
+
 // validatePath validates path parameter.
 func validatePath(path string) error {
 	parsedUrl, err := url.Parse(path)
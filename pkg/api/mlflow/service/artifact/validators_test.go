@@ -91,6 +91,14 @@ func TestValidateListArtifactsRequest_Error(t *testing.T) {
 			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
 			request: &request.ListArtifactsRequest{},
 		},
+		{
+			name:  "NegativeMaxResults",
+			error: api.NewInvalidParameterValueError("Invalid value for parameter 'max_results' supplied"),
+			request: &request.ListArtifactsRequest{
+				RunID:      "run_id",
+				MaxResults: -1,
+			},
+		},
 		{
 			name:  "IncorrectPathProvidedCase1",
 			error: api.NewInvalidParameterValueError("Invalid path"),
@@ -300,3 +308,388 @@ func TestValidateGetArtifactRequest_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDownloadArtifactsRequest_Ok(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *request.DownloadArtifactsRequest
+	}{
+		{
+			name: "DefaultFormat",
+			request: &request.DownloadArtifactsRequest{
+				RunID: "run_id",
+			},
+		},
+		{
+			name: "ZipFormat",
+			request: &request.DownloadArtifactsRequest{
+				RunID:  "run_id",
+				Path:   "foo",
+				Format: ArchiveFormatZip,
+			},
+		},
+		{
+			name: "TarGzFormat",
+			request: &request.DownloadArtifactsRequest{
+				RunUUID: "run_id",
+				Format:  ArchiveFormatTarGz,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Nil(t, ValidateDownloadArtifactsRequest(tt.request))
+		})
+	}
+}
+
+func TestValidateDownloadArtifactsRequest_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		error   error
+		request *request.DownloadArtifactsRequest
+	}{
+		{
+			name:    "EmptyRunIDAndRunUUID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.DownloadArtifactsRequest{},
+		},
+		{
+			name: "UnsupportedFormat",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'format' supplied. It must be one of: zip, tar.gz",
+			),
+			request: &request.DownloadArtifactsRequest{
+				RunID:  "run_id",
+				Format: "rar",
+			},
+		},
+		{
+			name:  "IncorrectPathProvided",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.DownloadArtifactsRequest{
+				RunID: "run_id",
+				Path:  "foo/../bar",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDownloadArtifactsRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateUploadProxiedArtifactRequest_Ok(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *request.UploadProxiedArtifactRequest
+	}{
+		{
+			name: "FilenameNoSlash",
+			request: &request.UploadProxiedArtifactRequest{
+				Path: "foo.bar",
+			},
+		},
+		{
+			name: "Dirname/Filename",
+			request: &request.UploadProxiedArtifactRequest{
+				Path: "1/run_id/artifacts/foo.bar",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Nil(t, ValidateUploadProxiedArtifactRequest(tt.request))
+		})
+	}
+}
+
+func TestValidateUploadProxiedArtifactRequest_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		error   error
+		request *request.UploadProxiedArtifactRequest
+	}{
+		{
+			name:    "EmptyPath",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'path'"),
+			request: &request.UploadProxiedArtifactRequest{},
+		},
+		{
+			name:  "IncorrectPathProvided",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.UploadProxiedArtifactRequest{
+				Path: "foo/../../bar",
+			},
+		},
+		{
+			name:  "IncorrectLeadingSlash",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.UploadProxiedArtifactRequest{
+				Path: "/foo.bar",
+			},
+		},
+		{
+			name:  "CurrentDirectory",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.UploadProxiedArtifactRequest{
+				Path: ".",
+			},
+		},
+		{
+			name:  "CurrentDirectoryWithSlash",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.UploadProxiedArtifactRequest{
+				Path: "./",
+			},
+		},
+		{
+			name:  "ParentOfSubdirectory",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.UploadProxiedArtifactRequest{
+				Path: "foo/..",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUploadProxiedArtifactRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateCreateMultipartUploadRequest_Ok(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *request.CreateMultipartUploadRequest
+	}{
+		{
+			name: "EmptyArtifactPath",
+			request: &request.CreateMultipartUploadRequest{
+				Path:     "model.bin",
+				NumParts: 1,
+			},
+		},
+		{
+			name: "ArtifactPathAndMaxParts",
+			request: &request.CreateMultipartUploadRequest{
+				ArtifactPath: "1/run_id/artifacts",
+				Path:         "/tmp/model.bin",
+				NumParts:     MaxMultipartUploadParts,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Nil(t, ValidateCreateMultipartUploadRequest(tt.request))
+		})
+	}
+}
+
+func TestValidateCreateMultipartUploadRequest_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		error   error
+		request *request.CreateMultipartUploadRequest
+	}{
+		{
+			name: "ZeroNumParts",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'num_parts' supplied. It must be between 1 and 10000",
+			),
+			request: &request.CreateMultipartUploadRequest{
+				Path: "model.bin",
+			},
+		},
+		{
+			name: "TooManyParts",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'num_parts' supplied. It must be between 1 and 10000",
+			),
+			request: &request.CreateMultipartUploadRequest{
+				Path:     "model.bin",
+				NumParts: MaxMultipartUploadParts + 1,
+			},
+		},
+		{
+			name:  "EmptyPath",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'path'"),
+			request: &request.CreateMultipartUploadRequest{
+				NumParts: 1,
+			},
+		},
+		{
+			name:  "IncorrectArtifactPath",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.CreateMultipartUploadRequest{
+				ArtifactPath: "foo/../../bar",
+				Path:         "model.bin",
+				NumParts:     1,
+			},
+		},
+		{
+			name:  "ParentDirectoryPath",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.CreateMultipartUploadRequest{
+				Path:     "..",
+				NumParts: 1,
+			},
+		},
+		{
+			name:  "ParentDirectoryPathWithArtifactPath",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.CreateMultipartUploadRequest{
+				ArtifactPath: "1/run_id/artifacts",
+				Path:         "/tmp/..",
+				NumParts:     1,
+			},
+		},
+		{
+			name:  "CurrentDirectoryPath",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.CreateMultipartUploadRequest{
+				ArtifactPath: "1/run_id/artifacts",
+				Path:         ".",
+				NumParts:     1,
+			},
+		},
+		{
+			name:  "RootPath",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.CreateMultipartUploadRequest{
+				Path:     "/",
+				NumParts: 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCreateMultipartUploadRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateCompleteMultipartUploadRequest_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		error   error
+		request *request.CompleteMultipartUploadRequest
+	}{
+		{
+			name:  "EmptyUploadID",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'upload_id'"),
+			request: &request.CompleteMultipartUploadRequest{
+				Path: "model.bin",
+			},
+		},
+		{
+			name:  "EmptyParts",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'parts'"),
+			request: &request.CompleteMultipartUploadRequest{
+				Path:     "model.bin",
+				UploadID: "upload-id",
+			},
+		},
+		{
+			name:  "IncorrectPartNumber",
+			error: api.NewInvalidParameterValueError("Invalid value for parameter 'part_number' supplied"),
+			request: &request.CompleteMultipartUploadRequest{
+				Path:     "model.bin",
+				UploadID: "upload-id",
+				Parts: []request.MultipartUploadPartPartialRequest{
+					{ETag: "etag"},
+				},
+			},
+		},
+		{
+			name:  "EmptyETag",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'etag'"),
+			request: &request.CompleteMultipartUploadRequest{
+				Path:     "model.bin",
+				UploadID: "upload-id",
+				Parts: []request.MultipartUploadPartPartialRequest{
+					{PartNumber: 1},
+				},
+			},
+		},
+		{
+			name:  "IncorrectArtifactPath",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.CompleteMultipartUploadRequest{
+				ArtifactPath: "/foo",
+				Path:         "model.bin",
+				UploadID:     "upload-id",
+				Parts: []request.MultipartUploadPartPartialRequest{
+					{PartNumber: 1, ETag: "etag"},
+				},
+			},
+		},
+		{
+			name:  "ParentDirectoryPath",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.CompleteMultipartUploadRequest{
+				ArtifactPath: "1/run_id/artifacts",
+				Path:         "..",
+				UploadID:     "upload-id",
+				Parts: []request.MultipartUploadPartPartialRequest{
+					{PartNumber: 1, ETag: "etag"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCompleteMultipartUploadRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateAbortMultipartUploadRequest_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		error   error
+		request *request.AbortMultipartUploadRequest
+	}{
+		{
+			name:  "EmptyUploadID",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'upload_id'"),
+			request: &request.AbortMultipartUploadRequest{
+				Path: "model.bin",
+			},
+		},
+		{
+			name:  "EmptyPath",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'path'"),
+			request: &request.AbortMultipartUploadRequest{
+				UploadID: "upload-id",
+			},
+		},
+		{
+			name:  "ParentDirectoryPath",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: &request.AbortMultipartUploadRequest{
+				Path:     "..",
+				UploadID: "upload-id",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAbortMultipartUploadRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
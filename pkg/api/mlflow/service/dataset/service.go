@@ -0,0 +1,77 @@
+package dataset
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+// Service provides service layer to work with `dataset` business logic.
+type Service struct {
+	runRepository     repositories.RunRepositoryProvider
+	datasetRepository repositories.DatasetRepositoryProvider
+}
+
+// NewService creates new Service instance.
+func NewService(
+	runRepository repositories.RunRepositoryProvider,
+	datasetRepository repositories.DatasetRepositoryProvider,
+) *Service {
+	return &Service{
+		runRepository:     runRepository,
+		datasetRepository: datasetRepository,
+	}
+}
+
+// LogInputs handles logging of the dataset inputs of models.Run entity.
+func (s Service) LogInputs(ctx context.Context, namespace *models.Namespace, req *request.LogInputsRequest) error {
+	if err := ValidateLogInputsRequest(req); err != nil {
+		return err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDRunIDAndLifecycleStage(
+		ctx, namespace.ID, req.RunID, models.LifecycleStageActive,
+	)
+	if err != nil {
+		return api.NewInternalError("Unable to find run '%s': %s", req.RunID, err)
+	}
+	if run == nil {
+		return api.NewResourceDoesNotExistError("Run '%s' not found", req.RunID)
+	}
+
+	if err := s.datasetRepository.LogInputs(ctx, run, convertors.ConvertLogInputsRequestToDBModel(req)); err != nil {
+		return api.NewInternalError("unable to log inputs for run '%s': %s", run.ID, err)
+	}
+	return nil
+}
+
+// SearchDatasets returns summaries of the datasets used by runs of requested experiments.
+func (s Service) SearchDatasets(
+	ctx context.Context, namespace *models.Namespace, req *request.SearchDatasetsRequest,
+) ([]models.DatasetSummary, error) {
+	if err := ValidateSearchDatasetsRequest(req); err != nil {
+		return nil, err
+	}
+
+	experimentIDs := make([]int32, len(req.ExperimentIDs))
+	for i, id := range req.ExperimentIDs {
+		experimentID, err := strconv.ParseInt(id, 10, 32)
+		if err != nil {
+			return nil, api.NewBadRequestError("unable to parse experiment id '%s': %s", id, err)
+		}
+		experimentIDs[i] = int32(experimentID)
+	}
+
+	summaries, err := s.datasetRepository.SearchSummaries(
+		ctx, namespace.ID, experimentIDs, MaxResultsForSearchDatasetsRequest,
+	)
+	if err != nil {
+		return nil, api.NewInternalError("unable to search datasets: %s", err)
+	}
+	return summaries, nil
+}
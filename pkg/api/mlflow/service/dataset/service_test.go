@@ -0,0 +1,178 @@
+package dataset
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rotisserie/eris"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+func TestService_LogInputs_Ok(t *testing.T) {
+	// init repository mocks.
+	run := &models.Run{ID: "1", ExperimentID: 1}
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDRunIDAndLifecycleStage", context.TODO(), uint(1), "1", models.LifecycleStageActive,
+	).Return(run, nil)
+
+	datasetRepository := repositories.MockDatasetRepositoryProvider{}
+	datasetRepository.On(
+		"LogInputs", context.TODO(), run, mock.MatchedBy(func(inputs []models.Input) bool {
+			return len(inputs) == 1 &&
+				inputs[0].Dataset.Name == "name" &&
+				inputs[0].Dataset.Digest == "digest" &&
+				len(inputs[0].Tags) == 1 &&
+				inputs[0].Tags[0].InputID == inputs[0].ID &&
+				inputs[0].Tags[0].Name == models.InputTagContextKey
+		}),
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(&runRepository, &datasetRepository)
+	err := service.LogInputs(context.TODO(), &models.Namespace{ID: 1}, &request.LogInputsRequest{
+		RunID: "1",
+		Datasets: []request.DatasetInputPartialRequest{{
+			Tags: []request.InputTagPartialRequest{{Key: models.InputTagContextKey, Value: "training"}},
+			Dataset: request.DatasetPartialRequest{
+				Name:       "name",
+				Digest:     "digest",
+				SourceType: "local",
+				Source:     "source",
+			},
+		}},
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	datasetRepository.AssertExpectations(t)
+}
+
+func TestService_LogInputs_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.LogInputsRequest
+		service func() *Service
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.LogInputsRequest{},
+			service: func() *Service {
+				return NewService(&repositories.MockRunRepositoryProvider{}, &repositories.MockDatasetRepositoryProvider{})
+			},
+		},
+		{
+			name:    "RunNotFound",
+			error:   api.NewResourceDoesNotExistError("Run '1' not found"),
+			request: &request.LogInputsRequest{RunID: "1"},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDRunIDAndLifecycleStage", context.TODO(), uint(1), "1", models.LifecycleStageActive,
+				).Return(nil, nil)
+				return NewService(&runRepository, &repositories.MockDatasetRepositoryProvider{})
+			},
+		},
+		{
+			name:    "DatabaseError",
+			error:   api.NewInternalError("unable to log inputs for run '1': database error"),
+			request: &request.LogInputsRequest{RunID: "1"},
+			service: func() *Service {
+				run := &models.Run{ID: "1"}
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDRunIDAndLifecycleStage", context.TODO(), uint(1), "1", models.LifecycleStageActive,
+				).Return(run, nil)
+				datasetRepository := repositories.MockDatasetRepositoryProvider{}
+				datasetRepository.On(
+					"LogInputs", context.TODO(), run, []models.Input{},
+				).Return(eris.New("database error"))
+				return NewService(&runRepository, &datasetRepository)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.service().LogInputs(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestService_SearchDatasets_Ok(t *testing.T) {
+	// init repository mocks.
+	summaries := []models.DatasetSummary{
+		{ExperimentID: 1, Name: "name", Digest: "digest", Context: "training"},
+	}
+	datasetRepository := repositories.MockDatasetRepositoryProvider{}
+	datasetRepository.On(
+		"SearchSummaries", context.TODO(), uint(1), []int32{1, 2}, MaxResultsForSearchDatasetsRequest,
+	).Return(summaries, nil)
+
+	// call service under testing.
+	service := NewService(&repositories.MockRunRepositoryProvider{}, &datasetRepository)
+	result, err := service.SearchDatasets(context.TODO(), &models.Namespace{ID: 1}, &request.SearchDatasetsRequest{
+		ExperimentIDs: []string{"1", "2"},
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, summaries, result)
+}
+
+func TestService_SearchDatasets_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.SearchDatasetsRequest
+		service func() *Service
+	}{
+		{
+			name:    "EmptyExperimentIDs",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_ids'"),
+			request: &request.SearchDatasetsRequest{},
+			service: func() *Service {
+				return NewService(&repositories.MockRunRepositoryProvider{}, &repositories.MockDatasetRepositoryProvider{})
+			},
+		},
+		{
+			name: "IncorrectExperimentID",
+			error: api.NewBadRequestError(
+				`unable to parse experiment id 'incorrect_id': strconv.ParseInt: parsing "incorrect_id": invalid syntax`,
+			),
+			request: &request.SearchDatasetsRequest{ExperimentIDs: []string{"incorrect_id"}},
+			service: func() *Service {
+				return NewService(&repositories.MockRunRepositoryProvider{}, &repositories.MockDatasetRepositoryProvider{})
+			},
+		},
+		{
+			name:    "DatabaseError",
+			error:   api.NewInternalError("unable to search datasets: database error"),
+			request: &request.SearchDatasetsRequest{ExperimentIDs: []string{"1"}},
+			service: func() *Service {
+				datasetRepository := repositories.MockDatasetRepositoryProvider{}
+				datasetRepository.On(
+					"SearchSummaries", context.TODO(), uint(1), []int32{1}, MaxResultsForSearchDatasetsRequest,
+				).Return(nil, eris.New("database error"))
+				return NewService(&repositories.MockRunRepositoryProvider{}, &datasetRepository)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.service().SearchDatasets(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
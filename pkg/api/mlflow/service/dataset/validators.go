@@ -0,0 +1,86 @@
+package dataset
+
+import (
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+)
+
+// Limits of the dataset and input tag properties.
+const (
+	MaxDatasetNameLength               = 500
+	MaxDatasetDigestLength             = 36
+	MaxDatasetSourceTypeLength         = 36
+	MaxInputTagKeyLength               = 255
+	MaxInputTagValueLength             = 500
+	MaxExperimentIDsPerSearchDatasets  = 20
+	MaxResultsForSearchDatasetsRequest = 1000
+)
+
+// ValidateLogInputsRequest validates `POST /mlflow/runs/log-inputs` request.
+func ValidateLogInputsRequest(req *request.LogInputsRequest) error {
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+
+	for _, datasetInput := range req.Datasets {
+		dataset := datasetInput.Dataset
+		if dataset.Name == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'dataset.name'")
+		}
+		if len(dataset.Name) > MaxDatasetNameLength {
+			return api.NewInvalidParameterValueError(
+				"Dataset name '%s' exceeds the maximum length of %d", dataset.Name, MaxDatasetNameLength,
+			)
+		}
+		if dataset.Digest == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'dataset.digest'")
+		}
+		if len(dataset.Digest) > MaxDatasetDigestLength {
+			return api.NewInvalidParameterValueError(
+				"Dataset digest '%s' exceeds the maximum length of %d", dataset.Digest, MaxDatasetDigestLength,
+			)
+		}
+		if dataset.SourceType == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'dataset.source_type'")
+		}
+		if len(dataset.SourceType) > MaxDatasetSourceTypeLength {
+			return api.NewInvalidParameterValueError(
+				"Dataset source type '%s' exceeds the maximum length of %d",
+				dataset.SourceType, MaxDatasetSourceTypeLength,
+			)
+		}
+		if dataset.Source == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'dataset.source'")
+		}
+		for _, tag := range datasetInput.Tags {
+			if tag.Key == "" {
+				return api.NewInvalidParameterValueError("Missing value for required parameter 'tag.key'")
+			}
+			if len(tag.Key) > MaxInputTagKeyLength {
+				return api.NewInvalidParameterValueError(
+					"Input tag key '%s' exceeds the maximum length of %d", tag.Key, MaxInputTagKeyLength,
+				)
+			}
+			if len(tag.Value) > MaxInputTagValueLength {
+				return api.NewInvalidParameterValueError(
+					"Value of input tag '%s' exceeds the maximum length of %d", tag.Key, MaxInputTagValueLength,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateSearchDatasetsRequest validates `POST /mlflow/experiments/search-datasets` request.
+func ValidateSearchDatasetsRequest(req *request.SearchDatasetsRequest) error {
+	if len(req.ExperimentIDs) == 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_ids'")
+	}
+	if len(req.ExperimentIDs) > MaxExperimentIDsPerSearchDatasets {
+		return api.NewInvalidParameterValueError(
+			"SearchDatasets request cannot specify more than %d experiment_ids. Received %d experiment_ids.",
+			MaxExperimentIDsPerSearchDatasets, len(req.ExperimentIDs),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package dataset
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+)
+
+func TestValidateLogInputsRequest_Ok(t *testing.T) {
+	err := ValidateLogInputsRequest(&request.LogInputsRequest{
+		RunID: "id",
+		Datasets: []request.DatasetInputPartialRequest{
+			{
+				Tags: []request.InputTagPartialRequest{{Key: "mlflow.data.context", Value: "training"}},
+				Dataset: request.DatasetPartialRequest{
+					Name:       "name",
+					Digest:     "digest",
+					SourceType: "local",
+					Source:     `{"uri": "file:///data.csv"}`,
+				},
+			},
+		},
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateLogInputsRequest_Error(t *testing.T) {
+	dataset := request.DatasetPartialRequest{
+		Name:       "name",
+		Digest:     "digest",
+		SourceType: "local",
+		Source:     "source",
+	}
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.LogInputsRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.LogInputsRequest{},
+		},
+		{
+			name:  "EmptyDatasetName",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'dataset.name'"),
+			request: &request.LogInputsRequest{
+				RunID:    "id",
+				Datasets: []request.DatasetInputPartialRequest{{}},
+			},
+		},
+		{
+			name: "TooLongDatasetDigest",
+			error: api.NewInvalidParameterValueError(
+				"Dataset digest '%s' exceeds the maximum length of 36", strings.Repeat("a", 37),
+			),
+			request: &request.LogInputsRequest{
+				RunID: "id",
+				Datasets: []request.DatasetInputPartialRequest{{
+					Dataset: request.DatasetPartialRequest{Name: "name", Digest: strings.Repeat("a", 37)},
+				}},
+			},
+		},
+		{
+			name:  "EmptyDatasetSourceType",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'dataset.source_type'"),
+			request: &request.LogInputsRequest{
+				RunID: "id",
+				Datasets: []request.DatasetInputPartialRequest{{
+					Dataset: request.DatasetPartialRequest{Name: "name", Digest: "digest"},
+				}},
+			},
+		},
+		{
+			name:  "EmptyDatasetSource",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'dataset.source'"),
+			request: &request.LogInputsRequest{
+				RunID: "id",
+				Datasets: []request.DatasetInputPartialRequest{{
+					Dataset: request.DatasetPartialRequest{Name: "name", Digest: "digest", SourceType: "local"},
+				}},
+			},
+		},
+		{
+			name:  "EmptyTagKey",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'tag.key'"),
+			request: &request.LogInputsRequest{
+				RunID: "id",
+				Datasets: []request.DatasetInputPartialRequest{{
+					Tags:    []request.InputTagPartialRequest{{Value: "value"}},
+					Dataset: dataset,
+				}},
+			},
+		},
+		{
+			name: "TooLongTagValue",
+			error: api.NewInvalidParameterValueError(
+				"Value of input tag 'key' exceeds the maximum length of 500",
+			),
+			request: &request.LogInputsRequest{
+				RunID: "id",
+				Datasets: []request.DatasetInputPartialRequest{{
+					Tags:    []request.InputTagPartialRequest{{Key: "key", Value: strings.Repeat("a", 501)}},
+					Dataset: dataset,
+				}},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLogInputsRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateSearchDatasetsRequest_Ok(t *testing.T) {
+	err := ValidateSearchDatasetsRequest(&request.SearchDatasetsRequest{
+		ExperimentIDs: []string{"1", "2"},
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateSearchDatasetsRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.SearchDatasetsRequest
+	}{
+		{
+			name:    "EmptyExperimentIDs",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_ids'"),
+			request: &request.SearchDatasetsRequest{},
+		},
+		{
+			name: "TooManyExperimentIDs",
+			error: api.NewInvalidParameterValueError(
+				"SearchDatasets request cannot specify more than 20 experiment_ids. Received 21 experiment_ids.",
+			),
+			request: &request.SearchDatasetsRequest{
+				ExperimentIDs: make([]string, 21),
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSearchDatasetsRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package experiment
+
+import (
+	"strconv"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/filter"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// buildFilterCondition compiles the filter expression into the condition of `experiments` query.
+// filterText is the original filter, which is referenced by the errors.
+func buildFilterCondition(filterText string, expression filter.Expression) (clause.Expression, error) {
+	switch expression := expression.(type) {
+	case *filter.LogicalExpression:
+		operands := make([]clause.Expression, len(expression.Operands))
+		for i, operand := range expression.Operands {
+			condition, err := buildFilterCondition(filterText, operand)
+			if err != nil {
+				return nil, err
+			}
+			operands[i] = condition
+		}
+		if expression.Operator == filter.OrOperator {
+			return clause.Or(operands...), nil
+		}
+		return clause.And(operands...), nil
+	case *filter.Comparison:
+		return buildComparisonCondition(filterText, expression)
+	default:
+		return nil, api.NewInternalError("unsupported filter expression %T", expression)
+	}
+}
+
+// buildComparisonCondition compiles single comparison of the filter into the condition of `experiments` query.
+func buildComparisonCondition(filterText string, comparison *filter.Comparison) (clause.Expression, error) {
+	key, operator := comparison.Key, comparison.Operator
+	switch comparison.Entity {
+	case "", "attribute", "attributes", "attr":
+		switch key {
+		case "creation_time", "last_update_time":
+			switch operator {
+			case IsNullExpression, IsNotNullExpression:
+				return buildColumnCondition("experiments."+key, operator, nil), nil
+			case GraterExpression, GraterOrEqualExpression, NotEqualExpression,
+				EqualExpression, LessExpression, LessOrEqualExpression:
+				value, err := strconv.Atoi(comparison.Value.Raw)
+				if err != nil {
+					return nil, filter.NewSemanticError(
+						filterText, comparison.Value.Position(), "invalid numeric value '%s'", comparison.Value.Raw,
+					)
+				}
+				return buildColumnCondition("experiments."+key, operator, value), nil
+			default:
+				return nil, filter.NewSemanticError(
+					filterText, comparison.Position(),
+					"invalid numeric attribute comparison operator '%s'", operator,
+				)
+			}
+		case "name":
+			switch operator {
+			case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
+				return buildColumnCondition("experiments.name", operator, comparison.Value.Text), nil
+			default:
+				return nil, filter.NewSemanticError(
+					filterText, comparison.Position(),
+					"invalid string attribute comparison operator '%s'", operator,
+				)
+			}
+		default:
+			return nil, filter.NewSemanticError(
+				filterText, comparison.Position(),
+				"invalid attribute '%s'. Valid values are ['name', 'creation_time', 'last_update_time']", key,
+			)
+		}
+	case "tag", "tags":
+		switch operator {
+		case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression,
+			InExpression, NotInExpression, IsNullExpression, IsNotNullExpression:
+			return buildTagCondition(key, operator, comparison), nil
+		default:
+			return nil, filter.NewSemanticError(
+				filterText, comparison.Position(), "invalid tag comparison operator '%s'", operator,
+			)
+		}
+	default:
+		return nil, filter.NewSemanticError(
+			filterText, comparison.Position(),
+			"invalid entity type '%s'. Valid values are ['tag', 'attribute']", comparison.Entity,
+		)
+	}
+}
+
+// buildColumnCondition builds condition, which compares the column of `experiments` table with the value.
+func buildColumnCondition(column, operator string, value any) clause.Expression {
+	return filter.BuildCondition(database.DB.Dialector.Name(), column, operator, value)
+}
+
+// buildTagCondition builds condition, which compares the value of the experiment tag.
+// `IS NULL` matches the experiments, which don't have the tag at all.
+func buildTagCondition(key, operator string, comparison *filter.Comparison) clause.Expression {
+	query := database.DB.Model(&database.ExperimentTag{}).Select("experiment_id").Where("key = ?", key)
+	switch operator {
+	case IsNullExpression:
+		return clause.Expr{SQL: "experiments.experiment_id NOT IN (?)", Vars: []any{query}}
+	case IsNotNullExpression:
+		return clause.Expr{SQL: "experiments.experiment_id IN (?)", Vars: []any{query}}
+	}
+
+	var value any
+	if comparison.Value != nil {
+		value = comparison.Value.Text
+	} else {
+		values := make([]string, len(comparison.Values))
+		for i, v := range comparison.Values {
+			values[i] = v.Text
+		}
+		value = values
+	}
+	return clause.Expr{SQL: "experiments.experiment_id IN (?)", Vars: []any{query.Where(
+		filter.BuildCondition(database.DB.Dialector.Name(), "value", operator, value),
+	)}}
+}
@@ -0,0 +1,30 @@
+package experiment
+
+import (
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// sortColumn represents column of the experiments ordering.
+type sortColumn struct {
+	name string
+	desc bool
+}
+
+// getValue returns value of the column in the experiment, which is stored into the keyset page token.
+func (c sortColumn) getValue(experiment *models.Experiment) any {
+	switch c.name {
+	case "experiment_id":
+		return *experiment.ID
+	case "name":
+		return experiment.Name
+	case "creation_time":
+		if experiment.CreationTime.Valid {
+			return experiment.CreationTime.Int64
+		}
+	case "last_update_time":
+		if experiment.LastUpdateTime.Valid {
+			return experiment.LastUpdateTime.Int64
+		}
+	}
+	return nil
+}
@@ -3,8 +3,6 @@ package experiment
 import (
 	"context"
 	"database/sql"
-	"encoding/base64"
-	"encoding/json"
 	"fmt"
 	"net/url"
 	"regexp"
@@ -20,13 +18,13 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/filter"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/webhook"
 	"github.com/G-Research/fasttrackml/pkg/database"
 )
 
 //nolint:lll
 var (
-	filterAnd       = regexp.MustCompile(`(?i)\s+AND\s+`)
-	filterCond      = regexp.MustCompile(`^(?:(\w+)\.)?("[^"]+"|` + "`[^`]+`" + `|[\w\.]+)\s+(<|<=|>|>=|=|!=|(?i:I?LIKE)|(?i:(?:NOT )?IN))\s+(\((?:'[^']+'(?:,\s*)?)+\)|"[^"]+"|'[^']+'|[\w\.]+)$`)
 	experimentOrder = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
 )
 
@@ -42,6 +40,8 @@ const (
 	LessOrEqualExpression   = "<="
 	GraterExpression        = ">"
 	GraterOrEqualExpression = ">="
+	IsNullExpression        = "IS NULL"
+	IsNotNullExpression     = "IS NOT NULL"
 )
 
 // Service provides service layer to work with `metric` business logic.
@@ -49,6 +49,7 @@ type Service struct {
 	config               *config.ServiceConfig
 	tagRepository        repositories.TagRepositoryProvider
 	experimentRepository repositories.ExperimentRepositoryProvider
+	webhookDispatcher    webhook.DispatcherProvider
 }
 
 // NewService creates new Service instance.
@@ -56,11 +57,13 @@ func NewService(
 	config *config.ServiceConfig,
 	tagRepository repositories.TagRepositoryProvider,
 	experimentRepository repositories.ExperimentRepositoryProvider,
+	webhookDispatcher webhook.DispatcherProvider,
 ) *Service {
 	return &Service{
 		config:               config,
 		tagRepository:        tagRepository,
 		experimentRepository: experimentRepository,
+		webhookDispatcher:    webhookDispatcher,
 	}
 }
 
@@ -104,6 +107,9 @@ func (s Service) CreateExperiment(
 			)
 		}
 	}
+	s.webhookDispatcher.Dispatch(
+		ctx, ns, models.WebhookEventExperimentCreated, webhook.NewExperimentData(experiment),
+	)
 
 	return experiment, nil
 }
@@ -206,6 +212,9 @@ func (s Service) DeleteExperiment(
 	if err := s.experimentRepository.Update(ctx, experiment); err != nil {
 		return api.NewInternalError("unable to delete experiment '%d': %s", *experiment.ID, err)
 	}
+	s.webhookDispatcher.Dispatch(
+		ctx, ns, models.WebhookEventExperimentDeleted, webhook.NewExperimentData(experiment),
+	)
 
 	return nil
 }
@@ -237,6 +246,9 @@ func (s Service) RestoreExperiment(
 	if err := s.experimentRepository.Update(ctx, experiment); err != nil {
 		return api.NewInternalError("Unable to restore experiment '%d': %s", *experiment.ID, err)
 	}
+	s.webhookDispatcher.Dispatch(
+		ctx, ns, models.WebhookEventExperimentRestored, webhook.NewExperimentData(experiment),
+	)
 
 	return nil
 }
@@ -270,9 +282,9 @@ func (s Service) SetExperimentTag(
 // TODO:get back and fix `gocyclo` problem.
 func (s Service) SearchExperiments(
 	ctx context.Context, ns *models.Namespace, req *request.SearchExperimentsRequest,
-) ([]models.Experiment, int, int, error) {
+) ([]models.Experiment, *request.PageToken, error) {
 	if err := ValidateSearchExperimentsRequest(req); err != nil {
-		return nil, 0, 0, err
+		return nil, nil, err
 	}
 
 	query := database.DB.Where(
@@ -306,110 +318,36 @@ func (s Service) SearchExperiments(
 	query.Limit(limit + 1)
 
 	// PageToken
-	var offset int
+	var pageToken *request.PageToken
 	if req.PageToken != "" {
-		var token request.PageToken
-		if err := json.NewDecoder(
-			base64.NewDecoder(
-				base64.StdEncoding,
-				strings.NewReader(req.PageToken),
-			),
-		).Decode(&token); err != nil {
-			return nil, 0, 0, api.NewInvalidParameterValueError("invalid page_token '%s': %s", req.PageToken, err)
+		token, err := request.DecodePageToken(req.PageToken)
+		if err != nil {
+			return nil, nil, api.NewInvalidParameterValueError("invalid page_token '%s': %s", req.PageToken, err)
 		}
-		offset = int(token.Offset)
+		pageToken = token
+		query.Offset(int(token.Offset))
 	}
-	query.Offset(offset)
 
 	// Filter
-	if req.Filter != "" {
-		for n, f := range filterAnd.Split(req.Filter, -1) {
-			components := filterCond.FindStringSubmatch(f)
-			if len(components) != 5 {
-				return nil, 0, 0, api.NewInvalidParameterValueError("malformed filter '%s'", f)
-			}
-
-			entity := components[1]
-			key := strings.Trim(components[2], "\"`")
-			comparison := components[3]
-			var value any = components[4]
-
-			switch entity {
-			case "", "attribute", "attributes", "attr":
-				switch key {
-				case "creation_time", "last_update_time":
-					switch comparison {
-					case GraterExpression, GraterOrEqualExpression, NotEqualExpression,
-						EqualExpression, LessExpression, LessOrEqualExpression:
-						v, err := strconv.Atoi(value.(string))
-						if err != nil {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid numeric value '%s'", value)
-						}
-						value = v
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid numeric attribute comparison operator '%s'", comparison,
-						)
-					}
-				case "name":
-					switch strings.ToUpper(comparison) {
-					case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-						if strings.HasPrefix(value.(string), "(") {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-						}
-						value = strings.Trim(value.(string), `"'`)
-						if database.DB.Dialector.Name() == "sqlite" && strings.ToUpper(comparison) == ILikeExpression {
-							key = fmt.Sprintf("LOWER(%s)", key)
-							comparison = LikeExpression
-							value = strings.ToLower(value.(string))
-						}
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid string attribute comparison operator '%s'", comparison,
-						)
-					}
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError(
-						"invalid attribute '%s'. Valid values are ['name', 'creation_time', 'last_update_time']", key,
-					)
-				}
-				query.Where(fmt.Sprintf("%s %s ?", key, comparison), value)
-			case "tag", "tags":
-				switch strings.ToUpper(comparison) {
-				case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-					if strings.HasPrefix(value.(string), "(") {
-						return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-					}
-					value = strings.Trim(value.(string), `"'`)
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError("invalid tag comparison operator '%s'", comparison)
-				}
-				table := fmt.Sprintf("filter_%d", n)
-				where := fmt.Sprintf("value %s ?", comparison)
-				if database.DB.Dialector.Name() == "sqlite" && strings.ToUpper(comparison) == ILikeExpression {
-					where = "LOWER(value) LIKE ?"
-					value = strings.ToLower(value.(string))
-				}
-				query.Joins(
-					fmt.Sprintf("JOIN (?) AS %s ON experiments.experiment_id = %s.experiment_id", table, table),
-					database.DB.Select(
-						"experiment_id", "value",
-					).Where("key = ?", key).Where(where, value).Model(&database.ExperimentTag{}),
-				)
-			default:
-				return nil, 0, 0, api.NewInvalidParameterValueError(
-					"invalid entity type '%s'. Valid values are ['tag', 'attribute']", entity,
-				)
-			}
+	expression, err := filter.Parse(req.Filter)
+	if err != nil {
+		return nil, nil, api.NewInvalidParameterValueError(err.Error())
+	}
+	if expression != nil {
+		condition, err := buildFilterCondition(req.Filter, expression)
+		if err != nil {
+			return nil, nil, err
 		}
+		query.Where(condition)
 	}
 
 	// OrderBy
 	expOrder := false
+	var sortColumns []sortColumn
 	for _, o := range req.OrderBy {
 		components := experimentOrder.FindStringSubmatch(o)
 		if len(components) == 0 {
-			return nil, 0, 0, api.NewInvalidParameterValueError("invalid order_by clause '%s'", o)
+			return nil, nil, api.NewInvalidParameterValueError("invalid order_by clause '%s'", o)
 		}
 
 		column := components[1]
@@ -419,29 +357,60 @@ func (s Service) SearchExperiments(
 			fallthrough
 		case "name", "creation_time", "last_update_time":
 		default:
-			return nil, 0, 0, api.NewInvalidParameterValueError(
+			return nil, nil, api.NewInvalidParameterValueError(
 				`invalid attribute '%s'. Valid values are ['name', 'experiment_id', 'creation_time', 'last_update_time']`,
 				column,
 			)
 		}
-		query.Order(clause.OrderByColumn{
-			Column: clause.Column{Name: column},
-			Desc:   len(components) == 3 && strings.ToUpper(components[2]) == "DESC",
+		sortColumns = append(sortColumns, sortColumn{
+			name: column,
+			desc: len(components) == 3 && strings.ToUpper(components[2]) == "DESC",
 		})
-
 	}
 	if len(req.OrderBy) == 0 {
-		query.Order("experiments.creation_time DESC")
+		sortColumns = append(sortColumns, sortColumn{name: "creation_time", desc: true})
 	}
 	if !expOrder {
-		query.Order("experiments.experiment_id ASC")
+		sortColumns = append(sortColumns, sortColumn{name: "experiment_id"})
+	}
+	for _, column := range sortColumns {
+		query.Order(clause.OrderByColumn{
+			Column: clause.Column{Table: "experiments", Name: column.name},
+			Desc:   column.desc,
+		})
+	}
+
+	// the next page starts right after the last row of the previous one.
+	if pageToken != nil && pageToken.Keys != nil {
+		if len(pageToken.Keys) != len(sortColumns) {
+			return nil, nil, api.NewInvalidParameterValueError(
+				"invalid page_token '%s': token doesn't match order_by clause", req.PageToken,
+			)
+		}
+		keys := make([]filter.SortKey, len(sortColumns))
+		for i, column := range sortColumns {
+			keys[i] = filter.SortKey{
+				Column: fmt.Sprintf("experiments.%s", column.name), Desc: column.desc, Value: pageToken.Keys[i],
+			}
+		}
+		query.Where(filter.BuildKeysetCondition(database.DB.Dialector.Name(), keys))
 	}
 
 	// Actual query
 	var exps []models.Experiment
 	if err := query.Preload("Tags").Find(&exps).Error; err != nil {
-		return nil, 0, 0, api.NewInternalError("unable to search runs: %s", err)
+		return nil, nil, api.NewInternalError("unable to search runs: %s", err)
 	}
 
-	return exps, limit, offset, nil
+	// one more experiment has been requested to find out whether there is the next page.
+	if len(exps) <= limit {
+		return exps, nil, nil
+	}
+	exps = exps[:limit]
+	lastExperiment := &exps[len(exps)-1]
+	nextPageToken := request.PageToken{Keys: make([]any, len(sortColumns))}
+	for i, column := range sortColumns {
+		nextPageToken.Keys[i] = column.getValue(lastExperiment)
+	}
+	return exps, &nextPageToken, nil
 }
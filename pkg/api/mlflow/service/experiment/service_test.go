@@ -16,6 +16,7 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/webhook"
 )
 
 func TestService_CreateExperiment_Ok(t *testing.T) {
@@ -34,11 +35,21 @@ func TestService_CreateExperiment_Ok(t *testing.T) {
 		"Create", context.TODO(), mock.Anything,
 	).Return(nil)
 
+	webhookDispatcher := webhook.MockDispatcherProvider{}
+	webhookDispatcher.On(
+		"Dispatch",
+		context.TODO(),
+		mock.Anything,
+		models.WebhookEventExperimentCreated,
+		mock.AnythingOfType("*webhook.ExperimentData"),
+	).Return()
+
 	// call service under testing.
 	service := NewService(
 		&config.ServiceConfig{},
 		&repositories.MockTagRepositoryProvider{},
 		&experimentRepository,
+		&webhookDispatcher,
 	)
 	experiment, err := service.CreateExperiment(context.TODO(), &ns, &request.CreateExperimentRequest{
 		Name: "name",
@@ -64,6 +75,7 @@ func TestService_CreateExperiment_Ok(t *testing.T) {
 	assert.Equal(t, models.LifecycleStageActive, experiment.LifecycleStage)
 	assert.NotEmpty(t, experiment.CreationTime.Int64)
 	assert.NotEmpty(t, experiment.LastUpdateTime.Int64)
+	webhookDispatcher.AssertExpectations(t)
 }
 
 func TestService_CreateExperiment_Error(t *testing.T) {
@@ -88,6 +100,7 @@ func TestService_CreateExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -110,6 +123,7 @@ func TestService_CreateExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&experimentRepository,
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -128,6 +142,7 @@ func TestService_CreateExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&experimentRepository,
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -146,6 +161,7 @@ func TestService_CreateExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&experimentRepository,
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -167,6 +183,7 @@ func TestService_CreateExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&experimentRepository,
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -196,6 +213,7 @@ func TestService_CreateExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&experimentRepository,
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -234,11 +252,21 @@ func TestService_DeleteExperiment_Ok(t *testing.T) {
 		}),
 	).Return(nil)
 
+	webhookDispatcher := webhook.MockDispatcherProvider{}
+	webhookDispatcher.On(
+		"Dispatch",
+		context.TODO(),
+		mock.Anything,
+		models.WebhookEventExperimentDeleted,
+		mock.AnythingOfType("*webhook.ExperimentData"),
+	).Return()
+
 	// call service under testing.
 	service := NewService(
 		&config.ServiceConfig{},
 		&repositories.MockTagRepositoryProvider{},
 		&experimentRepository,
+		&webhookDispatcher,
 	)
 	err := service.DeleteExperiment(context.TODO(), &ns, &request.DeleteExperimentRequest{
 		ID: "1",
@@ -246,6 +274,7 @@ func TestService_DeleteExperiment_Ok(t *testing.T) {
 
 	// compare results.
 	require.Nil(t, err)
+	webhookDispatcher.AssertExpectations(t)
 }
 
 func TestService_DeleteExperiment_Error(t *testing.T) {
@@ -270,6 +299,7 @@ func TestService_DeleteExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -286,6 +316,7 @@ func TestService_DeleteExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -304,6 +335,7 @@ func TestService_DeleteExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&experimentRepository,
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -325,6 +357,7 @@ func TestService_DeleteExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&experimentRepository,
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -348,6 +381,7 @@ func TestService_DeleteExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&experimentRepository,
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -397,6 +431,7 @@ func TestService_GetExperiment_Ok(t *testing.T) {
 		&config.ServiceConfig{},
 		&repositories.MockTagRepositoryProvider{},
 		&experimentRepository,
+		&webhook.MockDispatcherProvider{},
 	)
 	experiment, err := service.GetExperiment(context.TODO(), &ns, &request.GetExperimentRequest{
 		ID: "1",
@@ -445,6 +480,7 @@ func TestService_GetExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -461,6 +497,7 @@ func TestService_GetExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -479,6 +516,7 @@ func TestService_GetExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&experimentRepository,
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -529,6 +567,7 @@ func TestService_GetExperimentByName_Ok(t *testing.T) {
 		&config.ServiceConfig{},
 		&repositories.MockTagRepositoryProvider{},
 		&experimentRepository,
+		&webhook.MockDispatcherProvider{},
 	)
 	experiment, err := service.GetExperimentByName(
 		context.TODO(),
@@ -581,6 +620,7 @@ func TestService_GetExperimentByName_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -599,6 +639,7 @@ func TestService_GetExperimentByName_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&experimentRepository,
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -617,6 +658,7 @@ func TestService_GetExperimentByName_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&experimentRepository,
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -655,11 +697,21 @@ func TestService_RestoreExperiment_Ok(t *testing.T) {
 		}),
 	).Return(nil)
 
+	webhookDispatcher := webhook.MockDispatcherProvider{}
+	webhookDispatcher.On(
+		"Dispatch",
+		context.TODO(),
+		mock.Anything,
+		models.WebhookEventExperimentRestored,
+		mock.AnythingOfType("*webhook.ExperimentData"),
+	).Return()
+
 	// call service under testing.
 	service := NewService(
 		&config.ServiceConfig{},
 		&repositories.MockTagRepositoryProvider{},
 		&experimentRepository,
+		&webhookDispatcher,
 	)
 	err := service.RestoreExperiment(context.TODO(), &ns, &request.RestoreExperimentRequest{
 		ID: "1",
@@ -667,6 +719,7 @@ func TestService_RestoreExperiment_Ok(t *testing.T) {
 
 	// compare results.
 	require.Nil(t, err)
+	webhookDispatcher.AssertExpectations(t)
 }
 
 func TestService_RestoreExperiment_Error(t *testing.T) {
@@ -691,6 +744,7 @@ func TestService_RestoreExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -707,6 +761,7 @@ func TestService_RestoreExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -725,6 +780,7 @@ func TestService_RestoreExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&experimentRepository,
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -748,6 +804,7 @@ func TestService_RestoreExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&experimentRepository,
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -792,6 +849,7 @@ func TestService_SetExperimentTag_Ok(t *testing.T) {
 		&config.ServiceConfig{},
 		&tagsRepository,
 		&experimentRepository,
+		&webhook.MockDispatcherProvider{},
 	)
 	err := service.SetExperimentTag(context.TODO(), &ns, &request.SetExperimentTagRequest{
 		ID:    "1",
@@ -825,6 +883,7 @@ func TestService_SetExperimentTag_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -839,6 +898,7 @@ func TestService_SetExperimentTag_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -856,6 +916,7 @@ func TestService_SetExperimentTag_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -875,6 +936,7 @@ func TestService_SetExperimentTag_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&experimentRepository,
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -903,6 +965,7 @@ func TestService_SetExperimentTag_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&tagRepository,
 					&experimentRepository,
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -945,6 +1008,7 @@ func TestService_UpdateExperiment_Ok(t *testing.T) {
 		&config.ServiceConfig{},
 		&repositories.MockTagRepositoryProvider{},
 		&experimentRepository,
+		&webhook.MockDispatcherProvider{},
 	)
 	err := service.UpdateExperiment(context.TODO(), &ns, &request.UpdateExperimentRequest{
 		ID:   "1",
@@ -977,6 +1041,7 @@ func TestService_UpdateExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -991,6 +1056,7 @@ func TestService_UpdateExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -1008,6 +1074,7 @@ func TestService_UpdateExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -1027,6 +1094,7 @@ func TestService_UpdateExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&experimentRepository,
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -1051,6 +1119,7 @@ func TestService_UpdateExperiment_Error(t *testing.T) {
 					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&experimentRepository,
+					&webhook.MockDispatcherProvider{},
 				)
 			},
 		},
@@ -0,0 +1,172 @@
+package gc
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"time"
+
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+)
+
+// Result represents entities which have been purged, or would be purged in dry-run mode.
+type Result struct {
+	Experiments  []models.Experiment
+	Runs         []models.Run
+	LoggedModels []models.LoggedModel
+}
+
+// Service provides service layer to permanently purge deleted entities.
+type Service struct {
+	runRepository          repositories.RunRepositoryProvider
+	experimentRepository   repositories.ExperimentRepositoryProvider
+	loggedModelRepository  repositories.LoggedModelRepositoryProvider
+	artifactStorageFactory storage.ArtifactStorageFactoryProvider
+}
+
+// NewService creates new Service instance.
+func NewService(
+	runRepository repositories.RunRepositoryProvider,
+	experimentRepository repositories.ExperimentRepositoryProvider,
+	loggedModelRepository repositories.LoggedModelRepositoryProvider,
+	artifactStorageFactory storage.ArtifactStorageFactoryProvider,
+) *Service {
+	return &Service{
+		runRepository:          runRepository,
+		experimentRepository:   experimentRepository,
+		loggedModelRepository:  loggedModelRepository,
+		artifactStorageFactory: artifactStorageFactory,
+	}
+}
+
+// Collect permanently purges experiments, runs and logged models, which have been deleted for longer than
+// `olderThan`, together with their artifacts. Artifacts of the traces and logged models are removed together
+// with the experiments. Metrics, params, tags, latest metrics, traces and logged models of the purged
+// experiments are removed by the database cascade.
+// In dry-run mode nothing is removed and only the list of entities to purge is returned.
+func (s Service) Collect(ctx context.Context, olderThan time.Duration, dryRun bool) (*Result, error) {
+	deletedBefore := time.Now().Add(-olderThan).UnixMilli()
+
+	// 1. find deleted experiments, and deleted runs and logged models, which don't belong to these experiments.
+	experiments, err := s.experimentRepository.GetDeletedBefore(ctx, deletedBefore)
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting deleted experiments")
+	}
+	purgedExperimentIDs := make(map[int32]struct{}, len(experiments))
+	for _, experiment := range experiments {
+		purgedExperimentIDs[*experiment.ID] = struct{}{}
+	}
+
+	allRuns, err := s.runRepository.GetDeletedBefore(ctx, deletedBefore)
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting deleted runs")
+	}
+	runs := make([]models.Run, 0, len(allRuns))
+	for _, run := range allRuns {
+		if _, ok := purgedExperimentIDs[run.ExperimentID]; !ok {
+			runs = append(runs, run)
+		}
+	}
+
+	allLoggedModels, err := s.loggedModelRepository.GetDeletedBefore(ctx, deletedBefore)
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting deleted logged models")
+	}
+	loggedModels := make([]models.LoggedModel, 0, len(allLoggedModels))
+	for _, loggedModel := range allLoggedModels {
+		if _, ok := purgedExperimentIDs[loggedModel.ExperimentID]; !ok {
+			loggedModels = append(loggedModels, loggedModel)
+		}
+	}
+
+	result := Result{
+		Experiments:  experiments,
+		Runs:         runs,
+		LoggedModels: loggedModels,
+	}
+	if dryRun {
+		return &result, nil
+	}
+
+	// 2. purge experiments together with all their runs.
+	if len(experiments) > 0 {
+		ids := make([]*int32, len(experiments))
+		for i, experiment := range experiments {
+			for _, run := range experiment.Runs {
+				if err := s.deleteArtifacts(ctx, run.ArtifactURI); err != nil {
+					return nil, eris.Wrapf(err, "error deleting artifacts of run with id: %s", run.ID)
+				}
+			}
+			for _, trace := range experiment.Traces {
+				artifactLocation, _ := trace.GetTag(models.TraceTagArtifactLocation)
+				if err := s.deleteArtifacts(ctx, artifactLocation); err != nil {
+					return nil, eris.Wrapf(err, "error deleting artifacts of trace with id: %s", trace.RequestID)
+				}
+			}
+			for _, loggedModel := range experiment.LoggedModels {
+				if err := s.deleteArtifacts(ctx, loggedModel.ArtifactLocation); err != nil {
+					return nil, eris.Wrapf(err, "error deleting artifacts of logged model with id: %s", loggedModel.ID)
+				}
+			}
+			ids[i] = experiment.ID
+		}
+		if err := s.experimentRepository.DeleteBatch(ctx, ids); err != nil {
+			return nil, eris.Wrap(err, "error purging deleted experiments")
+		}
+	}
+
+	// 3. purge runs. runs could be deleted only in scope of their namespace.
+	namespaceRunIDs := map[uint][]string{}
+	for _, run := range runs {
+		if err := s.deleteArtifacts(ctx, run.ArtifactURI); err != nil {
+			return nil, eris.Wrapf(err, "error deleting artifacts of run with id: %s", run.ID)
+		}
+		namespaceRunIDs[run.Experiment.NamespaceID] = append(namespaceRunIDs[run.Experiment.NamespaceID], run.ID)
+	}
+	for namespaceID, ids := range namespaceRunIDs {
+		if err := s.runRepository.DeleteBatch(ctx, namespaceID, ids); err != nil {
+			return nil, eris.Wrapf(err, "error purging deleted runs in namespace with id: %d", namespaceID)
+		}
+	}
+
+	// 4. purge logged models.
+	if len(loggedModels) > 0 {
+		ids := make([]string, len(loggedModels))
+		for i, loggedModel := range loggedModels {
+			if err := s.deleteArtifacts(ctx, loggedModel.ArtifactLocation); err != nil {
+				return nil, eris.Wrapf(err, "error deleting artifacts of logged model with id: %s", loggedModel.ID)
+			}
+			ids[i] = loggedModel.ID
+		}
+		if err := s.loggedModelRepository.DeleteBatch(ctx, ids); err != nil {
+			return nil, eris.Wrap(err, "error purging deleted logged models")
+		}
+	}
+
+	return &result, nil
+}
+
+// deleteArtifacts deletes all the artifacts under provided artifact uri.
+// Runs and logged models without any logged artifacts have nothing in the storage, so missing artifacts are not an error.
+func (s Service) deleteArtifacts(ctx context.Context, artifactURI string) error {
+	if artifactURI == "" {
+		return nil
+	}
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, artifactURI)
+	if err != nil {
+		return eris.Wrapf(err, "error getting artifact storage for uri: %s", artifactURI)
+	}
+	if err := artifactStorage.Delete(ctx, artifactURI, ""); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			log.Debugf("no artifacts found for uri: %s", artifactURI)
+			return nil
+		}
+		return eris.Wrapf(err, "error deleting artifacts for uri: %s", artifactURI)
+	}
+	return nil
+}
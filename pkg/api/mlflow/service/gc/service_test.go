@@ -0,0 +1,228 @@
+package gc
+
+import (
+	"context"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+)
+
+// deleted entities used by the tests:
+// - experiment 1 with run `run1`, trace `tr-1` and logged model `m-1` is deleted, so they are purged
+// together with the experiment.
+// - runs `run2` and `run3` are deleted in the active experiments of different namespaces.
+// - logged model `m-2` is deleted in the active experiment.
+var (
+	deletedExperiments = []models.Experiment{
+		{
+			ID:             common.GetPointer[int32](1),
+			Name:           "experiment1",
+			LifecycleStage: models.LifecycleStageDeleted,
+			Runs: []models.Run{
+				{ID: "run1", ExperimentID: 1, ArtifactURI: "s3://bucket/1/run1/artifacts"},
+			},
+			Traces: []models.TraceInfo{
+				{
+					RequestID:    "tr-1",
+					ExperimentID: 1,
+					Tags: []models.TraceTag{
+						{Key: models.TraceTagArtifactLocation, Value: "s3://bucket/1/traces/tr-1/artifacts"},
+					},
+				},
+			},
+			LoggedModels: []models.LoggedModel{
+				{ID: "m-1", ExperimentID: 1, ArtifactLocation: "s3://bucket/1/models/m-1/artifacts"},
+			},
+		},
+	}
+	deletedRuns = []models.Run{
+		{
+			ID:           "run1",
+			ExperimentID: 1,
+			ArtifactURI:  "s3://bucket/1/run1/artifacts",
+			Experiment:   models.Experiment{ID: common.GetPointer[int32](1), NamespaceID: 1},
+		},
+		{
+			ID:           "run2",
+			ExperimentID: 2,
+			ArtifactURI:  "s3://bucket/2/run2/artifacts",
+			Experiment:   models.Experiment{ID: common.GetPointer[int32](2), NamespaceID: 1},
+		},
+		{
+			ID:           "run3",
+			ExperimentID: 3,
+			ArtifactURI:  "s3://bucket/3/run3/artifacts",
+			Experiment:   models.Experiment{ID: common.GetPointer[int32](3), NamespaceID: 2},
+		},
+	}
+	deletedLoggedModels = []models.LoggedModel{
+		{ID: "m-1", ExperimentID: 1, ArtifactLocation: "s3://bucket/1/models/m-1/artifacts"},
+		{ID: "m-2", ExperimentID: 2, ArtifactLocation: "s3://bucket/2/models/m-2/artifacts"},
+	}
+)
+
+func TestService_Collect_Ok(t *testing.T) {
+	// init repository mocks.
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+	experimentRepository.On(
+		"GetDeletedBefore", context.TODO(), mock.AnythingOfType("int64"),
+	).Return(deletedExperiments, nil)
+	experimentRepository.On(
+		"DeleteBatch", context.TODO(), []*int32{common.GetPointer[int32](1)},
+	).Return(nil)
+
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetDeletedBefore", context.TODO(), mock.AnythingOfType("int64"),
+	).Return(deletedRuns, nil)
+	runRepository.On("DeleteBatch", context.TODO(), uint(1), []string{"run2"}).Return(nil)
+	runRepository.On("DeleteBatch", context.TODO(), uint(2), []string{"run3"}).Return(nil)
+
+	loggedModelRepository := repositories.MockLoggedModelRepositoryProvider{}
+	loggedModelRepository.On(
+		"GetDeletedBefore", context.TODO(), mock.AnythingOfType("int64"),
+	).Return(deletedLoggedModels, nil)
+	loggedModelRepository.On("DeleteBatch", context.TODO(), []string{"m-2"}).Return(nil)
+
+	// init storage mocks. artifacts of `run3` have never been logged.
+	artifactStorage := storage.MockArtifactStorageProvider{}
+	artifactStorage.On("Delete", context.TODO(), "s3://bucket/1/run1/artifacts", "").Return(nil)
+	artifactStorage.On("Delete", context.TODO(), "s3://bucket/1/traces/tr-1/artifacts", "").Return(nil)
+	artifactStorage.On("Delete", context.TODO(), "s3://bucket/1/models/m-1/artifacts", "").Return(nil)
+	artifactStorage.On("Delete", context.TODO(), "s3://bucket/2/models/m-2/artifacts", "").Return(nil)
+	artifactStorage.On("Delete", context.TODO(), "s3://bucket/2/run2/artifacts", "").Return(nil)
+	artifactStorage.On(
+		"Delete", context.TODO(), "s3://bucket/3/run3/artifacts", "",
+	).Return(eris.Wrap(fs.ErrNotExist, "object does not exist"))
+	artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+	artifactStorageFactory.On("GetStorage", context.TODO(), mock.Anything).Return(&artifactStorage, nil)
+
+	// call service under testing.
+	service := NewService(&runRepository, &experimentRepository, &loggedModelRepository, &artifactStorageFactory)
+	result, err := service.Collect(context.TODO(), 24*time.Hour, false)
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, deletedExperiments, result.Experiments)
+	assert.Equal(t, deletedRuns[1:], result.Runs)
+	assert.Equal(t, deletedLoggedModels[1:], result.LoggedModels)
+	experimentRepository.AssertExpectations(t)
+	runRepository.AssertExpectations(t)
+	loggedModelRepository.AssertExpectations(t)
+	artifactStorage.AssertExpectations(t)
+}
+
+func TestService_Collect_DryRun(t *testing.T) {
+	// init repository mocks. nothing has to be deleted.
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+	experimentRepository.On(
+		"GetDeletedBefore", context.TODO(), mock.AnythingOfType("int64"),
+	).Return(deletedExperiments, nil)
+
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetDeletedBefore", context.TODO(), mock.AnythingOfType("int64"),
+	).Return(deletedRuns, nil)
+
+	loggedModelRepository := repositories.MockLoggedModelRepositoryProvider{}
+	loggedModelRepository.On(
+		"GetDeletedBefore", context.TODO(), mock.AnythingOfType("int64"),
+	).Return(deletedLoggedModels, nil)
+
+	artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+
+	// call service under testing.
+	service := NewService(&runRepository, &experimentRepository, &loggedModelRepository, &artifactStorageFactory)
+	result, err := service.Collect(context.TODO(), 0, true)
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, deletedExperiments, result.Experiments)
+	assert.Equal(t, deletedRuns[1:], result.Runs)
+	assert.Equal(t, deletedLoggedModels[1:], result.LoggedModels)
+	experimentRepository.AssertNotCalled(t, "DeleteBatch", mock.Anything, mock.Anything)
+	runRepository.AssertNotCalled(t, "DeleteBatch", mock.Anything, mock.Anything, mock.Anything)
+	loggedModelRepository.AssertNotCalled(t, "DeleteBatch", mock.Anything, mock.Anything)
+	artifactStorageFactory.AssertNotCalled(t, "GetStorage", mock.Anything, mock.Anything)
+}
+
+func TestService_Collect_Error(t *testing.T) {
+	// init repository mocks.
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+	experimentRepository.On(
+		"GetDeletedBefore", context.TODO(), mock.AnythingOfType("int64"),
+	).Return([]models.Experiment{}, nil)
+
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetDeletedBefore", context.TODO(), mock.AnythingOfType("int64"),
+	).Return(deletedRuns[1:2], nil)
+
+	loggedModelRepository := repositories.MockLoggedModelRepositoryProvider{}
+	loggedModelRepository.On(
+		"GetDeletedBefore", context.TODO(), mock.AnythingOfType("int64"),
+	).Return([]models.LoggedModel{}, nil)
+
+	// init storage mocks. artifacts can't be deleted, so the run has to stay in the database.
+	artifactStorage := storage.MockArtifactStorageProvider{}
+	artifactStorage.On(
+		"Delete", context.TODO(), "s3://bucket/2/run2/artifacts", "",
+	).Return(eris.New("access denied"))
+	artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+	artifactStorageFactory.On("GetStorage", context.TODO(), mock.Anything).Return(&artifactStorage, nil)
+
+	// call service under testing.
+	service := NewService(&runRepository, &experimentRepository, &loggedModelRepository, &artifactStorageFactory)
+	result, err := service.Collect(context.TODO(), 0, false)
+
+	// compare results.
+	assert.Nil(t, result)
+	assert.ErrorContains(t, err, "error deleting artifacts of run with id: run2")
+	runRepository.AssertNotCalled(t, "DeleteBatch", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_Collect_TraceArtifactsError(t *testing.T) {
+	// init repository mocks.
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+	experimentRepository.On(
+		"GetDeletedBefore", context.TODO(), mock.AnythingOfType("int64"),
+	).Return(deletedExperiments, nil)
+
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetDeletedBefore", context.TODO(), mock.AnythingOfType("int64"),
+	).Return([]models.Run{}, nil)
+
+	loggedModelRepository := repositories.MockLoggedModelRepositoryProvider{}
+	loggedModelRepository.On(
+		"GetDeletedBefore", context.TODO(), mock.AnythingOfType("int64"),
+	).Return([]models.LoggedModel{}, nil)
+
+	// init storage mocks. trace artifacts can't be deleted, so the experiment has to stay in the database.
+	artifactStorage := storage.MockArtifactStorageProvider{}
+	artifactStorage.On("Delete", context.TODO(), "s3://bucket/1/run1/artifacts", "").Return(nil)
+	artifactStorage.On(
+		"Delete", context.TODO(), "s3://bucket/1/traces/tr-1/artifacts", "",
+	).Return(eris.New("access denied"))
+	artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+	artifactStorageFactory.On("GetStorage", context.TODO(), mock.Anything).Return(&artifactStorage, nil)
+
+	// call service under testing.
+	service := NewService(&runRepository, &experimentRepository, &loggedModelRepository, &artifactStorageFactory)
+	result, err := service.Collect(context.TODO(), 0, false)
+
+	// compare results.
+	assert.Nil(t, result)
+	assert.ErrorContains(t, err, "error deleting artifacts of trace with id: tr-1")
+	experimentRepository.AssertNotCalled(t, "DeleteBatch", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,174 @@
+package loggedmodel
+
+import (
+	"strconv"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/filter"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// buildFilterCondition compiles the filter expression into the condition of `logged_models` query.
+// filterText is the original filter, which is referenced by the errors.
+func buildFilterCondition(filterText string, expression filter.Expression) (clause.Expression, error) {
+	switch expression := expression.(type) {
+	case *filter.LogicalExpression:
+		operands := make([]clause.Expression, len(expression.Operands))
+		for i, operand := range expression.Operands {
+			condition, err := buildFilterCondition(filterText, operand)
+			if err != nil {
+				return nil, err
+			}
+			operands[i] = condition
+		}
+		if expression.Operator == filter.OrOperator {
+			return clause.Or(operands...), nil
+		}
+		return clause.And(operands...), nil
+	case *filter.Comparison:
+		return buildComparisonCondition(filterText, expression)
+	default:
+		return nil, api.NewInternalError("unsupported filter expression %T", expression)
+	}
+}
+
+// buildComparisonCondition compiles single comparison of the filter into the condition of `logged_models` query.
+func buildComparisonCondition(filterText string, comparison *filter.Comparison) (clause.Expression, error) {
+	key, operator := comparison.Key, comparison.Operator
+	switch comparison.Entity {
+	case "", "attribute", "attributes", "attr":
+		switch key {
+		case "creation_timestamp", "creation_timestamp_ms", "last_updated_timestamp", "last_updated_timestamp_ms":
+			column := normalizeAttribute(key)
+			switch operator {
+			case filter.GreaterOperator, filter.GreaterOrEqualOperator, filter.NotEqualOperator,
+				filter.EqualOperator, filter.LessOperator, filter.LessOrEqualOperator:
+				value, err := strconv.ParseInt(comparison.Value.Raw, 10, 64)
+				if err != nil {
+					return nil, filter.NewSemanticError(
+						filterText, comparison.Value.Position(), "invalid numeric value '%s'", comparison.Value.Raw,
+					)
+				}
+				return buildColumnCondition("logged_models."+column, operator, value), nil
+			default:
+				return nil, filter.NewSemanticError(
+					filterText, comparison.Position(),
+					"invalid numeric attribute comparison operator '%s'", operator,
+				)
+			}
+		case "name", "model_id", "model_type", "status", "source_run_id":
+			switch operator {
+			case filter.NotEqualOperator, filter.EqualOperator, filter.LikeOperator, filter.ILikeOperator:
+				return buildColumnCondition("logged_models."+key, operator, comparison.Value.Text), nil
+			case filter.InOperator, filter.NotInOperator:
+				return buildColumnCondition("logged_models."+key, operator, getValues(comparison)), nil
+			default:
+				return nil, filter.NewSemanticError(
+					filterText, comparison.Position(),
+					"invalid string attribute comparison operator '%s'", operator,
+				)
+			}
+		default:
+			return nil, filter.NewSemanticError(
+				filterText, comparison.Position(),
+				"invalid attribute '%s'. Valid values are ['name', 'model_id', 'model_type', 'status', "+
+					"'source_run_id', 'creation_timestamp', 'last_updated_timestamp']",
+				key,
+			)
+		}
+	case "metric", "metrics":
+		switch operator {
+		case filter.IsNullOperator, filter.IsNotNullOperator:
+			return buildKeyValueCondition(&database.Metric{}, key, operator, nil), nil
+		case filter.GreaterOperator, filter.GreaterOrEqualOperator, filter.NotEqualOperator,
+			filter.EqualOperator, filter.LessOperator, filter.LessOrEqualOperator:
+			value, err := strconv.ParseFloat(comparison.Value.Raw, 64)
+			if err != nil {
+				return nil, filter.NewSemanticError(
+					filterText, comparison.Value.Position(), "invalid numeric value '%s'", comparison.Value.Raw,
+				)
+			}
+			return buildKeyValueCondition(&database.Metric{}, key, operator, value), nil
+		default:
+			return nil, filter.NewSemanticError(
+				filterText, comparison.Position(), "invalid metric comparison operator '%s'", operator,
+			)
+		}
+	case "parameter", "parameters", "param", "params", "tag", "tags":
+		var model any = &database.LoggedModelTag{}
+		if comparison.Entity != "tag" && comparison.Entity != "tags" {
+			model = &database.LoggedModelParam{}
+		}
+		switch operator {
+		case filter.NotEqualOperator, filter.EqualOperator, filter.LikeOperator, filter.ILikeOperator,
+			filter.InOperator, filter.NotInOperator, filter.IsNullOperator, filter.IsNotNullOperator:
+			return buildKeyValueCondition(model, key, operator, getValue(comparison)), nil
+		default:
+			return nil, filter.NewSemanticError(
+				filterText, comparison.Position(),
+				"invalid %s comparison operator '%s'", comparison.Entity, operator,
+			)
+		}
+	default:
+		return nil, filter.NewSemanticError(
+			filterText, comparison.Position(),
+			"invalid entity type '%s'. Valid values are ['metric', 'parameter', 'tag', 'attribute']",
+			comparison.Entity,
+		)
+	}
+}
+
+// buildColumnCondition builds condition, which compares the column of `logged_models` table with the value.
+func buildColumnCondition(column, operator string, value any) clause.Expression {
+	return filter.BuildCondition(database.DB.Dialector.Name(), column, operator, value)
+}
+
+// buildKeyValueCondition builds condition, which compares the value of the logged model param, tag or metric.
+// Metric comparison matches the model, if any of its metric values matches. `IS NULL` matches the models,
+// which don't have the key at all.
+func buildKeyValueCondition(model any, key, operator string, value any) clause.Expression {
+	query := database.DB.Model(model).Select("model_id").Where("key = ? AND model_id IS NOT NULL", key)
+	switch operator {
+	case filter.IsNullOperator:
+		return clause.Expr{SQL: "logged_models.model_id NOT IN (?)", Vars: []any{query}}
+	case filter.IsNotNullOperator:
+		return clause.Expr{SQL: "logged_models.model_id IN (?)", Vars: []any{query}}
+	default:
+		return clause.Expr{SQL: "logged_models.model_id IN (?)", Vars: []any{query.Where(
+			filter.BuildCondition(database.DB.Dialector.Name(), "value", operator, value),
+		)}}
+	}
+}
+
+// normalizeAttribute returns column name of the timestamp attributes, which could be used without `_ms` suffix.
+func normalizeAttribute(key string) string {
+	switch key {
+	case "creation_timestamp":
+		return "creation_timestamp_ms"
+	case "last_updated_timestamp":
+		return "last_updated_timestamp_ms"
+	}
+	return key
+}
+
+// getValue returns string value of the comparison, list of values for `IN` and nil for `IS NULL`.
+func getValue(comparison *filter.Comparison) any {
+	switch {
+	case comparison.Value != nil:
+		return comparison.Value.Text
+	case comparison.Values != nil:
+		return getValues(comparison)
+	}
+	return nil
+}
+
+// getValues returns list of the values of `IN` and `NOT IN` comparisons.
+func getValues(comparison *filter.Comparison) []string {
+	values := make([]string, len(comparison.Values))
+	for i, v := range comparison.Values {
+		values[i] = v.Text
+	}
+	return values
+}
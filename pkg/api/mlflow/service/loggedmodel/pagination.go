@@ -0,0 +1,32 @@
+package loggedmodel
+
+import (
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// sortColumn represents column of the logged models ordering.
+type sortColumn struct {
+	name string
+	desc bool
+}
+
+// getValue returns value of the column in the logged model, which is stored into the keyset page token.
+func (c sortColumn) getValue(model *models.LoggedModel) any {
+	switch c.name {
+	case "model_id":
+		return model.ID
+	case "name":
+		return model.Name
+	case "model_type":
+		return model.ModelType
+	case "status":
+		return string(model.Status)
+	case "source_run_id":
+		return model.SourceRunID
+	case "creation_timestamp_ms":
+		return model.CreationTimestampMS
+	case "last_updated_timestamp_ms":
+		return model.LastUpdatedTimestampMS
+	}
+	return nil
+}
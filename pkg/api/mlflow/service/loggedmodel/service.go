@@ -0,0 +1,354 @@
+package loggedmodel
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/filter"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// Service provides service layer to work with `logged model` business logic.
+type Service struct {
+	loggedModelRepository repositories.LoggedModelRepositoryProvider
+	experimentRepository  repositories.ExperimentRepositoryProvider
+}
+
+// NewService creates new Service instance.
+func NewService(
+	loggedModelRepository repositories.LoggedModelRepositoryProvider,
+	experimentRepository repositories.ExperimentRepositoryProvider,
+) *Service {
+	return &Service{
+		loggedModelRepository: loggedModelRepository,
+		experimentRepository:  experimentRepository,
+	}
+}
+
+// CreateLoggedModel creates new models.LoggedModel entity in pending status.
+func (s Service) CreateLoggedModel(
+	ctx context.Context, namespace *models.Namespace, req *request.CreateLoggedModelRequest,
+) (*models.LoggedModel, error) {
+	if err := ValidateCreateLoggedModelRequest(req); err != nil {
+		return nil, err
+	}
+
+	experiment, err := s.getExperiment(ctx, namespace, req.ExperimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	modelID := "m-" + database.NewUUID()
+	artifactLocation, err := url.JoinPath(experiment.ArtifactLocation, "models", modelID, "artifacts")
+	if err != nil {
+		return nil, api.NewInternalError(
+			"error creating artifact location for logged model '%s': %s", modelID, err,
+		)
+	}
+
+	model := convertors.ConvertCreateLoggedModelRequestToDBModel(*experiment.ID, modelID, req)
+	if model.Name == "" {
+		if model.Name, err = convertors.GenerateRandomName(); err != nil {
+			return nil, api.NewInternalError("error generating name of logged model '%s': %s", modelID, err)
+		}
+	}
+	model.ArtifactLocation = artifactLocation
+	model.CreationTimestampMS = time.Now().UTC().UnixMilli()
+	model.LastUpdatedTimestampMS = model.CreationTimestampMS
+	if err := s.loggedModelRepository.Create(ctx, model); err != nil {
+		return nil, api.NewInternalError("unable to create logged model '%s': %s", modelID, err)
+	}
+	return model, nil
+}
+
+// GetLoggedModel returns models.LoggedModel entity by model id.
+func (s Service) GetLoggedModel(
+	ctx context.Context, namespace *models.Namespace, req *request.GetLoggedModelRequest,
+) (*models.LoggedModel, error) {
+	if err := ValidateGetLoggedModelRequest(req); err != nil {
+		return nil, err
+	}
+	return s.getLoggedModel(ctx, namespace, req.ModelID)
+}
+
+// FinalizeLoggedModel sets the final status of existing models.LoggedModel entity.
+func (s Service) FinalizeLoggedModel(
+	ctx context.Context, namespace *models.Namespace, req *request.FinalizeLoggedModelRequest,
+) (*models.LoggedModel, error) {
+	if err := ValidateFinalizeLoggedModelRequest(req); err != nil {
+		return nil, err
+	}
+
+	model, err := s.getLoggedModel(ctx, namespace, req.ModelID)
+	if err != nil {
+		return nil, err
+	}
+
+	model.Status = models.LoggedModelStatus(req.Status)
+	model.LastUpdatedTimestampMS = time.Now().UTC().UnixMilli()
+	if err := s.loggedModelRepository.Update(ctx, model); err != nil {
+		return nil, api.NewInternalError("unable to finalize logged model '%s': %s", req.ModelID, err)
+	}
+	return model, nil
+}
+
+// DeleteLoggedModel marks existing models.LoggedModel entity as deleted.
+func (s Service) DeleteLoggedModel(
+	ctx context.Context, namespace *models.Namespace, req *request.DeleteLoggedModelRequest,
+) error {
+	if err := ValidateDeleteLoggedModelRequest(req); err != nil {
+		return err
+	}
+
+	model, err := s.getLoggedModel(ctx, namespace, req.ModelID)
+	if err != nil {
+		return err
+	}
+
+	model.LifecycleStage = models.LifecycleStageDeleted
+	model.LastUpdatedTimestampMS = time.Now().UTC().UnixMilli()
+	if err := s.loggedModelRepository.Update(ctx, model); err != nil {
+		return api.NewInternalError("unable to delete logged model '%s': %s", req.ModelID, err)
+	}
+	return nil
+}
+
+// SetLoggedModelTags sets tags of existing models.LoggedModel entity.
+func (s Service) SetLoggedModelTags(
+	ctx context.Context, namespace *models.Namespace, req *request.SetLoggedModelTagsRequest,
+) (*models.LoggedModel, error) {
+	if err := ValidateSetLoggedModelTagsRequest(req); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getLoggedModel(ctx, namespace, req.ModelID); err != nil {
+		return nil, err
+	}
+
+	tags := convertors.ConvertLoggedModelTagsToDBModel(req.ModelID, req.Tags)
+	if err := s.loggedModelRepository.SetTags(ctx, tags); err != nil {
+		return nil, api.NewInternalError("unable to set tags of logged model '%s': %s", req.ModelID, err)
+	}
+	return s.getLoggedModel(ctx, namespace, req.ModelID)
+}
+
+// DeleteLoggedModelTag deletes tag of existing models.LoggedModel entity.
+func (s Service) DeleteLoggedModelTag(
+	ctx context.Context, namespace *models.Namespace, req *request.DeleteLoggedModelTagRequest,
+) error {
+	if err := ValidateDeleteLoggedModelTagRequest(req); err != nil {
+		return err
+	}
+
+	model, err := s.getLoggedModel(ctx, namespace, req.ModelID)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, tag := range model.Tags {
+		if tag.Key == req.TagKey {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return api.NewResourceDoesNotExistError(
+			"No tag with key '%s' found for logged model '%s'", req.TagKey, req.ModelID,
+		)
+	}
+
+	if err := s.loggedModelRepository.DeleteTag(ctx, &models.LoggedModelTag{
+		Key:     req.TagKey,
+		ModelID: req.ModelID,
+	}); err != nil {
+		return api.NewInternalError(
+			"unable to delete tag '%s' of logged model '%s': %s", req.TagKey, req.ModelID, err,
+		)
+	}
+	return nil
+}
+
+// LogLoggedModelParams logs params of existing models.LoggedModel entity.
+func (s Service) LogLoggedModelParams(
+	ctx context.Context, namespace *models.Namespace, req *request.LogLoggedModelParamsRequest,
+) error {
+	if err := ValidateLogLoggedModelParamsRequest(req); err != nil {
+		return err
+	}
+
+	if _, err := s.getLoggedModel(ctx, namespace, req.ModelID); err != nil {
+		return err
+	}
+
+	params := convertors.ConvertLoggedModelParamsToDBModel(req.ModelID, req.Params)
+	if err := s.loggedModelRepository.LogParams(ctx, params); err != nil {
+		return api.NewInternalError("unable to log params of logged model '%s': %s", req.ModelID, err)
+	}
+	return nil
+}
+
+// SearchLoggedModels returns models.LoggedModel entities of requested experiments, which match the filter.
+func (s Service) SearchLoggedModels(
+	ctx context.Context, namespace *models.Namespace, req *request.SearchLoggedModelsRequest,
+) ([]models.LoggedModel, *request.PageToken, error) {
+	if err := ValidateSearchLoggedModelsRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	experimentIDs := make([]int32, len(req.ExperimentIDs))
+	for i, id := range req.ExperimentIDs {
+		experimentID, err := strconv.ParseInt(id, 10, 32)
+		if err != nil {
+			return nil, nil, api.NewBadRequestError("unable to parse experiment id '%s': %s", id, err)
+		}
+		experimentIDs[i] = int32(experimentID)
+	}
+
+	limit := req.MaxResults
+	if limit == 0 {
+		limit = DefaultMaxResultsForSearchLoggedModels
+	}
+
+	// Filter
+	var conditions []clause.Expression
+	expression, err := filter.Parse(req.Filter)
+	if err != nil {
+		return nil, nil, api.NewInvalidParameterValueError(err.Error())
+	}
+	if expression != nil {
+		condition, err := buildFilterCondition(req.Filter, expression)
+		if err != nil {
+			return nil, nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	// OrderBy
+	sortColumns, err := parseOrderBy(req.OrderBy)
+	if err != nil {
+		return nil, nil, err
+	}
+	orderBy := make([]clause.OrderByColumn, len(sortColumns))
+	for i, column := range sortColumns {
+		orderBy[i] = clause.OrderByColumn{
+			Column: clause.Column{Table: "logged_models", Name: column.name},
+			Desc:   column.desc,
+		}
+	}
+
+	// the next page starts right after the last row of the previous one.
+	if req.PageToken != "" {
+		pageToken, err := request.DecodePageToken(req.PageToken)
+		if err != nil {
+			return nil, nil, api.NewInvalidParameterValueError("invalid page_token '%s': %s", req.PageToken, err)
+		}
+		if len(pageToken.Keys) != len(sortColumns) {
+			return nil, nil, api.NewInvalidParameterValueError(
+				"invalid page_token '%s': token doesn't match order_by clause", req.PageToken,
+			)
+		}
+		keys := make([]filter.SortKey, len(sortColumns))
+		for i, column := range sortColumns {
+			keys[i] = filter.SortKey{
+				Column: fmt.Sprintf("logged_models.%s", column.name), Desc: column.desc, Value: pageToken.Keys[i],
+			}
+		}
+		conditions = append(conditions, filter.BuildKeysetCondition(database.DB.Dialector.Name(), keys))
+	}
+
+	var condition clause.Expression
+	if len(conditions) > 0 {
+		condition = clause.And(conditions...)
+	}
+
+	// one more model is requested to find out whether there is the next page.
+	loggedModels, err := s.loggedModelRepository.Search(
+		ctx, namespace.ID, experimentIDs, condition, orderBy, limit+1,
+	)
+	if err != nil {
+		return nil, nil, api.NewInternalError("unable to search logged models: %s", err)
+	}
+	if len(loggedModels) <= limit {
+		return loggedModels, nil, nil
+	}
+	loggedModels = loggedModels[:limit]
+	lastModel := &loggedModels[len(loggedModels)-1]
+	nextPageToken := request.PageToken{Keys: make([]any, len(sortColumns))}
+	for i, column := range sortColumns {
+		nextPageToken.Keys[i] = column.getValue(lastModel)
+	}
+	return loggedModels, &nextPageToken, nil
+}
+
+// getExperiment returns models.Experiment entity by its string id.
+func (s Service) getExperiment(
+	ctx context.Context, namespace *models.Namespace, id string,
+) (*models.Experiment, error) {
+	experimentID, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		return nil, api.NewBadRequestError("unable to parse experiment id '%s': %s", id, err)
+	}
+	experiment, err := s.experimentRepository.GetByNamespaceIDAndExperimentID(
+		ctx, namespace.ID, int32(experimentID),
+	)
+	if err != nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find experiment '%s': %s", id, err)
+	}
+	return experiment, nil
+}
+
+// getLoggedModel returns active models.LoggedModel entity by model id.
+func (s Service) getLoggedModel(
+	ctx context.Context, namespace *models.Namespace, modelID string,
+) (*models.LoggedModel, error) {
+	model, err := s.loggedModelRepository.GetByNamespaceIDAndModelID(ctx, namespace.ID, modelID)
+	if err != nil {
+		return nil, api.NewInternalError("unable to find logged model '%s': %s", modelID, err)
+	}
+	if model == nil {
+		return nil, api.NewResourceDoesNotExistError("Logged model with ID '%s' not found", modelID)
+	}
+	return model, nil
+}
+
+// parseOrderBy parses `order_by` clauses of the search request. Models are ordered by the newest first
+// by default, and the model id is always the last column to make the order total for the page tokens.
+func parseOrderBy(clauses []request.LoggedModelOrderByPartialRequest) ([]sortColumn, error) {
+	var sortColumns []sortColumn
+	modelIDOrder := false
+	for _, o := range clauses {
+		column := normalizeAttribute(o.FieldName)
+		switch column {
+		case "model_id":
+			modelIDOrder = true
+		case "name", "model_type", "status", "source_run_id", "creation_timestamp_ms", "last_updated_timestamp_ms":
+		default:
+			return nil, api.NewInvalidParameterValueError(
+				"invalid order_by field '%s'. Valid values are ['name', 'model_id', 'model_type', 'status', "+
+					"'source_run_id', 'creation_timestamp', 'last_updated_timestamp']",
+				o.FieldName,
+			)
+		}
+		sortColumns = append(sortColumns, sortColumn{
+			name: column,
+			desc: o.Ascending != nil && !*o.Ascending,
+		})
+	}
+	if len(clauses) == 0 {
+		sortColumns = append(sortColumns, sortColumn{name: "creation_timestamp_ms", desc: true})
+	}
+	if !modelIDOrder {
+		sortColumns = append(sortColumns, sortColumn{name: "model_id"})
+	}
+	return sortColumns, nil
+}
@@ -0,0 +1,157 @@
+package loggedmodel
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+func TestService_CreateLoggedModel_Ok(t *testing.T) {
+	// init repository mocks.
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+	experimentRepository.On(
+		"GetByNamespaceIDAndExperimentID", context.TODO(), uint(1), int32(1),
+	).Return(&models.Experiment{ID: common.GetPointer[int32](1), ArtifactLocation: "s3://bucket/1"}, nil)
+
+	loggedModelRepository := repositories.MockLoggedModelRepositoryProvider{}
+	loggedModelRepository.On(
+		"Create", context.TODO(), mock.AnythingOfType("*models.LoggedModel"),
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(&loggedModelRepository, &experimentRepository)
+	model, err := service.CreateLoggedModel(context.TODO(), &models.Namespace{ID: 1}, &request.CreateLoggedModelRequest{
+		ExperimentID: "1",
+		Params:       []request.LoggedModelParamPartialRequest{{Key: "alpha", Value: "0.5"}},
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	assert.True(t, strings.HasPrefix(model.ID, "m-"))
+	assert.NotEmpty(t, model.Name)
+	assert.Equal(t, int32(1), model.ExperimentID)
+	assert.Equal(t, models.LoggedModelStatusPending, model.Status)
+	assert.Equal(t, "s3://bucket/1/models/"+model.ID+"/artifacts", model.ArtifactLocation)
+	assert.Equal(t, model.CreationTimestampMS, model.LastUpdatedTimestampMS)
+	assert.Equal(t, []models.LoggedModelParam{{Key: "alpha", Value: "0.5", ModelID: model.ID}}, model.Params)
+	loggedModelRepository.AssertExpectations(t)
+}
+
+func TestService_FinalizeLoggedModel_Ok(t *testing.T) {
+	// init repository mocks.
+	loggedModelRepository := repositories.MockLoggedModelRepositoryProvider{}
+	loggedModelRepository.On(
+		"GetByNamespaceIDAndModelID", context.TODO(), uint(1), "m-1",
+	).Return(&models.LoggedModel{
+		ID:                     "m-1",
+		Status:                 models.LoggedModelStatusPending,
+		LastUpdatedTimestampMS: 1000,
+	}, nil)
+	loggedModelRepository.On(
+		"Update", context.TODO(), mock.MatchedBy(func(model *models.LoggedModel) bool {
+			return model.Status == models.LoggedModelStatusReady && model.LastUpdatedTimestampMS > 1000
+		}),
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(&loggedModelRepository, &repositories.MockExperimentRepositoryProvider{})
+	_, err := service.FinalizeLoggedModel(context.TODO(), &models.Namespace{ID: 1}, &request.FinalizeLoggedModelRequest{
+		ModelID: "m-1",
+		Status:  "LOGGED_MODEL_READY",
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	loggedModelRepository.AssertExpectations(t)
+}
+
+func TestService_SearchLoggedModels_Ok(t *testing.T) {
+	// init repository mocks.
+	loggedModelRepository := repositories.MockLoggedModelRepositoryProvider{}
+	loggedModelRepository.On(
+		"Search",
+		context.TODO(),
+		uint(1),
+		[]int32{1},
+		nil,
+		[]clause.OrderByColumn{
+			{Column: clause.Column{Table: "logged_models", Name: "name"}},
+			{Column: clause.Column{Table: "logged_models", Name: "model_id"}},
+		},
+		3,
+	).Return([]models.LoggedModel{
+		{ID: "m-1", Name: "a"},
+		{ID: "m-2", Name: "b"},
+		{ID: "m-3", Name: "c"},
+	}, nil)
+
+	// call service under testing.
+	service := NewService(&loggedModelRepository, &repositories.MockExperimentRepositoryProvider{})
+	loggedModels, nextPageToken, err := service.SearchLoggedModels(
+		context.TODO(), &models.Namespace{ID: 1}, &request.SearchLoggedModelsRequest{
+			ExperimentIDs: []string{"1"},
+			MaxResults:    2,
+			OrderBy:       []request.LoggedModelOrderByPartialRequest{{FieldName: "name"}},
+		},
+	)
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, []models.LoggedModel{
+		{ID: "m-1", Name: "a"},
+		{ID: "m-2", Name: "b"},
+	}, loggedModels)
+	assert.Equal(t, &request.PageToken{Keys: []any{"b", "m-2"}}, nextPageToken)
+}
+
+func TestService_DeleteLoggedModelTag_Error(t *testing.T) {
+	// init repository mocks.
+	loggedModelRepository := repositories.MockLoggedModelRepositoryProvider{}
+	loggedModelRepository.On(
+		"GetByNamespaceIDAndModelID", context.TODO(), uint(1), "m-1",
+	).Return(&models.LoggedModel{ID: "m-1"}, nil)
+	loggedModelRepository.On(
+		"GetByNamespaceIDAndModelID", context.TODO(), uint(1), "m-2",
+	).Return(nil, nil)
+
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.DeleteLoggedModelTagRequest
+	}{
+		{
+			name:    "EmptyTagKey",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'tag_key'"),
+			request: &request.DeleteLoggedModelTagRequest{ModelID: "m-1"},
+		},
+		{
+			name:    "NotFoundModel",
+			error:   api.NewResourceDoesNotExistError("Logged model with ID 'm-2' not found"),
+			request: &request.DeleteLoggedModelTagRequest{ModelID: "m-2", TagKey: "env"},
+		},
+		{
+			name:    "NotFoundTag",
+			error:   api.NewResourceDoesNotExistError("No tag with key 'env' found for logged model 'm-1'"),
+			request: &request.DeleteLoggedModelTagRequest{ModelID: "m-1", TagKey: "env"},
+		},
+	}
+
+	service := NewService(&loggedModelRepository, &repositories.MockExperimentRepositoryProvider{})
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.DeleteLoggedModelTag(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
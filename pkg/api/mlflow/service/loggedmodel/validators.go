@@ -0,0 +1,140 @@
+package loggedmodel
+
+import (
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// Limits of the logged model properties and requests.
+const (
+	MaxLoggedModelNameLength               = 500
+	MaxLoggedModelKeyLength                = 250
+	MaxLoggedModelValueLength              = 8000
+	MaxResultsForSearchLoggedModelsRequest = 1000
+	DefaultMaxResultsForSearchLoggedModels = 100
+)
+
+// ValidateCreateLoggedModelRequest validates `POST /mlflow/logged-models` request.
+func ValidateCreateLoggedModelRequest(req *request.CreateLoggedModelRequest) error {
+	if req.ExperimentID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'")
+	}
+	if len(req.Name) > MaxLoggedModelNameLength {
+		return api.NewInvalidParameterValueError(
+			"Logged model name '%s' exceeds the maximum length of %d", req.Name, MaxLoggedModelNameLength,
+		)
+	}
+	for _, param := range req.Params {
+		if err := validateKeyValue("param", param.Key, param.Value); err != nil {
+			return err
+		}
+	}
+	for _, tag := range req.Tags {
+		if err := validateKeyValue("tag", tag.Key, tag.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateGetLoggedModelRequest validates `GET /mlflow/logged-models/:model_id` request.
+func ValidateGetLoggedModelRequest(req *request.GetLoggedModelRequest) error {
+	if req.ModelID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'model_id'")
+	}
+	return nil
+}
+
+// ValidateFinalizeLoggedModelRequest validates `PATCH /mlflow/logged-models/:model_id` request.
+func ValidateFinalizeLoggedModelRequest(req *request.FinalizeLoggedModelRequest) error {
+	if req.ModelID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'model_id'")
+	}
+	switch models.LoggedModelStatus(req.Status) {
+	case models.LoggedModelStatusReady, models.LoggedModelStatusUploadFailed:
+	default:
+		return api.NewInvalidParameterValueError(
+			"Invalid value '%s' for parameter 'status'. Valid values are ['%s', '%s']",
+			req.Status, models.LoggedModelStatusReady, models.LoggedModelStatusUploadFailed,
+		)
+	}
+	return nil
+}
+
+// ValidateDeleteLoggedModelRequest validates `DELETE /mlflow/logged-models/:model_id` request.
+func ValidateDeleteLoggedModelRequest(req *request.DeleteLoggedModelRequest) error {
+	if req.ModelID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'model_id'")
+	}
+	return nil
+}
+
+// ValidateSetLoggedModelTagsRequest validates `PATCH /mlflow/logged-models/:model_id/tags` request.
+func ValidateSetLoggedModelTagsRequest(req *request.SetLoggedModelTagsRequest) error {
+	if req.ModelID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'model_id'")
+	}
+	for _, tag := range req.Tags {
+		if err := validateKeyValue("tag", tag.Key, tag.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateDeleteLoggedModelTagRequest validates `DELETE /mlflow/logged-models/:model_id/tags/:tag_key` request.
+func ValidateDeleteLoggedModelTagRequest(req *request.DeleteLoggedModelTagRequest) error {
+	if req.ModelID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'model_id'")
+	}
+	if req.TagKey == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'tag_key'")
+	}
+	return nil
+}
+
+// ValidateLogLoggedModelParamsRequest validates `POST /mlflow/logged-models/:model_id/params` request.
+func ValidateLogLoggedModelParamsRequest(req *request.LogLoggedModelParamsRequest) error {
+	if req.ModelID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'model_id'")
+	}
+	for _, param := range req.Params {
+		if err := validateKeyValue("param", param.Key, param.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateSearchLoggedModelsRequest validates `POST /mlflow/logged-models/search` request.
+func ValidateSearchLoggedModelsRequest(req *request.SearchLoggedModelsRequest) error {
+	if len(req.ExperimentIDs) == 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_ids'")
+	}
+	if req.MaxResults < 0 || req.MaxResults > MaxResultsForSearchLoggedModelsRequest {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'max_results' supplied. It must be at most %d, but got value %d",
+			MaxResultsForSearchLoggedModelsRequest, req.MaxResults,
+		)
+	}
+	return nil
+}
+
+// validateKeyValue validates key and value of the logged model param or tag.
+func validateKeyValue(entity, key, value string) error {
+	if key == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter '%s.key'", entity)
+	}
+	if len(key) > MaxLoggedModelKeyLength {
+		return api.NewInvalidParameterValueError(
+			"Logged model %s key '%s' exceeds the maximum length of %d", entity, key, MaxLoggedModelKeyLength,
+		)
+	}
+	if len(value) > MaxLoggedModelValueLength {
+		return api.NewInvalidParameterValueError(
+			"Value of logged model %s '%s' exceeds the maximum length of %d", entity, key, MaxLoggedModelValueLength,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package loggedmodel
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+)
+
+func TestValidateCreateLoggedModelRequest_Ok(t *testing.T) {
+	err := ValidateCreateLoggedModelRequest(&request.CreateLoggedModelRequest{
+		ExperimentID: "1",
+		Params:       []request.LoggedModelParamPartialRequest{{Key: "alpha", Value: "0.5"}},
+		Tags:         []request.LoggedModelTagPartialRequest{{Key: "env", Value: "test"}},
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateCreateLoggedModelRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.CreateLoggedModelRequest
+	}{
+		{
+			name:    "EmptyExperimentID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'"),
+			request: &request.CreateLoggedModelRequest{},
+		},
+		{
+			name:  "EmptyParamKey",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'param.key'"),
+			request: &request.CreateLoggedModelRequest{
+				ExperimentID: "1",
+				Params:       []request.LoggedModelParamPartialRequest{{Value: "0.5"}},
+			},
+		},
+		{
+			name: "LongTagValue",
+			error: api.NewInvalidParameterValueError(
+				"Value of logged model tag 'env' exceeds the maximum length of 8000",
+			),
+			request: &request.CreateLoggedModelRequest{
+				ExperimentID: "1",
+				Tags: []request.LoggedModelTagPartialRequest{
+					{Key: "env", Value: strings.Repeat("a", MaxLoggedModelValueLength+1)},
+				},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCreateLoggedModelRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateFinalizeLoggedModelRequest_Ok(t *testing.T) {
+	for _, status := range []string{"LOGGED_MODEL_READY", "LOGGED_MODEL_UPLOAD_FAILED"} {
+		err := ValidateFinalizeLoggedModelRequest(&request.FinalizeLoggedModelRequest{
+			ModelID: "m-1",
+			Status:  status,
+		})
+		require.Nil(t, err)
+	}
+}
+
+func TestValidateFinalizeLoggedModelRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.FinalizeLoggedModelRequest
+	}{
+		{
+			name:    "EmptyModelID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'model_id'"),
+			request: &request.FinalizeLoggedModelRequest{},
+		},
+		{
+			name: "InvalidStatus",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value 'LOGGED_MODEL_PENDING' for parameter 'status'. " +
+					"Valid values are ['LOGGED_MODEL_READY', 'LOGGED_MODEL_UPLOAD_FAILED']",
+			),
+			request: &request.FinalizeLoggedModelRequest{ModelID: "m-1", Status: "LOGGED_MODEL_PENDING"},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFinalizeLoggedModelRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package metric
 
 import (
+	"encoding/json"
 	"fmt"
 
+	"github.com/rotisserie/eris"
+
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 )
@@ -15,3 +18,30 @@ func adjustGetMetricHistoriesRequestForNamespace(ns *models.Namespace, req *requ
 		}
 	}
 }
+
+// convertMetricContext decodes JSON encoded `context` parameter of the metrics history request.
+func convertMetricContext(metricContext string) (map[string]string, error) {
+	if metricContext == "" {
+		return nil, nil
+	}
+	var result map[string]string
+	if err := json.Unmarshal([]byte(metricContext), &result); err != nil {
+		return nil, eris.Wrap(err, "error decoding context")
+	}
+	return result, nil
+}
+
+// convertPageToken decodes `page_token` parameter of the metrics history request into the keys of the page.
+func convertPageToken(pageToken string) ([]any, error) {
+	if pageToken == "" {
+		return nil, nil
+	}
+	token, err := request.DecodePageToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+	if token.Keys == nil {
+		return nil, eris.New("page token doesn't have keys")
+	}
+	return token.Keys, nil
+}
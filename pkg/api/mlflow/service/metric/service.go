@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/rotisserie/eris"
+
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
@@ -29,47 +31,96 @@ func NewService(
 
 func (s Service) GetMetricHistory(
 	ctx context.Context, namespace *models.Namespace, req *request.GetMetricHistoryRequest,
-) ([]models.Metric, error) {
+) ([]models.Metric, *request.PageToken, error) {
 	if err := ValidateGetMetricHistoryRequest(req); err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	metricContext, pageKeys, err := convertMetricHistoryFilters(req.Context, req.PageToken)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.GetRunID())
 	if err != nil {
-		return nil, api.NewInternalError("unable to find run '%s': %s", req.GetRunID(), err)
+		return nil, nil, api.NewInternalError("unable to find run '%s': %s", req.GetRunID(), err)
 	}
 	if run == nil {
-		return nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
+		return nil, nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
 	}
 
-	metrics, err := s.metricRepository.GetMetricHistoryByRunIDAndKey(ctx, run.ID, req.MetricKey)
+	metrics, nextPageKeys, err := s.metricRepository.GetMetricHistoryByRunIDAndKey(
+		ctx, run.ID, req.MetricKey, metricContext, pageKeys, req.MaxResults,
+	)
 	if err != nil {
-		return nil, api.NewInternalError(
+		if eris.Is(err, repositories.ErrPageKeysMismatch) {
+			return nil, nil, api.NewInvalidParameterValueError("invalid page_token '%s'", req.PageToken)
+		}
+		return nil, nil, api.NewInternalError(
 			"unable to get metric history for metric '%s' of run '%s'", req.MetricKey, req.GetRunID(),
 		)
 	}
 
-	return metrics, nil
+	return metrics, newPageToken(nextPageKeys), nil
 }
 
 func (s Service) GetMetricHistoryBulk(
 	ctx context.Context, namespace *models.Namespace, req *request.GetMetricHistoryBulkRequest,
-) ([]models.Metric, error) {
+) ([]models.Metric, *request.PageToken, error) {
 	if err := ValidateGetMetricHistoryBulkRequest(req); err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	metricContext, pageKeys, err := convertMetricHistoryFilters(req.Context, req.PageToken)
+	if err != nil {
+		return nil, nil, err
 	}
-	metrics, err := s.metricRepository.GetMetricHistoryBulk(
+
+	metrics, nextPageKeys, err := s.metricRepository.GetMetricHistoryBulk(
 		ctx,
 		namespace.ID,
 		req.RunIDs,
 		req.MetricKey,
+		metricContext,
+		pageKeys,
 		req.MaxResults,
 	)
 	if err != nil {
-		return nil, api.NewInternalError(
+		if eris.Is(err, repositories.ErrPageKeysMismatch) {
+			return nil, nil, api.NewInvalidParameterValueError("invalid page_token '%s'", req.PageToken)
+		}
+		return nil, nil, api.NewInternalError(
 			"unable to get metric history in bulk for metric %q of runs %q", req.MetricKey, req.RunIDs,
 		)
 	}
+	return metrics, newPageToken(nextPageKeys), nil
+}
+
+func (s Service) GetMetricHistoryBulkInterval(
+	ctx context.Context, namespace *models.Namespace, req *request.GetMetricHistoryBulkIntervalRequest,
+) ([]models.Metric, error) {
+	if err := ValidateGetMetricHistoryBulkIntervalRequest(req); err != nil {
+		return nil, err
+	}
+
+	maxResults := req.MaxResults
+	if maxResults == 0 {
+		maxResults = MaxResultsForMetricHistoryBulkInterval
+	}
+	metrics, err := s.metricRepository.GetMetricHistoryBulkInterval(
+		ctx,
+		namespace.ID,
+		req.RunIDs,
+		req.MetricKey,
+		req.StartStep,
+		req.EndStep,
+		maxResults,
+	)
+	if err != nil {
+		return nil, api.NewInternalError(
+			"unable to get metric history interval for metric %q of runs %q", req.MetricKey, req.RunIDs,
+		)
+	}
 	return metrics, nil
 }
 
@@ -97,3 +148,77 @@ func (s Service) GetMetricHistories(
 
 	return rows, iterator, nil
 }
+
+// DeleteMetric deletes the whole history of the metric key of the run, optionally only in provided context.
+func (s Service) DeleteMetric(
+	ctx context.Context, namespace *models.Namespace, req *request.DeleteMetricRequest,
+) error {
+	if err := ValidateDeleteMetricRequest(req); err != nil {
+		return err
+	}
+
+	run, err := s.getRun(ctx, namespace, req.RunID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.metricRepository.DeleteHistory(ctx, run.ID, req.Key, req.Context, nil); err != nil {
+		return api.NewInternalError("unable to delete metric '%s' of run '%s': %s", req.Key, req.RunID, err)
+	}
+	return nil
+}
+
+// TruncateMetrics deletes the metrics history of the run after provided step,
+// optionally only for provided metric key and context.
+func (s Service) TruncateMetrics(
+	ctx context.Context, namespace *models.Namespace, req *request.TruncateMetricsRequest,
+) error {
+	if err := ValidateTruncateMetricsRequest(req); err != nil {
+		return err
+	}
+
+	run, err := s.getRun(ctx, namespace, req.RunID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.metricRepository.DeleteHistory(ctx, run.ID, req.Key, req.Context, req.Step); err != nil {
+		return api.NewInternalError(
+			"unable to truncate metrics of run '%s' after step %d: %s", req.RunID, *req.Step, err,
+		)
+	}
+	return nil
+}
+
+// getRun returns the run of the namespace or ResourceDoesNotExist error, when the run doesn't exist.
+func (s Service) getRun(ctx context.Context, namespace *models.Namespace, runID string) (*models.Run, error) {
+	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, runID)
+	if err != nil {
+		return nil, api.NewInternalError("unable to find run '%s': %s", runID, err)
+	}
+	if run == nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find run '%s'", runID)
+	}
+	return run, nil
+}
+
+// convertMetricHistoryFilters decodes `context` and `page_token` parameters of the metrics history requests.
+func convertMetricHistoryFilters(metricContext, pageToken string) (map[string]string, []any, error) {
+	contextMap, err := convertMetricContext(metricContext)
+	if err != nil {
+		return nil, nil, api.NewInvalidParameterValueError("invalid context '%s': %s", metricContext, err)
+	}
+	pageKeys, err := convertPageToken(pageToken)
+	if err != nil {
+		return nil, nil, api.NewInvalidParameterValueError("invalid page_token '%s': %s", pageToken, err)
+	}
+	return contextMap, pageKeys, nil
+}
+
+// newPageToken creates token of the next page from its keys, if there is the next page.
+func newPageToken(pageKeys []any) *request.PageToken {
+	if pageKeys == nil {
+		return nil
+	}
+	return &request.PageToken{Keys: pageKeys}
+}
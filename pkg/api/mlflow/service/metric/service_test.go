@@ -6,6 +6,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/rotisserie/eris"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -35,6 +36,9 @@ func TestService_GetMetricHistory_Ok(t *testing.T) {
 		context.TODO(),
 		"1",
 		"key",
+		map[string]string{"subset": "val"},
+		[]any{int64(0), int64(1234567889), 0.5, false, int64(1)},
+		1,
 	).Return([]models.Metric{
 		{
 			Key:       "key",
@@ -42,23 +46,33 @@ func TestService_GetMetricHistory_Ok(t *testing.T) {
 			Value:     1.1,
 			Timestamp: 1234567890,
 		},
-	}, nil)
+	}, []any{int64(1), int64(1234567890), 1.1, false, uint(1)}, nil)
 
 	// call service under testing.
+	pageToken, err := request.PageToken{
+		Keys: []any{int64(0), int64(1234567889), 0.5, false, int64(1)},
+	}.Encode()
+	require.Nil(t, err)
 	service := NewService(&runRepository, &metricRepository)
-	metrics, err := service.GetMetricHistory(
+	metrics, nextPageToken, err := service.GetMetricHistory(
 		context.TODO(),
 		&models.Namespace{
 			ID: 1,
 		},
 		&request.GetMetricHistoryRequest{
-			RunID:     "1",
-			MetricKey: "key",
+			RunID:      "1",
+			MetricKey:  "key",
+			MaxResults: 1,
+			PageToken:  pageToken,
+			Context:    `{"subset": "val"}`,
 		},
 	)
 
 	// compare results.
 	require.Nil(t, err)
+	assert.Equal(t, &request.PageToken{
+		Keys: []any{int64(1), int64(1234567890), 1.1, false, uint(1)},
+	}, nextPageToken)
 	assert.Equal(t, []models.Metric{
 		{
 			Key:       "key",
@@ -129,7 +143,57 @@ func TestService_GetMetricHistory_Error(t *testing.T) {
 					context.TODO(),
 					"1",
 					"key",
-				).Return(nil, errors.New("database error"))
+					map[string]string(nil),
+					[]any(nil),
+					0,
+				).Return(nil, nil, errors.New("database error"))
+				return NewService(&runRepository, &metricRepository)
+			},
+		},
+		{
+			name: "InvalidContext",
+			error: api.NewInvalidParameterValueError(
+				"invalid context 'subset': error decoding context: invalid character 's' looking for beginning of value",
+			),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "1",
+				MetricKey: "key",
+				Context:   "subset",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				return NewService(&runRepository, &metricRepository)
+			},
+		},
+		{
+			name:  "PageTokenDoesNotMatchOrdering",
+			error: api.NewInvalidParameterValueError("invalid page_token 'eyJrZXlzIjpbMV19Cg=='"),
+			request: &request.GetMetricHistoryRequest{
+				RunID:     "1",
+				MetricKey: "key",
+				PageToken: "eyJrZXlzIjpbMV19Cg==",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID",
+					context.TODO(),
+					uint(1),
+					"1",
+				).Return(&models.Run{
+					ID: "1",
+				}, nil)
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				metricRepository.On(
+					"GetMetricHistoryByRunIDAndKey",
+					context.TODO(),
+					"1",
+					"key",
+					map[string]string(nil),
+					[]any{int64(1)},
+					0,
+				).Return(nil, nil, eris.Wrap(repositories.ErrPageKeysMismatch, "error getting metric history"))
 				return NewService(&runRepository, &metricRepository)
 			},
 		},
@@ -138,7 +202,7 @@ func TestService_GetMetricHistory_Error(t *testing.T) {
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
 			// call service under testing.
-			_, err := tt.service().GetMetricHistory(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			_, _, err := tt.service().GetMetricHistory(context.TODO(), &models.Namespace{ID: 1}, tt.request)
 			assert.Equal(t, tt.error, err)
 		})
 	}
@@ -155,6 +219,8 @@ func TestService_GetMetricHistoryBulk_Ok(t *testing.T) {
 		uint(1),
 		[]string{"1", "2"},
 		"key",
+		map[string]string{"subset": "val"},
+		[]any(nil),
 		10,
 	).Return([]models.Metric{
 		{
@@ -163,20 +229,22 @@ func TestService_GetMetricHistoryBulk_Ok(t *testing.T) {
 			Value:     1.1,
 			Timestamp: 1234567890,
 		},
-	}, nil)
+	}, nil, nil)
 
 	// call service under testing.
 	service := NewService(&runRepository, &metricRepository)
-	metrics, err := service.GetMetricHistoryBulk(context.TODO(), &models.Namespace{
+	metrics, nextPageToken, err := service.GetMetricHistoryBulk(context.TODO(), &models.Namespace{
 		ID: 1,
 	}, &request.GetMetricHistoryBulkRequest{
 		RunIDs:     []string{"1", "2"},
 		MetricKey:  "key",
 		MaxResults: 10,
+		Context:    `{"subset": "val"}`,
 	})
 
 	// compare results.
 	require.Nil(t, err)
+	assert.Nil(t, nextPageToken)
 	assert.Equal(t, []models.Metric{
 		{
 			Key:       "key",
@@ -251,6 +319,149 @@ func TestService_GetMetricHistoryBulk_Error(t *testing.T) {
 					uint(1),
 					[]string{"1"},
 					"key",
+					map[string]string(nil),
+					[]any(nil),
+					10,
+				).Return(nil, nil, errors.New("database error"))
+				return NewService(&runRepository, &metricRepository)
+			},
+		},
+		{
+			name: "InvalidPageToken",
+			error: api.NewInvalidParameterValueError(
+				"invalid page_token 'token': error decoding page token: " +
+					"invalid character '\\xb6' looking for beginning of value",
+			),
+			request: &request.GetMetricHistoryBulkRequest{
+				RunIDs:    []string{"1"},
+				MetricKey: "key",
+				PageToken: "token",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				return NewService(&runRepository, &metricRepository)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			// call service under testing.
+			_, _, err := tt.service().GetMetricHistoryBulk(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestService_GetMetricHistoryBulkInterval_Ok(t *testing.T) {
+	testData := []struct {
+		name               string
+		request            *request.GetMetricHistoryBulkIntervalRequest
+		expectedMaxResults int
+	}{
+		{
+			name: "WithStepsAndMaxResults",
+			request: &request.GetMetricHistoryBulkIntervalRequest{
+				RunIDs:     []string{"1", "2"},
+				MetricKey:  "key",
+				StartStep:  common.GetPointer[int64](1),
+				EndStep:    common.GetPointer[int64](100),
+				MaxResults: 10,
+			},
+			expectedMaxResults: 10,
+		},
+		{
+			name: "WithDefaultMaxResults",
+			request: &request.GetMetricHistoryBulkIntervalRequest{
+				RunIDs:    []string{"1", "2"},
+				MetricKey: "key",
+			},
+			expectedMaxResults: MaxResultsForMetricHistoryBulkInterval,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			// init repository mocks.
+			runRepository := repositories.MockRunRepositoryProvider{}
+			metricRepository := repositories.MockMetricRepositoryProvider{}
+			metricRepository.On(
+				"GetMetricHistoryBulkInterval",
+				context.TODO(),
+				uint(1),
+				tt.request.RunIDs,
+				"key",
+				tt.request.StartStep,
+				tt.request.EndStep,
+				tt.expectedMaxResults,
+			).Return([]models.Metric{
+				{
+					Key:       "key",
+					Step:      1,
+					Value:     1.1,
+					Timestamp: 1234567890,
+				},
+			}, nil)
+
+			// call service under testing.
+			service := NewService(&runRepository, &metricRepository)
+			metrics, err := service.GetMetricHistoryBulkInterval(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+
+			// compare results.
+			require.Nil(t, err)
+			assert.Equal(t, []models.Metric{
+				{
+					Key:       "key",
+					Step:      1,
+					Value:     1.1,
+					Timestamp: 1234567890,
+				},
+			}, metrics)
+		})
+	}
+}
+
+func TestService_GetMetricHistoryBulkInterval_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.GetMetricHistoryBulkIntervalRequest
+		service func() *Service
+	}{
+		{
+			name: "EmptyOrIncorrectRunIDs",
+			error: api.NewInvalidParameterValueError(
+				`GetMetricHistoryBulkInterval request must specify at least one run_id.`,
+			),
+			request: &request.GetMetricHistoryBulkIntervalRequest{},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				return NewService(&runRepository, &metricRepository)
+			},
+		},
+		{
+			name: "GetMetricHistoryBulkIntervalDatabaseError",
+			error: api.NewInternalError(
+				`unable to get metric history interval for metric "key" of runs ["1"]`,
+			),
+			request: &request.GetMetricHistoryBulkIntervalRequest{
+				RunIDs:     []string{"1"},
+				MetricKey:  "key",
+				MaxResults: 10,
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				metricRepository.On(
+					"GetMetricHistoryBulkInterval",
+					context.TODO(),
+					uint(1),
+					[]string{"1"},
+					"key",
+					(*int64)(nil),
+					(*int64)(nil),
 					10,
 				).Return(nil, errors.New("database error"))
 				return NewService(&runRepository, &metricRepository)
@@ -261,7 +472,7 @@ func TestService_GetMetricHistoryBulk_Error(t *testing.T) {
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
 			// call service under testing.
-			_, err := tt.service().GetMetricHistoryBulk(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			_, err := tt.service().GetMetricHistoryBulkInterval(context.TODO(), &models.Namespace{ID: 1}, tt.request)
 			assert.Equal(t, tt.error, err)
 		})
 	}
@@ -444,3 +655,166 @@ func TestNewService_GetMetricHistories_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestService_DeleteMetric_Ok(t *testing.T) {
+	// init repository mocks.
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDAndRunID", context.TODO(), uint(1), "1",
+	).Return(&models.Run{
+		ID: "1",
+	}, nil)
+	metricRepository := repositories.MockMetricRepositoryProvider{}
+	metricRepository.On(
+		"DeleteHistory", context.TODO(), "1", "key", map[string]string{"subset": "val"}, (*int64)(nil),
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(&runRepository, &metricRepository)
+	err := service.DeleteMetric(context.TODO(), &models.Namespace{ID: 1}, &request.DeleteMetricRequest{
+		RunID:   "1",
+		Key:     "key",
+		Context: map[string]string{"subset": "val"},
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	metricRepository.AssertExpectations(t)
+}
+
+func TestService_DeleteMetric_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.DeleteMetricRequest
+		service func() *Service
+	}{
+		{
+			name:    "EmptyOrIncorrectRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.DeleteMetricRequest{},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				return NewService(&runRepository, &metricRepository)
+			},
+		},
+		{
+			name:  "RunNotFound",
+			error: api.NewResourceDoesNotExistError("unable to find run '1'"),
+			request: &request.DeleteMetricRequest{
+				RunID: "1",
+				Key:   "key",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID", context.TODO(), uint(1), "1",
+				).Return(nil, nil)
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				return NewService(&runRepository, &metricRepository)
+			},
+		},
+		{
+			name:  "DeleteHistoryDatabaseError",
+			error: api.NewInternalError("unable to delete metric 'key' of run '1': database error"),
+			request: &request.DeleteMetricRequest{
+				RunID: "1",
+				Key:   "key",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID", context.TODO(), uint(1), "1",
+				).Return(&models.Run{ID: "1"}, nil)
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				metricRepository.On(
+					"DeleteHistory", context.TODO(), "1", "key", map[string]string(nil), (*int64)(nil),
+				).Return(errors.New("database error"))
+				return NewService(&runRepository, &metricRepository)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			// call service under testing.
+			err := tt.service().DeleteMetric(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestService_TruncateMetrics_Ok(t *testing.T) {
+	// init repository mocks.
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDAndRunID", context.TODO(), uint(1), "1",
+	).Return(&models.Run{
+		ID: "1",
+	}, nil)
+	metricRepository := repositories.MockMetricRepositoryProvider{}
+	metricRepository.On(
+		"DeleteHistory", context.TODO(), "1", "", map[string]string(nil), common.GetPointer[int64](10),
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(&runRepository, &metricRepository)
+	err := service.TruncateMetrics(context.TODO(), &models.Namespace{ID: 1}, &request.TruncateMetricsRequest{
+		RunID: "1",
+		Step:  common.GetPointer[int64](10),
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	metricRepository.AssertExpectations(t)
+}
+
+func TestService_TruncateMetrics_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.TruncateMetricsRequest
+		service func() *Service
+	}{
+		{
+			name:  "EmptyOrIncorrectStep",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'step'"),
+			request: &request.TruncateMetricsRequest{
+				RunID: "1",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				return NewService(&runRepository, &metricRepository)
+			},
+		},
+		{
+			name:  "DeleteHistoryDatabaseError",
+			error: api.NewInternalError("unable to truncate metrics of run '1' after step 10: database error"),
+			request: &request.TruncateMetricsRequest{
+				RunID: "1",
+				Step:  common.GetPointer[int64](10),
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID", context.TODO(), uint(1), "1",
+				).Return(&models.Run{ID: "1"}, nil)
+				metricRepository := repositories.MockMetricRepositoryProvider{}
+				metricRepository.On(
+					"DeleteHistory", context.TODO(), "1", "", map[string]string(nil), common.GetPointer[int64](10),
+				).Return(errors.New("database error"))
+				return NewService(&runRepository, &metricRepository)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			// call service under testing.
+			err := tt.service().TruncateMetrics(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
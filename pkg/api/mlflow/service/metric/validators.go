@@ -6,8 +6,10 @@ import (
 )
 
 const (
-	MaxResultsForMetricHistoriesRequest  = 1000000000
-	MaxRunIDsForMetricHistoryBulkRequest = 200
+	MaxResultsForMetricHistoriesRequest          = 1000000000
+	MaxRunIDsForMetricHistoryBulkRequest         = 200
+	MaxRunIDsForMetricHistoryBulkIntervalRequest = 100
+	MaxResultsForMetricHistoryBulkInterval       = 2500
 )
 
 // AllowedViewTypeList supported list of ViewType.
@@ -28,6 +30,9 @@ func ValidateGetMetricHistoryRequest(req *request.GetMetricHistoryRequest) error
 	if req.MetricKey == "" {
 		return api.NewInvalidParameterValueError("Missing value for required parameter 'metric_key'")
 	}
+	if req.MaxResults < 0 {
+		return api.NewInvalidParameterValueError("Invalid value for parameter 'max_results' supplied.")
+	}
 	return nil
 }
 
@@ -49,6 +54,46 @@ func ValidateGetMetricHistoryBulkRequest(req *request.GetMetricHistoryBulkReques
 	return nil
 }
 
+// ValidateGetMetricHistoryBulkIntervalRequest validates `GET /mlflow/metrics/get-history-bulk-interval` request.
+func ValidateGetMetricHistoryBulkIntervalRequest(req *request.GetMetricHistoryBulkIntervalRequest) error {
+	if len(req.RunIDs) == 0 {
+		return api.NewInvalidParameterValueError(
+			"GetMetricHistoryBulkInterval request must specify at least one run_id.",
+		)
+	}
+
+	if len(req.RunIDs) > MaxRunIDsForMetricHistoryBulkIntervalRequest {
+		return api.NewInvalidParameterValueError(
+			"GetMetricHistoryBulkInterval request cannot specify more than %d run_ids. Received %d run_ids.",
+			MaxRunIDsForMetricHistoryBulkIntervalRequest, len(req.RunIDs),
+		)
+	}
+
+	if req.MetricKey == "" {
+		return api.NewInvalidParameterValueError("GetMetricHistoryBulkInterval request must specify a metric_key.")
+	}
+
+	if req.MaxResults < 0 || req.MaxResults > MaxResultsForMetricHistoryBulkInterval {
+		return api.NewInvalidParameterValueError(
+			"max_results must be between 1 and %d.", MaxResultsForMetricHistoryBulkInterval,
+		)
+	}
+
+	if (req.StartStep == nil) != (req.EndStep == nil) {
+		return api.NewInvalidParameterValueError(
+			"If either start step or end step are specified, both must be specified.",
+		)
+	}
+
+	if req.StartStep != nil && *req.StartStep > *req.EndStep {
+		return api.NewInvalidParameterValueError(
+			"end_step must be greater than start_step. Found start_step=%d and end_step=%d.",
+			*req.StartStep, *req.EndStep,
+		)
+	}
+	return nil
+}
+
 // ValidateGetMetricHistoriesRequest validates `GET /mlflow/metrics/get-histories` request.
 func ValidateGetMetricHistoriesRequest(req *request.GetMetricHistoriesRequest) error {
 	if len(req.ExperimentIDs) > 0 && len(req.RunIDs) > 0 {
@@ -68,3 +113,25 @@ func ValidateGetMetricHistoriesRequest(req *request.GetMetricHistoriesRequest) e
 	}
 	return nil
 }
+
+// ValidateDeleteMetricRequest validates `POST /mlflow/metrics/delete` request.
+func ValidateDeleteMetricRequest(req *request.DeleteMetricRequest) error {
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	if req.Key == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'key'")
+	}
+	return nil
+}
+
+// ValidateTruncateMetricsRequest validates `POST /mlflow/metrics/truncate` request.
+func ValidateTruncateMetricsRequest(req *request.TruncateMetricsRequest) error {
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	if req.Step == nil {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'step'")
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 )
 
 func TestValidateGetMetricHistoryRequest_Ok(t *testing.T) {
@@ -93,6 +94,90 @@ func TestValidateGetMetricHistoryBulkRequest_Error(t *testing.T) {
 	}
 }
 
+func TestValidateGetMetricHistoryBulkIntervalRequest_Ok(t *testing.T) {
+	err := ValidateGetMetricHistoryBulkIntervalRequest(&request.GetMetricHistoryBulkIntervalRequest{
+		RunIDs:     []string{"id1", "id2"},
+		MetricKey:  "key",
+		StartStep:  common.GetPointer[int64](10),
+		EndStep:    common.GetPointer[int64](10),
+		MaxResults: 100,
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateGetMetricHistoryBulkIntervalRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.GetMetricHistoryBulkIntervalRequest
+	}{
+		{
+			name: "EmptyRunIDsProperty",
+			error: api.NewInvalidParameterValueError(
+				"GetMetricHistoryBulkInterval request must specify at least one run_id.",
+			),
+			request: &request.GetMetricHistoryBulkIntervalRequest{},
+		},
+		{
+			name: "IncorrectSizeOfRunIDsProperty",
+			error: api.NewInvalidParameterValueError(
+				"GetMetricHistoryBulkInterval request cannot specify more than 100 run_ids. Received 101 run_ids.",
+			),
+			request: &request.GetMetricHistoryBulkIntervalRequest{
+				RunIDs: make([]string, 101),
+			},
+		},
+		{
+			name: "EmptyMetricKeyProperty",
+			error: api.NewInvalidParameterValueError(
+				"GetMetricHistoryBulkInterval request must specify a metric_key.",
+			),
+			request: &request.GetMetricHistoryBulkIntervalRequest{
+				RunIDs: []string{"id1"},
+			},
+		},
+		{
+			name:  "IncorrectMaxResultsProperty",
+			error: api.NewInvalidParameterValueError("max_results must be between 1 and 2500."),
+			request: &request.GetMetricHistoryBulkIntervalRequest{
+				RunIDs:     []string{"id1"},
+				MetricKey:  "key",
+				MaxResults: 2501,
+			},
+		},
+		{
+			name: "StartStepWithoutEndStep",
+			error: api.NewInvalidParameterValueError(
+				"If either start step or end step are specified, both must be specified.",
+			),
+			request: &request.GetMetricHistoryBulkIntervalRequest{
+				RunIDs:    []string{"id1"},
+				MetricKey: "key",
+				StartStep: common.GetPointer[int64](1),
+			},
+		},
+		{
+			name: "StartStepGreaterThanEndStep",
+			error: api.NewInvalidParameterValueError(
+				"end_step must be greater than start_step. Found start_step=10 and end_step=1.",
+			),
+			request: &request.GetMetricHistoryBulkIntervalRequest{
+				RunIDs:    []string{"id1"},
+				MetricKey: "key",
+				StartStep: common.GetPointer[int64](10),
+				EndStep:   common.GetPointer[int64](1),
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGetMetricHistoryBulkIntervalRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
 func TestValidateGetMetricHistoriesRequest_Ok(t *testing.T) {
 	err := ValidateGetMetricHistoriesRequest(&request.GetMetricHistoriesRequest{
 		RunIDs:     []string{"id1"},
@@ -143,3 +228,76 @@ func TestValidateGetMetricHistoriesRequest_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDeleteMetricRequest_Ok(t *testing.T) {
+	err := ValidateDeleteMetricRequest(&request.DeleteMetricRequest{
+		RunID: "id",
+		Key:   "key",
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateDeleteMetricRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.DeleteMetricRequest
+	}{
+		{
+			name:    "EmptyRunIDProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.DeleteMetricRequest{},
+		},
+		{
+			name:  "EmptyKeyProperty",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'key'"),
+			request: &request.DeleteMetricRequest{
+				RunID: "id",
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDeleteMetricRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateTruncateMetricsRequest_Ok(t *testing.T) {
+	err := ValidateTruncateMetricsRequest(&request.TruncateMetricsRequest{
+		RunID: "id",
+		Step:  common.GetPointer[int64](0),
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateTruncateMetricsRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.TruncateMetricsRequest
+	}{
+		{
+			name:    "EmptyRunIDProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.TruncateMetricsRequest{},
+		},
+		{
+			name:  "EmptyStepProperty",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'step'"),
+			request: &request.TruncateMetricsRequest{
+				RunID: "id",
+				Key:   "key",
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTruncateMetricsRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
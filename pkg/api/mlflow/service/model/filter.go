@@ -0,0 +1,182 @@
+package model
+
+import (
+	"strconv"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/filter"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// comparisonConditionBuilder compiles single comparison of the filter into the condition of the search query.
+type comparisonConditionBuilder func(filterText string, comparison *filter.Comparison) (clause.Expression, error)
+
+// buildFilterCondition compiles the filter expression into the condition of the search query, using
+// buildComparison for the single comparisons. filterText is the original filter, which is referenced by the errors.
+func buildFilterCondition(
+	filterText string, expression filter.Expression, buildComparison comparisonConditionBuilder,
+) (clause.Expression, error) {
+	switch expression := expression.(type) {
+	case *filter.LogicalExpression:
+		operands := make([]clause.Expression, len(expression.Operands))
+		for i, operand := range expression.Operands {
+			condition, err := buildFilterCondition(filterText, operand, buildComparison)
+			if err != nil {
+				return nil, err
+			}
+			operands[i] = condition
+		}
+		if expression.Operator == filter.OrOperator {
+			return clause.Or(operands...), nil
+		}
+		return clause.And(operands...), nil
+	case *filter.Comparison:
+		return buildComparison(filterText, expression)
+	default:
+		return nil, api.NewInternalError("unsupported filter expression %T", expression)
+	}
+}
+
+// buildRegisteredModelComparisonCondition compiles single comparison of the filter into the condition
+// of `registered_models` query.
+func buildRegisteredModelComparisonCondition(
+	filterText string, comparison *filter.Comparison,
+) (clause.Expression, error) {
+	switch comparison.Entity {
+	case "", "attribute", "attributes", "attr":
+		if comparison.Key != "name" {
+			return nil, filter.NewSemanticError(
+				filterText, comparison.Position(),
+				"invalid attribute '%s'. Valid values are ['name']", comparison.Key,
+			)
+		}
+		return buildStringCondition(filterText, comparison, "registered_models.name")
+	case "tag", "tags":
+		return buildTagCondition(
+			filterText, comparison, &models.RegisteredModelTag{}, "registered_models.id", "registered_model_id",
+		)
+	default:
+		return nil, filter.NewSemanticError(
+			filterText, comparison.Position(),
+			"invalid entity type '%s'. Valid values are ['tag', 'attribute']", comparison.Entity,
+		)
+	}
+}
+
+// buildModelVersionComparisonCondition compiles single comparison of the filter into the condition
+// of `model_versions` query.
+func buildModelVersionComparisonCondition(
+	filterText string, comparison *filter.Comparison,
+) (clause.Expression, error) {
+	key, operator := comparison.Key, comparison.Operator
+	switch comparison.Entity {
+	case "", "attribute", "attributes", "attr":
+		switch key {
+		case "name":
+			return buildStringCondition(filterText, comparison, "registered_models.name")
+		case "source_path":
+			return buildStringCondition(filterText, comparison, "model_versions.source")
+		case "run_id":
+			switch operator {
+			case filter.NotEqualOperator, filter.EqualOperator:
+				return buildColumnCondition("model_versions.run_uuid", operator, comparison.Value.Text), nil
+			case filter.InOperator, filter.NotInOperator:
+				return buildColumnCondition("model_versions.run_uuid", operator, getValues(comparison)), nil
+			default:
+				return nil, filter.NewSemanticError(
+					filterText, comparison.Position(), "invalid run_id comparison operator '%s'", operator,
+				)
+			}
+		case "version_number":
+			switch operator {
+			case filter.GreaterOperator, filter.GreaterOrEqualOperator, filter.NotEqualOperator,
+				filter.EqualOperator, filter.LessOperator, filter.LessOrEqualOperator:
+				value, err := strconv.ParseInt(comparison.Value.Text, 10, 64)
+				if err != nil {
+					return nil, filter.NewSemanticError(
+						filterText, comparison.Value.Position(), "invalid numeric value '%s'", comparison.Value.Raw,
+					)
+				}
+				return buildColumnCondition("model_versions.version", operator, value), nil
+			default:
+				return nil, filter.NewSemanticError(
+					filterText, comparison.Position(),
+					"invalid numeric attribute comparison operator '%s'", operator,
+				)
+			}
+		default:
+			return nil, filter.NewSemanticError(
+				filterText, comparison.Position(),
+				"invalid attribute '%s'. Valid values are ['name', 'run_id', 'source_path', 'version_number']",
+				key,
+			)
+		}
+	case "tag", "tags":
+		return buildTagCondition(
+			filterText, comparison, &models.ModelVersionTag{}, "model_versions.id", "model_version_id",
+		)
+	default:
+		return nil, filter.NewSemanticError(
+			filterText, comparison.Position(),
+			"invalid entity type '%s'. Valid values are ['tag', 'attribute']", comparison.Entity,
+		)
+	}
+}
+
+// buildStringCondition builds condition, which compares the string column with the value of the comparison.
+func buildStringCondition(filterText string, comparison *filter.Comparison, column string) (clause.Expression, error) {
+	switch comparison.Operator {
+	case filter.NotEqualOperator, filter.EqualOperator, filter.LikeOperator, filter.ILikeOperator:
+		return buildColumnCondition(column, comparison.Operator, comparison.Value.Text), nil
+	case filter.InOperator, filter.NotInOperator:
+		return buildColumnCondition(column, comparison.Operator, getValues(comparison)), nil
+	default:
+		return nil, filter.NewSemanticError(
+			filterText, comparison.Position(),
+			"invalid string attribute comparison operator '%s'", comparison.Operator,
+		)
+	}
+}
+
+// buildTagCondition builds condition, which compares the tag value of the registered model or model version.
+// `IS NULL` matches the entities, which don't have the tag at all.
+func buildTagCondition(
+	filterText string, comparison *filter.Comparison, model any, column, foreignKey string,
+) (clause.Expression, error) {
+	query := database.DB.Model(model).Select(foreignKey).Where("key = ?", comparison.Key)
+	switch comparison.Operator {
+	case filter.IsNullOperator:
+		return clause.Expr{SQL: column + " NOT IN (?)", Vars: []any{query}}, nil
+	case filter.IsNotNullOperator:
+		return clause.Expr{SQL: column + " IN (?)", Vars: []any{query}}, nil
+	case filter.NotEqualOperator, filter.EqualOperator, filter.LikeOperator, filter.ILikeOperator:
+		return clause.Expr{SQL: column + " IN (?)", Vars: []any{query.Where(
+			filter.BuildCondition(database.DB.Dialector.Name(), "value", comparison.Operator, comparison.Value.Text),
+		)}}, nil
+	case filter.InOperator, filter.NotInOperator:
+		return clause.Expr{SQL: column + " IN (?)", Vars: []any{query.Where(
+			filter.BuildCondition(database.DB.Dialector.Name(), "value", comparison.Operator, getValues(comparison)),
+		)}}, nil
+	default:
+		return nil, filter.NewSemanticError(
+			filterText, comparison.Position(), "invalid tag comparison operator '%s'", comparison.Operator,
+		)
+	}
+}
+
+// buildColumnCondition builds condition, which compares the column of the search query with the value.
+func buildColumnCondition(column, operator string, value any) clause.Expression {
+	return filter.BuildCondition(database.DB.Dialector.Name(), column, operator, value)
+}
+
+// getValues returns list of the values of `IN` and `NOT IN` comparisons.
+func getValues(comparison *filter.Comparison) []string {
+	values := make([]string, len(comparison.Values))
+	for i, v := range comparison.Values {
+		values[i] = v.Text
+	}
+	return values
+}
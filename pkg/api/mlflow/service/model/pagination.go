@@ -0,0 +1,94 @@
+package model
+
+import (
+	"database/sql"
+	"fmt"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/filter"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// sortColumn represents column of the registered models or model versions ordering.
+type sortColumn struct {
+	table string
+	name  string
+	desc  bool
+}
+
+// getRegisteredModelValue returns value of the column in the registered model,
+// which is stored into the keyset page token.
+func (c sortColumn) getRegisteredModelValue(registeredModel *models.RegisteredModel) any {
+	switch c.name {
+	case "name":
+		return registeredModel.Name
+	case "creation_time":
+		return getNullInt64Value(registeredModel.CreationTime)
+	case "last_updated_time":
+		return getNullInt64Value(registeredModel.LastUpdatedTime)
+	}
+	return nil
+}
+
+// getModelVersionValue returns value of the column in the model version, which is stored into the keyset page token.
+func (c sortColumn) getModelVersionValue(modelVersion *models.ModelVersion) any {
+	switch c.name {
+	case "name":
+		return modelVersion.RegisteredModel.Name
+	case "version":
+		return modelVersion.Version
+	case "creation_time":
+		return getNullInt64Value(modelVersion.CreationTime)
+	case "last_updated_time":
+		return getNullInt64Value(modelVersion.LastUpdatedTime)
+	}
+	return nil
+}
+
+// getNullInt64Value returns value of the nullable column or nil for NULL.
+func getNullInt64Value(value sql.NullInt64) any {
+	if value.Valid {
+		return value.Int64
+	}
+	return nil
+}
+
+// buildOrderBy builds ORDER BY columns of the search query.
+func buildOrderBy(sortColumns []sortColumn) []clause.OrderByColumn {
+	orderBy := make([]clause.OrderByColumn, len(sortColumns))
+	for i, column := range sortColumns {
+		orderBy[i] = clause.OrderByColumn{
+			Column: clause.Column{Table: column.table, Name: column.name},
+			Desc:   column.desc,
+		}
+	}
+	return orderBy
+}
+
+// buildKeysetCondition builds condition, which selects rows placed right after the last row of the previous page.
+// Result is nil for the first page.
+func buildKeysetCondition(pageToken string, sortColumns []sortColumn) (clause.Expression, error) {
+	if pageToken == "" {
+		return nil, nil
+	}
+	token, err := request.DecodePageToken(pageToken)
+	if err != nil {
+		return nil, api.NewInvalidParameterValueError("invalid page_token '%s': %s", pageToken, err)
+	}
+	if len(token.Keys) != len(sortColumns) {
+		return nil, api.NewInvalidParameterValueError(
+			"invalid page_token '%s': token doesn't match order_by clause", pageToken,
+		)
+	}
+	keys := make([]filter.SortKey, len(sortColumns))
+	for i, column := range sortColumns {
+		keys[i] = filter.SortKey{
+			Column: fmt.Sprintf("%s.%s", column.table, column.name), Desc: column.desc, Value: token.Keys[i],
+		}
+	}
+	return filter.BuildKeysetCondition(database.DB.Dialector.Name(), keys), nil
+}
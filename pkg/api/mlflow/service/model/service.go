@@ -2,26 +2,951 @@ package model
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/filter"
 )
 
+var modelOrder = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
+
 // Service provides service layer to work with `model` business logic.
-type Service struct{}
+type Service struct {
+	config                           *config.ServiceConfig
+	modelVersionRepository           repositories.ModelVersionRepositoryProvider
+	registeredModelRepository        repositories.RegisteredModelRepositoryProvider
+	modelVersionTransitionRepository repositories.ModelVersionTransitionRepositoryProvider
+}
 
 // NewService creates new Service instance.
-func NewService() *Service {
-	return &Service{}
+func NewService(
+	config *config.ServiceConfig,
+	modelVersionRepository repositories.ModelVersionRepositoryProvider,
+	registeredModelRepository repositories.RegisteredModelRepositoryProvider,
+	modelVersionTransitionRepository repositories.ModelVersionTransitionRepositoryProvider,
+) *Service {
+	return &Service{
+		config:                           config,
+		modelVersionRepository:           modelVersionRepository,
+		registeredModelRepository:        registeredModelRepository,
+		modelVersionTransitionRepository: modelVersionTransitionRepository,
+	}
+}
+
+// CreateRegisteredModel creates new RegisteredModel entity.
+func (s Service) CreateRegisteredModel(
+	ctx context.Context, ns *models.Namespace, req *request.CreateRegisteredModelRequest,
+) (*models.RegisteredModel, error) {
+	if err := ValidateCreateRegisteredModelRequest(req); err != nil {
+		return nil, err
+	}
+
+	registeredModel, err := s.registeredModelRepository.GetByNamespaceIDAndName(ctx, ns.ID, req.Name)
+	if err != nil {
+		return nil, api.NewInternalError("error getting registered model with name: '%s', error: %s", req.Name, err)
+	}
+	if registeredModel != nil {
+		return nil, api.NewResourceAlreadyExistsError("Registered Model (name=%s) already exists.", req.Name)
+	}
+
+	registeredModel = convertors.ConvertCreateRegisteredModelRequestToDBModel(ns.ID, req)
+	if err := s.registeredModelRepository.Create(ctx, registeredModel); err != nil {
+		return nil, api.NewInternalError("error inserting registered model '%s': %s", req.Name, err)
+	}
+
+	return registeredModel, nil
+}
+
+// GetRegisteredModel returns existing RegisteredModel entity by name.
+func (s Service) GetRegisteredModel(
+	ctx context.Context, ns *models.Namespace, req *request.GetRegisteredModelRequest,
+) (*models.RegisteredModel, error) {
+	if err := ValidateGetRegisteredModelRequest(req); err != nil {
+		return nil, err
+	}
+	return s.getRegisteredModel(ctx, ns, req.Name)
+}
+
+// RenameRegisteredModel renames existing RegisteredModel entity.
+func (s Service) RenameRegisteredModel(
+	ctx context.Context, ns *models.Namespace, req *request.RenameRegisteredModelRequest,
+) (*models.RegisteredModel, error) {
+	if err := ValidateRenameRegisteredModelRequest(req); err != nil {
+		return nil, err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.registeredModelRepository.GetByNamespaceIDAndName(ctx, ns.ID, req.NewName)
+	if err != nil {
+		return nil, api.NewInternalError("error getting registered model with name: '%s', error: %s", req.NewName, err)
+	}
+	if existing != nil {
+		return nil, api.NewResourceAlreadyExistsError("Registered Model (name=%s) already exists.", req.NewName)
+	}
+
+	registeredModel.Name = req.NewName
+	registeredModel.LastUpdatedTime = sql.NullInt64{
+		Int64: time.Now().UTC().UnixMilli(),
+		Valid: true,
+	}
+	if err := s.registeredModelRepository.Update(ctx, registeredModel); err != nil {
+		return nil, api.NewInternalError("unable to rename registered model '%s': %s", req.Name, err)
+	}
+
+	return registeredModel, nil
+}
+
+// UpdateRegisteredModel updates description of existing RegisteredModel entity.
+func (s Service) UpdateRegisteredModel(
+	ctx context.Context, ns *models.Namespace, req *request.UpdateRegisteredModelRequest,
+) (*models.RegisteredModel, error) {
+	if err := ValidateUpdateRegisteredModelRequest(req); err != nil {
+		return nil, err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	registeredModel.Description = req.Description
+	registeredModel.LastUpdatedTime = sql.NullInt64{
+		Int64: time.Now().UTC().UnixMilli(),
+		Valid: true,
+	}
+	if err := s.registeredModelRepository.Update(ctx, registeredModel); err != nil {
+		return nil, api.NewInternalError("unable to update registered model '%s': %s", req.Name, err)
+	}
+
+	return registeredModel, nil
+}
+
+// DeleteRegisteredModel deletes existing RegisteredModel entity with all its versions.
+func (s Service) DeleteRegisteredModel(
+	ctx context.Context, ns *models.Namespace, req *request.DeleteRegisteredModelRequest,
+) error {
+	if err := ValidateDeleteRegisteredModelRequest(req); err != nil {
+		return err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := s.registeredModelRepository.Delete(ctx, registeredModel); err != nil {
+		return api.NewInternalError("unable to delete registered model '%s': %s", req.Name, err)
+	}
+
+	return nil
+}
+
+// GetLatestVersions returns the latest ModelVersion entities of each stage.
+func (s Service) GetLatestVersions(
+	ctx context.Context, ns *models.Namespace, req *request.GetLatestVersionsRequest,
+) ([]models.ModelVersion, error) {
+	if err := ValidateGetLatestVersionsRequest(req); err != nil {
+		return nil, err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	stages := make([]models.ModelVersionStage, len(req.Stages))
+	for n, stage := range req.Stages {
+		stages[n] = models.ModelVersionStage(stage)
+	}
+	modelVersions, err := s.modelVersionRepository.GetLatestByRegisteredModelID(ctx, registeredModel.ID, stages)
+	if err != nil {
+		return nil, api.NewInternalError("unable to get latest versions of registered model '%s': %s", req.Name, err)
+	}
+
+	return modelVersions, nil
+}
+
+// SetRegisteredModelTag sets a tag on existing RegisteredModel entity.
+func (s Service) SetRegisteredModelTag(
+	ctx context.Context, ns *models.Namespace, req *request.SetRegisteredModelTagRequest,
+) error {
+	if err := ValidateSetRegisteredModelTagRequest(req); err != nil {
+		return err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := s.registeredModelRepository.SetTag(ctx, &models.RegisteredModelTag{
+		Key:               req.Key,
+		Value:             req.Value,
+		RegisteredModelID: registeredModel.ID,
+	}); err != nil {
+		return api.NewInternalError("unable to set tag for registered model '%s': %s", req.Name, err)
+	}
+
+	return nil
+}
+
+// DeleteRegisteredModelTag deletes a tag of existing RegisteredModel entity.
+func (s Service) DeleteRegisteredModelTag(
+	ctx context.Context, ns *models.Namespace, req *request.DeleteRegisteredModelTagRequest,
+) error {
+	if err := ValidateDeleteRegisteredModelTagRequest(req); err != nil {
+		return err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := s.registeredModelRepository.DeleteTag(ctx, &models.RegisteredModelTag{
+		Key:               req.Key,
+		RegisteredModelID: registeredModel.ID,
+	}); err != nil {
+		return api.NewInternalError("unable to delete tag for registered model '%s': %s", req.Name, err)
+	}
+
+	return nil
+}
+
+// SetRegisteredModelAlias points an alias of existing RegisteredModel entity to one of its versions.
+func (s Service) SetRegisteredModelAlias(
+	ctx context.Context, ns *models.Namespace, req *request.SetRegisteredModelAliasRequest,
+) error {
+	if err := ValidateSetRegisteredModelAliasRequest(req); err != nil {
+		return err
+	}
+
+	modelVersion, err := s.getModelVersion(ctx, ns, req.Name, req.Version)
+	if err != nil {
+		return err
+	}
+
+	if err := s.registeredModelRepository.SetAlias(ctx, &models.RegisteredModelAlias{
+		Alias:             req.Alias,
+		Version:           modelVersion.Version,
+		RegisteredModelID: modelVersion.RegisteredModelID,
+	}); err != nil {
+		return api.NewInternalError("unable to set alias for registered model '%s': %s", req.Name, err)
+	}
+
+	return nil
+}
+
+// DeleteRegisteredModelAlias deletes an alias of existing RegisteredModel entity.
+func (s Service) DeleteRegisteredModelAlias(
+	ctx context.Context, ns *models.Namespace, req *request.DeleteRegisteredModelAliasRequest,
+) error {
+	if err := ValidateDeleteRegisteredModelAliasRequest(req); err != nil {
+		return err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := s.registeredModelRepository.DeleteAlias(ctx, &models.RegisteredModelAlias{
+		Alias:             req.Alias,
+		RegisteredModelID: registeredModel.ID,
+	}); err != nil {
+		return api.NewInternalError("unable to delete alias for registered model '%s': %s", req.Name, err)
+	}
+
+	return nil
+}
+
+// GetModelVersionByAlias returns existing ModelVersion entity by alias of its RegisteredModel.
+func (s Service) GetModelVersionByAlias(
+	ctx context.Context, ns *models.Namespace, req *request.GetModelVersionByAliasRequest,
+) (*models.ModelVersion, error) {
+	if err := ValidateGetModelVersionByAliasRequest(req); err != nil {
+		return nil, err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, alias := range registeredModel.Aliases {
+		if alias.Alias == req.Alias {
+			return s.getModelVersion(ctx, ns, req.Name, fmt.Sprint(alias.Version))
+		}
+	}
+
+	return nil, api.NewInvalidParameterValueError("Registered model alias %s not found.", req.Alias)
+}
+
+// SearchRegisteredModels returns the list of RegisteredModel entities matching the request
+// together with the token of the next page, if there is one.
+func (s Service) SearchRegisteredModels(
+	ctx context.Context, ns *models.Namespace, req *request.SearchRegisteredModelsRequest,
+) ([]models.RegisteredModel, *request.PageToken, error) {
+	if err := ValidateSearchRegisteredModelsRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	limit := int(req.MaxResults)
+	if limit == 0 {
+		limit = DefaultRegisteredModelsPerPage
+	}
+
+	// Filter
+	var conditions []clause.Expression
+	expression, err := filter.Parse(req.Filter)
+	if err != nil {
+		return nil, nil, api.NewInvalidParameterValueError(err.Error())
+	}
+	if expression != nil {
+		condition, err := buildFilterCondition(req.Filter, expression, buildRegisteredModelComparisonCondition)
+		if err != nil {
+			return nil, nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	// OrderBy
+	nameOrder := false
+	var sortColumns []sortColumn
+	for _, o := range req.OrderBy {
+		components := modelOrder.FindStringSubmatch(o)
+		if len(components) == 0 {
+			return nil, nil, api.NewInvalidParameterValueError("invalid order_by clause '%s'", o)
+		}
+
+		column := sortColumn{
+			table: "registered_models",
+			desc:  len(components) == 3 && strings.ToUpper(components[2]) == "DESC",
+		}
+		switch components[1] {
+		case "name":
+			nameOrder = true
+			column.name = "name"
+		case "timestamp", "last_updated_timestamp":
+			column.name = "last_updated_time"
+		case "creation_timestamp":
+			column.name = "creation_time"
+		default:
+			return nil, nil, api.NewInvalidParameterValueError(
+				`invalid attribute '%s'. Valid values are ['name', 'timestamp', 'last_updated_timestamp', `+
+					`'creation_timestamp']`,
+				components[1],
+			)
+		}
+		sortColumns = append(sortColumns, column)
+	}
+	// names are unique within the namespace, so they make the order total.
+	if !nameOrder {
+		sortColumns = append(sortColumns, sortColumn{table: "registered_models", name: "name"})
+	}
+
+	// the next page starts right after the last row of the previous one.
+	keysetCondition, err := buildKeysetCondition(req.PageToken, sortColumns)
+	if err != nil {
+		return nil, nil, err
+	}
+	if keysetCondition != nil {
+		conditions = append(conditions, keysetCondition)
+	}
+
+	var condition clause.Expression
+	if len(conditions) > 0 {
+		condition = clause.And(conditions...)
+	}
+
+	// one more registered model is requested to find out whether there is the next page.
+	registeredModels, err := s.registeredModelRepository.Search(
+		ctx, ns.ID, condition, buildOrderBy(sortColumns), limit+1,
+	)
+	if err != nil {
+		return nil, nil, api.NewInternalError("unable to search registered models: %s", err)
+	}
+	if len(registeredModels) <= limit {
+		return registeredModels, nil, nil
+	}
+	registeredModels = registeredModels[:limit]
+	lastRegisteredModel := &registeredModels[len(registeredModels)-1]
+	nextPageToken := request.PageToken{Keys: make([]any, len(sortColumns))}
+	for i, column := range sortColumns {
+		nextPageToken.Keys[i] = column.getRegisteredModelValue(lastRegisteredModel)
+	}
+	return registeredModels, &nextPageToken, nil
+}
+
+// CreateModelVersion creates new ModelVersion entity of existing RegisteredModel.
+func (s Service) CreateModelVersion(
+	ctx context.Context, ns *models.Namespace, req *request.CreateModelVersionRequest,
+) (*models.ModelVersion, error) {
+	if err := ValidateCreateModelVersionRequest(req); err != nil {
+		return nil, err
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	modelVersion := convertors.ConvertCreateModelVersionRequestToDBModel(registeredModel, req)
+	if err := s.modelVersionRepository.Create(ctx, modelVersion); err != nil {
+		return nil, api.NewInternalError("error inserting model version for registered model '%s': %s", req.Name, err)
+	}
+
+	return modelVersion, nil
+}
+
+// GetModelVersion returns existing ModelVersion entity.
+func (s Service) GetModelVersion(
+	ctx context.Context, ns *models.Namespace, req *request.GetModelVersionRequest,
+) (*models.ModelVersion, error) {
+	if err := ValidateGetModelVersionRequest(req); err != nil {
+		return nil, err
+	}
+	return s.getModelVersion(ctx, ns, req.Name, req.Version)
+}
+
+// UpdateModelVersion updates description of existing ModelVersion entity.
+func (s Service) UpdateModelVersion(
+	ctx context.Context, ns *models.Namespace, req *request.UpdateModelVersionRequest,
+) (*models.ModelVersion, error) {
+	if err := ValidateUpdateModelVersionRequest(req); err != nil {
+		return nil, err
+	}
+
+	modelVersion, err := s.getModelVersion(ctx, ns, req.Name, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	modelVersion.Description = req.Description
+	modelVersion.LastUpdatedTime = sql.NullInt64{
+		Int64: time.Now().UTC().UnixMilli(),
+		Valid: true,
+	}
+	if err := s.modelVersionRepository.Update(ctx, modelVersion); err != nil {
+		return nil, api.NewInternalError(
+			"unable to update version %s of registered model '%s': %s", req.Version, req.Name, err,
+		)
+	}
+
+	return modelVersion, nil
+}
+
+// DeleteModelVersion deletes existing ModelVersion entity.
+func (s Service) DeleteModelVersion(
+	ctx context.Context, ns *models.Namespace, req *request.DeleteModelVersionRequest,
+) error {
+	if err := ValidateDeleteModelVersionRequest(req); err != nil {
+		return err
+	}
+
+	modelVersion, err := s.getModelVersion(ctx, ns, req.Name, req.Version)
+	if err != nil {
+		return err
+	}
+
+	if err := s.modelVersionRepository.Delete(ctx, modelVersion); err != nil {
+		return api.NewInternalError(
+			"unable to delete version %s of registered model '%s': %s", req.Version, req.Name, err,
+		)
+	}
+
+	return nil
 }
 
-func (s Service) SearchModelVersions(ctx context.Context) (any, error) {
-	return fiber.Map{
-		"model_versions": []any{},
-	}, nil
+// GetModelVersionDownloadURI returns existing ModelVersion entity to build its download uri.
+func (s Service) GetModelVersionDownloadURI(
+	ctx context.Context, ns *models.Namespace, req *request.GetModelVersionDownloadURIRequest,
+) (*models.ModelVersion, error) {
+	if err := ValidateGetModelVersionDownloadURIRequest(req); err != nil {
+		return nil, err
+	}
+	return s.getModelVersion(ctx, ns, req.Name, req.Version)
+}
+
+// SetModelVersionTag sets a tag on existing ModelVersion entity.
+func (s Service) SetModelVersionTag(
+	ctx context.Context, ns *models.Namespace, req *request.SetModelVersionTagRequest,
+) error {
+	if err := ValidateSetModelVersionTagRequest(req); err != nil {
+		return err
+	}
+
+	modelVersion, err := s.getModelVersion(ctx, ns, req.Name, req.Version)
+	if err != nil {
+		return err
+	}
+
+	if err := s.modelVersionRepository.SetTag(ctx, &models.ModelVersionTag{
+		Key:            req.Key,
+		Value:          req.Value,
+		ModelVersionID: modelVersion.ID,
+	}); err != nil {
+		return api.NewInternalError(
+			"unable to set tag for version %s of registered model '%s': %s", req.Version, req.Name, err,
+		)
+	}
+
+	return nil
+}
+
+// DeleteModelVersionTag deletes a tag of existing ModelVersion entity.
+func (s Service) DeleteModelVersionTag(
+	ctx context.Context, ns *models.Namespace, req *request.DeleteModelVersionTagRequest,
+) error {
+	if err := ValidateDeleteModelVersionTagRequest(req); err != nil {
+		return err
+	}
+
+	modelVersion, err := s.getModelVersion(ctx, ns, req.Name, req.Version)
+	if err != nil {
+		return err
+	}
+
+	if err := s.modelVersionRepository.DeleteTag(ctx, &models.ModelVersionTag{
+		Key:            req.Key,
+		ModelVersionID: modelVersion.ID,
+	}); err != nil {
+		return api.NewInternalError(
+			"unable to delete tag for version %s of registered model '%s': %s", req.Version, req.Name, err,
+		)
+	}
+
+	return nil
 }
 
-func (s Service) SearchRegisteredModels(ctx context.Context) (any, error) {
-	return fiber.Map{
-		"registered_models": []any{},
-	}, nil
+// SearchModelVersions returns the list of ModelVersion entities matching the request
+// together with the token of the next page, if there is one.
+func (s Service) SearchModelVersions(
+	ctx context.Context, ns *models.Namespace, req *request.SearchModelVersionsRequest,
+) ([]models.ModelVersion, *request.PageToken, error) {
+	if err := ValidateSearchModelVersionsRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	limit := int(req.MaxResults)
+	if limit == 0 {
+		limit = DefaultModelVersionsPerPage
+	}
+
+	// Filter
+	var conditions []clause.Expression
+	expression, err := filter.Parse(req.Filter)
+	if err != nil {
+		return nil, nil, api.NewInvalidParameterValueError(err.Error())
+	}
+	if expression != nil {
+		condition, err := buildFilterCondition(req.Filter, expression, buildModelVersionComparisonCondition)
+		if err != nil {
+			return nil, nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	// OrderBy
+	nameOrder, versionOrder := false, false
+	var sortColumns []sortColumn
+	for _, o := range req.OrderBy {
+		components := modelOrder.FindStringSubmatch(o)
+		if len(components) == 0 {
+			return nil, nil, api.NewInvalidParameterValueError("invalid order_by clause '%s'", o)
+		}
+
+		column := sortColumn{
+			table: "model_versions",
+			desc:  len(components) == 3 && strings.ToUpper(components[2]) == "DESC",
+		}
+		switch components[1] {
+		case "name":
+			nameOrder = true
+			column.table, column.name = "registered_models", "name"
+		case "version_number":
+			versionOrder = true
+			column.name = "version"
+		case "creation_timestamp":
+			column.name = "creation_time"
+		case "timestamp", "last_updated_timestamp":
+			column.name = "last_updated_time"
+		default:
+			return nil, nil, api.NewInvalidParameterValueError(
+				`invalid attribute '%s'. Valid values are ['name', 'version_number', 'creation_timestamp', `+
+					`'last_updated_timestamp']`,
+				components[1],
+			)
+		}
+		sortColumns = append(sortColumns, column)
+	}
+	// registered model name together with version number make the order total.
+	if !nameOrder {
+		sortColumns = append(sortColumns, sortColumn{table: "registered_models", name: "name"})
+	}
+	if !versionOrder {
+		sortColumns = append(sortColumns, sortColumn{table: "model_versions", name: "version", desc: true})
+	}
+
+	// the next page starts right after the last row of the previous one.
+	keysetCondition, err := buildKeysetCondition(req.PageToken, sortColumns)
+	if err != nil {
+		return nil, nil, err
+	}
+	if keysetCondition != nil {
+		conditions = append(conditions, keysetCondition)
+	}
+
+	var condition clause.Expression
+	if len(conditions) > 0 {
+		condition = clause.And(conditions...)
+	}
+
+	// one more model version is requested to find out whether there is the next page.
+	modelVersions, err := s.modelVersionRepository.Search(
+		ctx, ns.ID, condition, buildOrderBy(sortColumns), limit+1,
+	)
+	if err != nil {
+		return nil, nil, api.NewInternalError("unable to search model versions: %s", err)
+	}
+	if len(modelVersions) <= limit {
+		return modelVersions, nil, nil
+	}
+	modelVersions = modelVersions[:limit]
+	lastModelVersion := &modelVersions[len(modelVersions)-1]
+	nextPageToken := request.PageToken{Keys: make([]any, len(sortColumns))}
+	for i, column := range sortColumns {
+		nextPageToken.Keys[i] = column.getModelVersionValue(lastModelVersion)
+	}
+	return modelVersions, &nextPageToken, nil
+}
+
+// TransitionModelVersionStage moves existing ModelVersion entity to the requested stage.
+// Direct transitions to `Production` are refused, unless they are explicitly allowed by configuration,
+// so that a model version reaches `Production` only through an approved transition request.
+func (s Service) TransitionModelVersionStage(
+	ctx context.Context, ns *models.Namespace, req *request.TransitionModelVersionStageRequest,
+) (*models.ModelVersion, error) {
+	if err := ValidateTransitionModelVersionStageRequest(req); err != nil {
+		return nil, err
+	}
+
+	stage, _ := GetCanonicalStage(req.Stage)
+	if stage == models.ModelVersionStageProduction && !s.config.AllowDirectProductionTransition {
+		return nil, api.NewPermissionDeniedError(
+			"Model Version (name=%s, version=%s) can be moved to stage %s only by an approved transition request",
+			req.Name, req.Version, stage,
+		)
+	}
+
+	modelVersion, err := s.getModelVersion(ctx, ns, req.Name, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.transitionModelVersion(
+		ctx, modelVersion, stage, req.ArchiveExistingVersions, req.Comment, nil,
+	); err != nil {
+		return nil, api.NewInternalError(
+			"unable to transition version %s of registered model '%s': %s", req.Version, req.Name, err,
+		)
+	}
+
+	return modelVersion, nil
+}
+
+// GetModelVersionTransitionHistory returns the history of stage transitions of existing RegisteredModel entity.
+// If version is provided then only the history of this ModelVersion entity is returned.
+func (s Service) GetModelVersionTransitionHistory(
+	ctx context.Context, ns *models.Namespace, req *request.GetModelVersionTransitionHistoryRequest,
+) ([]models.ModelVersionTransition, error) {
+	if err := ValidateGetModelVersionTransitionHistoryRequest(req); err != nil {
+		return nil, err
+	}
+
+	var version int64
+	if req.Version != "" {
+		parsedVersion, err := strconv.ParseInt(req.Version, 10, 64)
+		if err != nil {
+			return nil, api.NewInvalidParameterValueError("Model version must be an integer, got '%s'", req.Version)
+		}
+		version = parsedVersion
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions, err := s.modelVersionTransitionRepository.GetByRegisteredModelID(ctx, registeredModel.ID, version)
+	if err != nil {
+		return nil, api.NewInternalError("unable to get transition history of registered model '%s': %s", req.Name, err)
+	}
+
+	return transitions, nil
+}
+
+// CreateTransitionRequest creates new request to move existing ModelVersion entity to another stage.
+func (s Service) CreateTransitionRequest(
+	ctx context.Context, ns *models.Namespace, req *request.CreateTransitionRequestRequest,
+) (*models.ModelVersionTransitionRequest, error) {
+	if err := ValidateCreateTransitionRequestRequest(req); err != nil {
+		return nil, err
+	}
+
+	modelVersion, err := s.getModelVersion(ctx, ns, req.Name, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	stage, _ := GetCanonicalStage(req.Stage)
+	if modelVersion.CurrentStage == stage {
+		return nil, api.NewInvalidParameterValueError(
+			"Model Version (name=%s, version=%s) is already in stage %s", req.Name, req.Version, stage,
+		)
+	}
+	pendingRequest, err := s.modelVersionTransitionRepository.GetPendingRequest(ctx, modelVersion.ID, stage)
+	if err != nil {
+		return nil, api.NewInternalError(
+			"unable to get pending transition request of version %s of registered model '%s': %s",
+			req.Version, req.Name, err,
+		)
+	}
+	if pendingRequest != nil {
+		return nil, api.NewResourceAlreadyExistsError(
+			"Pending transition request to stage %s already exists for Model Version (name=%s, version=%s)",
+			stage, req.Name, req.Version,
+		)
+	}
+
+	transitionRequest := convertors.ConvertCreateTransitionRequestRequestToDBModel(
+		modelVersion, stage, common.GetUserFromContext(ctx), req,
+	)
+	if err := s.modelVersionTransitionRepository.CreateRequest(ctx, transitionRequest); err != nil {
+		return nil, api.NewInternalError(
+			"unable to create transition request for version %s of registered model '%s': %s",
+			req.Version, req.Name, err,
+		)
+	}
+
+	return transitionRequest, nil
+}
+
+// ListTransitionRequests returns all the transition requests of existing ModelVersion entity.
+func (s Service) ListTransitionRequests(
+	ctx context.Context, ns *models.Namespace, req *request.ListTransitionRequestsRequest,
+) ([]models.ModelVersionTransitionRequest, error) {
+	if err := ValidateListTransitionRequestsRequest(req); err != nil {
+		return nil, err
+	}
+
+	modelVersion, err := s.getModelVersion(ctx, ns, req.Name, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	transitionRequests, err := s.modelVersionTransitionRepository.GetRequestsByModelVersionID(ctx, modelVersion.ID)
+	if err != nil {
+		return nil, api.NewInternalError(
+			"unable to get transition requests of version %s of registered model '%s': %s", req.Version, req.Name, err,
+		)
+	}
+
+	return transitionRequests, nil
+}
+
+// ApproveTransitionRequest approves pending transition request and moves ModelVersion entity to the requested stage.
+// When several users are configured, the request could not be approved by the user, who has created it.
+// The only configured user has nobody else to review the request, so it is allowed to approve it.
+func (s Service) ApproveTransitionRequest(
+	ctx context.Context, ns *models.Namespace, req *request.ApproveTransitionRequestRequest,
+) (*models.ModelVersionTransitionRequest, error) {
+	if err := ValidateApproveTransitionRequestRequest(req); err != nil {
+		return nil, err
+	}
+
+	modelVersion, err := s.getModelVersion(ctx, ns, req.Name, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	stage, _ := GetCanonicalStage(req.Stage)
+	transitionRequest, err := s.getPendingTransitionRequest(ctx, modelVersion, req.Name, req.Version, stage)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewer := common.GetUserFromContext(ctx)
+	if len(s.config.GetAuthUsers()) > 1 && reviewer == transitionRequest.UserID {
+		return nil, api.NewPermissionDeniedError(
+			"Transition request of Model Version (name=%s, version=%s) can not be approved by its creator '%s'",
+			req.Name, req.Version, reviewer,
+		)
+	}
+
+	transitionRequest.Status = models.ModelVersionTransitionRequestStatusApproved
+	transitionRequest.ReviewerID = reviewer
+	transitionRequest.ReviewComment = req.Comment
+	transitionRequest.LastUpdatedTime = sql.NullInt64{
+		Int64: time.Now().UTC().UnixMilli(),
+		Valid: true,
+	}
+	if err := s.transitionModelVersion(
+		ctx, modelVersion, stage, req.ArchiveExistingVersions, req.Comment, transitionRequest,
+	); err != nil {
+		return nil, api.NewInternalError(
+			"unable to approve transition request of version %s of registered model '%s': %s",
+			req.Version, req.Name, err,
+		)
+	}
+	transitionRequest.ModelVersion = *modelVersion
+
+	return transitionRequest, nil
+}
+
+// RejectTransitionRequest rejects pending transition request of existing ModelVersion entity.
+func (s Service) RejectTransitionRequest(
+	ctx context.Context, ns *models.Namespace, req *request.RejectTransitionRequestRequest,
+) (*models.ModelVersionTransitionRequest, error) {
+	if err := ValidateRejectTransitionRequestRequest(req); err != nil {
+		return nil, err
+	}
+
+	modelVersion, err := s.getModelVersion(ctx, ns, req.Name, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	stage, _ := GetCanonicalStage(req.Stage)
+	transitionRequest, err := s.getPendingTransitionRequest(ctx, modelVersion, req.Name, req.Version, stage)
+	if err != nil {
+		return nil, err
+	}
+
+	transitionRequest.Status = models.ModelVersionTransitionRequestStatusRejected
+	transitionRequest.ReviewerID = common.GetUserFromContext(ctx)
+	transitionRequest.ReviewComment = req.Comment
+	transitionRequest.LastUpdatedTime = sql.NullInt64{
+		Int64: time.Now().UTC().UnixMilli(),
+		Valid: true,
+	}
+	if err := s.modelVersionTransitionRepository.UpdateRequest(ctx, transitionRequest); err != nil {
+		return nil, api.NewInternalError(
+			"unable to reject transition request of version %s of registered model '%s': %s",
+			req.Version, req.Name, err,
+		)
+	}
+	transitionRequest.ModelVersion = *modelVersion
+
+	return transitionRequest, nil
+}
+
+// transitionModelVersion moves ModelVersion entity to the stage and records the change in the history.
+// Existing versions are archived only when the model version is moved to `Staging` or `Production`.
+func (s Service) transitionModelVersion(
+	ctx context.Context,
+	modelVersion *models.ModelVersion,
+	stage models.ModelVersionStage,
+	archiveExistingVersions bool,
+	comment string,
+	transitionRequest *models.ModelVersionTransitionRequest,
+) error {
+	transition := models.ModelVersionTransition{
+		FromStage: modelVersion.CurrentStage,
+		ToStage:   stage,
+		UserID:    common.GetUserFromContext(ctx),
+		Comment:   comment,
+		CreationTime: sql.NullInt64{
+			Int64: time.Now().UTC().UnixMilli(),
+			Valid: true,
+		},
+		TransitionRequest: transitionRequest,
+		ModelVersionID:    modelVersion.ID,
+		ModelVersion:      *modelVersion,
+	}
+	archiveExistingVersions = archiveExistingVersions &&
+		(stage == models.ModelVersionStageStaging || stage == models.ModelVersionStageProduction)
+	if err := s.modelVersionTransitionRepository.Transition(ctx, &transition, archiveExistingVersions); err != nil {
+		return err
+	}
+
+	modelVersion.CurrentStage = stage
+	modelVersion.LastUpdatedTime = transition.CreationTime
+	return nil
+}
+
+// getPendingTransitionRequest returns pending transition request of ModelVersion entity or not found error.
+func (s Service) getPendingTransitionRequest(
+	ctx context.Context, modelVersion *models.ModelVersion, name, version string, stage models.ModelVersionStage,
+) (*models.ModelVersionTransitionRequest, error) {
+	transitionRequest, err := s.modelVersionTransitionRepository.GetPendingRequest(ctx, modelVersion.ID, stage)
+	if err != nil {
+		return nil, api.NewInternalError(
+			"unable to get pending transition request of version %s of registered model '%s': %s", version, name, err,
+		)
+	}
+	if transitionRequest == nil {
+		return nil, api.NewResourceDoesNotExistError(
+			"Pending transition request to stage %s not found for Model Version (name=%s, version=%s)",
+			stage, name, version,
+		)
+	}
+	return transitionRequest, nil
+}
+
+// getRegisteredModel returns existing RegisteredModel entity by name or not found error.
+func (s Service) getRegisteredModel(
+	ctx context.Context, ns *models.Namespace, name string,
+) (*models.RegisteredModel, error) {
+	registeredModel, err := s.registeredModelRepository.GetByNamespaceIDAndName(ctx, ns.ID, name)
+	if err != nil {
+		return nil, api.NewInternalError("unable to get registered model by name '%s': %s", name, err)
+	}
+	if registeredModel == nil {
+		return nil, api.NewResourceDoesNotExistError("Registered Model with name=%s not found", name)
+	}
+	return registeredModel, nil
+}
+
+// getModelVersion returns existing ModelVersion entity by name and version or not found error.
+func (s Service) getModelVersion(
+	ctx context.Context, ns *models.Namespace, name, version string,
+) (*models.ModelVersion, error) {
+	parsedVersion, err := strconv.ParseInt(version, 10, 64)
+	if err != nil {
+		return nil, api.NewInvalidParameterValueError("Model version must be an integer, got '%s'", version)
+	}
+
+	registeredModel, err := s.getRegisteredModel(ctx, ns, name)
+	if err != nil {
+		return nil, err
+	}
+
+	modelVersion, err := s.modelVersionRepository.GetByRegisteredModelIDAndVersion(
+		ctx, registeredModel.ID, parsedVersion,
+	)
+	if err != nil {
+		return nil, api.NewInternalError("unable to get version %s of registered model '%s': %s", version, name, err)
+	}
+	if modelVersion == nil {
+		return nil, api.NewResourceDoesNotExistError("Model Version (name=%s, version=%s) not found", name, version)
+	}
+	return modelVersion, nil
 }
@@ -0,0 +1,192 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+func TestValidateCreateRegisteredModelRequest_Ok(t *testing.T) {
+	err := ValidateCreateRegisteredModelRequest(&request.CreateRegisteredModelRequest{
+		Name: "name",
+		Tags: []request.RegisteredModelTagPartialRequest{{Key: "key", Value: "value"}},
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateCreateRegisteredModelRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.CreateRegisteredModelRequest
+	}{
+		{
+			name:    "EmptyNameProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: &request.CreateRegisteredModelRequest{},
+		},
+		{
+			name:  "EmptyTagKeyProperty",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'key'"),
+			request: &request.CreateRegisteredModelRequest{
+				Name: "name",
+				Tags: []request.RegisteredModelTagPartialRequest{{Value: "value"}},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCreateRegisteredModelRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateSearchRegisteredModelsRequest_Ok(t *testing.T) {
+	err := ValidateSearchRegisteredModelsRequest(&request.SearchRegisteredModelsRequest{
+		MaxResults: MaxRegisteredModelsPerPage,
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateSearchRegisteredModelsRequest_Error(t *testing.T) {
+	err := ValidateSearchRegisteredModelsRequest(&request.SearchRegisteredModelsRequest{
+		MaxResults: MaxRegisteredModelsPerPage + 1,
+	})
+	assert.Equal(t, api.NewInvalidParameterValueError(
+		"Invalid value for parameter 'max_results' supplied. It must be at most 1000, but got value 1001",
+	), err)
+}
+
+func TestValidateGetLatestVersionsRequest_Ok(t *testing.T) {
+	err := ValidateGetLatestVersionsRequest(&request.GetLatestVersionsRequest{
+		Name:   "name",
+		Stages: []string{"None", "Staging", "Production", "Archived"},
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateGetLatestVersionsRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.GetLatestVersionsRequest
+	}{
+		{
+			name:    "EmptyNameProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: &request.GetLatestVersionsRequest{},
+		},
+		{
+			name: "InvalidStageProperty",
+			error: api.NewInvalidParameterValueError(
+				"Invalid Model Version stage: unknown. Value must be one of None, Staging, Production, Archived.",
+			),
+			request: &request.GetLatestVersionsRequest{Name: "name", Stages: []string{"unknown"}},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGetLatestVersionsRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateSetModelVersionTagRequest_Ok(t *testing.T) {
+	err := ValidateSetModelVersionTagRequest(&request.SetModelVersionTagRequest{
+		Name:    "name",
+		Version: "1",
+		Key:     "key",
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateSetModelVersionTagRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.SetModelVersionTagRequest
+	}{
+		{
+			name:    "EmptyNameProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: &request.SetModelVersionTagRequest{},
+		},
+		{
+			name:    "EmptyVersionProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'version'"),
+			request: &request.SetModelVersionTagRequest{Name: "name"},
+		},
+		{
+			name:    "EmptyKeyProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'key'"),
+			request: &request.SetModelVersionTagRequest{Name: "name", Version: "1"},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetModelVersionTagRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateTransitionModelVersionStageRequest_Ok(t *testing.T) {
+	err := ValidateTransitionModelVersionStageRequest(&request.TransitionModelVersionStageRequest{
+		Name:    "name",
+		Version: "1",
+		Stage:   "production",
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateTransitionModelVersionStageRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.TransitionModelVersionStageRequest
+	}{
+		{
+			name:    "EmptyVersionProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'version'"),
+			request: &request.TransitionModelVersionStageRequest{Name: "name"},
+		},
+		{
+			name:    "EmptyStageProperty",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'stage'"),
+			request: &request.TransitionModelVersionStageRequest{Name: "name", Version: "1"},
+		},
+		{
+			name: "InvalidStageProperty",
+			error: api.NewInvalidParameterValueError(
+				"Invalid Model Version stage: unknown. Value must be one of None, Staging, Production, Archived.",
+			),
+			request: &request.TransitionModelVersionStageRequest{Name: "name", Version: "1", Stage: "unknown"},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTransitionModelVersionStageRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestGetCanonicalStage(t *testing.T) {
+	stage, ok := GetCanonicalStage("sTaGiNg")
+	assert.True(t, ok)
+	assert.Equal(t, models.ModelVersionStageStaging, stage)
+
+	_, ok = GetCanonicalStage("unknown")
+	assert.False(t, ok)
+}
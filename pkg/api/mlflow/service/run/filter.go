@@ -0,0 +1,226 @@
+package run
+
+import (
+	"fmt"
+	"strconv"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/filter"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// buildFilterCondition compiles the filter expression into the condition of `runs` query.
+// filterText is the original filter, which is referenced by the errors.
+func buildFilterCondition(filterText string, expression filter.Expression) (clause.Expression, error) {
+	switch expression := expression.(type) {
+	case *filter.LogicalExpression:
+		operands := make([]clause.Expression, len(expression.Operands))
+		for i, operand := range expression.Operands {
+			condition, err := buildFilterCondition(filterText, operand)
+			if err != nil {
+				return nil, err
+			}
+			operands[i] = condition
+		}
+		if expression.Operator == filter.OrOperator {
+			return clause.Or(operands...), nil
+		}
+		return clause.And(operands...), nil
+	case *filter.Comparison:
+		return buildComparisonCondition(filterText, expression)
+	default:
+		return nil, api.NewInternalError("unsupported filter expression %T", expression)
+	}
+}
+
+// buildComparisonCondition compiles single comparison of the filter into the condition of `runs` query.
+//
+//nolint:gocyclo
+func buildComparisonCondition(filterText string, comparison *filter.Comparison) (clause.Expression, error) {
+	key, operator := comparison.Key, comparison.Operator
+	switch comparison.Entity {
+	case "", "attribute", "attributes", "attr", "run":
+		switch key {
+		case "start_time", "end_time":
+			switch operator {
+			case IsNullExpression, IsNotNullExpression:
+				return buildColumnCondition("runs."+key, operator, nil), nil
+			case GraterExpression, GraterOrEqualExpression, NotEqualExpression,
+				EqualExpression, LessExpression, LessOrEqualExpression:
+				value, err := strconv.Atoi(comparison.Value.Raw)
+				if err != nil {
+					return nil, filter.NewSemanticError(
+						filterText, comparison.Value.Position(), "invalid numeric value '%s'", comparison.Value.Raw,
+					)
+				}
+				return buildColumnCondition("runs."+key, operator, value), nil
+			default:
+				return nil, filter.NewSemanticError(
+					filterText, comparison.Position(),
+					"invalid numeric attribute comparison operator '%s'", operator,
+				)
+			}
+		case "run_name":
+			switch operator {
+			case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression,
+				IsNullExpression, IsNotNullExpression:
+				return buildKeyValueCondition(&database.Tag{}, "mlflow.runName", operator, getValue(comparison)), nil
+			default:
+				return nil, filter.NewSemanticError(
+					filterText, comparison.Position(),
+					"invalid string attribute comparison operator '%s'", operator,
+				)
+			}
+		case "status", "user_id", "artifact_uri":
+			switch operator {
+			case IsNullExpression, IsNotNullExpression:
+				return buildColumnCondition("runs."+key, operator, nil), nil
+			case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
+				return buildColumnCondition("runs."+key, operator, comparison.Value.Text), nil
+			default:
+				return nil, filter.NewSemanticError(
+					filterText, comparison.Position(),
+					"invalid string attribute comparison operator '%s'", operator,
+				)
+			}
+		case "run_id":
+			switch operator {
+			case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
+				return buildColumnCondition("runs.run_uuid", operator, comparison.Value.Text), nil
+			case InExpression, NotInExpression:
+				return buildColumnCondition("runs.run_uuid", operator, getValues(comparison)), nil
+			default:
+				return nil, filter.NewSemanticError(
+					filterText, comparison.Position(),
+					"invalid string attribute comparison operator '%s'", operator,
+				)
+			}
+		default:
+			return nil, filter.NewSemanticError(
+				filterText, comparison.Position(),
+				`invalid attribute '%s'. `+
+					`Valid values are ['run_name', 'start_time', 'end_time', 'status', 'user_id', 'artifact_uri', 'run_id']`,
+				key,
+			)
+		}
+	case "metric", "metrics":
+		switch operator {
+		case IsNullExpression, IsNotNullExpression:
+			return buildKeyValueCondition(&database.LatestMetric{}, key, operator, nil), nil
+		case GraterExpression, GraterOrEqualExpression,
+			NotEqualExpression, EqualExpression, LessExpression, LessOrEqualExpression:
+			if entity, otherKey, ok := comparison.Value.Identifier(); ok {
+				if entity != "metric" && entity != "metrics" {
+					return nil, filter.NewSemanticError(
+						filterText, comparison.Value.Position(),
+						"invalid metric comparison with '%s', only other metrics could be compared", comparison.Value.Raw,
+					)
+				}
+				return buildMetricsComparisonCondition(key, operator, otherKey), nil
+			}
+			value, err := strconv.ParseFloat(comparison.Value.Raw, 64)
+			if err != nil {
+				return nil, filter.NewSemanticError(
+					filterText, comparison.Value.Position(), "invalid numeric value '%s'", comparison.Value.Raw,
+				)
+			}
+			return buildKeyValueCondition(&database.LatestMetric{}, key, operator, value), nil
+		default:
+			return nil, filter.NewSemanticError(
+				filterText, comparison.Position(), "invalid metric comparison operator '%s'", operator,
+			)
+		}
+	case "parameter", "parameters", "param", "params":
+		if !isStringComparison(operator) {
+			return nil, filter.NewSemanticError(
+				filterText, comparison.Position(), "invalid param comparison operator '%s'", operator,
+			)
+		}
+		return buildKeyValueCondition(&database.Param{}, key, operator, getValue(comparison)), nil
+	case "tag", "tags":
+		if !isStringComparison(operator) {
+			return nil, filter.NewSemanticError(
+				filterText, comparison.Position(), "invalid tag comparison operator '%s'", operator,
+			)
+		}
+		return buildKeyValueCondition(&database.Tag{}, key, operator, getValue(comparison)), nil
+	default:
+		return nil, filter.NewSemanticError(
+			filterText, comparison.Position(),
+			"invalid entity type '%s'. Valid values are ['metric', 'parameter', 'tag', 'attribute']",
+			comparison.Entity,
+		)
+	}
+}
+
+// buildColumnCondition builds condition, which compares the column of `runs` table with the value.
+func buildColumnCondition(column, operator string, value any) clause.Expression {
+	return filter.BuildCondition(database.DB.Dialector.Name(), column, operator, value)
+}
+
+// buildKeyValueCondition builds condition, which compares the value of the run param, tag or latest metric.
+// `IS NULL` matches the runs, which don't have the key at all.
+func buildKeyValueCondition(model any, key, operator string, value any) clause.Expression {
+	query := database.DB.Model(model).Select("run_uuid").Where("key = ?", key)
+	switch operator {
+	case IsNullExpression:
+		return clause.Expr{SQL: "runs.run_uuid NOT IN (?)", Vars: []any{query}}
+	case IsNotNullExpression:
+		return clause.Expr{SQL: "runs.run_uuid IN (?)", Vars: []any{query}}
+	default:
+		return clause.Expr{SQL: "runs.run_uuid IN (?)", Vars: []any{query.Where(
+			filter.BuildCondition(database.DB.Dialector.Name(), "value", operator, value),
+		)}}
+	}
+}
+
+// buildMetricsComparisonCondition builds condition, which compares the latest values of two metrics
+// of the same run and context, e.g. `metrics.val_loss > metrics.train_loss`.
+func buildMetricsComparisonCondition(key, operator, otherKey string) clause.Expression {
+	query := database.DB.Table(
+		"latest_metrics AS l",
+	).Select(
+		"l.run_uuid",
+	).Joins(
+		"JOIN latest_metrics AS r ON r.run_uuid = l.run_uuid AND r.context_id = l.context_id AND r.key = ?",
+		otherKey,
+	).Where(
+		"l.key = ?", key,
+	).Where(
+		clause.Expr{SQL: fmt.Sprintf("l.value %s r.value", operator)},
+	)
+	return clause.Expr{SQL: "runs.run_uuid IN (?)", Vars: []any{query}}
+}
+
+// isStringComparison checks that operator could be used to compare string values of params and tags.
+func isStringComparison(operator string) bool {
+	switch operator {
+	case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression,
+		InExpression, NotInExpression, IsNullExpression, IsNotNullExpression:
+		return true
+	}
+	return false
+}
+
+// getValue returns string value of the comparison, list of values for `IN` and nil for `IS NULL`.
+func getValue(comparison *filter.Comparison) any {
+	switch {
+	case comparison.Value != nil:
+		return comparison.Value.Text
+	case comparison.Values != nil:
+		return getValues(comparison)
+	default:
+		return nil
+	}
+}
+
+// getValues returns list of values of `IN` and `NOT IN` comparisons.
+func getValues(comparison *filter.Comparison) []string {
+	values := make([]string, len(comparison.Values))
+	for i, value := range comparison.Values {
+		values[i] = value.Text
+	}
+	return values
+}
@@ -0,0 +1,101 @@
+package run
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// sortColumn represents column of the runs ordering. Besides ordering itself, it provides value
+// of the column in the run, which is stored into the keyset page token of the next page.
+type sortColumn struct {
+	name  string
+	desc  bool
+	value func(run *models.Run) any
+}
+
+var (
+	// startTimeSortColumn is the default ordering of the runs.
+	startTimeSortColumn = sortColumn{
+		name: "runs.start_time",
+		desc: true,
+		value: func(run *models.Run) any {
+			return getDriverValue(run.StartTime)
+		},
+	}
+	// runIDSortColumn makes ordering of the runs total, so keyset pagination never skips or repeats rows.
+	runIDSortColumn = sortColumn{
+		name: "runs.run_uuid",
+		value: func(run *models.Run) any {
+			return run.ID
+		},
+	}
+)
+
+// newAttributeSortColumn creates sortColumn for the column of `runs` table.
+func newAttributeSortColumn(column string, desc bool) (sortColumn, error) {
+	statement := gorm.Statement{DB: database.DB}
+	if err := statement.Parse(&models.Run{}); err != nil {
+		return sortColumn{}, eris.Wrap(err, "error parsing run schema")
+	}
+	field, ok := statement.Schema.FieldsByDBName[column]
+	if !ok {
+		return sortColumn{}, eris.Errorf("unsupported run attribute '%s'", column)
+	}
+	return sortColumn{
+		name: fmt.Sprintf("runs.%s", field.DBName),
+		desc: desc,
+		value: func(run *models.Run) any {
+			value, _ := field.ValueOf(context.Background(), reflect.ValueOf(run).Elem())
+			return getDriverValue(value)
+		},
+	}, nil
+}
+
+// newKeyValueSortColumn creates sortColumn for the value of the run metric, param or tag,
+// which is joined to the query as provided table.
+func newKeyValueSortColumn(table, entity, key string, desc bool) sortColumn {
+	return sortColumn{
+		name: fmt.Sprintf("%s.value", table),
+		desc: desc,
+		value: func(run *models.Run) any {
+			switch entity {
+			case "metric":
+				for _, metric := range run.LatestMetrics {
+					if metric.Key == key {
+						return metric.Value
+					}
+				}
+			case "param":
+				for _, param := range run.Params {
+					if param.Key == key {
+						return param.Value
+					}
+				}
+			case "tag":
+				for _, tag := range run.Tags {
+					if tag.Key == key {
+						return tag.Value
+					}
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// getDriverValue unwraps nullable values, like sql.NullInt64, into the plain value or nil.
+func getDriverValue(value any) any {
+	if valuer, ok := value.(driver.Valuer); ok {
+		//nolint:errcheck
+		value, _ = valuer.Value()
+	}
+	return value
+}
@@ -3,32 +3,36 @@ package run
 import (
 	"context"
 	"database/sql"
-	"encoding/base64"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/rotisserie/eris"
 	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/filter"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/alert"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/webhook"
 	"github.com/G-Research/fasttrackml/pkg/database"
 )
 
 //nolint:lll
 var (
-	runOrder      = regexp.MustCompile(`^(attribute|metric|param|tag)s?\.("[^"]+"|` + "`[^`]+`" + `|[\w\.]+)(?i:\s+(ASC|DESC))?$`)
-	filterAnd     = regexp.MustCompile(`(?i)\s+AND\s+`)
-	filterCond    = regexp.MustCompile(`^(?:(\w+)\.)?("[^"]+"|` + "`[^`]+`" + `|[\w\.]+)\s+(<|<=|>|>=|=|!=|(?i:I?LIKE)|(?i:(?:NOT )?IN))\s+(\((?:'[^']+'(?:,\s*)?)+\)|"[^"]+"|'[^']+'|[\w\.]+)$`)
-	filterInGroup = regexp.MustCompile(`,\s*`)
+	runOrder = regexp.MustCompile(`^(attribute|metric|param|tag)s?\.("[^"]+"|` + "`[^`]+`" + `|[\w\.]+)(?i:\s+(ASC|DESC))?$`)
 )
 
 // supported expression list.
@@ -43,31 +47,48 @@ const (
 	LessOrEqualExpression   = "<="
 	GraterExpression        = ">"
 	GraterOrEqualExpression = ">="
+	IsNullExpression        = "IS NULL"
+	IsNotNullExpression     = "IS NOT NULL"
 )
 
 // Service provides service layer to work with `run` business logic.
 type Service struct {
-	tagRepository        repositories.TagRepositoryProvider
-	runRepository        repositories.RunRepositoryProvider
-	paramRepository      repositories.ParamRepositoryProvider
-	metricRepository     repositories.MetricRepositoryProvider
-	experimentRepository repositories.ExperimentRepositoryProvider
+	config                 *config.ServiceConfig
+	tagRepository          repositories.TagRepositoryProvider
+	runRepository          repositories.RunRepositoryProvider
+	paramRepository        repositories.ParamRepositoryProvider
+	metricRepository       repositories.MetricRepositoryProvider
+	experimentRepository   repositories.ExperimentRepositoryProvider
+	namespaceRepository    repositories.NamespaceRepositoryProvider
+	webhookDispatcher      webhook.DispatcherProvider
+	alertEvaluator         alert.EvaluatorProvider
+	artifactStorageFactory storage.ArtifactStorageFactoryProvider
 }
 
 // NewService creates new Service instance.
 func NewService(
+	config *config.ServiceConfig,
 	tagRepository repositories.TagRepositoryProvider,
 	runRepository repositories.RunRepositoryProvider,
 	paramRepository repositories.ParamRepositoryProvider,
 	metricRepository repositories.MetricRepositoryProvider,
 	experimentRepository repositories.ExperimentRepositoryProvider,
+	namespaceRepository repositories.NamespaceRepositoryProvider,
+	webhookDispatcher webhook.DispatcherProvider,
+	alertEvaluator alert.EvaluatorProvider,
+	artifactStorageFactory storage.ArtifactStorageFactoryProvider,
 ) *Service {
 	return &Service{
-		tagRepository:        tagRepository,
-		runRepository:        runRepository,
-		paramRepository:      paramRepository,
-		metricRepository:     metricRepository,
-		experimentRepository: experimentRepository,
+		config:                 config,
+		tagRepository:          tagRepository,
+		runRepository:          runRepository,
+		paramRepository:        paramRepository,
+		metricRepository:       metricRepository,
+		experimentRepository:   experimentRepository,
+		namespaceRepository:    namespaceRepository,
+		webhookDispatcher:      webhookDispatcher,
+		alertEvaluator:         alertEvaluator,
+		artifactStorageFactory: artifactStorageFactory,
 	}
 }
 
@@ -111,6 +132,7 @@ func (s Service) UpdateRun(
 		return nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.GetRunID())
 	}
 
+	previousStatus := run.Status
 	run = convertors.ConvertUpdateRunRequestToDBModel(run, req)
 	if err := s.runRepository.GetDB().Transaction(func(tx *gorm.DB) error {
 		if err := s.runRepository.UpdateWithTransaction(ctx, tx, run); err != nil {
@@ -130,6 +152,12 @@ func (s Service) UpdateRun(
 		return nil, api.NewInternalError("unable to update run '%s': %s", run.ID, err)
 	}
 
+	if run.Status != previousStatus {
+		if event, ok := webhook.RunStatusEvents[run.Status]; ok {
+			s.webhookDispatcher.Dispatch(ctx, namespace, event, webhook.NewRunData(run))
+		}
+	}
+
 	return run, nil
 }
 
@@ -157,9 +185,9 @@ func (s Service) GetRun(
 // TODO:get back and fix `gocyclo` problem.
 func (s Service) SearchRuns(
 	ctx context.Context, namespace *models.Namespace, req *request.SearchRunsRequest,
-) ([]models.Run, int, int, error) {
+) ([]models.Run, *request.PageToken, error) {
 	if err := ValidateSearchRunsRequest(req); err != nil {
-		return nil, 0, 0, err
+		return nil, nil, err
 	}
 	adjustSearchRunsRequestForNamespace(namespace, req)
 
@@ -199,187 +227,56 @@ func (s Service) SearchRuns(
 	tx.Limit(limit)
 
 	// PageToken
-	var offset int
+	var pageToken *request.PageToken
 	if req.PageToken != "" {
-		var token request.PageToken
-		if err := json.NewDecoder(
-			base64.NewDecoder(
-				base64.StdEncoding,
-				strings.NewReader(req.PageToken),
-			),
-		).Decode(&token); err != nil {
-			return nil, 0, 0, api.NewInvalidParameterValueError("invalid page_token '%s': %s", req.PageToken, err)
+		token, err := request.DecodePageToken(req.PageToken)
+		if err != nil {
+			return nil, nil, api.NewInvalidParameterValueError("invalid page_token '%s': %s", req.PageToken, err)
 		}
-		offset = int(token.Offset)
+		pageToken = token
+		tx.Offset(int(token.Offset))
 	}
-	tx.Offset(offset)
 
 	// Filter
-	if req.Filter != "" {
-		for n, f := range filterAnd.Split(req.Filter, -1) {
-			components := filterCond.FindStringSubmatch(f)
-			if len(components) != 5 {
-				return nil, 0, 0, api.NewInvalidParameterValueError("malformed filter '%s'", f)
-			}
-
-			entity := components[1]
-			key := strings.Trim(components[2], "\"`")
-			comparison := components[3]
-			var value any = components[4]
-
-			var kind any
-			switch entity {
-			case "", "attribute", "attributes", "attr", "run":
-				switch key {
-				case "start_time", "end_time":
-					switch comparison {
-					case GraterExpression, GraterOrEqualExpression, NotEqualExpression,
-						EqualExpression, LessExpression, LessOrEqualExpression:
-						v, err := strconv.Atoi(value.(string))
-						if err != nil {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid numeric value '%s'", value)
-						}
-						value = v
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid numeric attribute comparison operator '%s'", comparison,
-						)
-					}
-				case "run_name":
-					key = "mlflow.runName"
-					kind = &database.Tag{}
-					fallthrough
-				case "status", "user_id", "artifact_uri":
-					switch strings.ToUpper(comparison) {
-					case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-						if strings.HasPrefix(value.(string), "(") {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-						}
-						value = strings.Trim(value.(string), `"'`)
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid string attribute comparison operator '%s'", comparison,
-						)
-					}
-				case "run_id":
-					key = "run_uuid"
-					switch strings.ToUpper(comparison) {
-					case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-						if strings.HasPrefix(value.(string), "(") {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-						}
-						value = strings.Trim(value.(string), `"'`)
-					case InExpression, NotInExpression:
-						if !strings.HasPrefix(value.(string), "(") {
-							return nil, 0, 0, api.NewInvalidParameterValueError("invalid list definition '%s'", value)
-						}
-						var values []string
-						for _, v := range filterInGroup.Split(value.(string)[1:len(value.(string))-1], -1) {
-							values = append(values, strings.Trim(v, "'"))
-						}
-						value = values
-					default:
-						return nil, 0, 0, api.NewInvalidParameterValueError(
-							"invalid string attribute comparison operator '%s'", comparison,
-						)
-					}
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError(
-						`invalid attribute '%s'. `+
-							`Valid values are ['run_name', 'start_time', 'end_time', 'status', 'user_id', 'artifact_uri', 'run_id']`,
-						key,
-					)
-				}
-			case "metric", "metrics":
-				switch comparison {
-				case GraterExpression, GraterOrEqualExpression,
-					NotEqualExpression, EqualExpression, LessExpression, LessOrEqualExpression:
-					v, err := strconv.ParseFloat(value.(string), 64)
-					if err != nil {
-						return nil, 0, 0, api.NewInvalidParameterValueError("invalid numeric value '%s'", value)
-					}
-					value = v
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError(
-						"invalid metric comparison operator '%s'", comparison,
-					)
-				}
-				kind = &database.LatestMetric{}
-			case "parameter", "parameters", "param", "params":
-				switch strings.ToUpper(comparison) {
-				case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-					if strings.HasPrefix(value.(string), "(") {
-						return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-					}
-					value = strings.Trim(value.(string), `"'`)
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError(
-						"invalid param comparison operator '%s'", comparison,
-					)
-				}
-				kind = &database.Param{}
-			case "tag", "tags":
-				switch strings.ToUpper(comparison) {
-				case NotEqualExpression, EqualExpression, LikeExpression, ILikeExpression:
-					if strings.HasPrefix(value.(string), "(") {
-						return nil, 0, 0, api.NewInvalidParameterValueError("invalid string value '%s'", value)
-					}
-					value = strings.Trim(value.(string), `"'`)
-				default:
-					return nil, 0, 0, api.NewInvalidParameterValueError(
-						"invalid tag comparison operator '%s'", comparison,
-					)
-				}
-				kind = &database.Tag{}
-			default:
-				return nil, 0, 0, api.NewInvalidParameterValueError(
-					"invalid entity type '%s'. Valid values are ['metric', 'parameter', 'tag', 'attribute']", entity,
-				)
-			}
-
-			if kind == nil {
-				if database.DB.Dialector.Name() == "sqlite" && strings.ToUpper(comparison) == ILikeExpression {
-					key = fmt.Sprintf("LOWER(runs.%s)", key)
-					comparison = LikeExpression
-					value = strings.ToLower(value.(string))
-					tx.Where(fmt.Sprintf("%s %s ?", key, comparison), value)
-				} else {
-					tx.Where(fmt.Sprintf("runs.%s %s ?", key, comparison), value)
-				}
-			} else {
-				table := fmt.Sprintf("filter_%d", n)
-				where := fmt.Sprintf("value %s ?", comparison)
-				if database.DB.Dialector.Name() == "sqlite" && strings.ToUpper(comparison) == ILikeExpression {
-					where = "LOWER(value) LIKE ?"
-					value = strings.ToLower(value.(string))
-				}
-				tx.Joins(
-					fmt.Sprintf("JOIN (?) AS %s ON runs.run_uuid = %s.run_uuid", table, table),
-					database.DB.Select("run_uuid", "value").Where("key = ?", key).Where(where, value).Model(kind),
-				)
-			}
+	expression, err := filter.Parse(req.Filter)
+	if err != nil {
+		return nil, nil, api.NewInvalidParameterValueError(err.Error())
+	}
+	if expression != nil {
+		condition, err := buildFilterCondition(req.Filter, expression)
+		if err != nil {
+			return nil, nil, err
 		}
+		tx.Where(condition)
 	}
 
 	// OrderBy
 	// TODO order numeric, nan, null?
 	// TODO collation for strings on postgres?
 	startTimeOrder := false
+	var sortColumns []sortColumn
 	for n, o := range req.OrderBy {
 		components := runOrder.FindStringSubmatch(o)
 		log.Debugf("Components: %#v", components)
 		if len(components) < 3 {
-			return nil, 0, 0, api.NewInvalidParameterValueError("invalid order_by clause '%s'", o)
+			return nil, nil, api.NewInvalidParameterValueError("invalid order_by clause '%s'", o)
 		}
 
-		column := strings.Trim(components[2], "`\"")
+		key := strings.Trim(components[2], "`\"")
+		desc := len(components) == 4 && strings.ToUpper(components[3]) == "DESC"
 
 		var kind any
 		switch components[1] {
 		case "attribute":
-			if column == "start_time" {
+			column, err := newAttributeSortColumn(key, desc)
+			if err != nil {
+				return nil, nil, api.NewInvalidParameterValueError("invalid order_by clause '%s'", o)
+			}
+			if key == "start_time" {
 				startTimeOrder = true
 			}
+			sortColumns = append(sortColumns, column)
+			continue
 		case "metric":
 			kind = &database.LatestMetric{}
 		case "param":
@@ -387,42 +284,66 @@ func (s Service) SearchRuns(
 		case "tag":
 			kind = &database.Tag{}
 		default:
-			return nil, 0, 0, api.NewInvalidParameterValueError(
+			return nil, nil, api.NewInvalidParameterValueError(
 				"invalid entity type '%s'. Valid values are ['metric', 'parameter', 'tag', 'attribute']",
 				components[1],
 			)
 		}
-		if kind != nil {
-			table := fmt.Sprintf("order_%d", n)
-			tx.Joins(
-				fmt.Sprintf("LEFT OUTER JOIN (?) AS %s ON runs.run_uuid = %s.run_uuid", table, table),
-				database.DB.Select("run_uuid", "value").Where("key = ?", column).Model(kind),
-			)
-			column = fmt.Sprintf("%s.value", table)
-		}
+		table := fmt.Sprintf("order_%d", n)
+		tx.Joins(
+			fmt.Sprintf("LEFT OUTER JOIN (?) AS %s ON runs.run_uuid = %s.run_uuid", table, table),
+			database.DB.Select("run_uuid", "value").Where("key = ?", key).Model(kind),
+		)
+		sortColumns = append(sortColumns, newKeyValueSortColumn(table, components[1], key, desc))
+	}
+	if !startTimeOrder {
+		sortColumns = append(sortColumns, startTimeSortColumn)
+	}
+	sortColumns = append(sortColumns, runIDSortColumn)
+	for _, column := range sortColumns {
 		tx.Order(clause.OrderByColumn{
 			Column: clause.Column{
-				Name: column,
+				Name: column.name,
 			},
-			Desc: len(components) == 4 && strings.ToUpper(components[3]) == "DESC",
+			Desc: column.desc,
 		})
 	}
-	if !startTimeOrder {
-		tx.Order("runs.start_time DESC")
+
+	// the next page starts right after the last row of the previous one.
+	if pageToken != nil && pageToken.Keys != nil {
+		if len(pageToken.Keys) != len(sortColumns) {
+			return nil, nil, api.NewInvalidParameterValueError(
+				"invalid page_token '%s': token doesn't match order_by clause", req.PageToken,
+			)
+		}
+		keys := make([]filter.SortKey, len(sortColumns))
+		for i, column := range sortColumns {
+			keys[i] = filter.SortKey{Column: column.name, Desc: column.desc, Value: pageToken.Keys[i]}
+		}
+		tx.Where(filter.BuildKeysetCondition(database.DB.Dialector.Name(), keys))
 	}
-	tx.Order("runs.run_uuid")
 
 	// Actual query
 	var runs []models.Run
 	tx.Preload("LatestMetrics").
 		Preload("Params").
 		Preload("Tags").
+		Preload("Inputs.Dataset").
+		Preload("Inputs.Tags").
 		Find(&runs)
 	if tx.Error != nil {
-		return nil, 0, 0, api.NewInternalError("unable to search runs: %s", tx.Error)
+		return nil, nil, api.NewInternalError("unable to search runs: %s", tx.Error)
 	}
 
-	return runs, limit, offset, nil
+	if len(runs) < limit {
+		return runs, nil, nil
+	}
+	lastRun := &runs[len(runs)-1]
+	nextPageToken := request.PageToken{Keys: make([]any, len(sortColumns))}
+	for i, column := range sortColumns {
+		nextPageToken.Keys[i] = column.value(lastRun)
+	}
+	return runs, &nextPageToken, nil
 }
 
 // DeleteRun handles delete models.Run entity business logic.
@@ -444,6 +365,7 @@ func (s Service) DeleteRun(
 	if err := s.runRepository.Archive(ctx, run); err != nil {
 		return api.NewInternalError("unable to delete run '%s': %s", run.ID, err)
 	}
+	s.webhookDispatcher.Dispatch(ctx, namespace, models.WebhookEventRunDeleted, webhook.NewRunData(run))
 
 	return nil
 }
@@ -470,6 +392,7 @@ func (s Service) RestoreRun(
 	if err := s.runRepository.Update(ctx, run); err != nil {
 		return api.NewInternalError("unable to restore run '%s': %s", run.ID, err)
 	}
+	s.webhookDispatcher.Dispatch(ctx, namespace, models.WebhookEventRunRestored, webhook.NewRunData(run))
 
 	return nil
 }
@@ -495,9 +418,12 @@ func (s Service) LogMetric(
 	if err != nil {
 		return api.NewInvalidParameterValueError(err.Error())
 	}
-	if err := s.metricRepository.CreateBatch(ctx, run, 1, []models.Metric{*metric}); err != nil {
+	latestMetrics, err := s.metricRepository.CreateBatch(ctx, run, 1, []models.Metric{*metric})
+	if err != nil {
 		return api.NewInternalError("unable to log metric '%s' for run '%s': %s", req.Key, req.GetRunID(), err)
 	}
+	s.evaluateAlertRules(ctx, run, latestMetrics)
+	s.touchRun(ctx, run)
 
 	return nil
 }
@@ -528,6 +454,7 @@ func (s Service) LogParam(
 		}
 		return api.NewInternalError("unable to insert params for run '%s': %s", run.ID, err)
 	}
+	s.touchRun(ctx, run)
 
 	return nil
 }
@@ -621,12 +548,277 @@ func (s Service) LogBatch(
 		}
 		return api.NewInternalError("unable to insert params for run '%s': %s", run.ID, err)
 	}
-	if err := s.metricRepository.CreateBatch(ctx, run, 100, metrics); err != nil {
+	latestMetrics, err := s.metricRepository.CreateBatch(ctx, run, 100, metrics)
+	if err != nil {
 		return api.NewInternalError("unable to insert metrics for run '%s': %s", run.ID, err)
 	}
 	if err := s.runRepository.SetRunTagsBatch(ctx, run, 100, tags); err != nil {
 		return api.NewInternalError("unable to insert tags for run '%s': %s", run.ID, err)
 	}
+	s.evaluateAlertRules(ctx, run, latestMetrics)
+	s.touchRun(ctx, run)
+
+	return nil
+}
+
+// Heartbeat records that the run is still alive, so it is not treated as stale.
+func (s Service) Heartbeat(
+	ctx context.Context,
+	namespace *models.Namespace,
+	req *request.RunHeartbeatRequest,
+) error {
+	if err := ValidateRunHeartbeatRequest(req); err != nil {
+		return err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDRunIDAndLifecycleStage(
+		ctx, namespace.ID, req.GetRunID(), models.LifecycleStageActive,
+	)
+	if err != nil {
+		return api.NewInternalError("Unable to find run '%s': %s", req.GetRunID(), err)
+	}
+	if run == nil {
+		return api.NewResourceDoesNotExistError("Run '%s' not found", req.GetRunID())
+	}
+
+	if err := s.runRepository.UpdateLastSeenTime(ctx, run.ID, time.Now().UTC().UnixMilli()); err != nil {
+		return api.NewInternalError("unable to record heartbeat of run '%s': %s", run.ID, err)
+	}
+
+	return nil
+}
+
+// MoveRun moves the run into another experiment, which could belong to another namespace. Params, tags
+// and metrics follow the run. Artifacts stay in place, unless they are requested to be moved as well.
+func (s Service) MoveRun(
+	ctx context.Context,
+	namespace *models.Namespace,
+	req *request.MoveRunRequest,
+) (*models.Run, error) {
+	if err := ValidateMoveRunRequest(req); err != nil {
+		return nil, err
+	}
+
+	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.RunID)
+	if err != nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find run '%s': %s", req.RunID, err)
+	}
+	if run == nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.RunID)
+	}
+
+	targetNamespace, experiment, err := s.getTargetExperiment(ctx, namespace, req.Namespace, req.ExperimentID)
+	if err != nil {
+		return nil, err
+	}
+	if *experiment.ID == run.ExperimentID {
+		return run, nil
+	}
+
+	sourceArtifactURI := run.ArtifactURI
+	run.ExperimentID = *experiment.ID
+	if req.MoveArtifacts {
+		artifactURI, err := url.JoinPath(experiment.ArtifactLocation, run.ID, "artifacts")
+		if err != nil {
+			return nil, api.NewInternalError("error constructing artifact_uri for run '%s': %s", run.ID, err)
+		}
+		if err := s.copyArtifacts(ctx, sourceArtifactURI, artifactURI); err != nil {
+			return nil, api.NewInternalError("unable to move artifacts of run '%s': %s", run.ID, err)
+		}
+		run.ArtifactURI = artifactURI
+	}
+
+	if err := s.runRepository.Move(ctx, run); err != nil {
+		return nil, api.NewInternalError("unable to move run '%s': %s", run.ID, err)
+	}
+
+	// the run already references the copied artifacts, so the source ones could only be left behind.
+	if run.ArtifactURI != sourceArtifactURI {
+		if err := s.deleteArtifacts(ctx, sourceArtifactURI); err != nil {
+			log.Errorf("error deleting moved artifacts of run '%s': %+v", run.ID, err)
+		}
+	}
+
+	return s.getRun(ctx, targetNamespace, run.ID)
+}
+
+// CloneRun creates the copy of the run in another experiment, which could belong to another namespace,
+// together with its params, tags and metrics. Artifacts are copied only if it is requested.
+func (s Service) CloneRun(
+	ctx context.Context,
+	namespace *models.Namespace,
+	req *request.CloneRunRequest,
+) (*models.Run, error) {
+	if err := ValidateCloneRunRequest(req); err != nil {
+		return nil, err
+	}
+
+	source, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, req.RunID)
+	if err != nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find run '%s': %s", req.RunID, err)
+	}
+	if source == nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find run '%s'", req.RunID)
+	}
+
+	targetNamespace, experiment, err := s.getTargetExperiment(ctx, namespace, req.Namespace, req.ExperimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	clone, err := convertors.ConvertCloneRunRequestToDBModel(source, experiment, req)
+	if err != nil {
+		return nil, api.NewInternalError("error converting request to actual run model: %s", err)
+	}
+	if req.CopyArtifacts {
+		if err := s.copyArtifacts(ctx, source.ArtifactURI, clone.ArtifactURI); err != nil {
+			return nil, api.NewInternalError("unable to copy artifacts of run '%s': %s", source.ID, err)
+		}
+	}
 
+	if err := s.runRepository.Clone(ctx, source, clone); err != nil {
+		if req.CopyArtifacts {
+			if err := s.deleteArtifacts(ctx, clone.ArtifactURI); err != nil {
+				log.Errorf("error deleting copied artifacts of run '%s': %+v", source.ID, err)
+			}
+		}
+		return nil, api.NewInternalError("unable to clone run '%s': %s", source.ID, err)
+	}
+
+	return s.getRun(ctx, targetNamespace, clone.ID)
+}
+
+// getTargetExperiment returns the experiment, which the run is moved or cloned into, together with
+// its namespace. The current namespace is used, when the namespace code is not provided.
+func (s Service) getTargetExperiment(
+	ctx context.Context, namespace *models.Namespace, namespaceCode, experimentID string,
+) (*models.Namespace, *models.Experiment, error) {
+	if namespaceCode != "" && namespaceCode != namespace.Code {
+		target, err := s.namespaceRepository.GetByCode(ctx, namespaceCode)
+		if err != nil {
+			return nil, nil, api.NewInternalError("unable to find namespace '%s': %s", namespaceCode, err)
+		}
+		if target == nil {
+			return nil, nil, api.NewResourceDoesNotExistError("unable to find namespace '%s'", namespaceCode)
+		}
+		namespace = target
+	}
+
+	id, err := strconv.ParseInt(experimentID, 10, 32)
+	if err != nil {
+		return nil, nil, api.NewBadRequestError("unable to parse experiment id '%s': %s", experimentID, err)
+	}
+	experiment, err := s.experimentRepository.GetByNamespaceIDAndExperimentID(ctx, namespace.ID, int32(id))
+	if err != nil {
+		return nil, nil, api.NewResourceDoesNotExistError(
+			"unable to find experiment with id '%s': %s", experimentID, err,
+		)
+	}
+	if experiment.LifecycleStage != models.LifecycleStageActive {
+		return nil, nil, api.NewInvalidParameterValueError(
+			"experiment '%s' is not active, runs can't be moved or cloned into it", experimentID,
+		)
+	}
+	return namespace, experiment, nil
+}
+
+// getRun returns the run with all its data after it has been moved or cloned into the namespace.
+func (s Service) getRun(ctx context.Context, namespace *models.Namespace, id string) (*models.Run, error) {
+	run, err := s.runRepository.GetByNamespaceIDAndRunID(ctx, namespace.ID, id)
+	if err != nil {
+		return nil, api.NewInternalError("unable to find run '%s': %s", id, err)
+	}
+	if run == nil {
+		return nil, api.NewInternalError("unable to find run '%s'", id)
+	}
+	return run, nil
+}
+
+// copyArtifacts copies all the artifacts under source artifact uri into target artifact uri,
+// which could belong to another artifact storage.
+func (s Service) copyArtifacts(ctx context.Context, sourceURI, targetURI string) error {
+	if sourceURI == "" {
+		return nil
+	}
+	sourceStorage, err := s.artifactStorageFactory.GetStorage(ctx, sourceURI)
+	if err != nil {
+		return eris.Wrapf(err, "error getting artifact storage for uri: %s", sourceURI)
+	}
+	targetStorage, err := s.artifactStorageFactory.GetStorage(ctx, targetURI)
+	if err != nil {
+		return eris.Wrapf(err, "error getting artifact storage for uri: %s", targetURI)
+	}
+
+	options := storage.ListOptions{Recursive: true}
+	for {
+		objects, nextPageToken, err := sourceStorage.List(ctx, sourceURI, "", options)
+		if err != nil {
+			return eris.Wrapf(err, "error listing artifacts for uri: %s", sourceURI)
+		}
+		for _, object := range objects {
+			if object.IsDirectory() {
+				continue
+			}
+			if err := copyArtifact(ctx, sourceStorage, sourceURI, targetStorage, targetURI, object.GetPath()); err != nil {
+				return err
+			}
+		}
+		if nextPageToken == "" {
+			return nil
+		}
+		options.PageToken = nextPageToken
+	}
+}
+
+// copyArtifact copies the single artifact object between the storages.
+func copyArtifact(
+	ctx context.Context,
+	sourceStorage storage.ArtifactStorageProvider,
+	sourceURI string,
+	targetStorage storage.ArtifactStorageProvider,
+	targetURI string,
+	path string,
+) error {
+	reader, err := sourceStorage.Get(ctx, sourceURI, path)
+	if err != nil {
+		return eris.Wrapf(err, "error reading artifact: %s", path)
+	}
+	//nolint:errcheck
+	defer reader.Close()
+	if err := targetStorage.Put(ctx, targetURI, path, reader); err != nil {
+		return eris.Wrapf(err, "error writing artifact: %s", path)
+	}
 	return nil
 }
+
+// deleteArtifacts deletes all the artifacts under provided artifact uri.
+func (s Service) deleteArtifacts(ctx context.Context, artifactURI string) error {
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, artifactURI)
+	if err != nil {
+		return eris.Wrapf(err, "error getting artifact storage for uri: %s", artifactURI)
+	}
+	if err := artifactStorage.Delete(ctx, artifactURI, ""); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return eris.Wrapf(err, "error deleting artifacts for uri: %s", artifactURI)
+	}
+	return nil
+}
+
+// evaluateAlertRules fires the alerts of the rules, which match just updated latest metrics of the run.
+// Metrics have been already stored at this point, so failure is only logged and doesn't fail the request.
+func (s Service) evaluateAlertRules(ctx context.Context, run *models.Run, latestMetrics []models.LatestMetric) {
+	if _, err := s.alertEvaluator.Evaluate(ctx, run, latestMetrics); err != nil {
+		log.Errorf("error evaluating alert rules for run '%s': %+v", run.ID, err)
+	}
+}
+
+// touchRun records activity of the run after metrics or params have been written. Data has been
+// already written at this point, so failure is only logged and doesn't fail the request.
+// Activity is only used by the sweeper of the stale runs, so nothing is recorded when it is disabled.
+func (s Service) touchRun(ctx context.Context, run *models.Run) {
+	if s.config.StaleRunTimeout == 0 {
+		return
+	}
+	if err := s.runRepository.UpdateLastSeenTime(ctx, run.ID, time.Now().UTC().UnixMilli()); err != nil {
+		log.Errorf("error updating last seen time of run '%s': %+v", run.ID, err)
+	}
+}
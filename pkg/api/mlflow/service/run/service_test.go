@@ -4,7 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -13,8 +16,12 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/alert"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/webhook"
 )
 
 func TestService_CreateRun_Ok(t *testing.T) {
@@ -62,11 +69,16 @@ func TestService_CreateRun_Ok(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(
+		&config.ServiceConfig{},
 		&repositories.MockTagRepositoryProvider{},
 		&runRepository,
 		&repositories.MockParamRepositoryProvider{},
 		&repositories.MockMetricRepositoryProvider{},
 		&experimentRepository,
+		&repositories.MockNamespaceRepositoryProvider{},
+		&webhook.MockDispatcherProvider{},
+		&alert.MockEvaluatorProvider{},
+		&storage.MockArtifactStorageFactoryProvider{},
 	)
 	run, err := service.CreateRun(context.TODO(), &ns, &request.CreateRunRequest{
 		ExperimentID: "0", // default experiment id provided by the client is "0"
@@ -117,11 +129,16 @@ func TestService_CreateRun_Error(t *testing.T) {
 			request: &request.CreateRunRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -140,11 +157,16 @@ func TestService_CreateRun_Error(t *testing.T) {
 					int32(1),
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&experimentRepository,
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -192,11 +214,16 @@ func TestService_CreateRun_Error(t *testing.T) {
 					}),
 				).Return(errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&experimentRepository,
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -228,11 +255,16 @@ func TestService_UpdateRun_Error(t *testing.T) {
 			request: &request.UpdateRunRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -251,11 +283,16 @@ func TestService_UpdateRun_Error(t *testing.T) {
 					"1",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -289,18 +326,29 @@ func TestService_RestoreRun_Ok(t *testing.T) {
 		},
 	).Return(nil)
 
+	webhookDispatcher := webhook.MockDispatcherProvider{}
+	webhookDispatcher.On(
+		"Dispatch", context.TODO(), mock.Anything, models.WebhookEventRunRestored, mock.AnythingOfType("*webhook.RunData"),
+	).Return()
+
 	// call service under testing.
 	service := NewService(
+		&config.ServiceConfig{},
 		&repositories.MockTagRepositoryProvider{},
 		&runRepository,
 		&repositories.MockParamRepositoryProvider{},
 		&repositories.MockMetricRepositoryProvider{},
 		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		&webhookDispatcher,
+		&alert.MockEvaluatorProvider{},
+		&storage.MockArtifactStorageFactoryProvider{},
 	)
 	err := service.RestoreRun(context.TODO(), &models.Namespace{ID: 1}, &request.RestoreRunRequest{RunID: "1"})
 
 	// compare results.
 	require.Nil(t, err)
+	webhookDispatcher.AssertExpectations(t)
 }
 
 func TestService_RestoreRun_Error(t *testing.T) {
@@ -316,11 +364,16 @@ func TestService_RestoreRun_Error(t *testing.T) {
 			request: &request.RestoreRunRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -339,11 +392,16 @@ func TestService_RestoreRun_Error(t *testing.T) {
 					"1",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -374,11 +432,16 @@ func TestService_RestoreRun_Error(t *testing.T) {
 					}),
 				).Return(errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -415,11 +478,16 @@ func TestService_SetRunTag_Ok(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(
+		&config.ServiceConfig{},
 		&repositories.MockTagRepositoryProvider{},
 		&runRepository,
 		&repositories.MockParamRepositoryProvider{},
 		&repositories.MockMetricRepositoryProvider{},
 		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		&webhook.MockDispatcherProvider{},
+		&alert.MockEvaluatorProvider{},
+		&storage.MockArtifactStorageFactoryProvider{},
 	)
 	err := service.SetRunTag(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -449,18 +517,29 @@ func TestService_DeleteRun_Ok(t *testing.T) {
 		&models.Run{ID: "1"},
 	).Return(nil)
 
+	webhookDispatcher := webhook.MockDispatcherProvider{}
+	webhookDispatcher.On(
+		"Dispatch", context.TODO(), mock.Anything, models.WebhookEventRunDeleted, mock.AnythingOfType("*webhook.RunData"),
+	).Return()
+
 	// call service under testing.
 	service := NewService(
+		&config.ServiceConfig{},
 		&repositories.MockTagRepositoryProvider{},
 		&runRepository,
 		&repositories.MockParamRepositoryProvider{},
 		&repositories.MockMetricRepositoryProvider{},
 		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		&webhookDispatcher,
+		&alert.MockEvaluatorProvider{},
+		&storage.MockArtifactStorageFactoryProvider{},
 	)
 	err := service.DeleteRun(context.TODO(), &models.Namespace{ID: 1}, &request.DeleteRunRequest{RunID: "1"})
 
 	// compare results.
 	require.Nil(t, err)
+	webhookDispatcher.AssertExpectations(t)
 }
 
 func TestService_DeleteRun_Error(t *testing.T) {
@@ -476,11 +555,16 @@ func TestService_DeleteRun_Error(t *testing.T) {
 			request: &request.DeleteRunRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -499,11 +583,16 @@ func TestService_DeleteRun_Error(t *testing.T) {
 					"1",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -522,11 +611,16 @@ func TestService_DeleteRun_Error(t *testing.T) {
 					"1",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -555,11 +649,16 @@ func TestService_DeleteRun_Error(t *testing.T) {
 					}),
 				).Return(errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -590,11 +689,16 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 			request: &request.DeleteRunTagRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -614,11 +718,16 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					models.LifecycleStageActive,
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -638,11 +747,16 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					models.LifecycleStageActive,
 				).Return(nil, nil)
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -673,11 +787,16 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					"key",
 				).Return(nil, nil)
 				return NewService(
+					&config.ServiceConfig{},
 					&tagRepository,
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -708,11 +827,16 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					"key",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&tagRepository,
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -757,11 +881,16 @@ func TestService_DeleteRunTag_Error(t *testing.T) {
 					}),
 				).Return(errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&tagRepository,
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -823,11 +952,16 @@ func TestService_GetRun_Ok(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(
+		&config.ServiceConfig{},
 		&repositories.MockTagRepositoryProvider{},
 		&runRepository,
 		&repositories.MockParamRepositoryProvider{},
 		&repositories.MockMetricRepositoryProvider{},
 		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		&webhook.MockDispatcherProvider{},
+		&alert.MockEvaluatorProvider{},
+		&storage.MockArtifactStorageFactoryProvider{},
 	)
 	run, err := service.GetRun(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -884,11 +1018,16 @@ func TestService_GetRun_Error(t *testing.T) {
 			request: &request.GetRunRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -907,11 +1046,16 @@ func TestService_GetRun_Error(t *testing.T) {
 					"1",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -941,6 +1085,9 @@ func TestService_LogBatch_Ok(t *testing.T) {
 		ID:             "1",
 		LifecycleStage: models.LifecycleStageActive,
 	}, nil)
+	runRepository.On(
+		"UpdateLastSeenTime", context.TODO(), "1", mock.AnythingOfType("int64"),
+	).Return(nil)
 	runRepository.On(
 		"SetRunTagsBatch",
 		context.TODO(),
@@ -979,15 +1126,27 @@ func TestService_LogBatch_Ok(t *testing.T) {
 			assert.Equal(t, int64(1234567890), metrics[0].Timestamp)
 			return true
 		}),
-	).Return(nil)
+	).Return([]models.LatestMetric{{RunID: "1", Key: "key3", Value: 1.1, Step: 1}}, nil)
+	alertEvaluator := alert.MockEvaluatorProvider{}
+	alertEvaluator.On(
+		"Evaluate",
+		context.TODO(),
+		&models.Run{ID: "1", LifecycleStage: models.LifecycleStageActive},
+		[]models.LatestMetric{{RunID: "1", Key: "key3", Value: 1.1, Step: 1}},
+	).Return(nil, nil)
 
 	// call service under testing.
 	service := NewService(
+		&config.ServiceConfig{StaleRunTimeout: time.Hour},
 		&repositories.MockTagRepositoryProvider{},
 		&runRepository,
 		&paramRepository,
 		&metricRepository,
 		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		&webhook.MockDispatcherProvider{},
+		&alertEvaluator,
+		&storage.MockArtifactStorageFactoryProvider{},
 	)
 	err := service.LogBatch(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -1017,6 +1176,8 @@ func TestService_LogBatch_Ok(t *testing.T) {
 
 	// compare results.
 	require.Nil(t, err)
+	runRepository.AssertExpectations(t)
+	alertEvaluator.AssertExpectations(t)
 }
 
 func TestService_LogBatch_Error(t *testing.T) {
@@ -1032,11 +1193,16 @@ func TestService_LogBatch_Error(t *testing.T) {
 			request: &request.LogBatchRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1056,11 +1222,16 @@ func TestService_LogBatch_Error(t *testing.T) {
 					models.LifecycleStageActive,
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1080,11 +1251,16 @@ func TestService_LogBatch_Error(t *testing.T) {
 					models.LifecycleStageActive,
 				).Return(nil, nil)
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1104,11 +1280,16 @@ func TestService_LogBatch_Error(t *testing.T) {
 					models.LifecycleStageActive,
 				).Return(nil, nil)
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1137,11 +1318,16 @@ func TestService_LogBatch_Error(t *testing.T) {
 					ID: "1",
 				}, nil)
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1182,11 +1368,16 @@ func TestService_LogBatch_Error(t *testing.T) {
 					},
 				).Return(errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&paramRepository,
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1227,11 +1418,16 @@ func TestService_LogBatch_Error(t *testing.T) {
 					},
 				).Return(repositories.ParamConflictError{Message: "param conflict!"})
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&paramRepository,
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1300,13 +1496,18 @@ func TestService_LogBatch_Error(t *testing.T) {
 							Context:   models.DefaultContext,
 						},
 					},
-				).Return(errors.New("database error"))
+				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&paramRepository,
 					&metricRepository,
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1397,13 +1598,18 @@ func TestService_LogBatch_Error(t *testing.T) {
 							Context:   models.DefaultContext,
 						},
 					},
-				).Return(nil)
+				).Return(nil, nil)
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&paramRepository,
 					&metricRepository,
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1430,6 +1636,9 @@ func TestService_LogMetric_Ok(t *testing.T) {
 		ID:             "1",
 		LifecycleStage: models.LifecycleStageActive,
 	}, nil)
+	runRepository.On(
+		"UpdateLastSeenTime", context.TODO(), "1", mock.AnythingOfType("int64"),
+	).Return(nil)
 	metricRepository := repositories.MockMetricRepositoryProvider{}
 	metricRepository.On(
 		"CreateBatch",
@@ -1444,15 +1653,28 @@ func TestService_LogMetric_Ok(t *testing.T) {
 			assert.Equal(t, int64(1234567890), metrics[0].Timestamp)
 			return true
 		}),
-	).Return(nil)
+	).Return([]models.LatestMetric{{RunID: "1", Key: "key", Value: 1.1, Step: 1}}, nil)
+	// alert rules are evaluated after the metric has been stored, so their failure is only logged.
+	alertEvaluator := alert.MockEvaluatorProvider{}
+	alertEvaluator.On(
+		"Evaluate",
+		context.TODO(),
+		&models.Run{ID: "1", LifecycleStage: models.LifecycleStageActive},
+		[]models.LatestMetric{{RunID: "1", Key: "key", Value: 1.1, Step: 1}},
+	).Return(nil, errors.New("database error"))
 
 	// call service under testing.
 	service := NewService(
+		&config.ServiceConfig{StaleRunTimeout: time.Hour},
 		&repositories.MockTagRepositoryProvider{},
 		&runRepository,
 		&repositories.MockParamRepositoryProvider{},
 		&metricRepository,
 		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		&webhook.MockDispatcherProvider{},
+		&alertEvaluator,
+		&storage.MockArtifactStorageFactoryProvider{},
 	)
 	err := service.LogMetric(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -1466,6 +1688,8 @@ func TestService_LogMetric_Ok(t *testing.T) {
 
 	// compare results.
 	require.Nil(t, err)
+	runRepository.AssertExpectations(t)
+	alertEvaluator.AssertExpectations(t)
 }
 
 func TestService_LogMetric_Error(t *testing.T) {
@@ -1481,11 +1705,16 @@ func TestService_LogMetric_Error(t *testing.T) {
 			request: &request.LogMetricRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1497,11 +1726,16 @@ func TestService_LogMetric_Error(t *testing.T) {
 			},
 			service: func() *Service {
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1514,11 +1748,16 @@ func TestService_LogMetric_Error(t *testing.T) {
 			},
 			service: func() *Service {
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1540,11 +1779,16 @@ func TestService_LogMetric_Error(t *testing.T) {
 					"1",
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1568,11 +1812,16 @@ func TestService_LogMetric_Error(t *testing.T) {
 					ID: "1",
 				}, nil)
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1614,13 +1863,18 @@ func TestService_LogMetric_Error(t *testing.T) {
 						assert.Equal(t, int64(1234567890), metrics[0].Timestamp)
 						return true
 					}),
-				).Return(errors.New("database error"))
+				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&metricRepository,
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1648,6 +1902,9 @@ func TestService_LogParam_Ok(t *testing.T) {
 		ID:             "1",
 		LifecycleStage: models.LifecycleStageActive,
 	}, nil)
+	runRepository.On(
+		"UpdateLastSeenTime", context.TODO(), "1", mock.AnythingOfType("int64"),
+	).Return(nil)
 	paramRepository := repositories.MockParamRepositoryProvider{}
 	paramRepository.On(
 		"CreateBatch",
@@ -1663,11 +1920,58 @@ func TestService_LogParam_Ok(t *testing.T) {
 
 	// call service under testing.
 	service := NewService(
+		&config.ServiceConfig{StaleRunTimeout: time.Hour},
+		&repositories.MockTagRepositoryProvider{},
+		&runRepository,
+		&paramRepository,
+		&repositories.MockMetricRepositoryProvider{},
+		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		&webhook.MockDispatcherProvider{},
+		&alert.MockEvaluatorProvider{},
+		&storage.MockArtifactStorageFactoryProvider{},
+	)
+	err := service.LogParam(context.TODO(), &models.Namespace{
+		ID: 1,
+	}, &request.LogParamRequest{
+		RunID: "1",
+		Key:   "key",
+		Value: "value",
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	runRepository.AssertExpectations(t)
+}
+
+func TestService_LogParam_SweeperDisabled(t *testing.T) {
+	// init repository mocks. activity of the run isn't recorded, when the sweeper is disabled.
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDRunIDAndLifecycleStage",
+		context.TODO(),
+		uint(1),
+		"1",
+		models.LifecycleStageActive,
+	).Return(&models.Run{
+		ID:             "1",
+		LifecycleStage: models.LifecycleStageActive,
+	}, nil)
+	paramRepository := repositories.MockParamRepositoryProvider{}
+	paramRepository.On("CreateBatch", context.TODO(), 1, mock.Anything).Return(nil)
+
+	// call service under testing.
+	service := NewService(
+		&config.ServiceConfig{},
 		&repositories.MockTagRepositoryProvider{},
 		&runRepository,
 		&paramRepository,
 		&repositories.MockMetricRepositoryProvider{},
 		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		&webhook.MockDispatcherProvider{},
+		&alert.MockEvaluatorProvider{},
+		&storage.MockArtifactStorageFactoryProvider{},
 	)
 	err := service.LogParam(context.TODO(), &models.Namespace{
 		ID: 1,
@@ -1679,6 +1983,48 @@ func TestService_LogParam_Ok(t *testing.T) {
 
 	// compare results.
 	require.Nil(t, err)
+	runRepository.AssertNotCalled(t, "UpdateLastSeenTime", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_Heartbeat_Ok(t *testing.T) {
+	// init repository mocks.
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDRunIDAndLifecycleStage",
+		context.TODO(),
+		uint(1),
+		"1",
+		models.LifecycleStageActive,
+	).Return(&models.Run{
+		ID:             "1",
+		LifecycleStage: models.LifecycleStageActive,
+	}, nil)
+	runRepository.On(
+		"UpdateLastSeenTime", context.TODO(), "1", mock.AnythingOfType("int64"),
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(
+		&config.ServiceConfig{},
+		&repositories.MockTagRepositoryProvider{},
+		&runRepository,
+		&repositories.MockParamRepositoryProvider{},
+		&repositories.MockMetricRepositoryProvider{},
+		&repositories.MockExperimentRepositoryProvider{},
+		&repositories.MockNamespaceRepositoryProvider{},
+		&webhook.MockDispatcherProvider{},
+		&alert.MockEvaluatorProvider{},
+		&storage.MockArtifactStorageFactoryProvider{},
+	)
+	err := service.Heartbeat(context.TODO(), &models.Namespace{
+		ID: 1,
+	}, &request.RunHeartbeatRequest{
+		RunID: "1",
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	runRepository.AssertExpectations(t)
 }
 
 func TestService_LogParam_Error(t *testing.T) {
@@ -1694,11 +2040,16 @@ func TestService_LogParam_Error(t *testing.T) {
 			request: &request.LogParamRequest{},
 			service: func() *Service {
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1710,11 +2061,16 @@ func TestService_LogParam_Error(t *testing.T) {
 			},
 			service: func() *Service {
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&repositories.MockRunRepositoryProvider{},
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1736,11 +2092,16 @@ func TestService_LogParam_Error(t *testing.T) {
 					models.LifecycleStageActive,
 				).Return(nil, errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1762,11 +2123,16 @@ func TestService_LogParam_Error(t *testing.T) {
 					models.LifecycleStageActive,
 				).Return(nil, nil)
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&repositories.MockParamRepositoryProvider{},
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1804,11 +2170,16 @@ func TestService_LogParam_Error(t *testing.T) {
 					}),
 				).Return(errors.New("database error"))
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&paramRepository,
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1846,11 +2217,16 @@ func TestService_LogParam_Error(t *testing.T) {
 					}),
 				).Return(repositories.ParamConflictError{Message: "conflict!"})
 				return NewService(
+					&config.ServiceConfig{},
 					&repositories.MockTagRepositoryProvider{},
 					&runRepository,
 					&paramRepository,
 					&repositories.MockMetricRepositoryProvider{},
 					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
 				)
 			},
 		},
@@ -1864,3 +2240,426 @@ func TestService_LogParam_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestService_MoveRun_Ok(t *testing.T) {
+	// init repository mocks.
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDAndRunID", context.TODO(), uint(1), "1",
+	).Return(&models.Run{
+		ID:           "1",
+		ExperimentID: 1,
+		ArtifactURI:  "/artifacts/1/1/artifacts",
+	}, nil).Once()
+	runRepository.On(
+		"Move",
+		context.TODO(),
+		mock.MatchedBy(func(run *models.Run) bool {
+			assert.Equal(t, int32(2), run.ExperimentID)
+			assert.Equal(t, "/artifacts/2/1/artifacts", run.ArtifactURI)
+			return true
+		}),
+	).Return(nil)
+	runRepository.On(
+		"GetByNamespaceIDAndRunID", context.TODO(), uint(2), "1",
+	).Return(&models.Run{
+		ID:           "1",
+		ExperimentID: 2,
+		ArtifactURI:  "/artifacts/2/1/artifacts",
+	}, nil).Once()
+
+	namespaceRepository := repositories.MockNamespaceRepositoryProvider{}
+	namespaceRepository.On(
+		"GetByCode", context.TODO(), "target",
+	).Return(&models.Namespace{ID: 2, Code: "target"}, nil)
+
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+	experimentRepository.On(
+		"GetByNamespaceIDAndExperimentID", context.TODO(), uint(2), int32(2),
+	).Return(&models.Experiment{
+		ID:               common.GetPointer(int32(2)),
+		ArtifactLocation: "/artifacts/2",
+		LifecycleStage:   models.LifecycleStageActive,
+	}, nil)
+
+	sourceStorage := storage.MockArtifactStorageProvider{}
+	sourceStorage.On(
+		"List", context.TODO(), "/artifacts/1/1/artifacts", "", storage.ListOptions{Recursive: true},
+	).Return([]storage.ArtifactObject{{Path: "model/model.pkl", Size: 5}}, "", nil)
+	sourceStorage.On(
+		"Get", context.TODO(), "/artifacts/1/1/artifacts", "model/model.pkl",
+	).Return(io.NopCloser(strings.NewReader("model")), nil)
+	sourceStorage.On(
+		"Put", context.TODO(), "/artifacts/2/1/artifacts", "model/model.pkl", mock.Anything,
+	).Return(nil)
+	sourceStorage.On(
+		"Delete", context.TODO(), "/artifacts/1/1/artifacts", "",
+	).Return(nil)
+	artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+	artifactStorageFactory.On(
+		"GetStorage", context.TODO(), mock.AnythingOfType("string"),
+	).Return(&sourceStorage, nil)
+
+	// call service under testing.
+	service := NewService(
+		&config.ServiceConfig{},
+		&repositories.MockTagRepositoryProvider{},
+		&runRepository,
+		&repositories.MockParamRepositoryProvider{},
+		&repositories.MockMetricRepositoryProvider{},
+		&experimentRepository,
+		&namespaceRepository,
+		&webhook.MockDispatcherProvider{},
+		&alert.MockEvaluatorProvider{},
+		&artifactStorageFactory,
+	)
+	run, err := service.MoveRun(context.TODO(), &models.Namespace{ID: 1, Code: "default"}, &request.MoveRunRequest{
+		RunID:         "1",
+		ExperimentID:  "2",
+		Namespace:     "target",
+		MoveArtifacts: true,
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, int32(2), run.ExperimentID)
+	assert.Equal(t, "/artifacts/2/1/artifacts", run.ArtifactURI)
+	runRepository.AssertExpectations(t)
+	sourceStorage.AssertExpectations(t)
+}
+
+func TestService_MoveRun_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.MoveRunRequest
+		service func() *Service
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.MoveRunRequest{},
+			service: func() *Service {
+				return NewService(
+					&config.ServiceConfig{},
+					&repositories.MockTagRepositoryProvider{},
+					&repositories.MockRunRepositoryProvider{},
+					&repositories.MockParamRepositoryProvider{},
+					&repositories.MockMetricRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "RunNotFound",
+			error: api.NewResourceDoesNotExistError("unable to find run '1'"),
+			request: &request.MoveRunRequest{
+				RunID:        "1",
+				ExperimentID: "2",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID", context.TODO(), uint(1), "1",
+				).Return(nil, nil)
+				return NewService(
+					&config.ServiceConfig{},
+					&repositories.MockTagRepositoryProvider{},
+					&runRepository,
+					&repositories.MockParamRepositoryProvider{},
+					&repositories.MockMetricRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "NamespaceNotFound",
+			error: api.NewResourceDoesNotExistError("unable to find namespace 'target'"),
+			request: &request.MoveRunRequest{
+				RunID:        "1",
+				ExperimentID: "2",
+				Namespace:    "target",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID", context.TODO(), uint(1), "1",
+				).Return(&models.Run{ID: "1", ExperimentID: 1}, nil)
+				namespaceRepository := repositories.MockNamespaceRepositoryProvider{}
+				namespaceRepository.On(
+					"GetByCode", context.TODO(), "target",
+				).Return(nil, nil)
+				return NewService(
+					&config.ServiceConfig{},
+					&repositories.MockTagRepositoryProvider{},
+					&runRepository,
+					&repositories.MockParamRepositoryProvider{},
+					&repositories.MockMetricRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
+					&namespaceRepository,
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "ExperimentNotActive",
+			error: api.NewInvalidParameterValueError("experiment '2' is not active, runs can't be moved or cloned into it"),
+			request: &request.MoveRunRequest{
+				RunID:        "1",
+				ExperimentID: "2",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID", context.TODO(), uint(1), "1",
+				).Return(&models.Run{ID: "1", ExperimentID: 1}, nil)
+				experimentRepository := repositories.MockExperimentRepositoryProvider{}
+				experimentRepository.On(
+					"GetByNamespaceIDAndExperimentID", context.TODO(), uint(1), int32(2),
+				).Return(&models.Experiment{
+					ID:             common.GetPointer(int32(2)),
+					LifecycleStage: models.LifecycleStageDeleted,
+				}, nil)
+				return NewService(
+					&config.ServiceConfig{},
+					&repositories.MockTagRepositoryProvider{},
+					&runRepository,
+					&repositories.MockParamRepositoryProvider{},
+					&repositories.MockMetricRepositoryProvider{},
+					&experimentRepository,
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "MoveRunDatabaseError",
+			error: api.NewInternalError("unable to move run '1': database error"),
+			request: &request.MoveRunRequest{
+				RunID:        "1",
+				ExperimentID: "2",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID", context.TODO(), uint(1), "1",
+				).Return(&models.Run{ID: "1", ExperimentID: 1}, nil)
+				runRepository.On(
+					"Move", context.TODO(), mock.AnythingOfType("*models.Run"),
+				).Return(errors.New("database error"))
+				experimentRepository := repositories.MockExperimentRepositoryProvider{}
+				experimentRepository.On(
+					"GetByNamespaceIDAndExperimentID", context.TODO(), uint(1), int32(2),
+				).Return(&models.Experiment{
+					ID:             common.GetPointer(int32(2)),
+					LifecycleStage: models.LifecycleStageActive,
+				}, nil)
+				return NewService(
+					&config.ServiceConfig{},
+					&repositories.MockTagRepositoryProvider{},
+					&runRepository,
+					&repositories.MockParamRepositoryProvider{},
+					&repositories.MockMetricRepositoryProvider{},
+					&experimentRepository,
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
+				)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			// call service under testing.
+			_, err := tt.service().MoveRun(context.TODO(), &models.Namespace{ID: 1, Code: "default"}, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestService_CloneRun_Ok(t *testing.T) {
+	// init repository mocks.
+	source := models.Run{
+		ID:           "1",
+		Name:         "source",
+		ExperimentID: 1,
+		Status:       models.StatusFinished,
+		StartTime:    sql.NullInt64{Int64: 1234567890, Valid: true},
+	}
+	var clone *models.Run
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDAndRunID", context.TODO(), uint(1), "1",
+	).Return(&source, nil)
+	runRepository.On(
+		"Clone",
+		context.TODO(),
+		&source,
+		mock.MatchedBy(func(run *models.Run) bool {
+			clone = run
+			return true
+		}),
+	).Return(nil)
+	runRepository.On(
+		"GetByNamespaceIDAndRunID", context.TODO(), uint(1), mock.AnythingOfType("string"),
+	).Return(func(ctx context.Context, namespaceID uint, runID string) (*models.Run, error) {
+		return clone, nil
+	})
+
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+	experimentRepository.On(
+		"GetByNamespaceIDAndExperimentID", context.TODO(), uint(1), int32(2),
+	).Return(&models.Experiment{
+		ID:               common.GetPointer(int32(2)),
+		ArtifactLocation: "/artifacts/2",
+		LifecycleStage:   models.LifecycleStageActive,
+	}, nil)
+
+	// call service under testing.
+	service := NewService(
+		&config.ServiceConfig{},
+		&repositories.MockTagRepositoryProvider{},
+		&runRepository,
+		&repositories.MockParamRepositoryProvider{},
+		&repositories.MockMetricRepositoryProvider{},
+		&experimentRepository,
+		&repositories.MockNamespaceRepositoryProvider{},
+		&webhook.MockDispatcherProvider{},
+		&alert.MockEvaluatorProvider{},
+		&storage.MockArtifactStorageFactoryProvider{},
+	)
+	run, err := service.CloneRun(context.TODO(), &models.Namespace{ID: 1, Code: "default"}, &request.CloneRunRequest{
+		RunID:        "1",
+		ExperimentID: "2",
+		Name:         "clone",
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	assert.NotEqual(t, "1", run.ID)
+	assert.Equal(t, "clone", run.Name)
+	assert.Equal(t, int32(2), run.ExperimentID)
+	assert.Equal(t, models.StatusFinished, run.Status)
+	assert.Equal(t, source.StartTime, run.StartTime)
+	assert.Equal(t, "/artifacts/2/"+run.ID+"/artifacts", run.ArtifactURI)
+	runRepository.AssertExpectations(t)
+}
+
+func TestService_CloneRun_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.CloneRunRequest
+		service func() *Service
+	}{
+		{
+			name:  "EmptyExperimentID",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'"),
+			request: &request.CloneRunRequest{
+				RunID: "1",
+			},
+			service: func() *Service {
+				return NewService(
+					&config.ServiceConfig{},
+					&repositories.MockTagRepositoryProvider{},
+					&repositories.MockRunRepositoryProvider{},
+					&repositories.MockParamRepositoryProvider{},
+					&repositories.MockMetricRepositoryProvider{},
+					&repositories.MockExperimentRepositoryProvider{},
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "ExperimentNotFound",
+			error: api.NewResourceDoesNotExistError("unable to find experiment with id '2': database error"),
+			request: &request.CloneRunRequest{
+				RunID:        "1",
+				ExperimentID: "2",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID", context.TODO(), uint(1), "1",
+				).Return(&models.Run{ID: "1", ExperimentID: 1}, nil)
+				experimentRepository := repositories.MockExperimentRepositoryProvider{}
+				experimentRepository.On(
+					"GetByNamespaceIDAndExperimentID", context.TODO(), uint(1), int32(2),
+				).Return(nil, errors.New("database error"))
+				return NewService(
+					&config.ServiceConfig{},
+					&repositories.MockTagRepositoryProvider{},
+					&runRepository,
+					&repositories.MockParamRepositoryProvider{},
+					&repositories.MockMetricRepositoryProvider{},
+					&experimentRepository,
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
+				)
+			},
+		},
+		{
+			name:  "CloneRunDatabaseError",
+			error: api.NewInternalError("unable to clone run '1': database error"),
+			request: &request.CloneRunRequest{
+				RunID:        "1",
+				ExperimentID: "2",
+			},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDAndRunID", context.TODO(), uint(1), "1",
+				).Return(&models.Run{ID: "1", ExperimentID: 1}, nil)
+				runRepository.On(
+					"Clone", context.TODO(), mock.AnythingOfType("*models.Run"), mock.AnythingOfType("*models.Run"),
+				).Return(errors.New("database error"))
+				experimentRepository := repositories.MockExperimentRepositoryProvider{}
+				experimentRepository.On(
+					"GetByNamespaceIDAndExperimentID", context.TODO(), uint(1), int32(2),
+				).Return(&models.Experiment{
+					ID:             common.GetPointer(int32(2)),
+					LifecycleStage: models.LifecycleStageActive,
+				}, nil)
+				return NewService(
+					&config.ServiceConfig{},
+					&repositories.MockTagRepositoryProvider{},
+					&runRepository,
+					&repositories.MockParamRepositoryProvider{},
+					&repositories.MockMetricRepositoryProvider{},
+					&experimentRepository,
+					&repositories.MockNamespaceRepositoryProvider{},
+					&webhook.MockDispatcherProvider{},
+					&alert.MockEvaluatorProvider{},
+					&storage.MockArtifactStorageFactoryProvider{},
+				)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			// call service under testing.
+			_, err := tt.service().CloneRun(context.TODO(), &models.Namespace{ID: 1, Code: "default"}, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
@@ -27,6 +27,14 @@ func ValidateUpdateRunRequest(req *request.UpdateRunRequest) error {
 	return nil
 }
 
+// ValidateRunHeartbeatRequest validates `POST /mlflow/runs/heartbeat` request.
+func ValidateRunHeartbeatRequest(req *request.RunHeartbeatRequest) error {
+	if req.RunID == "" && req.RunUUID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	return nil
+}
+
 // ValidateGetRunRequest validates `GET /mlflow/runs/get` request.
 func ValidateGetRunRequest(req *request.GetRunRequest) error {
 	if req.RunID == "" && req.RunUUID == "" {
@@ -133,3 +141,25 @@ func ValidateSearchRunsRequest(req *request.SearchRunsRequest) error {
 	}
 	return nil
 }
+
+// ValidateMoveRunRequest validates `POST /mlflow/runs/move` request.
+func ValidateMoveRunRequest(req *request.MoveRunRequest) error {
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	if req.ExperimentID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'")
+	}
+	return nil
+}
+
+// ValidateCloneRunRequest validates `POST /mlflow/runs/clone` request.
+func ValidateCloneRunRequest(req *request.CloneRunRequest) error {
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	if req.ExperimentID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'")
+	}
+	return nil
+}
@@ -405,3 +405,75 @@ func TestValidateSearchRunsRequest_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMoveRunRequest_Ok(t *testing.T) {
+	err := ValidateMoveRunRequest(&request.MoveRunRequest{
+		RunID:        "id",
+		ExperimentID: "1",
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateMoveRunRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.MoveRunRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.MoveRunRequest{},
+		},
+		{
+			name:  "EmptyExperimentID",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'"),
+			request: &request.MoveRunRequest{
+				RunID: "id",
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMoveRunRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateCloneRunRequest_Ok(t *testing.T) {
+	err := ValidateCloneRunRequest(&request.CloneRunRequest{
+		RunID:        "id",
+		ExperimentID: "1",
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateCloneRunRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.CloneRunRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.CloneRunRequest{},
+		},
+		{
+			name:  "EmptyExperimentID",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'"),
+			request: &request.CloneRunRequest{
+				RunID: "id",
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCloneRunRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
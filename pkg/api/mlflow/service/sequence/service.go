@@ -0,0 +1,116 @@
+package sequence
+
+import (
+	"context"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+// Service provides service layer to work with the sequences, which are shown by Aim UI
+// next to the metrics: images, texts and so on.
+type Service struct {
+	runRepository          repositories.RunRepositoryProvider
+	imageRepository        repositories.ImageRepositoryProvider
+	textRepository         repositories.TextRepositoryProvider
+	distributionRepository repositories.DistributionRepositoryProvider
+}
+
+// NewService creates new Service instance.
+func NewService(
+	runRepository repositories.RunRepositoryProvider,
+	imageRepository repositories.ImageRepositoryProvider,
+	textRepository repositories.TextRepositoryProvider,
+	distributionRepository repositories.DistributionRepositoryProvider,
+) *Service {
+	return &Service{
+		runRepository:          runRepository,
+		imageRepository:        imageRepository,
+		textRepository:         textRepository,
+		distributionRepository: distributionRepository,
+	}
+}
+
+// LogImages handles logging of the images of models.Run entity.
+func (s Service) LogImages(ctx context.Context, namespace *models.Namespace, req *request.LogImagesRequest) error {
+	if err := ValidateLogImagesRequest(req); err != nil {
+		return err
+	}
+
+	run, err := s.getActiveRun(ctx, namespace, req.RunID)
+	if err != nil {
+		return err
+	}
+
+	images, err := convertors.ConvertLogImagesRequestToDBModel(run.ID, req)
+	if err != nil {
+		return api.NewInvalidParameterValueError(err.Error())
+	}
+	if err := s.imageRepository.CreateBatch(ctx, MaxImagesPerLogImagesBatch, images); err != nil {
+		return api.NewInternalError("unable to log images for run '%s': %s", run.ID, err)
+	}
+	return nil
+}
+
+// LogTexts handles logging of the texts of models.Run entity.
+func (s Service) LogTexts(ctx context.Context, namespace *models.Namespace, req *request.LogTextsRequest) error {
+	if err := ValidateLogTextsRequest(req); err != nil {
+		return err
+	}
+
+	run, err := s.getActiveRun(ctx, namespace, req.RunID)
+	if err != nil {
+		return err
+	}
+
+	texts, err := convertors.ConvertLogTextsRequestToDBModel(run.ID, req)
+	if err != nil {
+		return api.NewInvalidParameterValueError(err.Error())
+	}
+	if err := s.textRepository.CreateBatch(ctx, MaxTextsPerLogTextsBatch, texts); err != nil {
+		return api.NewInternalError("unable to log texts for run '%s': %s", run.ID, err)
+	}
+	return nil
+}
+
+// LogDistributions handles logging of the distributions of models.Run entity.
+func (s Service) LogDistributions(
+	ctx context.Context, namespace *models.Namespace, req *request.LogDistributionsRequest,
+) error {
+	if err := ValidateLogDistributionsRequest(req); err != nil {
+		return err
+	}
+
+	run, err := s.getActiveRun(ctx, namespace, req.RunID)
+	if err != nil {
+		return err
+	}
+
+	distributions, err := convertors.ConvertLogDistributionsRequestToDBModel(run.ID, req)
+	if err != nil {
+		return api.NewInvalidParameterValueError(err.Error())
+	}
+	if err := s.distributionRepository.CreateBatch(
+		ctx, MaxDistributionsPerLogDistributionsBatch, distributions,
+	); err != nil {
+		return api.NewInternalError("unable to log distributions for run '%s': %s", run.ID, err)
+	}
+	return nil
+}
+
+// getActiveRun returns the active run of the namespace or ResourceDoesNotExist error, when there is no such run.
+func (s Service) getActiveRun(ctx context.Context, namespace *models.Namespace, runID string) (*models.Run, error) {
+	run, err := s.runRepository.GetByNamespaceIDRunIDAndLifecycleStage(
+		ctx, namespace.ID, runID, models.LifecycleStageActive,
+	)
+	if err != nil {
+		return nil, api.NewInternalError("Unable to find run '%s': %s", runID, err)
+	}
+	if run == nil {
+		return nil, api.NewResourceDoesNotExistError("Run '%s' not found", runID)
+	}
+	return run, nil
+}
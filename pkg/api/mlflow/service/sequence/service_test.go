@@ -0,0 +1,358 @@
+package sequence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rotisserie/eris"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+func TestService_LogImages_Ok(t *testing.T) {
+	// init repository mocks.
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDRunIDAndLifecycleStage", context.TODO(), uint(1), "1", models.LifecycleStageActive,
+	).Return(&models.Run{ID: "1"}, nil)
+
+	imageRepository := repositories.MockImageRepositoryProvider{}
+	imageRepository.On(
+		"CreateBatch", context.TODO(), MaxImagesPerLogImagesBatch, mock.MatchedBy(func(images []models.Image) bool {
+			return len(images) == 1 &&
+				images[0].RunID == "1" &&
+				images[0].Name == "name" &&
+				string(images[0].Context.Json) == `{"subset":"train"}` &&
+				images[0].Step == 1 &&
+				images[0].Index == 2 &&
+				images[0].BlobURI == "images/name/0.png"
+		}),
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(
+		&runRepository,
+		&imageRepository,
+		&repositories.MockTextRepositoryProvider{},
+		&repositories.MockDistributionRepositoryProvider{},
+	)
+	err := service.LogImages(context.TODO(), &models.Namespace{ID: 1}, &request.LogImagesRequest{
+		RunID: "1",
+		Images: []request.ImagePartialRequest{{
+			Name:      "name",
+			Context:   map[string]any{"subset": "train"},
+			Step:      1,
+			Index:     2,
+			Timestamp: 1234567890,
+			Format:    "png",
+			Width:     32,
+			Height:    32,
+			BlobURI:   "images/name/0.png",
+		}},
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	imageRepository.AssertExpectations(t)
+}
+
+func TestService_LogImages_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.LogImagesRequest
+		service func() *Service
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.LogImagesRequest{},
+			service: func() *Service {
+				return NewService(
+					&repositories.MockRunRepositoryProvider{},
+					&repositories.MockImageRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+				)
+			},
+		},
+		{
+			name:    "RunNotFound",
+			error:   api.NewResourceDoesNotExistError("Run '1' not found"),
+			request: &request.LogImagesRequest{RunID: "1"},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDRunIDAndLifecycleStage", context.TODO(), uint(1), "1", models.LifecycleStageActive,
+				).Return(nil, nil)
+				return NewService(
+					&runRepository,
+					&repositories.MockImageRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+				)
+			},
+		},
+		{
+			name:    "DatabaseError",
+			error:   api.NewInternalError("unable to log images for run '1': database error"),
+			request: &request.LogImagesRequest{RunID: "1"},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDRunIDAndLifecycleStage", context.TODO(), uint(1), "1", models.LifecycleStageActive,
+				).Return(&models.Run{ID: "1"}, nil)
+				imageRepository := repositories.MockImageRepositoryProvider{}
+				imageRepository.On(
+					"CreateBatch", context.TODO(), MaxImagesPerLogImagesBatch, []models.Image{},
+				).Return(eris.New("database error"))
+				return NewService(
+					&runRepository,
+					&imageRepository,
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+				)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.service().LogImages(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestService_LogTexts_Ok(t *testing.T) {
+	// init repository mocks.
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDRunIDAndLifecycleStage", context.TODO(), uint(1), "1", models.LifecycleStageActive,
+	).Return(&models.Run{ID: "1"}, nil)
+
+	textRepository := repositories.MockTextRepositoryProvider{}
+	textRepository.On(
+		"CreateBatch", context.TODO(), MaxTextsPerLogTextsBatch, mock.MatchedBy(func(texts []models.Text) bool {
+			return len(texts) == 1 &&
+				texts[0].RunID == "1" &&
+				texts[0].Name == "name" &&
+				string(texts[0].Context.Json) == `{"subset":"train"}` &&
+				texts[0].Step == 1 &&
+				texts[0].Index == 2 &&
+				texts[0].Data == "sample"
+		}),
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(
+		&runRepository,
+		&repositories.MockImageRepositoryProvider{},
+		&textRepository,
+		&repositories.MockDistributionRepositoryProvider{},
+	)
+	err := service.LogTexts(context.TODO(), &models.Namespace{ID: 1}, &request.LogTextsRequest{
+		RunID: "1",
+		Texts: []request.TextPartialRequest{{
+			Name:      "name",
+			Context:   map[string]any{"subset": "train"},
+			Step:      1,
+			Index:     2,
+			Timestamp: 1234567890,
+			Data:      "sample",
+		}},
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	textRepository.AssertExpectations(t)
+}
+
+func TestService_LogTexts_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.LogTextsRequest
+		service func() *Service
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.LogTextsRequest{},
+			service: func() *Service {
+				return NewService(
+					&repositories.MockRunRepositoryProvider{},
+					&repositories.MockImageRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+				)
+			},
+		},
+		{
+			name:    "RunNotFound",
+			error:   api.NewResourceDoesNotExistError("Run '1' not found"),
+			request: &request.LogTextsRequest{RunID: "1"},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDRunIDAndLifecycleStage", context.TODO(), uint(1), "1", models.LifecycleStageActive,
+				).Return(nil, nil)
+				return NewService(
+					&runRepository,
+					&repositories.MockImageRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+				)
+			},
+		},
+		{
+			name:    "DatabaseError",
+			error:   api.NewInternalError("unable to log texts for run '1': database error"),
+			request: &request.LogTextsRequest{RunID: "1"},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDRunIDAndLifecycleStage", context.TODO(), uint(1), "1", models.LifecycleStageActive,
+				).Return(&models.Run{ID: "1"}, nil)
+				textRepository := repositories.MockTextRepositoryProvider{}
+				textRepository.On(
+					"CreateBatch", context.TODO(), MaxTextsPerLogTextsBatch, []models.Text{},
+				).Return(eris.New("database error"))
+				return NewService(
+					&runRepository,
+					&repositories.MockImageRepositoryProvider{},
+					&textRepository,
+					&repositories.MockDistributionRepositoryProvider{},
+				)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.service().LogTexts(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestService_LogDistributions_Ok(t *testing.T) {
+	// init repository mocks.
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetByNamespaceIDRunIDAndLifecycleStage", context.TODO(), uint(1), "1", models.LifecycleStageActive,
+	).Return(&models.Run{ID: "1"}, nil)
+
+	distributionRepository := repositories.MockDistributionRepositoryProvider{}
+	distributionRepository.On(
+		"CreateBatch",
+		context.TODO(),
+		MaxDistributionsPerLogDistributionsBatch,
+		mock.MatchedBy(func(distributions []models.Distribution) bool {
+			return len(distributions) == 1 &&
+				distributions[0].RunID == "1" &&
+				distributions[0].Name == "weights" &&
+				string(distributions[0].Context.Json) == `{"layer":"fc1"}` &&
+				distributions[0].Step == 1 &&
+				len(distributions[0].BinEdges) == 3*8 &&
+				len(distributions[0].Counts) == 2*8
+		}),
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(
+		&runRepository,
+		&repositories.MockImageRepositoryProvider{},
+		&repositories.MockTextRepositoryProvider{},
+		&distributionRepository,
+	)
+	err := service.LogDistributions(context.TODO(), &models.Namespace{ID: 1}, &request.LogDistributionsRequest{
+		RunID: "1",
+		Distributions: []request.DistributionPartialRequest{{
+			Name:      "weights",
+			Context:   map[string]any{"layer": "fc1"},
+			Step:      1,
+			Timestamp: 1234567890,
+			BinEdges:  []float64{-1, 0, 1},
+			Counts:    []float64{3, 5},
+		}},
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	distributionRepository.AssertExpectations(t)
+}
+
+func TestService_LogDistributions_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.LogDistributionsRequest
+		service func() *Service
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.LogDistributionsRequest{},
+			service: func() *Service {
+				return NewService(
+					&repositories.MockRunRepositoryProvider{},
+					&repositories.MockImageRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+				)
+			},
+		},
+		{
+			name:    "RunNotFound",
+			error:   api.NewResourceDoesNotExistError("Run '1' not found"),
+			request: &request.LogDistributionsRequest{RunID: "1"},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDRunIDAndLifecycleStage", context.TODO(), uint(1), "1", models.LifecycleStageActive,
+				).Return(nil, nil)
+				return NewService(
+					&runRepository,
+					&repositories.MockImageRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&repositories.MockDistributionRepositoryProvider{},
+				)
+			},
+		},
+		{
+			name:    "DatabaseError",
+			error:   api.NewInternalError("unable to log distributions for run '1': database error"),
+			request: &request.LogDistributionsRequest{RunID: "1"},
+			service: func() *Service {
+				runRepository := repositories.MockRunRepositoryProvider{}
+				runRepository.On(
+					"GetByNamespaceIDRunIDAndLifecycleStage", context.TODO(), uint(1), "1", models.LifecycleStageActive,
+				).Return(&models.Run{ID: "1"}, nil)
+				distributionRepository := repositories.MockDistributionRepositoryProvider{}
+				distributionRepository.On(
+					"CreateBatch", context.TODO(), MaxDistributionsPerLogDistributionsBatch, []models.Distribution{},
+				).Return(eris.New("database error"))
+				return NewService(
+					&runRepository,
+					&repositories.MockImageRepositoryProvider{},
+					&repositories.MockTextRepositoryProvider{},
+					&distributionRepository,
+				)
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.service().LogDistributions(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package sequence
+
+import (
+	"math"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+)
+
+// Limits of the logged sequences.
+const (
+	MaxSequenceNameLength      = 250
+	MaxImageCaptionLength      = 1000
+	MaxImageFormatLength       = 20
+	MaxImageBlobURILength      = 1000
+	MaxImagesPerLogImagesBatch = 1000
+	MaxTextsPerLogTextsBatch   = 1000
+	// MaxDistributionBinCount is the same limit of histogram bins, which is used by Aim.
+	MaxDistributionBinCount                  = 512
+	MaxDistributionsPerLogDistributionsBatch = 1000
+)
+
+// ValidateLogImagesRequest validates `POST /mlflow/runs/log-images` request.
+func ValidateLogImagesRequest(req *request.LogImagesRequest) error {
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	if len(req.Images) > MaxImagesPerLogImagesBatch {
+		return api.NewInvalidParameterValueError(
+			"A batch logging request can contain at most %d images. Got %d images.",
+			MaxImagesPerLogImagesBatch, len(req.Images),
+		)
+	}
+
+	for _, image := range req.Images {
+		if err := validateSequenceName(image.Name); err != nil {
+			return err
+		}
+		if image.Timestamp == 0 {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'timestamp'")
+		}
+		if image.Step < 0 || image.Index < 0 {
+			return api.NewInvalidParameterValueError(
+				"Step and index of image '%s' must be non-negative", image.Name,
+			)
+		}
+		if image.Width <= 0 || image.Height <= 0 {
+			return api.NewInvalidParameterValueError("Size of image '%s' must be positive", image.Name)
+		}
+		if image.Format == "" {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'format'")
+		}
+		if len(image.Format) > MaxImageFormatLength {
+			return api.NewInvalidParameterValueError(
+				"Format of image '%s' exceeds the maximum length of %d", image.Name, MaxImageFormatLength,
+			)
+		}
+		if len(image.Caption) > MaxImageCaptionLength {
+			return api.NewInvalidParameterValueError(
+				"Caption of image '%s' exceeds the maximum length of %d", image.Name, MaxImageCaptionLength,
+			)
+		}
+		if err := validateBlobURI(image.BlobURI); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateLogTextsRequest validates `POST /mlflow/runs/log-texts` request.
+func ValidateLogTextsRequest(req *request.LogTextsRequest) error {
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	if len(req.Texts) > MaxTextsPerLogTextsBatch {
+		return api.NewInvalidParameterValueError(
+			"A batch logging request can contain at most %d texts. Got %d texts.",
+			MaxTextsPerLogTextsBatch, len(req.Texts),
+		)
+	}
+
+	for _, text := range req.Texts {
+		if err := validateSequenceName(text.Name); err != nil {
+			return err
+		}
+		if text.Timestamp == 0 {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'timestamp'")
+		}
+		if text.Step < 0 || text.Index < 0 {
+			return api.NewInvalidParameterValueError(
+				"Step and index of text '%s' must be non-negative", text.Name,
+			)
+		}
+	}
+	return nil
+}
+
+// ValidateLogDistributionsRequest validates `POST /mlflow/runs/log-distributions` request.
+func ValidateLogDistributionsRequest(req *request.LogDistributionsRequest) error {
+	if req.RunID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'")
+	}
+	if len(req.Distributions) > MaxDistributionsPerLogDistributionsBatch {
+		return api.NewInvalidParameterValueError(
+			"A batch logging request can contain at most %d distributions. Got %d distributions.",
+			MaxDistributionsPerLogDistributionsBatch, len(req.Distributions),
+		)
+	}
+
+	for _, distribution := range req.Distributions {
+		if err := validateSequenceName(distribution.Name); err != nil {
+			return err
+		}
+		if distribution.Timestamp == 0 {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'timestamp'")
+		}
+		if distribution.Step < 0 {
+			return api.NewInvalidParameterValueError(
+				"Step of distribution '%s' must be non-negative", distribution.Name,
+			)
+		}
+		if len(distribution.Counts) == 0 {
+			return api.NewInvalidParameterValueError("Missing value for required parameter 'counts'")
+		}
+		if len(distribution.Counts) > MaxDistributionBinCount {
+			return api.NewInvalidParameterValueError(
+				"Distribution '%s' exceeds the maximum bin count of %d", distribution.Name, MaxDistributionBinCount,
+			)
+		}
+		if len(distribution.BinEdges) != len(distribution.Counts)+1 {
+			return api.NewInvalidParameterValueError(
+				"Distribution '%s' must have exactly one bin edge more than counts", distribution.Name,
+			)
+		}
+		for i, edge := range distribution.BinEdges {
+			if math.IsNaN(edge) || math.IsInf(edge, 0) || (i > 0 && edge <= distribution.BinEdges[i-1]) {
+				return api.NewInvalidParameterValueError(
+					"Bin edges of distribution '%s' must be finite and strictly increasing", distribution.Name,
+				)
+			}
+		}
+		for _, count := range distribution.Counts {
+			if math.IsNaN(count) || math.IsInf(count, 0) || count < 0 {
+				return api.NewInvalidParameterValueError(
+					"Counts of distribution '%s' must be finite and non-negative", distribution.Name,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// validateSequenceName validates name of the sequence.
+func validateSequenceName(name string) error {
+	if name == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'name'")
+	}
+	if len(name) > MaxSequenceNameLength {
+		return api.NewInvalidParameterValueError(
+			"Sequence name '%s' exceeds the maximum length of %d", name, MaxSequenceNameLength,
+		)
+	}
+	return nil
+}
+
+// validateBlobURI validates that blob uri is a path inside the run artifact root.
+func validateBlobURI(blobURI string) error {
+	if blobURI == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'blob_uri'")
+	}
+	if len(blobURI) > MaxImageBlobURILength {
+		return api.NewInvalidParameterValueError(
+			"Blob uri '%s' exceeds the maximum length of %d", blobURI, MaxImageBlobURILength,
+		)
+	}
+	parsedURL, err := url.Parse(blobURI)
+	if err != nil ||
+		parsedURL.Scheme != "" ||
+		parsedURL.Host != "" ||
+		parsedURL.RawQuery != "" ||
+		parsedURL.RawFragment != "" ||
+		filepath.IsAbs(parsedURL.Path) ||
+		slices.Contains(strings.Split(parsedURL.Path, "/"), "..") {
+		return api.NewInvalidParameterValueError("Invalid value for parameter 'blob_uri' supplied: '%s'", blobURI)
+	}
+	return nil
+}
@@ -0,0 +1,358 @@
+package sequence
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+)
+
+func TestValidateLogImagesRequest_Ok(t *testing.T) {
+	err := ValidateLogImagesRequest(&request.LogImagesRequest{
+		RunID: "id",
+		Images: []request.ImagePartialRequest{
+			{
+				Name:      "name",
+				Context:   map[string]any{"subset": "train"},
+				Timestamp: 1234567890,
+				Format:    "png",
+				Width:     32,
+				Height:    32,
+				BlobURI:   "images/name/0.png",
+			},
+		},
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateLogImagesRequest_Error(t *testing.T) {
+	image := request.ImagePartialRequest{
+		Name:      "name",
+		Timestamp: 1234567890,
+		Format:    "png",
+		Width:     32,
+		Height:    32,
+		BlobURI:   "images/name/0.png",
+	}
+	withImage := func(update func(image *request.ImagePartialRequest)) *request.LogImagesRequest {
+		image := image
+		update(&image)
+		return &request.LogImagesRequest{RunID: "id", Images: []request.ImagePartialRequest{image}}
+	}
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.LogImagesRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.LogImagesRequest{},
+		},
+		{
+			name: "TooManyImages",
+			error: api.NewInvalidParameterValueError(
+				"A batch logging request can contain at most 1000 images. Got 1001 images.",
+			),
+			request: &request.LogImagesRequest{
+				RunID:  "id",
+				Images: make([]request.ImagePartialRequest, MaxImagesPerLogImagesBatch+1),
+			},
+		},
+		{
+			name:  "EmptyName",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: withImage(func(image *request.ImagePartialRequest) {
+				image.Name = ""
+			}),
+		},
+		{
+			name: "TooLongName",
+			error: api.NewInvalidParameterValueError(
+				"Sequence name '%s' exceeds the maximum length of 250", strings.Repeat("a", 251),
+			),
+			request: withImage(func(image *request.ImagePartialRequest) {
+				image.Name = strings.Repeat("a", 251)
+			}),
+		},
+		{
+			name:  "EmptyTimestamp",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'timestamp'"),
+			request: withImage(func(image *request.ImagePartialRequest) {
+				image.Timestamp = 0
+			}),
+		},
+		{
+			name:  "NegativeStep",
+			error: api.NewInvalidParameterValueError("Step and index of image 'name' must be non-negative"),
+			request: withImage(func(image *request.ImagePartialRequest) {
+				image.Step = -1
+			}),
+		},
+		{
+			name:  "EmptySize",
+			error: api.NewInvalidParameterValueError("Size of image 'name' must be positive"),
+			request: withImage(func(image *request.ImagePartialRequest) {
+				image.Width = 0
+			}),
+		},
+		{
+			name:  "EmptyFormat",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'format'"),
+			request: withImage(func(image *request.ImagePartialRequest) {
+				image.Format = ""
+			}),
+		},
+		{
+			name:  "TooLongCaption",
+			error: api.NewInvalidParameterValueError("Caption of image 'name' exceeds the maximum length of 1000"),
+			request: withImage(func(image *request.ImagePartialRequest) {
+				image.Caption = strings.Repeat("a", 1001)
+			}),
+		},
+		{
+			name:  "EmptyBlobURI",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'blob_uri'"),
+			request: withImage(func(image *request.ImagePartialRequest) {
+				image.BlobURI = ""
+			}),
+		},
+		{
+			name:  "AbsoluteBlobURI",
+			error: api.NewInvalidParameterValueError("Invalid value for parameter 'blob_uri' supplied: '/etc/passwd'"),
+			request: withImage(func(image *request.ImagePartialRequest) {
+				image.BlobURI = "/etc/passwd"
+			}),
+		},
+		{
+			name: "BlobURIOutsideOfArtifactRoot",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'blob_uri' supplied: 'images/../../secret.png'",
+			),
+			request: withImage(func(image *request.ImagePartialRequest) {
+				image.BlobURI = "images/../../secret.png"
+			}),
+		},
+		{
+			name: "BlobURIWithScheme",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'blob_uri' supplied: 's3://bucket/image.png'",
+			),
+			request: withImage(func(image *request.ImagePartialRequest) {
+				image.BlobURI = "s3://bucket/image.png"
+			}),
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLogImagesRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateLogTextsRequest_Ok(t *testing.T) {
+	err := ValidateLogTextsRequest(&request.LogTextsRequest{
+		RunID: "id",
+		Texts: []request.TextPartialRequest{
+			{
+				Name:      "name",
+				Context:   map[string]any{"subset": "train"},
+				Timestamp: 1234567890,
+				Data:      "sample",
+			},
+		},
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateLogTextsRequest_Error(t *testing.T) {
+	text := request.TextPartialRequest{
+		Name:      "name",
+		Timestamp: 1234567890,
+		Data:      "sample",
+	}
+	withText := func(update func(text *request.TextPartialRequest)) *request.LogTextsRequest {
+		text := text
+		update(&text)
+		return &request.LogTextsRequest{RunID: "id", Texts: []request.TextPartialRequest{text}}
+	}
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.LogTextsRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.LogTextsRequest{},
+		},
+		{
+			name: "TooManyTexts",
+			error: api.NewInvalidParameterValueError(
+				"A batch logging request can contain at most 1000 texts. Got 1001 texts.",
+			),
+			request: &request.LogTextsRequest{
+				RunID: "id",
+				Texts: make([]request.TextPartialRequest, MaxTextsPerLogTextsBatch+1),
+			},
+		},
+		{
+			name:  "EmptyName",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: withText(func(text *request.TextPartialRequest) {
+				text.Name = ""
+			}),
+		},
+		{
+			name:  "EmptyTimestamp",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'timestamp'"),
+			request: withText(func(text *request.TextPartialRequest) {
+				text.Timestamp = 0
+			}),
+		},
+		{
+			name:  "NegativeIndex",
+			error: api.NewInvalidParameterValueError("Step and index of text 'name' must be non-negative"),
+			request: withText(func(text *request.TextPartialRequest) {
+				text.Index = -1
+			}),
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLogTextsRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateLogDistributionsRequest_Ok(t *testing.T) {
+	err := ValidateLogDistributionsRequest(&request.LogDistributionsRequest{
+		RunID: "id",
+		Distributions: []request.DistributionPartialRequest{
+			{
+				Name:      "weights",
+				Context:   map[string]any{"layer": "fc1"},
+				Timestamp: 1234567890,
+				BinEdges:  []float64{-1, 0, 0.5, 1},
+				Counts:    []float64{1, 0, 3},
+			},
+		},
+	})
+	require.Nil(t, err)
+}
+
+func TestValidateLogDistributionsRequest_Error(t *testing.T) {
+	distribution := request.DistributionPartialRequest{
+		Name:      "weights",
+		Timestamp: 1234567890,
+		BinEdges:  []float64{-1, 0, 1},
+		Counts:    []float64{3, 5},
+	}
+	withDistribution := func(
+		update func(distribution *request.DistributionPartialRequest),
+	) *request.LogDistributionsRequest {
+		distribution := distribution
+		update(&distribution)
+		return &request.LogDistributionsRequest{
+			RunID: "id", Distributions: []request.DistributionPartialRequest{distribution},
+		}
+	}
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.LogDistributionsRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: &request.LogDistributionsRequest{},
+		},
+		{
+			name: "TooManyDistributions",
+			error: api.NewInvalidParameterValueError(
+				"A batch logging request can contain at most 1000 distributions. Got 1001 distributions.",
+			),
+			request: &request.LogDistributionsRequest{
+				RunID:         "id",
+				Distributions: make([]request.DistributionPartialRequest, MaxDistributionsPerLogDistributionsBatch+1),
+			},
+		},
+		{
+			name:  "EmptyName",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: withDistribution(func(distribution *request.DistributionPartialRequest) {
+				distribution.Name = ""
+			}),
+		},
+		{
+			name:  "EmptyTimestamp",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'timestamp'"),
+			request: withDistribution(func(distribution *request.DistributionPartialRequest) {
+				distribution.Timestamp = 0
+			}),
+		},
+		{
+			name:  "NegativeStep",
+			error: api.NewInvalidParameterValueError("Step of distribution 'weights' must be non-negative"),
+			request: withDistribution(func(distribution *request.DistributionPartialRequest) {
+				distribution.Step = -1
+			}),
+		},
+		{
+			name:  "EmptyCounts",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'counts'"),
+			request: withDistribution(func(distribution *request.DistributionPartialRequest) {
+				distribution.Counts = nil
+			}),
+		},
+		{
+			name:  "TooManyBins",
+			error: api.NewInvalidParameterValueError("Distribution 'weights' exceeds the maximum bin count of 512"),
+			request: withDistribution(func(distribution *request.DistributionPartialRequest) {
+				distribution.Counts = make([]float64, MaxDistributionBinCount+1)
+			}),
+		},
+		{
+			name: "BinEdgesMismatch",
+			error: api.NewInvalidParameterValueError(
+				"Distribution 'weights' must have exactly one bin edge more than counts",
+			),
+			request: withDistribution(func(distribution *request.DistributionPartialRequest) {
+				distribution.BinEdges = []float64{-1, 1}
+			}),
+		},
+		{
+			name: "NotIncreasingBinEdges",
+			error: api.NewInvalidParameterValueError(
+				"Bin edges of distribution 'weights' must be finite and strictly increasing",
+			),
+			request: withDistribution(func(distribution *request.DistributionPartialRequest) {
+				distribution.BinEdges = []float64{-1, 1, 1}
+			}),
+		},
+		{
+			name: "NegativeCount",
+			error: api.NewInvalidParameterValueError(
+				"Counts of distribution 'weights' must be finite and non-negative",
+			),
+			request: withDistribution(func(distribution *request.DistributionPartialRequest) {
+				distribution.Counts = []float64{3, -5}
+			}),
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLogDistributionsRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package sweeper
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/webhook"
+)
+
+// Service provides service layer to terminate stale runs, which haven't reported any activity
+// for longer than the configured timeout, e.g. because their process died.
+type Service struct {
+	config            *config.ServiceConfig
+	runRepository     repositories.RunRepositoryProvider
+	webhookDispatcher webhook.DispatcherProvider
+}
+
+// NewService creates new Service instance.
+func NewService(
+	config *config.ServiceConfig,
+	runRepository repositories.RunRepositoryProvider,
+	webhookDispatcher webhook.DispatcherProvider,
+) *Service {
+	return &Service{
+		config:            config,
+		runRepository:     runRepository,
+		webhookDispatcher: webhookDispatcher,
+	}
+}
+
+// Start periodically sweeps stale runs until the context is cancelled.
+func (s Service) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.config.StaleRunSweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.Sweep(ctx); err != nil {
+				log.Errorf("error sweeping stale runs: %+v", err)
+			}
+		}
+	}
+}
+
+// Sweep terminates running runs, which haven't been seen for longer than the configured timeout,
+// with the configured status and sets the tag explaining why. The end time of the run is its last seen time.
+// Terminated runs emit the same webhook events, as runs which have been terminated through the api.
+func (s Service) Sweep(ctx context.Context) ([]models.Run, error) {
+	lastSeenBefore := time.Now().Add(-s.config.StaleRunTimeout).UnixMilli()
+	runs, err := s.runRepository.GetStale(ctx, lastSeenBefore)
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting stale runs")
+	}
+
+	terminatedRuns := make([]models.Run, 0, len(runs))
+	for _, run := range runs {
+		lastSeenTime := run.LastSeenTime
+		if !lastSeenTime.Valid {
+			lastSeenTime = run.StartTime
+		}
+		run.Status = models.Status(s.config.StaleRunStatus)
+		run.EndTime = sql.NullInt64{Int64: lastSeenTime.Int64, Valid: true}
+		// run could report activity after it has been selected, so it is skipped in this case.
+		terminated, err := s.runRepository.TerminateStale(ctx, &run, lastSeenBefore, &models.Tag{
+			Key: convertors.TagKeyStaleReason,
+			Value: fmt.Sprintf(
+				"Run was marked as %s, because it hasn't reported any activity for longer than %s",
+				s.config.StaleRunStatus, s.config.StaleRunTimeout,
+			),
+			RunID: run.ID,
+		})
+		if err != nil {
+			return nil, eris.Wrapf(err, "error terminating stale run '%s'", run.ID)
+		}
+		if terminated {
+			log.Infof("Marked stale run %s as %s", run.ID, s.config.StaleRunStatus)
+			if event, ok := webhook.RunStatusEvents[run.Status]; ok {
+				s.webhookDispatcher.Dispatch(ctx, &run.Experiment.Namespace, event, webhook.NewRunData(&run))
+			}
+			terminatedRuns = append(terminatedRuns, run)
+		}
+	}
+	return terminatedRuns, nil
+}
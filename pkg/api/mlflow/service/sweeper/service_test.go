@@ -0,0 +1,107 @@
+package sweeper
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/webhook"
+)
+
+func TestService_Sweep_Ok(t *testing.T) {
+	experiment := models.Experiment{Namespace: models.Namespace{ID: 1, Code: "default"}}
+
+	// init repository mocks. `run2` reports activity after it has been selected, so it is not terminated.
+	runRepository := repositories.MockRunRepositoryProvider{}
+	runRepository.On(
+		"GetStale", context.TODO(), mock.AnythingOfType("int64"),
+	).Return([]models.Run{
+		{
+			ID:           "run1",
+			Status:       models.StatusRunning,
+			Experiment:   experiment,
+			StartTime:    sql.NullInt64{Int64: 1000, Valid: true},
+			LastSeenTime: sql.NullInt64{Int64: 2000, Valid: true},
+		},
+		{
+			ID:         "run2",
+			Status:     models.StatusRunning,
+			Experiment: experiment,
+			StartTime:  sql.NullInt64{Int64: 1000, Valid: true},
+		},
+		{
+			ID:         "run3",
+			Status:     models.StatusRunning,
+			Experiment: experiment,
+			StartTime:  sql.NullInt64{Int64: 1500, Valid: true},
+		},
+	}, nil)
+	runRepository.On(
+		"TerminateStale",
+		context.TODO(),
+		mock.MatchedBy(func(run *models.Run) bool { return run.ID == "run2" }),
+		mock.AnythingOfType("int64"),
+		mock.AnythingOfType("*models.Tag"),
+	).Return(false, nil)
+	runRepository.On(
+		"TerminateStale",
+		context.TODO(),
+		mock.AnythingOfType("*models.Run"),
+		mock.AnythingOfType("int64"),
+		mock.MatchedBy(func(tag *models.Tag) bool {
+			return tag.Key == convertors.TagKeyStaleReason && tag.Value ==
+				"Run was marked as KILLED, because it hasn't reported any activity for longer than 1h0m0s"
+		}),
+	).Return(true, nil)
+
+	// init dispatcher mock. only terminated runs emit the event.
+	webhookDispatcher := webhook.MockDispatcherProvider{}
+	webhookDispatcher.On(
+		"Dispatch",
+		context.TODO(),
+		&experiment.Namespace,
+		models.WebhookEventRunKilled,
+		mock.MatchedBy(func(data *webhook.RunData) bool {
+			return (data.RunID == "run1" || data.RunID == "run3") && data.Status == string(models.StatusKilled)
+		}),
+	).Return()
+
+	// call service under testing.
+	service := NewService(&config.ServiceConfig{
+		StaleRunTimeout:       time.Hour,
+		StaleRunStatus:        "KILLED",
+		StaleRunSweepInterval: time.Minute,
+	}, &runRepository, &webhookDispatcher)
+	runs, err := service.Sweep(context.TODO())
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, []models.Run{
+		{
+			ID:           "run1",
+			Status:       models.StatusKilled,
+			Experiment:   experiment,
+			StartTime:    sql.NullInt64{Int64: 1000, Valid: true},
+			EndTime:      sql.NullInt64{Int64: 2000, Valid: true},
+			LastSeenTime: sql.NullInt64{Int64: 2000, Valid: true},
+		},
+		{
+			ID:         "run3",
+			Status:     models.StatusKilled,
+			Experiment: experiment,
+			StartTime:  sql.NullInt64{Int64: 1500, Valid: true},
+			EndTime:    sql.NullInt64{Int64: 1500, Valid: true},
+		},
+	}, runs)
+	runRepository.AssertExpectations(t)
+	webhookDispatcher.AssertNumberOfCalls(t, "Dispatch", 2)
+}
@@ -0,0 +1,141 @@
+package trace
+
+import (
+	"strconv"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/filter"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// buildFilterCondition compiles the filter expression into the condition of `trace_info` query.
+// filterText is the original filter, which is referenced by the errors.
+func buildFilterCondition(filterText string, expression filter.Expression) (clause.Expression, error) {
+	switch expression := expression.(type) {
+	case *filter.LogicalExpression:
+		operands := make([]clause.Expression, len(expression.Operands))
+		for i, operand := range expression.Operands {
+			condition, err := buildFilterCondition(filterText, operand)
+			if err != nil {
+				return nil, err
+			}
+			operands[i] = condition
+		}
+		if expression.Operator == filter.OrOperator {
+			return clause.Or(operands...), nil
+		}
+		return clause.And(operands...), nil
+	case *filter.Comparison:
+		return buildComparisonCondition(filterText, expression)
+	default:
+		return nil, api.NewInternalError("unsupported filter expression %T", expression)
+	}
+}
+
+// buildComparisonCondition compiles single comparison of the filter into the condition of `trace_info` query.
+func buildComparisonCondition(filterText string, comparison *filter.Comparison) (clause.Expression, error) {
+	key, operator := comparison.Key, comparison.Operator
+	switch comparison.Entity {
+	case "", "attribute", "attributes", "attr":
+		switch key {
+		case "timestamp_ms", "timestamp", "execution_time_ms":
+			if key == "timestamp" {
+				key = "timestamp_ms"
+			}
+			switch operator {
+			case filter.IsNullOperator, filter.IsNotNullOperator:
+				return buildColumnCondition("trace_info."+key, operator, nil), nil
+			case filter.GreaterOperator, filter.GreaterOrEqualOperator, filter.NotEqualOperator,
+				filter.EqualOperator, filter.LessOperator, filter.LessOrEqualOperator:
+				value, err := strconv.ParseInt(comparison.Value.Raw, 10, 64)
+				if err != nil {
+					return nil, filter.NewSemanticError(
+						filterText, comparison.Value.Position(), "invalid numeric value '%s'", comparison.Value.Raw,
+					)
+				}
+				return buildColumnCondition("trace_info."+key, operator, value), nil
+			default:
+				return nil, filter.NewSemanticError(
+					filterText, comparison.Position(),
+					"invalid numeric attribute comparison operator '%s'", operator,
+				)
+			}
+		case "status", "request_id":
+			switch operator {
+			case filter.NotEqualOperator, filter.EqualOperator, filter.LikeOperator, filter.ILikeOperator:
+				return buildColumnCondition("trace_info."+key, operator, comparison.Value.Text), nil
+			case filter.InOperator, filter.NotInOperator:
+				return buildColumnCondition("trace_info."+key, operator, getValues(comparison)), nil
+			default:
+				return nil, filter.NewSemanticError(
+					filterText, comparison.Position(),
+					"invalid string attribute comparison operator '%s'", operator,
+				)
+			}
+		default:
+			return nil, filter.NewSemanticError(
+				filterText, comparison.Position(),
+				"invalid attribute '%s'. Valid values are ['request_id', 'timestamp_ms', 'execution_time_ms', 'status']",
+				key,
+			)
+		}
+	case "tag", "tags", "request_metadata":
+		switch operator {
+		case filter.NotEqualOperator, filter.EqualOperator, filter.LikeOperator, filter.ILikeOperator,
+			filter.InOperator, filter.NotInOperator, filter.IsNullOperator, filter.IsNotNullOperator:
+			return buildKeyValueCondition(comparison), nil
+		default:
+			return nil, filter.NewSemanticError(
+				filterText, comparison.Position(),
+				"invalid %s comparison operator '%s'", comparison.Entity, operator,
+			)
+		}
+	default:
+		return nil, filter.NewSemanticError(
+			filterText, comparison.Position(),
+			"invalid entity type '%s'. Valid values are ['tag', 'attribute', 'request_metadata']", comparison.Entity,
+		)
+	}
+}
+
+// buildColumnCondition builds condition, which compares the column of `trace_info` table with the value.
+func buildColumnCondition(column, operator string, value any) clause.Expression {
+	return filter.BuildCondition(database.DB.Dialector.Name(), column, operator, value)
+}
+
+// buildKeyValueCondition builds condition, which compares the value of the trace tag or request metadata.
+// `IS NULL` matches the traces, which don't have the key at all.
+func buildKeyValueCondition(comparison *filter.Comparison) clause.Expression {
+	var model any = &database.TraceTag{}
+	if comparison.Entity == "request_metadata" {
+		model = &database.TraceRequestMetadata{}
+	}
+	query := database.DB.Model(model).Select("request_id").Where("key = ?", comparison.Key)
+	switch comparison.Operator {
+	case filter.IsNullOperator:
+		return clause.Expr{SQL: "trace_info.request_id NOT IN (?)", Vars: []any{query}}
+	case filter.IsNotNullOperator:
+		return clause.Expr{SQL: "trace_info.request_id IN (?)", Vars: []any{query}}
+	}
+
+	var value any
+	if comparison.Value != nil {
+		value = comparison.Value.Text
+	} else {
+		value = getValues(comparison)
+	}
+	return clause.Expr{SQL: "trace_info.request_id IN (?)", Vars: []any{query.Where(
+		filter.BuildCondition(database.DB.Dialector.Name(), "value", comparison.Operator, value),
+	)}}
+}
+
+// getValues returns list of the values of `IN` and `NOT IN` comparisons.
+func getValues(comparison *filter.Comparison) []string {
+	values := make([]string, len(comparison.Values))
+	for i, v := range comparison.Values {
+		values[i] = v.Text
+	}
+	return values
+}
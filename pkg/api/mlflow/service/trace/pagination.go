@@ -0,0 +1,28 @@
+package trace
+
+import (
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// sortColumn represents column of the traces ordering.
+type sortColumn struct {
+	name string
+	desc bool
+}
+
+// getValue returns value of the column in the trace, which is stored into the keyset page token.
+func (c sortColumn) getValue(trace *models.TraceInfo) any {
+	switch c.name {
+	case "request_id":
+		return trace.RequestID
+	case "timestamp_ms":
+		return trace.TimestampMS
+	case "status":
+		return string(trace.Status)
+	case "execution_time_ms":
+		if trace.ExecutionTimeMS.Valid {
+			return trace.ExecutionTimeMS.Int64
+		}
+	}
+	return nil
+}
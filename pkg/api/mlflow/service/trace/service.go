@@ -0,0 +1,400 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/filter"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// TraceDataFileName is the name of the artifact, which holds span data of the trace.
+const TraceDataFileName = "traces.json"
+
+var traceOrder = regexp.MustCompile(`^(?:attr(?:ibutes?)?\.)?(\w+)(?i:\s+(ASC|DESC))?$`)
+
+// Service provides service layer to work with `trace` business logic.
+type Service struct {
+	traceRepository        repositories.TraceRepositoryProvider
+	experimentRepository   repositories.ExperimentRepositoryProvider
+	artifactStorageFactory storage.ArtifactStorageFactoryProvider
+}
+
+// NewService creates new Service instance.
+func NewService(
+	traceRepository repositories.TraceRepositoryProvider,
+	experimentRepository repositories.ExperimentRepositoryProvider,
+	artifactStorageFactory storage.ArtifactStorageFactoryProvider,
+) *Service {
+	return &Service{
+		traceRepository:        traceRepository,
+		experimentRepository:   experimentRepository,
+		artifactStorageFactory: artifactStorageFactory,
+	}
+}
+
+// StartTrace creates new models.TraceInfo entity in progress status.
+func (s Service) StartTrace(
+	ctx context.Context, namespace *models.Namespace, req *request.StartTraceRequest,
+) (*models.TraceInfo, error) {
+	if err := ValidateStartTraceRequest(req); err != nil {
+		return nil, err
+	}
+
+	experiment, err := s.getExperiment(ctx, namespace, req.ExperimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	requestID := "tr-" + database.NewUUID()
+	artifactLocation, err := url.JoinPath(experiment.ArtifactLocation, "traces", requestID, "artifacts")
+	if err != nil {
+		return nil, api.NewInternalError(
+			"error creating artifact location for trace '%s': %s", requestID, err,
+		)
+	}
+
+	trace := convertors.ConvertStartTraceRequestToDBModel(*experiment.ID, requestID, req)
+	trace.Tags = append(trace.Tags, models.TraceTag{
+		Key:       models.TraceTagArtifactLocation,
+		Value:     artifactLocation,
+		RequestID: requestID,
+	})
+	if err := s.traceRepository.Create(ctx, trace); err != nil {
+		return nil, api.NewInternalError("unable to create trace '%s': %s", requestID, err)
+	}
+	return trace, nil
+}
+
+// EndTrace finishes existing models.TraceInfo entity.
+func (s Service) EndTrace(
+	ctx context.Context, namespace *models.Namespace, req *request.EndTraceRequest,
+) (*models.TraceInfo, error) {
+	if err := ValidateEndTraceRequest(req); err != nil {
+		return nil, err
+	}
+
+	trace, err := s.getTrace(ctx, namespace, req.RequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	trace = convertors.ConvertEndTraceRequestToDBModel(trace, req)
+	if err := s.traceRepository.Update(ctx, trace); err != nil {
+		return nil, api.NewInternalError("unable to end trace '%s': %s", req.RequestID, err)
+	}
+	return trace, nil
+}
+
+// GetTraceInfo returns models.TraceInfo entity by request id.
+func (s Service) GetTraceInfo(
+	ctx context.Context, namespace *models.Namespace, req *request.GetTraceInfoRequest,
+) (*models.TraceInfo, error) {
+	if err := ValidateGetTraceInfoRequest(req); err != nil {
+		return nil, err
+	}
+	return s.getTrace(ctx, namespace, req.RequestID)
+}
+
+// SearchTraces returns models.TraceInfo entities of requested experiments, which match the filter.
+func (s Service) SearchTraces(
+	ctx context.Context, namespace *models.Namespace, req *request.SearchTracesRequest,
+) ([]models.TraceInfo, *request.PageToken, error) {
+	if err := ValidateSearchTracesRequest(req); err != nil {
+		return nil, nil, err
+	}
+
+	experimentIDs := make([]int32, len(req.ExperimentIDs))
+	for i, id := range req.ExperimentIDs {
+		experimentID, err := strconv.ParseInt(id, 10, 32)
+		if err != nil {
+			return nil, nil, api.NewBadRequestError("unable to parse experiment id '%s': %s", id, err)
+		}
+		experimentIDs[i] = int32(experimentID)
+	}
+
+	limit := req.MaxResults
+	if limit == 0 {
+		limit = DefaultMaxResultsForSearchTraces
+	}
+
+	// Filter
+	var conditions []clause.Expression
+	expression, err := filter.Parse(req.Filter)
+	if err != nil {
+		return nil, nil, api.NewInvalidParameterValueError(err.Error())
+	}
+	if expression != nil {
+		condition, err := buildFilterCondition(req.Filter, expression)
+		if err != nil {
+			return nil, nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	// OrderBy
+	sortColumns, err := parseOrderBy(req.OrderBy)
+	if err != nil {
+		return nil, nil, err
+	}
+	orderBy := make([]clause.OrderByColumn, len(sortColumns))
+	for i, column := range sortColumns {
+		orderBy[i] = clause.OrderByColumn{
+			Column: clause.Column{Table: "trace_info", Name: column.name},
+			Desc:   column.desc,
+		}
+	}
+
+	// the next page starts right after the last row of the previous one.
+	if req.PageToken != "" {
+		pageToken, err := request.DecodePageToken(req.PageToken)
+		if err != nil {
+			return nil, nil, api.NewInvalidParameterValueError("invalid page_token '%s': %s", req.PageToken, err)
+		}
+		if len(pageToken.Keys) != len(sortColumns) {
+			return nil, nil, api.NewInvalidParameterValueError(
+				"invalid page_token '%s': token doesn't match order_by clause", req.PageToken,
+			)
+		}
+		keys := make([]filter.SortKey, len(sortColumns))
+		for i, column := range sortColumns {
+			keys[i] = filter.SortKey{
+				Column: fmt.Sprintf("trace_info.%s", column.name), Desc: column.desc, Value: pageToken.Keys[i],
+			}
+		}
+		conditions = append(conditions, filter.BuildKeysetCondition(database.DB.Dialector.Name(), keys))
+	}
+
+	var condition clause.Expression
+	if len(conditions) > 0 {
+		condition = clause.And(conditions...)
+	}
+
+	// one more trace is requested to find out whether there is the next page.
+	traces, err := s.traceRepository.Search(ctx, namespace.ID, experimentIDs, condition, orderBy, limit+1)
+	if err != nil {
+		return nil, nil, api.NewInternalError("unable to search traces: %s", err)
+	}
+	if len(traces) <= limit {
+		return traces, nil, nil
+	}
+	traces = traces[:limit]
+	lastTrace := &traces[len(traces)-1]
+	nextPageToken := request.PageToken{Keys: make([]any, len(sortColumns))}
+	for i, column := range sortColumns {
+		nextPageToken.Keys[i] = column.getValue(lastTrace)
+	}
+	return traces, &nextPageToken, nil
+}
+
+// DeleteTraces deletes models.TraceInfo entities of the experiment together with their data artifacts.
+func (s Service) DeleteTraces(
+	ctx context.Context, namespace *models.Namespace, req *request.DeleteTracesRequest,
+) (int, error) {
+	if err := ValidateDeleteTracesRequest(req); err != nil {
+		return 0, err
+	}
+
+	experiment, err := s.getExperiment(ctx, namespace, req.ExperimentID)
+	if err != nil {
+		return 0, err
+	}
+
+	traces, err := s.traceRepository.GetForDeletion(
+		ctx, namespace.ID, *experiment.ID, req.RequestIDs, req.MaxTimestampMillis, req.MaxTraces,
+	)
+	if err != nil {
+		return 0, api.NewInternalError("unable to find traces for deletion: %s", err)
+	}
+
+	// traces, which data can't be deleted, stay in the database, so the deletion could be retried.
+	requestIDs := make([]string, 0, len(traces))
+	for _, trace := range traces {
+		if err := s.deleteTraceData(ctx, &trace); err != nil {
+			log.Errorf("error deleting data of trace '%s': %+v", trace.RequestID, err)
+			continue
+		}
+		requestIDs = append(requestIDs, trace.RequestID)
+	}
+	if err := s.traceRepository.DeleteBatch(ctx, requestIDs); err != nil {
+		return 0, api.NewInternalError("unable to delete traces: %s", err)
+	}
+	return len(requestIDs), nil
+}
+
+// SetTraceTag sets tag of existing models.TraceInfo entity.
+func (s Service) SetTraceTag(
+	ctx context.Context, namespace *models.Namespace, req *request.SetTraceTagRequest,
+) error {
+	if err := ValidateSetTraceTagRequest(req); err != nil {
+		return err
+	}
+
+	if _, err := s.getTrace(ctx, namespace, req.RequestID); err != nil {
+		return err
+	}
+
+	if err := s.traceRepository.SetTag(ctx, convertors.ConvertSetTraceTagRequestToDBModel(req)); err != nil {
+		return api.NewInternalError("unable to set tag '%s' of trace '%s': %s", req.Key, req.RequestID, err)
+	}
+	return nil
+}
+
+// DeleteTraceTag deletes tag of existing models.TraceInfo entity.
+func (s Service) DeleteTraceTag(
+	ctx context.Context, namespace *models.Namespace, req *request.DeleteTraceTagRequest,
+) error {
+	if err := ValidateDeleteTraceTagRequest(req); err != nil {
+		return err
+	}
+
+	trace, err := s.getTrace(ctx, namespace, req.RequestID)
+	if err != nil {
+		return err
+	}
+	if _, ok := trace.GetTag(req.Key); !ok {
+		return api.NewResourceDoesNotExistError(
+			"No trace tag with key '%s' for trace with request_id '%s'", req.Key, req.RequestID,
+		)
+	}
+
+	if err := s.traceRepository.DeleteTag(ctx, &models.TraceTag{
+		Key:       req.Key,
+		RequestID: req.RequestID,
+	}); err != nil {
+		return api.NewInternalError("unable to delete tag '%s' of trace '%s': %s", req.Key, req.RequestID, err)
+	}
+	return nil
+}
+
+// GetTraceArtifact returns content of the trace data artifact.
+func (s Service) GetTraceArtifact(
+	ctx context.Context, namespace *models.Namespace, req *request.GetTraceArtifactRequest,
+) (*artifact.Content, error) {
+	if err := ValidateGetTraceArtifactRequest(req); err != nil {
+		return nil, err
+	}
+
+	trace, err := s.getTrace(ctx, namespace, req.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	artifactLocation, ok := trace.GetTag(models.TraceTagArtifactLocation)
+	if !ok {
+		return nil, api.NewInternalError("trace '%s' doesn't have artifact location", req.RequestID)
+	}
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, artifactLocation)
+	if err != nil {
+		return nil, api.NewInternalError("trace '%s' has unsupported artifact storage", req.RequestID)
+	}
+
+	return artifact.NewContent(
+		ctx,
+		artifactStorage,
+		artifactLocation,
+		TraceDataFileName,
+		fmt.Sprintf("unable to find data of trace '%s'", req.RequestID),
+	)
+}
+
+// getExperiment returns models.Experiment entity by its string id.
+func (s Service) getExperiment(
+	ctx context.Context, namespace *models.Namespace, id string,
+) (*models.Experiment, error) {
+	experimentID, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		return nil, api.NewBadRequestError("unable to parse experiment id '%s': %s", id, err)
+	}
+	experiment, err := s.experimentRepository.GetByNamespaceIDAndExperimentID(
+		ctx, namespace.ID, int32(experimentID),
+	)
+	if err != nil {
+		return nil, api.NewResourceDoesNotExistError("unable to find experiment '%s': %s", id, err)
+	}
+	return experiment, nil
+}
+
+// getTrace returns models.TraceInfo entity by request id.
+func (s Service) getTrace(
+	ctx context.Context, namespace *models.Namespace, requestID string,
+) (*models.TraceInfo, error) {
+	trace, err := s.traceRepository.GetByNamespaceIDAndRequestID(ctx, namespace.ID, requestID)
+	if err != nil {
+		return nil, api.NewInternalError("unable to find trace '%s': %s", requestID, err)
+	}
+	if trace == nil {
+		return nil, api.NewResourceDoesNotExistError("Trace with request_id '%s' not found", requestID)
+	}
+	return trace, nil
+}
+
+// deleteTraceData deletes data artifacts of the trace. Traces, which data has never been logged,
+// have nothing in the storage, so missing artifacts are not an error.
+func (s Service) deleteTraceData(ctx context.Context, trace *models.TraceInfo) error {
+	artifactLocation, ok := trace.GetTag(models.TraceTagArtifactLocation)
+	if !ok {
+		return nil
+	}
+	artifactStorage, err := s.artifactStorageFactory.GetStorage(ctx, artifactLocation)
+	if err != nil {
+		return err
+	}
+	if err := artifactStorage.Delete(ctx, artifactLocation, ""); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// parseOrderBy parses `order_by` clauses of the search request. Traces are ordered by the newest first
+// by default, and the request id is always the last column to make the order total for the page tokens.
+func parseOrderBy(clauses []string) ([]sortColumn, error) {
+	var sortColumns []sortColumn
+	requestIDOrder := false
+	for _, o := range clauses {
+		components := traceOrder.FindStringSubmatch(o)
+		if len(components) == 0 {
+			return nil, api.NewInvalidParameterValueError("invalid order_by clause '%s'", o)
+		}
+
+		column := components[1]
+		switch column {
+		case "timestamp":
+			column = "timestamp_ms"
+		case "request_id":
+			requestIDOrder = true
+		case "timestamp_ms", "execution_time_ms", "status":
+		default:
+			return nil, api.NewInvalidParameterValueError(
+				`invalid attribute '%s'. Valid values are ['request_id', 'timestamp_ms', 'execution_time_ms', 'status']`,
+				column,
+			)
+		}
+		sortColumns = append(sortColumns, sortColumn{
+			name: column,
+			desc: len(components) == 3 && strings.ToUpper(components[2]) == "DESC",
+		})
+	}
+	if len(clauses) == 0 {
+		sortColumns = append(sortColumns, sortColumn{name: "timestamp_ms", desc: true})
+	}
+	if !requestIDOrder {
+		sortColumns = append(sortColumns, sortColumn{name: "request_id"})
+	}
+	return sortColumns, nil
+}
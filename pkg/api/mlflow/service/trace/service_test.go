@@ -0,0 +1,212 @@
+package trace
+
+import (
+	"context"
+	"database/sql"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/rotisserie/eris"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/clause"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+)
+
+func TestService_StartTrace_Ok(t *testing.T) {
+	// init repository mocks.
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+	experimentRepository.On(
+		"GetByNamespaceIDAndExperimentID", context.TODO(), uint(1), int32(1),
+	).Return(&models.Experiment{ID: common.GetPointer[int32](1), ArtifactLocation: "s3://bucket/1"}, nil)
+
+	traceRepository := repositories.MockTraceRepositoryProvider{}
+	traceRepository.On(
+		"Create", context.TODO(), mock.AnythingOfType("*models.TraceInfo"),
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(&traceRepository, &experimentRepository, &storage.MockArtifactStorageFactoryProvider{})
+	trace, err := service.StartTrace(context.TODO(), &models.Namespace{ID: 1}, &request.StartTraceRequest{
+		ExperimentID: "1",
+		TimestampMS:  1000,
+		Tags:         []request.TraceTagPartialRequest{{Key: "mlflow.traceName", Value: "predict"}},
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	assert.True(t, strings.HasPrefix(trace.RequestID, "tr-"))
+	assert.Equal(t, int32(1), trace.ExperimentID)
+	assert.Equal(t, models.TraceStatusInProgress, trace.Status)
+	artifactLocation, ok := trace.GetTag(models.TraceTagArtifactLocation)
+	assert.True(t, ok)
+	assert.Equal(t, "s3://bucket/1/traces/"+trace.RequestID+"/artifacts", artifactLocation)
+	traceRepository.AssertExpectations(t)
+}
+
+func TestService_EndTrace_Ok(t *testing.T) {
+	// init repository mocks.
+	traceRepository := repositories.MockTraceRepositoryProvider{}
+	traceRepository.On(
+		"GetByNamespaceIDAndRequestID", context.TODO(), uint(1), "tr-1",
+	).Return(&models.TraceInfo{
+		RequestID:   "tr-1",
+		TimestampMS: 1000,
+		Status:      models.TraceStatusInProgress,
+	}, nil)
+	traceRepository.On(
+		"Update", context.TODO(), mock.MatchedBy(func(trace *models.TraceInfo) bool {
+			return trace.Status == models.TraceStatusOK &&
+				trace.ExecutionTimeMS == sql.NullInt64{Int64: 500, Valid: true}
+		}),
+	).Return(nil)
+
+	// call service under testing.
+	service := NewService(
+		&traceRepository, &repositories.MockExperimentRepositoryProvider{}, &storage.MockArtifactStorageFactoryProvider{},
+	)
+	_, err := service.EndTrace(context.TODO(), &models.Namespace{ID: 1}, &request.EndTraceRequest{
+		RequestID:   "tr-1",
+		TimestampMS: 1500,
+		Status:      "OK",
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	traceRepository.AssertExpectations(t)
+}
+
+func TestService_SearchTraces_Ok(t *testing.T) {
+	// init repository mocks.
+	traceRepository := repositories.MockTraceRepositoryProvider{}
+	traceRepository.On(
+		"Search",
+		context.TODO(),
+		uint(1),
+		[]int32{1},
+		nil,
+		[]clause.OrderByColumn{
+			{Column: clause.Column{Table: "trace_info", Name: "timestamp_ms"}, Desc: true},
+			{Column: clause.Column{Table: "trace_info", Name: "request_id"}},
+		},
+		3,
+	).Return([]models.TraceInfo{
+		{RequestID: "tr-3", TimestampMS: 3000},
+		{RequestID: "tr-2", TimestampMS: 2000},
+		{RequestID: "tr-1", TimestampMS: 1000},
+	}, nil)
+
+	// call service under testing.
+	service := NewService(
+		&traceRepository, &repositories.MockExperimentRepositoryProvider{}, &storage.MockArtifactStorageFactoryProvider{},
+	)
+	traces, nextPageToken, err := service.SearchTraces(
+		context.TODO(), &models.Namespace{ID: 1}, &request.SearchTracesRequest{
+			ExperimentIDs: []string{"1"},
+			MaxResults:    2,
+		},
+	)
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, []models.TraceInfo{
+		{RequestID: "tr-3", TimestampMS: 3000},
+		{RequestID: "tr-2", TimestampMS: 2000},
+	}, traces)
+	assert.Equal(t, &request.PageToken{Keys: []any{int64(2000), "tr-2"}}, nextPageToken)
+}
+
+func TestService_DeleteTraces_Ok(t *testing.T) {
+	// init repository mocks. data of `tr-2` can't be deleted, so the trace has to stay in the database.
+	experimentRepository := repositories.MockExperimentRepositoryProvider{}
+	experimentRepository.On(
+		"GetByNamespaceIDAndExperimentID", context.TODO(), uint(1), int32(1),
+	).Return(&models.Experiment{ID: common.GetPointer[int32](1)}, nil)
+
+	traceRepository := repositories.MockTraceRepositoryProvider{}
+	traceRepository.On(
+		"GetForDeletion", context.TODO(), uint(1), int32(1), []string(nil), int64(1000), 0,
+	).Return([]models.TraceInfo{
+		{RequestID: "tr-1", Tags: []models.TraceTag{{Key: models.TraceTagArtifactLocation, Value: "s3://bucket/1"}}},
+		{RequestID: "tr-2", Tags: []models.TraceTag{{Key: models.TraceTagArtifactLocation, Value: "s3://bucket/2"}}},
+		{RequestID: "tr-3", Tags: []models.TraceTag{{Key: models.TraceTagArtifactLocation, Value: "s3://bucket/3"}}},
+		{RequestID: "tr-4"},
+	}, nil)
+	traceRepository.On("DeleteBatch", context.TODO(), []string{"tr-1", "tr-3", "tr-4"}).Return(nil)
+
+	// init storage mocks. data of `tr-3` has never been logged.
+	artifactStorage := storage.MockArtifactStorageProvider{}
+	artifactStorage.On("Delete", context.TODO(), "s3://bucket/1", "").Return(nil)
+	artifactStorage.On("Delete", context.TODO(), "s3://bucket/2", "").Return(eris.New("storage error"))
+	artifactStorage.On("Delete", context.TODO(), "s3://bucket/3", "").Return(fs.ErrNotExist)
+
+	artifactStorageFactory := storage.MockArtifactStorageFactoryProvider{}
+	artifactStorageFactory.On("GetStorage", context.TODO(), mock.Anything).Return(&artifactStorage, nil)
+
+	// call service under testing.
+	service := NewService(&traceRepository, &experimentRepository, &artifactStorageFactory)
+	deleted, err := service.DeleteTraces(context.TODO(), &models.Namespace{ID: 1}, &request.DeleteTracesRequest{
+		ExperimentID:       "1",
+		MaxTimestampMillis: 1000,
+	})
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, 3, deleted)
+	traceRepository.AssertExpectations(t)
+	artifactStorage.AssertExpectations(t)
+}
+
+func TestService_DeleteTraceTag_Error(t *testing.T) {
+	// init repository mocks.
+	traceRepository := repositories.MockTraceRepositoryProvider{}
+	traceRepository.On(
+		"GetByNamespaceIDAndRequestID", context.TODO(), uint(1), "tr-1",
+	).Return(&models.TraceInfo{RequestID: "tr-1"}, nil)
+	traceRepository.On(
+		"GetByNamespaceIDAndRequestID", context.TODO(), uint(1), "tr-2",
+	).Return(nil, nil)
+
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.DeleteTraceTagRequest
+	}{
+		{
+			name:    "EmptyKey",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'key'"),
+			request: &request.DeleteTraceTagRequest{RequestID: "tr-1"},
+		},
+		{
+			name:    "NotFoundTrace",
+			error:   api.NewResourceDoesNotExistError("Trace with request_id 'tr-2' not found"),
+			request: &request.DeleteTraceTagRequest{RequestID: "tr-2", Key: "env"},
+		},
+		{
+			name: "NotFoundTag",
+			error: api.NewResourceDoesNotExistError(
+				"No trace tag with key 'env' for trace with request_id 'tr-1'",
+			),
+			request: &request.DeleteTraceTagRequest{RequestID: "tr-1", Key: "env"},
+		},
+	}
+
+	// call service under testing.
+	service := NewService(
+		&traceRepository, &repositories.MockExperimentRepositoryProvider{}, &storage.MockArtifactStorageFactoryProvider{},
+	)
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.DeleteTraceTag(context.TODO(), &models.Namespace{ID: 1}, tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package trace
+
+import (
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// Limits of the trace properties and requests.
+const (
+	MaxTraceTagKeyLength                = 250
+	MaxTraceTagValueLength              = 8000
+	MaxResultsForSearchTracesRequest    = 500
+	DefaultMaxResultsForSearchTraces    = 100
+	MaxRequestIDsForDeleteTracesRequest = 100
+)
+
+// ValidateStartTraceRequest validates `POST /mlflow/traces` request.
+func ValidateStartTraceRequest(req *request.StartTraceRequest) error {
+	if req.ExperimentID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'")
+	}
+	if req.TimestampMS <= 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'timestamp_ms'")
+	}
+	for _, tag := range req.Tags {
+		if err := validateTraceTag(tag.Key, tag.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateEndTraceRequest validates `PATCH /mlflow/traces/:request_id` request.
+func ValidateEndTraceRequest(req *request.EndTraceRequest) error {
+	if req.RequestID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'request_id'")
+	}
+	if req.TimestampMS <= 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'timestamp_ms'")
+	}
+	switch models.TraceStatus(req.Status) {
+	case models.TraceStatusUnspecified, models.TraceStatusOK, models.TraceStatusError, models.TraceStatusInProgress:
+	default:
+		return api.NewInvalidParameterValueError("Invalid value '%s' for parameter 'status'", req.Status)
+	}
+	for _, tag := range req.Tags {
+		if err := validateTraceTag(tag.Key, tag.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateGetTraceInfoRequest validates `GET /mlflow/traces/:request_id/info` request.
+func ValidateGetTraceInfoRequest(req *request.GetTraceInfoRequest) error {
+	if req.RequestID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'request_id'")
+	}
+	return nil
+}
+
+// ValidateSearchTracesRequest validates `GET /mlflow/traces` request.
+func ValidateSearchTracesRequest(req *request.SearchTracesRequest) error {
+	if len(req.ExperimentIDs) == 0 {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_ids'")
+	}
+	if req.MaxResults < 0 || req.MaxResults > MaxResultsForSearchTracesRequest {
+		return api.NewInvalidParameterValueError(
+			"Invalid value for parameter 'max_results' supplied. It must be at most %d, but got value %d",
+			MaxResultsForSearchTracesRequest, req.MaxResults,
+		)
+	}
+	return nil
+}
+
+// ValidateDeleteTracesRequest validates `POST /mlflow/traces/delete-traces` request.
+func ValidateDeleteTracesRequest(req *request.DeleteTracesRequest) error {
+	if req.ExperimentID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'")
+	}
+	if (req.MaxTimestampMillis == 0) == (len(req.RequestIDs) == 0) {
+		return api.NewInvalidParameterValueError(
+			"Exactly one of 'max_timestamp_millis' and 'request_ids' must be specified.",
+		)
+	}
+	if req.MaxTraces < 0 {
+		return api.NewInvalidParameterValueError("Invalid value for parameter 'max_traces' supplied.")
+	}
+	if req.MaxTraces != 0 && len(req.RequestIDs) != 0 {
+		return api.NewInvalidParameterValueError("'max_traces' can't be specified if 'request_ids' is specified.")
+	}
+	if len(req.RequestIDs) > MaxRequestIDsForDeleteTracesRequest {
+		return api.NewInvalidParameterValueError(
+			"DeleteTraces request cannot specify more than %d request_ids. Received %d request_ids.",
+			MaxRequestIDsForDeleteTracesRequest, len(req.RequestIDs),
+		)
+	}
+	return nil
+}
+
+// ValidateSetTraceTagRequest validates `PATCH /mlflow/traces/:request_id/tags` request.
+func ValidateSetTraceTagRequest(req *request.SetTraceTagRequest) error {
+	if req.RequestID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'request_id'")
+	}
+	return validateTraceTag(req.Key, req.Value)
+}
+
+// ValidateDeleteTraceTagRequest validates `DELETE /mlflow/traces/:request_id/tags` request.
+func ValidateDeleteTraceTagRequest(req *request.DeleteTraceTagRequest) error {
+	if req.RequestID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'request_id'")
+	}
+	if req.Key == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'key'")
+	}
+	return nil
+}
+
+// ValidateGetTraceArtifactRequest validates `GET /mlflow/get-trace-artifact` request.
+func ValidateGetTraceArtifactRequest(req *request.GetTraceArtifactRequest) error {
+	if req.RequestID == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'request_id'")
+	}
+	return nil
+}
+
+// validateTraceTag validates key and value of the trace tag.
+func validateTraceTag(key, value string) error {
+	if key == "" {
+		return api.NewInvalidParameterValueError("Missing value for required parameter 'key'")
+	}
+	if len(key) > MaxTraceTagKeyLength {
+		return api.NewInvalidParameterValueError(
+			"Trace tag key '%s' exceeds the maximum length of %d", key, MaxTraceTagKeyLength,
+		)
+	}
+	if len(value) > MaxTraceTagValueLength {
+		return api.NewInvalidParameterValueError(
+			"Value of trace tag '%s' exceeds the maximum length of %d", key, MaxTraceTagValueLength,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package trace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+)
+
+func TestValidateEndTraceRequest_Ok(t *testing.T) {
+	for _, status := range []string{"OK", "ERROR", "IN_PROGRESS", "TRACE_STATUS_UNSPECIFIED"} {
+		err := ValidateEndTraceRequest(&request.EndTraceRequest{
+			RequestID:   "tr-1",
+			TimestampMS: 1000,
+			Status:      status,
+		})
+		require.Nil(t, err)
+	}
+}
+
+func TestValidateEndTraceRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.EndTraceRequest
+	}{
+		{
+			name:    "EmptyRequestID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'request_id'"),
+			request: &request.EndTraceRequest{},
+		},
+		{
+			name:    "EmptyTimestamp",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'timestamp_ms'"),
+			request: &request.EndTraceRequest{RequestID: "tr-1"},
+		},
+		{
+			name:  "InvalidStatus",
+			error: api.NewInvalidParameterValueError("Invalid value 'DONE' for parameter 'status'"),
+			request: &request.EndTraceRequest{
+				RequestID:   "tr-1",
+				TimestampMS: 1000,
+				Status:      "DONE",
+			},
+		},
+		{
+			name:  "EmptyTagKey",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'key'"),
+			request: &request.EndTraceRequest{
+				RequestID:   "tr-1",
+				TimestampMS: 1000,
+				Status:      "OK",
+				Tags:        []request.TraceTagPartialRequest{{Value: "value"}},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateEndTraceRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
+
+func TestValidateDeleteTracesRequest_Ok(t *testing.T) {
+	testData := []struct {
+		name    string
+		request *request.DeleteTracesRequest
+	}{
+		{
+			name:    "WithRequestIDs",
+			request: &request.DeleteTracesRequest{ExperimentID: "1", RequestIDs: []string{"tr-1"}},
+		},
+		{
+			name:    "WithMaxTimestamp",
+			request: &request.DeleteTracesRequest{ExperimentID: "1", MaxTimestampMillis: 1000, MaxTraces: 10},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Nil(t, ValidateDeleteTracesRequest(tt.request))
+		})
+	}
+}
+
+func TestValidateDeleteTracesRequest_Error(t *testing.T) {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request *request.DeleteTracesRequest
+	}{
+		{
+			name:    "EmptyExperimentID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'"),
+			request: &request.DeleteTracesRequest{},
+		},
+		{
+			name: "BothTimestampAndRequestIDs",
+			error: api.NewInvalidParameterValueError(
+				"Exactly one of 'max_timestamp_millis' and 'request_ids' must be specified.",
+			),
+			request: &request.DeleteTracesRequest{
+				ExperimentID:       "1",
+				MaxTimestampMillis: 1000,
+				RequestIDs:         []string{"tr-1"},
+			},
+		},
+		{
+			name:  "NegativeMaxTraces",
+			error: api.NewInvalidParameterValueError("Invalid value for parameter 'max_traces' supplied."),
+			request: &request.DeleteTracesRequest{
+				ExperimentID:       "1",
+				MaxTimestampMillis: 1000,
+				MaxTraces:          -1,
+			},
+		},
+		{
+			name: "TooManyRequestIDs",
+			error: api.NewInvalidParameterValueError(
+				"DeleteTraces request cannot specify more than 100 request_ids. Received 101 request_ids.",
+			),
+			request: &request.DeleteTracesRequest{
+				ExperimentID: "1",
+				RequestIDs:   make([]string, MaxRequestIDsForDeleteTracesRequest+1),
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDeleteTracesRequest(tt.request)
+			assert.Equal(t, tt.error, err)
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rotisserie/eris"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/version"
+)
+
+// List of headers, which are sent together with the Payload.
+const (
+	HeaderEvent     = "X-FastTrackML-Event"
+	HeaderDelivery  = "X-FastTrackML-Delivery"
+	HeaderSignature = "X-FastTrackML-Signature-256"
+)
+
+const (
+	// deliveryBatchSize is the maximum number of pending deliveries, which are attempted during one pass.
+	deliveryBatchSize = 100
+	// defaultPollInterval is the interval of checking pending deliveries, when retry backoff isn't set.
+	defaultPollInterval = time.Second
+	// claimMargin is added to the request timeout, while the attempt of the delivery is claimed by the worker.
+	claimMargin = time.Minute
+)
+
+// RunStatusEvents maps final statuses of the run to the webhook events, which are emitted on them.
+var RunStatusEvents = map[models.Status]models.WebhookEvent{
+	models.StatusFinished: models.WebhookEventRunFinished,
+	models.StatusFailed:   models.WebhookEventRunFailed,
+	models.StatusKilled:   models.WebhookEventRunKilled,
+}
+
+// DispatcherProvider provides an interface to emit webhook events.
+type DispatcherProvider interface {
+	// Dispatch delivers the event to all the active webhooks of the namespace, which are subscribed to it.
+	Dispatch(ctx context.Context, namespace *models.Namespace, event models.WebhookEvent, data any)
+}
+
+// Dispatcher delivers webhook events in the background and keeps the log of the deliveries.
+type Dispatcher struct {
+	config            *config.ServiceConfig
+	client            *http.Client
+	webhookRepository repositories.WebhookRepositoryProvider
+	pending           chan struct{}
+}
+
+// NewDispatcher creates new Dispatcher instance.
+func NewDispatcher(
+	config *config.ServiceConfig, webhookRepository repositories.WebhookRepositoryProvider,
+) *Dispatcher {
+	return &Dispatcher{
+		config: config,
+		client: &http.Client{
+			Timeout: config.WebhookTimeout,
+		},
+		webhookRepository: webhookRepository,
+		pending:           make(chan struct{}, 1),
+	}
+}
+
+// Dispatch delivers the event to all the active webhooks of the namespace, which are subscribed to it.
+// The event is only stored as the pending delivery, which is sent by the worker started with Start.
+// Failures are only logged, so webhooks never break the operation which emitted the event.
+func (d Dispatcher) Dispatch(
+	ctx context.Context, namespace *models.Namespace, event models.WebhookEvent, data any,
+) {
+	webhooks, err := d.webhookRepository.ListByNamespaceID(ctx, namespace.ID)
+	if err != nil {
+		log.Errorf("error getting webhooks for event '%s': %+v", event, err)
+		return
+	}
+
+	created := false
+	for _, webhook := range webhooks {
+		if !webhook.IsSubscribedTo(event) {
+			continue
+		}
+		if err := d.createDelivery(ctx, namespace, &webhook, event, data); err != nil {
+			log.Errorf("error creating delivery of event '%s' for webhook %d: %+v", event, webhook.ID, err)
+			continue
+		}
+		created = true
+	}
+
+	// wake up the worker, so the new deliveries don't wait for the next poll.
+	if created {
+		select {
+		case d.pending <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Start delivers pending deliveries until the context is cancelled. Deliveries are kept in the database,
+// so the ones, which haven't been completed before the restart, are resumed by the next worker.
+func (d Dispatcher) Start(ctx context.Context) {
+	pollInterval := d.config.WebhookRetryBackoff
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.pending:
+		}
+		if _, err := d.Deliver(ctx); err != nil {
+			log.Errorf("error delivering webhook events: %+v", err)
+		}
+	}
+}
+
+// Deliver makes the next attempt of the pending deliveries, which are due, and returns the number of them.
+// Each delivery is claimed before the attempt, so it is never sent by several workers at once. Claim expires
+// after the request timeout, so delivery, which has been claimed by the stopped worker, is attempted again.
+func (d Dispatcher) Deliver(ctx context.Context) (int, error) {
+	deliveries, err := d.webhookRepository.GetPendingDeliveries(ctx, time.Now().UTC(), deliveryBatchSize)
+	if err != nil {
+		return 0, eris.Wrap(err, "error getting pending webhook deliveries")
+	}
+
+	attempted := 0
+	for _, delivery := range deliveries {
+		claimed, err := d.webhookRepository.ClaimDelivery(
+			ctx, &delivery, time.Now().UTC().Add(d.config.WebhookTimeout+claimMargin),
+		)
+		if err != nil {
+			return attempted, eris.Wrapf(err, "error claiming webhook delivery '%s'", delivery.ID)
+		}
+		if !claimed {
+			continue
+		}
+		d.attempt(ctx, &delivery)
+		attempted++
+	}
+	return attempted, nil
+}
+
+// createDelivery builds the payload of the event and stores it as the pending delivery.
+func (d Dispatcher) createDelivery(
+	ctx context.Context,
+	namespace *models.Namespace,
+	webhook *models.Webhook,
+	event models.WebhookEvent,
+	data any,
+) error {
+	now := time.Now().UTC()
+	delivery := models.WebhookDelivery{
+		ID:            uuid.New().String(),
+		WebhookID:     webhook.ID,
+		Event:         event,
+		Status:        models.WebhookDeliveryStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	body, err := json.Marshal(Payload{
+		ID:        delivery.ID,
+		Event:     event,
+		Timestamp: now.UnixMilli(),
+		Namespace: namespace.Code,
+		Data:      data,
+	})
+	if err != nil {
+		return eris.Wrap(err, "error marshaling payload")
+	}
+	delivery.Payload = string(body)
+
+	return d.webhookRepository.CreateDelivery(ctx, &delivery)
+}
+
+// retryDelay returns the delay before the next attempt of the delivery. The first retry is made
+// after the configured backoff, after that the delay doubles each time.
+func (d Dispatcher) retryDelay(attempts int) time.Duration {
+	delay := d.config.WebhookRetryBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+	}
+	return delay
+}
+
+// attempt sends the payload to the webhook once and records the attempt in the delivery log.
+// Delivery fails once it runs out of attempts, otherwise the next attempt is scheduled.
+func (d Dispatcher) attempt(ctx context.Context, delivery *models.WebhookDelivery) {
+	code, err := d.send(ctx, &delivery.Webhook, delivery, []byte(delivery.Payload))
+	// attempt, interrupted by the shutdown, is repeated by the next worker, once the claim expires.
+	if ctx.Err() != nil {
+		return
+	}
+
+	delivery.Attempts++
+	delivery.ResponseCode = code
+	delivery.Error = ""
+	delivery.UpdatedAt = time.Now().UTC()
+	switch {
+	case err == nil:
+		delivery.Status = models.WebhookDeliveryStatusSucceeded
+	case delivery.Attempts >= max(d.config.WebhookMaxAttempts, 1):
+		delivery.Status = models.WebhookDeliveryStatusFailed
+		delivery.Error = err.Error()
+	default:
+		delivery.Status = models.WebhookDeliveryStatusPending
+		delivery.Error = err.Error()
+		delivery.NextAttemptAt = delivery.UpdatedAt.Add(d.retryDelay(delivery.Attempts))
+	}
+	if err := d.webhookRepository.UpdateDelivery(ctx, delivery); err != nil {
+		log.Errorf("error updating webhook delivery '%s': %+v", delivery.ID, err)
+	}
+	if delivery.Status == models.WebhookDeliveryStatusFailed {
+		log.Warnf(
+			"Giving up delivery '%s' to webhook %d after %d attempts: %s",
+			delivery.ID, delivery.WebhookID, delivery.Attempts, delivery.Error,
+		)
+	}
+}
+
+// send makes single attempt to deliver the payload and returns response status code.
+func (d Dispatcher) send(
+	ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery, body []byte,
+) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, eris.Wrap(err, "error creating request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", fmt.Sprintf("FastTrackML/%s", version.Version))
+	req.Header.Set(HeaderEvent, string(delivery.Event))
+	req.Header.Set(HeaderDelivery, delivery.ID)
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, eris.Wrap(err, "error sending request")
+	}
+	//nolint:errcheck
+	defer resp.Body.Close()
+	//nolint:errcheck
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, eris.Errorf("unexpected response status: %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,239 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+func TestSign_Ok(t *testing.T) {
+	assert.Equal(
+		t,
+		"sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8",
+		Sign("key", []byte("The quick brown fox jumps over the lazy dog")),
+	)
+}
+
+func TestDispatcher_Dispatch_Ok(t *testing.T) {
+	// init repository mocks.
+	webhookRepository := repositories.MockWebhookRepositoryProvider{}
+	webhookRepository.On("ListByNamespaceID", context.TODO(), uint(1)).Return([]models.Webhook{
+		{ID: 1, URL: "http://localhost", Secret: "secret", Events: "run.finished,run.failed", Active: true},
+		{ID: 2, URL: "http://localhost", Secret: "secret", Events: "run.deleted", Active: true},
+		{ID: 3, URL: "http://localhost", Secret: "secret", Events: "run.finished", Active: false},
+	}, nil)
+	var created models.WebhookDelivery
+	webhookRepository.On(
+		"CreateDelivery", context.TODO(), mock.MatchedBy(func(delivery *models.WebhookDelivery) bool {
+			return delivery.WebhookID == 1 && delivery.Status == models.WebhookDeliveryStatusPending
+		}),
+	).Run(func(args mock.Arguments) {
+		created = *args.Get(1).(*models.WebhookDelivery)
+	}).Return(nil)
+
+	// call service under testing.
+	dispatcher := NewDispatcher(&config.ServiceConfig{
+		WebhookMaxAttempts:  3,
+		WebhookRetryBackoff: time.Millisecond,
+		WebhookTimeout:      time.Second,
+	}, &webhookRepository)
+	dispatcher.Dispatch(
+		context.TODO(),
+		&models.Namespace{ID: 1, Code: "default"},
+		models.WebhookEventRunFinished,
+		&RunData{RunID: "1", Status: string(models.StatusFinished)},
+	)
+
+	// compare results. delivery is only stored, it is sent later by the worker.
+	assert.Equal(t, models.WebhookEventRunFinished, created.Event)
+	assert.Equal(t, 0, created.Attempts)
+	assert.Equal(t, created.CreatedAt, created.NextAttemptAt)
+
+	var payload map[string]any
+	require.Nil(t, json.Unmarshal([]byte(created.Payload), &payload))
+	assert.Equal(t, created.ID, payload["id"])
+	assert.Equal(t, "run.finished", payload["event"])
+	assert.Equal(t, "default", payload["namespace"])
+	assert.Equal(t, map[string]any{
+		"run_id":          "1",
+		"run_name":        "",
+		"experiment_id":   "",
+		"status":          "FINISHED",
+		"lifecycle_stage": "",
+	}, payload["data"])
+	assert.Len(t, dispatcher.pending, 1)
+	webhookRepository.AssertNumberOfCalls(t, "CreateDelivery", 1)
+	webhookRepository.AssertNotCalled(t, "UpdateDelivery", mock.Anything, mock.Anything)
+}
+
+func TestDispatcher_Deliver_Ok(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.Nil(t, err)
+		received <- r
+		bodies <- body
+	}))
+	defer receiver.Close()
+
+	// init repository mocks. delivery `2` has been already claimed by another worker.
+	webhook := models.Webhook{ID: 1, URL: receiver.URL, Secret: "secret"}
+	webhookRepository := repositories.MockWebhookRepositoryProvider{}
+	webhookRepository.On(
+		"GetPendingDeliveries", context.TODO(), mock.AnythingOfType("time.Time"), deliveryBatchSize,
+	).Return([]models.WebhookDelivery{
+		{
+			ID:        "1",
+			WebhookID: 1,
+			Webhook:   webhook,
+			Event:     models.WebhookEventRunFinished,
+			Payload:   `{"id":"1"}`,
+			Status:    models.WebhookDeliveryStatusPending,
+		},
+		{
+			ID:        "2",
+			WebhookID: 1,
+			Webhook:   webhook,
+			Event:     models.WebhookEventRunFinished,
+			Payload:   `{"id":"2"}`,
+			Status:    models.WebhookDeliveryStatusPending,
+		},
+	}, nil)
+	webhookRepository.On(
+		"ClaimDelivery", context.TODO(), mock.MatchedBy(func(delivery *models.WebhookDelivery) bool {
+			return delivery.ID == "1"
+		}), mock.AnythingOfType("time.Time"),
+	).Return(true, nil)
+	webhookRepository.On(
+		"ClaimDelivery", context.TODO(), mock.MatchedBy(func(delivery *models.WebhookDelivery) bool {
+			return delivery.ID == "2"
+		}), mock.AnythingOfType("time.Time"),
+	).Return(false, nil)
+	var updated models.WebhookDelivery
+	webhookRepository.On(
+		"UpdateDelivery", context.TODO(), mock.AnythingOfType("*models.WebhookDelivery"),
+	).Run(func(args mock.Arguments) {
+		updated = *args.Get(1).(*models.WebhookDelivery)
+	}).Return(nil)
+
+	// call service under testing.
+	dispatcher := NewDispatcher(&config.ServiceConfig{
+		WebhookMaxAttempts:  3,
+		WebhookRetryBackoff: time.Hour,
+		WebhookTimeout:      time.Second,
+	}, &webhookRepository)
+	count, err := dispatcher.Deliver(context.TODO())
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	req, body := <-received, <-bodies
+	assert.Equal(t, `{"id":"1"}`, string(body))
+	assert.Equal(t, "run.finished", req.Header.Get(HeaderEvent))
+	assert.Equal(t, "1", req.Header.Get(HeaderDelivery))
+	assert.Equal(t, Sign("secret", body), req.Header.Get(HeaderSignature))
+
+	assert.Equal(t, "1", updated.ID)
+	assert.Equal(t, models.WebhookDeliveryStatusSucceeded, updated.Status)
+	assert.Equal(t, 1, updated.Attempts)
+	assert.Equal(t, http.StatusOK, updated.ResponseCode)
+	assert.Empty(t, updated.Error)
+	webhookRepository.AssertNumberOfCalls(t, "UpdateDelivery", 1)
+}
+
+func TestDispatcher_Deliver_Error(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer receiver.Close()
+
+	// init repository mocks. delivery `1` is retried later, delivery `2` runs out of attempts.
+	webhook := models.Webhook{ID: 1, URL: receiver.URL}
+	webhookRepository := repositories.MockWebhookRepositoryProvider{}
+	webhookRepository.On(
+		"GetPendingDeliveries", context.TODO(), mock.AnythingOfType("time.Time"), deliveryBatchSize,
+	).Return([]models.WebhookDelivery{
+		{
+			ID:        "1",
+			WebhookID: 1,
+			Webhook:   webhook,
+			Event:     models.WebhookEventRunFailed,
+			Payload:   "{}",
+			Status:    models.WebhookDeliveryStatusPending,
+		},
+		{
+			ID:        "2",
+			WebhookID: 1,
+			Webhook:   webhook,
+			Event:     models.WebhookEventRunFailed,
+			Payload:   "{}",
+			Status:    models.WebhookDeliveryStatusPending,
+			Attempts:  1,
+		},
+	}, nil)
+	webhookRepository.On(
+		"ClaimDelivery", context.TODO(), mock.AnythingOfType("*models.WebhookDelivery"), mock.AnythingOfType("time.Time"),
+	).Return(true, nil)
+	updated := map[string]models.WebhookDelivery{}
+	webhookRepository.On(
+		"UpdateDelivery", context.TODO(), mock.AnythingOfType("*models.WebhookDelivery"),
+	).Run(func(args mock.Arguments) {
+		delivery := *args.Get(1).(*models.WebhookDelivery)
+		updated[delivery.ID] = delivery
+	}).Return(nil)
+
+	// call service under testing.
+	dispatcher := NewDispatcher(&config.ServiceConfig{
+		WebhookMaxAttempts:  2,
+		WebhookRetryBackoff: time.Millisecond,
+	}, &webhookRepository)
+	count, err := dispatcher.Deliver(context.TODO())
+
+	// compare results.
+	require.Nil(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, updated, 2)
+
+	assert.Equal(t, models.WebhookDeliveryStatusPending, updated["1"].Status)
+	assert.Equal(t, 1, updated["1"].Attempts)
+	assert.Equal(t, http.StatusBadGateway, updated["1"].ResponseCode)
+	assert.Equal(t, "unexpected response status: 502", updated["1"].Error)
+	assert.Equal(t, updated["1"].UpdatedAt.Add(time.Millisecond), updated["1"].NextAttemptAt)
+
+	assert.Equal(t, models.WebhookDeliveryStatusFailed, updated["2"].Status)
+	assert.Equal(t, 2, updated["2"].Attempts)
+	assert.Equal(t, http.StatusBadGateway, updated["2"].ResponseCode)
+	assert.Equal(t, "unexpected response status: 502", updated["2"].Error)
+}
+
+func TestDispatcher_Deliver_RepositoryError(t *testing.T) {
+	// init repository mocks.
+	webhookRepository := repositories.MockWebhookRepositoryProvider{}
+	webhookRepository.On(
+		"GetPendingDeliveries", context.TODO(), mock.AnythingOfType("time.Time"), deliveryBatchSize,
+	).Return(nil, errors.New("database error"))
+
+	// call service under testing.
+	dispatcher := NewDispatcher(&config.ServiceConfig{}, &webhookRepository)
+	count, err := dispatcher.Deliver(context.TODO())
+
+	// compare results.
+	require.NotNil(t, err)
+	assert.Equal(t, 0, count)
+	webhookRepository.AssertNotCalled(t, "UpdateDelivery", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// Payload represents the body of webhook request.
+type Payload struct {
+	ID        string              `json:"id"`
+	Event     models.WebhookEvent `json:"event"`
+	Timestamp int64               `json:"timestamp"`
+	Namespace string              `json:"namespace"`
+	Data      any                 `json:"data"`
+}
+
+// RunData represents run related part of the Payload.
+type RunData struct {
+	RunID          string `json:"run_id"`
+	RunName        string `json:"run_name"`
+	ExperimentID   string `json:"experiment_id"`
+	Status         string `json:"status"`
+	LifecycleStage string `json:"lifecycle_stage"`
+	StartTime      int64  `json:"start_time,omitempty"`
+	EndTime        int64  `json:"end_time,omitempty"`
+}
+
+// NewRunData creates new RunData object.
+func NewRunData(run *models.Run) *RunData {
+	return &RunData{
+		RunID:          run.ID,
+		RunName:        run.Name,
+		ExperimentID:   fmt.Sprint(run.ExperimentID),
+		Status:         string(run.Status),
+		LifecycleStage: string(run.LifecycleStage),
+		StartTime:      run.StartTime.Int64,
+		EndTime:        run.EndTime.Int64,
+	}
+}
+
+// ExperimentData represents experiment related part of the Payload.
+type ExperimentData struct {
+	ExperimentID   string `json:"experiment_id"`
+	Name           string `json:"name"`
+	LifecycleStage string `json:"lifecycle_stage"`
+}
+
+// NewExperimentData creates new ExperimentData object.
+func NewExperimentData(experiment *models.Experiment) *ExperimentData {
+	data := ExperimentData{
+		Name:           experiment.Name,
+		LifecycleStage: string(experiment.LifecycleStage),
+	}
+	if experiment.ID != nil {
+		data.ExperimentID = fmt.Sprint(*experiment.ID)
+	}
+	return &data
+}
+
+// Sign returns HMAC-SHA256 signature of the body, which receivers use to verify the request.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
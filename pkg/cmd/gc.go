@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	mlflowConfig "github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/gc"
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+var GCCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Permanently purges deleted runs, experiments and logged models",
+	Long: `The gc command permanently removes runs, experiments and logged
+         models, which have been deleted for longer than the given age,
+         together with their artifacts, metrics, params and tags. Use the
+         dry-run mode to see what would be removed.`,
+	RunE: gcCmd,
+}
+
+func gcCmd(cmd *cobra.Command, args []string) error {
+	olderThan := viper.GetDuration("older-than")
+	if olderThan < 0 {
+		return fmt.Errorf("'older-than' flag must not be negative")
+	}
+
+	config := mlflowConfig.NewServiceConfig()
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	db, err := database.NewDBProvider(
+		config.DatabaseURI,
+		time.Second*1,
+		20,
+	)
+	if err != nil {
+		return fmt.Errorf("error connecting to DB: %w", err)
+	}
+	//nolint:errcheck
+	defer db.Close()
+
+	if err := database.CheckAndMigrateDB(false, db.GormDB()); err != nil {
+		return fmt.Errorf("error checking database schema: %w", err)
+	}
+
+	artifactStorageFactory, err := storage.NewArtifactStorageFactory(config)
+	if err != nil {
+		return fmt.Errorf("error creating artifact storage factory: %w", err)
+	}
+
+	dryRun := viper.GetBool("dry-run")
+	result, err := gc.NewService(
+		repositories.NewRunRepository(db.GormDB()),
+		repositories.NewExperimentRepository(db.GormDB()),
+		repositories.NewLoggedModelRepository(db.GormDB()),
+		artifactStorageFactory,
+	).Collect(context.Background(), olderThan, dryRun)
+	if err != nil {
+		return err
+	}
+
+	action := "Purged"
+	if dryRun {
+		action = "Would purge"
+	}
+	for _, experiment := range result.Experiments {
+		log.Infof(
+			"%s experiment %d (%s) with %d runs, %d traces and %d logged models, artifacts: %s",
+			action, *experiment.ID, experiment.Name, len(experiment.Runs), len(experiment.Traces),
+			len(experiment.LoggedModels), experiment.ArtifactLocation,
+		)
+	}
+	for _, run := range result.Runs {
+		log.Infof("%s run %s, artifacts: %s", action, run.ID, run.ArtifactURI)
+	}
+	for _, loggedModel := range result.LoggedModels {
+		log.Infof("%s logged model %s, artifacts: %s", action, loggedModel.ID, loggedModel.ArtifactLocation)
+	}
+	log.Infof(
+		"%s %d experiments, %d runs and %d logged models",
+		action, len(result.Experiments), len(result.Runs), len(result.LoggedModels),
+	)
+	return nil
+}
+
+// nolint:errcheck,gosec
+func init() {
+	RootCmd.AddCommand(GCCmd)
+
+	GCCmd.Flags().StringP("database-uri", "d", "sqlite://fasttrackml.db", "Database URI")
+	GCCmd.Flags().Duration("older-than", 0, "Minimum time since deletion of the purged runs, experiments and logged models")
+	GCCmd.Flags().Bool("dry-run", false, "Only report runs, experiments and logged models which would be purged")
+	GCCmd.Flags().String("artifacts-destination", "", "Destination of artifacts proxied through the server")
+	GCCmd.Flags().String("s3-endpoint-uri", "", "S3 compatible storage base endpoint url")
+	GCCmd.Flags().String("gs-endpoint-uri", "", "Google Storage base endpoint url")
+	GCCmd.Flags().MarkHidden("gs-endpoint-uri")
+	GCCmd.Flags().String("azure-storage-connection-string", "", "Azure Storage connection string")
+	GCCmd.Flags().String("azure-storage-access-key", "", "Azure Storage account access key")
+}
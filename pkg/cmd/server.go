@@ -67,17 +67,33 @@ func init() {
 
 	ServerCmd.Flags().StringP("listen-address", "a", "localhost:5000", "Address (host:post) to listen to")
 	ServerCmd.Flags().String("default-artifact-root", "./artifacts", "Default artifact root")
+	ServerCmd.Flags().String("artifacts-destination", "./mlartifacts", "Destination of artifacts proxied through the server")
 	ServerCmd.Flags().String("s3-endpoint-uri", "", "S3 compatible storage base endpoint url")
 	ServerCmd.Flags().String("gs-endpoint-uri", "", "Google Storage base endpoint url")
 	ServerCmd.Flags().MarkHidden("gs-endpoint-uri")
+	ServerCmd.Flags().String("azure-storage-connection-string", "", "Azure Storage connection string")
+	ServerCmd.Flags().String("azure-storage-access-key", "", "Azure Storage account access key")
 	ServerCmd.Flags().String("auth-username", "", "BasicAuth username")
 	ServerCmd.Flags().String("auth-password", "", "BasicAuth password")
+	ServerCmd.Flags().StringSlice("auth-users", nil, "Additional BasicAuth users as username:password pairs")
 	ServerCmd.Flags().StringP("database-uri", "d", "sqlite://fasttrackml.db", "Database URI")
 	ServerCmd.Flags().Int("database-pool-max", 20, "Maximum number of database connections in the pool")
 	ServerCmd.Flags().Duration("database-slow-threshold", 1*time.Second, "Slow SQL warning threshold")
 	ServerCmd.Flags().Bool("database-migrate", true, "Run database migrations")
 	ServerCmd.Flags().Bool("database-reset", false, "Reinitialize database - WARNING all data will be lost!")
 	ServerCmd.Flags().MarkHidden("database-reset")
+	ServerCmd.Flags().Duration("stale-run-timeout", 0, "Terminate running runs silent for longer than this (0 to disable)")
+	ServerCmd.Flags().String("stale-run-status", "FAILED", "Status of the terminated stale runs (FAILED or KILLED)")
+	ServerCmd.Flags().Duration("stale-run-sweep-interval", 1*time.Minute, "Interval between stale run checks")
+	ServerCmd.Flags().Int("webhook-max-attempts", 5, "Maximum number of attempts to deliver a webhook event")
+	ServerCmd.Flags().Duration("webhook-retry-backoff", 5*time.Second, "Initial delay between webhook delivery attempts")
+	ServerCmd.Flags().Duration("webhook-timeout", 10*time.Second, "Timeout of a single webhook delivery attempt")
+	ServerCmd.Flags().String("alert-endpoint", "", "HTTP endpoint, which fired metric alerts are delivered to")
+	ServerCmd.Flags().Duration("alert-interval", 1*time.Minute, "Interval between alert checks (0 to disable)")
+	ServerCmd.Flags().Bool(
+		"allow-direct-production-transition", false,
+		"Allow moving model versions to Production without an approved transition request",
+	)
 	ServerCmd.Flags().Bool("dev-mode", false, "Development mode - enable CORS")
 	ServerCmd.Flags().MarkHidden("dev-mode")
 	viper.BindEnv("auth-username", "MLFLOW_TRACKING_USERNAME")
@@ -43,14 +43,20 @@ func (s *Importer) Import() error {
 		"namespaces",
 		"apps",
 		"dashboards",
+		"aim_tags",
+		"aim_pinned_sequences",
 		"experiments",
 		"experiment_tags",
 		"runs",
+		"aim_run_tags",
 		"tags",
 		"params",
 		"contexts",
 		"metrics",
 		"latest_metrics",
+		"images",
+		"texts",
+		"distributions",
 	}
 	for _, table := range tables {
 		if err := s.importTable(table); err != nil {
@@ -23,6 +23,19 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/database/migrations/v_0007"
 	"github.com/G-Research/fasttrackml/pkg/database/migrations/v_0008"
 	"github.com/G-Research/fasttrackml/pkg/database/migrations/v_0009"
+	"github.com/G-Research/fasttrackml/pkg/database/migrations/v_0010"
+	"github.com/G-Research/fasttrackml/pkg/database/migrations/v_0011"
+	"github.com/G-Research/fasttrackml/pkg/database/migrations/v_0012"
+	"github.com/G-Research/fasttrackml/pkg/database/migrations/v_0013"
+	"github.com/G-Research/fasttrackml/pkg/database/migrations/v_0014"
+	"github.com/G-Research/fasttrackml/pkg/database/migrations/v_0015"
+	"github.com/G-Research/fasttrackml/pkg/database/migrations/v_0016"
+	"github.com/G-Research/fasttrackml/pkg/database/migrations/v_0017"
+	"github.com/G-Research/fasttrackml/pkg/database/migrations/v_0018"
+	"github.com/G-Research/fasttrackml/pkg/database/migrations/v_0019"
+	"github.com/G-Research/fasttrackml/pkg/database/migrations/v_0020"
+	"github.com/G-Research/fasttrackml/pkg/database/migrations/v_0021"
+	"github.com/G-Research/fasttrackml/pkg/database/migrations/v_0022"
 )
 
 var supportedAlembicVersions = []string{
@@ -44,7 +57,7 @@ func CheckAndMigrateDB(migrate bool, db *gorm.DB) error {
 		tx.First(&schemaVersion)
 	}
 
-	if !slices.Contains(supportedAlembicVersions, alembicVersion.Version) || schemaVersion.Version != v_0009.Version {
+	if !slices.Contains(supportedAlembicVersions, alembicVersion.Version) || schemaVersion.Version != v_0022.Version {
 		if !migrate && alembicVersion.Version != "" {
 			return fmt.Errorf(
 				"unsupported database schema versions alembic %s, FastTrackML %s",
@@ -189,6 +202,97 @@ func CheckAndMigrateDB(migrate bool, db *gorm.DB) error {
 				if err := v_0009.Migrate(db); err != nil {
 					return fmt.Errorf("error migrating database to FastTrackML schema %s: %w", v_0009.Version, err)
 				}
+				fallthrough
+
+			case v_0009.Version:
+				log.Infof("Migrating database to FastTrackML schema %s", v_0010.Version)
+				if err := v_0010.Migrate(db); err != nil {
+					return fmt.Errorf("error migrating database to FastTrackML schema %s: %w", v_0010.Version, err)
+				}
+				fallthrough
+
+			case v_0010.Version:
+				log.Infof("Migrating database to FastTrackML schema %s", v_0011.Version)
+				if err := v_0011.Migrate(db); err != nil {
+					return fmt.Errorf("error migrating database to FastTrackML schema %s: %w", v_0011.Version, err)
+				}
+				fallthrough
+
+			case v_0011.Version:
+				log.Infof("Migrating database to FastTrackML schema %s", v_0012.Version)
+				if err := v_0012.Migrate(db); err != nil {
+					return fmt.Errorf("error migrating database to FastTrackML schema %s: %w", v_0012.Version, err)
+				}
+				fallthrough
+
+			case v_0012.Version:
+				log.Infof("Migrating database to FastTrackML schema %s", v_0013.Version)
+				if err := v_0013.Migrate(db); err != nil {
+					return fmt.Errorf("error migrating database to FastTrackML schema %s: %w", v_0013.Version, err)
+				}
+				fallthrough
+
+			case v_0013.Version:
+				log.Infof("Migrating database to FastTrackML schema %s", v_0014.Version)
+				if err := v_0014.Migrate(db); err != nil {
+					return fmt.Errorf("error migrating database to FastTrackML schema %s: %w", v_0014.Version, err)
+				}
+				fallthrough
+
+			case v_0014.Version:
+				log.Infof("Migrating database to FastTrackML schema %s", v_0015.Version)
+				if err := v_0015.Migrate(db); err != nil {
+					return fmt.Errorf("error migrating database to FastTrackML schema %s: %w", v_0015.Version, err)
+				}
+				fallthrough
+
+			case v_0015.Version:
+				log.Infof("Migrating database to FastTrackML schema %s", v_0016.Version)
+				if err := v_0016.Migrate(db); err != nil {
+					return fmt.Errorf("error migrating database to FastTrackML schema %s: %w", v_0016.Version, err)
+				}
+				fallthrough
+
+			case v_0016.Version:
+				log.Infof("Migrating database to FastTrackML schema %s", v_0017.Version)
+				if err := v_0017.Migrate(db); err != nil {
+					return fmt.Errorf("error migrating database to FastTrackML schema %s: %w", v_0017.Version, err)
+				}
+				fallthrough
+
+			case v_0017.Version:
+				log.Infof("Migrating database to FastTrackML schema %s", v_0018.Version)
+				if err := v_0018.Migrate(db); err != nil {
+					return fmt.Errorf("error migrating database to FastTrackML schema %s: %w", v_0018.Version, err)
+				}
+				fallthrough
+
+			case v_0018.Version:
+				log.Infof("Migrating database to FastTrackML schema %s", v_0019.Version)
+				if err := v_0019.Migrate(db); err != nil {
+					return fmt.Errorf("error migrating database to FastTrackML schema %s: %w", v_0019.Version, err)
+				}
+				fallthrough
+
+			case v_0019.Version:
+				log.Infof("Migrating database to FastTrackML schema %s", v_0020.Version)
+				if err := v_0020.Migrate(db); err != nil {
+					return fmt.Errorf("error migrating database to FastTrackML schema %s: %w", v_0020.Version, err)
+				}
+				fallthrough
+
+			case v_0020.Version:
+				log.Infof("Migrating database to FastTrackML schema %s", v_0021.Version)
+				if err := v_0021.Migrate(db); err != nil {
+					return fmt.Errorf("error migrating database to FastTrackML schema %s: %w", v_0021.Version, err)
+				}
+				fallthrough
+
+			case v_0021.Version:
+				log.Infof("Migrating database to FastTrackML schema %s", v_0022.Version)
+				if err := v_0022.Migrate(db); err != nil {
+					return fmt.Errorf("error migrating database to FastTrackML schema %s: %w", v_0022.Version, err)
+				}
 
 			default:
 				return fmt.Errorf("unsupported database FastTrackML schema version %s", schemaVersion.Version)
@@ -209,9 +313,34 @@ func CheckAndMigrateDB(migrate bool, db *gorm.DB) error {
 				&Context{},
 				&Metric{},
 				&LatestMetric{},
+				&Dataset{},
+				&Input{},
+				&InputTag{},
+				&TraceInfo{},
+				&TraceTag{},
+				&TraceRequestMetadata{},
+				&LoggedModel{},
+				&LoggedModelParam{},
+				&LoggedModelTag{},
+				&Webhook{},
+				&WebhookDelivery{},
+				&AlertRule{},
+				&Alert{},
+				&RegisteredModel{},
+				&RegisteredModelTag{},
+				&RegisteredModelAlias{},
+				&ModelVersion{},
+				&ModelVersionTag{},
+				&ModelVersionTransitionRequest{},
+				&ModelVersionTransition{},
 				&AlembicVersion{},
 				&Dashboard{},
 				&App{},
+				&AimTag{},
+				&AimPinnedSequence{},
+				&Image{},
+				&Text{},
+				&Distribution{},
 				&SchemaVersion{},
 			); err != nil {
 				return fmt.Errorf("error initializing database: %w", err)
@@ -220,7 +349,7 @@ func CheckAndMigrateDB(migrate bool, db *gorm.DB) error {
 				Version: "97727af70f4d",
 			})
 			tx.Create(&SchemaVersion{
-				Version: v_0009.Version,
+				Version: v_0022.Version,
 			})
 			tx.Commit()
 			if tx.Error != nil {
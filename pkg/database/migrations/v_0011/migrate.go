@@ -0,0 +1,30 @@
+package v_0011
+
+import (
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "8ea0bd4b6e6a"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			// Auto-migrate to create the model version transition tables
+			if err := tx.Migrator().AutoMigrate(
+				&ModelVersionTransitionRequest{},
+				&ModelVersionTransition{},
+			); err != nil {
+				return eris.Wrap(err, "error automigrating model version transition tables")
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
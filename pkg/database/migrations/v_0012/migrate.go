@@ -0,0 +1,31 @@
+package v_0012
+
+import (
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "5f0d9c1ba2e4"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			// Auto-migrate to create the dataset and run input tables
+			if err := tx.Migrator().AutoMigrate(
+				&Dataset{},
+				&Input{},
+				&InputTag{},
+			); err != nil {
+				return eris.Wrap(err, "error automigrating dataset and input tables")
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
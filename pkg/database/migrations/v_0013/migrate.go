@@ -0,0 +1,31 @@
+package v_0013
+
+import (
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "9eebe6cc1893"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			// Auto-migrate to create the trace tables
+			if err := tx.Migrator().AutoMigrate(
+				&TraceInfo{},
+				&TraceTag{},
+				&TraceRequestMetadata{},
+			); err != nil {
+				return eris.Wrap(err, "error automigrating trace tables")
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
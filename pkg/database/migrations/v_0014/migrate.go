@@ -0,0 +1,39 @@
+package v_0014
+
+import (
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "3a1d5c2e7f40"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			// Auto-migrate to create the logged model tables
+			if err := tx.Migrator().AutoMigrate(
+				&LoggedModel{},
+				&LoggedModelParam{},
+				&LoggedModelTag{},
+			); err != nil {
+				return eris.Wrap(err, "error automigrating logged model tables")
+			}
+
+			// Link metrics to the logged models
+			if err := tx.Migrator().AddColumn(&Metric{}, "ModelID"); err != nil {
+				return eris.Wrap(err, "error adding model_id column to metrics table")
+			}
+			if err := tx.Migrator().CreateIndex(&Metric{}, "ModelID"); err != nil {
+				return eris.Wrap(err, "error creating index on metrics model_id column")
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
@@ -0,0 +1,434 @@
+package v_0014
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Status string
+
+const (
+	StatusRunning   Status = "RUNNING"
+	StatusScheduled Status = "SCHEDULED"
+	StatusFinished  Status = "FINISHED"
+	StatusFailed    Status = "FAILED"
+	StatusKilled    Status = "KILLED"
+)
+
+type LifecycleStage string
+
+const (
+	LifecycleStageActive  LifecycleStage = "active"
+	LifecycleStageDeleted LifecycleStage = "deleted"
+)
+
+var DefaultContext = Context{ID: 1, Json: datatypes.JSON("{}")}
+
+type Namespace struct {
+	ID                  uint           `gorm:"primaryKey;autoIncrement" json:"id"`
+	Apps                []App          `gorm:"constraint:OnDelete:CASCADE" json:"apps"`
+	Code                string         `gorm:"unique;index;not null" json:"code"`
+	Description         string         `json:"description"`
+	CreatedAt           time.Time      `json:"created_at"`
+	UpdatedAt           time.Time      `json:"updated_at"`
+	DeletedAt           gorm.DeletedAt `gorm:"index" json:"deleted_at"`
+	DefaultExperimentID *int32         `gorm:"not null" json:"default_experiment_id"`
+	Experiments         []Experiment   `gorm:"constraint:OnDelete:CASCADE" json:"experiments"`
+}
+
+type Experiment struct {
+	ID               *int32         `gorm:"column:experiment_id;not null;primaryKey"`
+	Name             string         `gorm:"type:varchar(256);not null;index:,unique,composite:name"`
+	ArtifactLocation string         `gorm:"type:varchar(256)"`
+	LifecycleStage   LifecycleStage `gorm:"type:varchar(32);check:lifecycle_stage IN ('active', 'deleted')"`
+	CreationTime     sql.NullInt64  `gorm:"type:bigint"`
+	LastUpdateTime   sql.NullInt64  `gorm:"type:bigint"`
+	NamespaceID      uint           `gorm:"not null;index:,unique,composite:name"`
+	Namespace        Namespace
+	Tags             []ExperimentTag `gorm:"constraint:OnDelete:CASCADE"`
+	Runs             []Run           `gorm:"constraint:OnDelete:CASCADE"`
+	Datasets         []Dataset       `gorm:"constraint:OnDelete:CASCADE"`
+	Traces           []TraceInfo     `gorm:"constraint:OnDelete:CASCADE"`
+	LoggedModels     []LoggedModel   `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type ExperimentTag struct {
+	Key          string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value        string `gorm:"type:varchar(5000)"`
+	ExperimentID int32  `gorm:"not null;primaryKey"`
+}
+
+//nolint:lll
+type Run struct {
+	ID             string         `gorm:"<-:create;column:run_uuid;type:varchar(32);not null;primaryKey"`
+	Name           string         `gorm:"type:varchar(250)"`
+	SourceType     string         `gorm:"<-:create;type:varchar(20);check:source_type IN ('NOTEBOOK', 'JOB', 'LOCAL', 'UNKNOWN', 'PROJECT')"`
+	SourceName     string         `gorm:"<-:create;type:varchar(500)"`
+	EntryPointName string         `gorm:"<-:create;type:varchar(50)"`
+	UserID         string         `gorm:"<-:create;type:varchar(256)"`
+	Status         Status         `gorm:"type:varchar(9);check:status IN ('SCHEDULED', 'FAILED', 'FINISHED', 'RUNNING', 'KILLED')"`
+	StartTime      sql.NullInt64  `gorm:"<-:create;type:bigint"`
+	EndTime        sql.NullInt64  `gorm:"type:bigint"`
+	SourceVersion  string         `gorm:"<-:create;type:varchar(50)"`
+	LifecycleStage LifecycleStage `gorm:"type:varchar(20);check:lifecycle_stage IN ('active', 'deleted')"`
+	ArtifactURI    string         `gorm:"<-:create;type:varchar(200)"`
+	ExperimentID   int32
+	Experiment     Experiment
+	DeletedTime    sql.NullInt64  `gorm:"type:bigint"`
+	RowNum         RowNum         `gorm:"<-:create;index"`
+	Params         []Param        `gorm:"constraint:OnDelete:CASCADE"`
+	Tags           []Tag          `gorm:"constraint:OnDelete:CASCADE"`
+	Metrics        []Metric       `gorm:"constraint:OnDelete:CASCADE"`
+	LatestMetrics  []LatestMetric `gorm:"constraint:OnDelete:CASCADE"`
+	Inputs         []Input        `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type RowNum int64
+
+func (rn *RowNum) Scan(v interface{}) error {
+	nullInt := sql.NullInt64{}
+	if err := nullInt.Scan(v); err != nil {
+		return err
+	}
+	*rn = RowNum(nullInt.Int64)
+	return nil
+}
+
+func (rn RowNum) GormDataType() string {
+	return "bigint"
+}
+
+func (rn RowNum) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
+	if rn == 0 {
+		return clause.Expr{
+			SQL: "(SELECT COALESCE(MAX(row_num), -1) FROM runs) + 1",
+		}
+	}
+	return clause.Expr{
+		SQL:  "?",
+		Vars: []interface{}{int64(rn)},
+	}
+}
+
+type Dataset struct {
+	ID           string  `gorm:"column:dataset_uuid;type:varchar(36);not null;primaryKey"`
+	Name         string  `gorm:"type:varchar(500);not null;index:,unique,composite:dataset"`
+	Digest       string  `gorm:"type:varchar(36);not null;index:,unique,composite:dataset"`
+	SourceType   string  `gorm:"column:dataset_source_type;type:varchar(36);not null"`
+	Source       string  `gorm:"column:dataset_source;type:text;not null"`
+	Schema       string  `gorm:"column:dataset_schema;type:text"`
+	Profile      string  `gorm:"column:dataset_profile;type:text"`
+	ExperimentID int32   `gorm:"not null;index:,unique,composite:dataset"`
+	Inputs       []Input `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type Input struct {
+	ID        string     `gorm:"column:input_uuid;type:varchar(36);not null;primaryKey"`
+	DatasetID string     `gorm:"column:dataset_uuid;type:varchar(36);not null;index:,unique,composite:input"`
+	RunID     string     `gorm:"column:run_uuid;type:varchar(32);not null;index:,unique,composite:input"`
+	Tags      []InputTag `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type InputTag struct {
+	InputID string `gorm:"column:input_uuid;type:varchar(36);not null;primaryKey"`
+	Name    string `gorm:"type:varchar(255);not null;primaryKey"`
+	Value   string `gorm:"type:varchar(500);not null"`
+}
+
+type TraceStatus string
+
+const (
+	TraceStatusUnspecified TraceStatus = "TRACE_STATUS_UNSPECIFIED"
+	TraceStatusOK          TraceStatus = "OK"
+	TraceStatusError       TraceStatus = "ERROR"
+	TraceStatusInProgress  TraceStatus = "IN_PROGRESS"
+)
+
+type TraceInfo struct {
+	RequestID       string                 `gorm:"type:varchar(50);not null;primaryKey"`
+	ExperimentID    int32                  `gorm:"not null;index"`
+	TimestampMS     int64                  `gorm:"column:timestamp_ms;not null;index"`
+	ExecutionTimeMS sql.NullInt64          `gorm:"column:execution_time_ms"`
+	Status          TraceStatus            `gorm:"type:varchar(50);not null"`
+	Tags            []TraceTag             `gorm:"foreignKey:RequestID;constraint:OnDelete:CASCADE"`
+	RequestMetadata []TraceRequestMetadata `gorm:"foreignKey:RequestID;constraint:OnDelete:CASCADE"`
+}
+
+func (TraceInfo) TableName() string {
+	return "trace_info"
+}
+
+type TraceTag struct {
+	Key       string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value     string `gorm:"type:varchar(8000)"`
+	RequestID string `gorm:"type:varchar(50);not null;primaryKey;index"`
+}
+
+type TraceRequestMetadata struct {
+	Key       string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value     string `gorm:"type:varchar(8000)"`
+	RequestID string `gorm:"type:varchar(50);not null;primaryKey;index"`
+}
+
+func (TraceRequestMetadata) TableName() string {
+	return "trace_request_metadata"
+}
+
+type LoggedModelStatus string
+
+const (
+	LoggedModelStatusUnspecified  LoggedModelStatus = "LOGGED_MODEL_STATUS_UNSPECIFIED"
+	LoggedModelStatusPending      LoggedModelStatus = "LOGGED_MODEL_PENDING"
+	LoggedModelStatusReady        LoggedModelStatus = "LOGGED_MODEL_READY"
+	LoggedModelStatusUploadFailed LoggedModelStatus = "LOGGED_MODEL_UPLOAD_FAILED"
+)
+
+type LoggedModel struct {
+	ID                     string             `gorm:"column:model_id;type:varchar(50);not null;primaryKey"`
+	ExperimentID           int32              `gorm:"not null;index"`
+	Name                   string             `gorm:"type:varchar(500);not null"`
+	ArtifactLocation       string             `gorm:"type:varchar(1000)"`
+	CreationTimestampMS    int64              `gorm:"column:creation_timestamp_ms;not null"`
+	LastUpdatedTimestampMS int64              `gorm:"column:last_updated_timestamp_ms;not null"`
+	Status                 LoggedModelStatus  `gorm:"type:varchar(50);not null"`
+	StatusMessage          string             `gorm:"type:varchar(1000)"`
+	LifecycleStage         LifecycleStage     `gorm:"type:varchar(32);check:lifecycle_stage IN ('active', 'deleted')"`
+	ModelType              string             `gorm:"type:varchar(500)"`
+	SourceRunID            string             `gorm:"type:varchar(32)"`
+	Params                 []LoggedModelParam `gorm:"foreignKey:ModelID;constraint:OnDelete:CASCADE"`
+	Tags                   []LoggedModelTag   `gorm:"foreignKey:ModelID;constraint:OnDelete:CASCADE"`
+}
+
+type LoggedModelParam struct {
+	Key     string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value   string `gorm:"type:varchar(8000);not null"`
+	ModelID string `gorm:"type:varchar(50);not null;primaryKey;index"`
+}
+
+type LoggedModelTag struct {
+	Key     string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value   string `gorm:"type:varchar(8000)"`
+	ModelID string `gorm:"type:varchar(50);not null;primaryKey;index"`
+}
+
+type Param struct {
+	Key   string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value string `gorm:"type:varchar(500);not null"`
+	RunID string `gorm:"column:run_uuid;not null;primaryKey;index"`
+}
+
+type Tag struct {
+	Key   string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value string `gorm:"type:varchar(5000)"`
+	RunID string `gorm:"column:run_uuid;not null;primaryKey;index"`
+}
+
+type Metric struct {
+	Key       string  `gorm:"type:varchar(250);not null;primaryKey"`
+	Value     float64 `gorm:"type:double precision;not null;primaryKey"`
+	Timestamp int64   `gorm:"not null;primaryKey"`
+	RunID     string  `gorm:"column:run_uuid;not null;primaryKey;index"`
+	Step      int64   `gorm:"default:0;not null;primaryKey"`
+	IsNan     bool    `gorm:"default:false;not null;primaryKey"`
+	Iter      int64   `gorm:"index"`
+	ContextID uint    `gorm:"not null;primaryKey"`
+	Context   Context
+	ModelID   sql.NullString `gorm:"type:varchar(50);index"`
+}
+
+type LatestMetric struct {
+	Key       string  `gorm:"type:varchar(250);not null;primaryKey"`
+	Value     float64 `gorm:"type:double precision;not null"`
+	Timestamp int64
+	Step      int64  `gorm:"not null"`
+	IsNan     bool   `gorm:"not null"`
+	RunID     string `gorm:"column:run_uuid;not null;primaryKey;index"`
+	LastIter  int64
+	ContextID uint `gorm:"not null;primaryKey"`
+	Context   Context
+}
+
+type Context struct {
+	ID   uint           `gorm:"primaryKey;autoIncrement"`
+	Json datatypes.JSON `gorm:"not null;unique;index"`
+}
+
+type RegisteredModel struct {
+	ID              uint          `gorm:"primaryKey;autoIncrement"`
+	Name            string        `gorm:"type:varchar(256);not null;index:,unique,composite:name"`
+	Description     string        `gorm:"type:varchar(5000)"`
+	UserID          string        `gorm:"type:varchar(256)"`
+	CreationTime    sql.NullInt64 `gorm:"type:bigint"`
+	LastUpdatedTime sql.NullInt64 `gorm:"type:bigint"`
+	NamespaceID     uint          `gorm:"not null;index:,unique,composite:name"`
+	Namespace       Namespace
+	Tags            []RegisteredModelTag   `gorm:"constraint:OnDelete:CASCADE"`
+	Aliases         []RegisteredModelAlias `gorm:"constraint:OnDelete:CASCADE"`
+	Versions        []ModelVersion         `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type RegisteredModelTag struct {
+	Key               string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value             string `gorm:"type:varchar(5000)"`
+	RegisteredModelID uint   `gorm:"not null;primaryKey"`
+}
+
+type RegisteredModelAlias struct {
+	Alias             string `gorm:"type:varchar(256);not null;primaryKey"`
+	Version           int64  `gorm:"not null"`
+	RegisteredModelID uint   `gorm:"not null;primaryKey"`
+}
+
+//nolint:lll
+type ModelVersion struct {
+	ID                uint          `gorm:"primaryKey;autoIncrement"`
+	Version           int64         `gorm:"not null;index:,unique,composite:version"`
+	Description       string        `gorm:"type:varchar(5000)"`
+	UserID            string        `gorm:"type:varchar(256)"`
+	CurrentStage      string        `gorm:"type:varchar(20);not null;default:None"`
+	Source            string        `gorm:"type:varchar(500)"`
+	RunID             string        `gorm:"column:run_uuid;type:varchar(32);index"`
+	RunLink           string        `gorm:"type:varchar(500)"`
+	Status            string        `gorm:"type:varchar(20);check:status IN ('PENDING_REGISTRATION', 'FAILED_REGISTRATION', 'READY')"`
+	StatusMessage     string        `gorm:"type:varchar(500)"`
+	CreationTime      sql.NullInt64 `gorm:"type:bigint"`
+	LastUpdatedTime   sql.NullInt64 `gorm:"type:bigint"`
+	RegisteredModelID uint          `gorm:"not null;index:,unique,composite:version"`
+	RegisteredModel   RegisteredModel
+	Tags              []ModelVersionTag `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type ModelVersionTag struct {
+	Key            string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value          string `gorm:"type:varchar(5000)"`
+	ModelVersionID uint   `gorm:"not null;primaryKey"`
+}
+
+type ModelVersionTransitionRequest struct {
+	ID              uint          `gorm:"primaryKey;autoIncrement"`
+	ToStage         string        `gorm:"type:varchar(20);not null"`
+	Status          string        `gorm:"type:varchar(20);not null;default:PENDING;check:status IN ('PENDING', 'APPROVED', 'REJECTED')"`
+	Comment         string        `gorm:"type:varchar(5000)"`
+	UserID          string        `gorm:"type:varchar(256)"`
+	ReviewerID      string        `gorm:"type:varchar(256)"`
+	ReviewComment   string        `gorm:"type:varchar(5000)"`
+	CreationTime    sql.NullInt64 `gorm:"type:bigint"`
+	LastUpdatedTime sql.NullInt64 `gorm:"type:bigint"`
+	ModelVersionID  uint          `gorm:"not null;index"`
+	ModelVersion    ModelVersion
+}
+
+type ModelVersionTransition struct {
+	ID                  uint          `gorm:"primaryKey;autoIncrement"`
+	FromStage           string        `gorm:"type:varchar(20);not null"`
+	ToStage             string        `gorm:"type:varchar(20);not null"`
+	UserID              string        `gorm:"type:varchar(256)"`
+	Comment             string        `gorm:"type:varchar(5000)"`
+	CreationTime        sql.NullInt64 `gorm:"type:bigint"`
+	TransitionRequestID *uint
+	TransitionRequest   *ModelVersionTransitionRequest
+	ModelVersionID      uint `gorm:"not null;index"`
+	ModelVersion        ModelVersion
+}
+
+type AlembicVersion struct {
+	Version string `gorm:"column:version_num;type:varchar(32);not null;primaryKey"`
+}
+
+func (AlembicVersion) TableName() string {
+	return "alembic_version"
+}
+
+type SchemaVersion struct {
+	Version string `gorm:"not null;primaryKey"`
+}
+
+func (SchemaVersion) TableName() string {
+	return "schema_version"
+}
+
+type Base struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey" json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	IsArchived bool      `json:"-"`
+}
+
+func (b *Base) BeforeCreate(tx *gorm.DB) error {
+	b.ID = uuid.New()
+	return nil
+}
+
+type Dashboard struct {
+	Base
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	AppID       *uuid.UUID `gorm:"type:uuid" json:"app_id"`
+	App         App        `json:"-"`
+}
+
+func (d Dashboard) MarshalJSON() ([]byte, error) {
+	type localDashboard Dashboard
+	type jsonDashboard struct {
+		localDashboard
+		AppType *string `json:"app_type"`
+	}
+	jd := jsonDashboard{
+		localDashboard: localDashboard(d),
+	}
+	if d.App.IsArchived {
+		jd.AppID = nil
+	} else {
+		jd.AppType = &d.App.Type
+	}
+	return json.Marshal(jd)
+}
+
+type App struct {
+	Base
+	Type        string    `gorm:"not null" json:"type"`
+	State       AppState  `json:"state"`
+	Namespace   Namespace `json:"-"`
+	NamespaceID uint      `gorm:"not null" json:"-"`
+}
+
+type AppState map[string]any
+
+func (s AppState) Value() (driver.Value, error) {
+	v, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(v), nil
+}
+
+func (s *AppState) Scan(v interface{}) error {
+	var nullS sql.NullString
+	if err := nullS.Scan(v); err != nil {
+		return err
+	}
+	if nullS.Valid {
+		return json.Unmarshal([]byte(nullS.String), s)
+	}
+	return nil
+}
+
+func (s AppState) GormDataType() string {
+	return "text"
+}
+
+func NewUUID() string {
+	var r [32]byte
+	u := uuid.New()
+	hex.Encode(r[:], u[:])
+	return string(r[:])
+}
@@ -0,0 +1,27 @@
+package v_0015
+
+import (
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "8e2b4f6a1c93"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			// Track the last time the run reported any activity
+			if err := tx.Migrator().AddColumn(&Run{}, "LastSeenTime"); err != nil {
+				return eris.Wrap(err, "error adding last_seen_time column to runs table")
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
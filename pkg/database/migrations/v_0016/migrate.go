@@ -0,0 +1,30 @@
+package v_0016
+
+import (
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "5c7e9a3b2d18"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			// Auto-migrate to create the webhook tables
+			if err := tx.Migrator().AutoMigrate(
+				&Webhook{},
+				&WebhookDelivery{},
+			); err != nil {
+				return eris.Wrap(err, "error automigrating webhook tables")
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
@@ -0,0 +1,30 @@
+package v_0017
+
+import (
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "a4d61f0e7b25"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			// Auto-migrate to create the alert tables
+			if err := tx.Migrator().AutoMigrate(
+				&AlertRule{},
+				&Alert{},
+			); err != nil {
+				return eris.Wrap(err, "error automigrating alert tables")
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
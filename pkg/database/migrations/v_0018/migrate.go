@@ -0,0 +1,30 @@
+package v_0018
+
+import (
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "e3b8c51f9a07"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			// Auto-migrate to create the Aim tag tables
+			if err := tx.Migrator().AutoMigrate(
+				&AimTag{},
+				&AimRunTag{},
+			); err != nil {
+				return eris.Wrap(err, "error automigrating aim tag tables")
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
@@ -0,0 +1,29 @@
+package v_0019
+
+import (
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "7d2f4a9c1e6b"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			// Auto-migrate to create the Aim pinned sequences table
+			if err := tx.Migrator().AutoMigrate(
+				&AimPinnedSequence{},
+			); err != nil {
+				return eris.Wrap(err, "error automigrating aim pinned sequences table")
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
@@ -0,0 +1,39 @@
+package v_0020
+
+import (
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "b5e81c3f0d42"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			// Auto-migrate to create the images table
+			if err := tx.Migrator().AutoMigrate(
+				&Image{},
+			); err != nil {
+				return eris.Wrap(err, "error automigrating images table")
+			}
+			// The foreign key to runs is declared on the Run side, so it has to be created explicitly.
+			// SQLite recreates the table to add the constraint, which drops its indexes.
+			if err := tx.Migrator().CreateConstraint(&Run{}, "Images"); err != nil {
+				return eris.Wrap(err, "error creating images foreign key")
+			}
+			if !tx.Migrator().HasIndex(&Image{}, "RunID") {
+				if err := tx.Migrator().CreateIndex(&Image{}, "RunID"); err != nil {
+					return eris.Wrap(err, "error creating images run index")
+				}
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
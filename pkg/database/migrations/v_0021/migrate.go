@@ -0,0 +1,39 @@
+package v_0021
+
+import (
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "4c9a17e2d8b3"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			// Auto-migrate to create the texts table
+			if err := tx.Migrator().AutoMigrate(
+				&Text{},
+			); err != nil {
+				return eris.Wrap(err, "error automigrating texts table")
+			}
+			// The foreign key to runs is declared on the Run side, so it has to be created explicitly.
+			// SQLite recreates the table to add the constraint, which drops its indexes.
+			if err := tx.Migrator().CreateConstraint(&Run{}, "Texts"); err != nil {
+				return eris.Wrap(err, "error creating texts foreign key")
+			}
+			if !tx.Migrator().HasIndex(&Text{}, "RunID") {
+				if err := tx.Migrator().CreateIndex(&Text{}, "RunID"); err != nil {
+					return eris.Wrap(err, "error creating texts run index")
+				}
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
@@ -0,0 +1,39 @@
+package v_0022
+
+import (
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database/migrations"
+)
+
+const Version = "e1f3b6a5c270"
+
+func Migrate(db *gorm.DB) error {
+	return migrations.RunWithoutForeignKeyIfNeeded(db, func() error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			// Auto-migrate to create the distributions table
+			if err := tx.Migrator().AutoMigrate(
+				&Distribution{},
+			); err != nil {
+				return eris.Wrap(err, "error automigrating distributions table")
+			}
+			// The foreign key to runs is declared on the Run side, so it has to be created explicitly.
+			// SQLite recreates the table to add the constraint, which drops its indexes.
+			if err := tx.Migrator().CreateConstraint(&Run{}, "Distributions"); err != nil {
+				return eris.Wrap(err, "error creating distributions foreign key")
+			}
+			if !tx.Migrator().HasIndex(&Distribution{}, "RunID") {
+				if err := tx.Migrator().CreateIndex(&Distribution{}, "RunID"); err != nil {
+					return eris.Wrap(err, "error creating distributions run index")
+				}
+			}
+
+			// Update the schema version
+			return tx.Model(&SchemaVersion{}).
+				Where("1 = 1").
+				Update("Version", Version).
+				Error
+		})
+	})
+}
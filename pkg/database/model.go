@@ -40,15 +40,18 @@ const (
 )
 
 type Namespace struct {
-	ID                  uint           `gorm:"primaryKey;autoIncrement" json:"id"`
-	Apps                []App          `gorm:"constraint:OnDelete:CASCADE" json:"apps"`
-	Code                string         `gorm:"unique;index;not null" json:"code"`
-	Description         string         `json:"description"`
-	CreatedAt           time.Time      `json:"created_at"`
-	UpdatedAt           time.Time      `json:"updated_at"`
-	DeletedAt           gorm.DeletedAt `gorm:"index" json:"deleted_at"`
-	DefaultExperimentID *int32         `gorm:"not null" json:"default_experiment_id"`
-	Experiments         []Experiment   `gorm:"constraint:OnDelete:CASCADE" json:"experiments"`
+	ID                  uint                `gorm:"primaryKey;autoIncrement" json:"id"`
+	Apps                []App               `gorm:"constraint:OnDelete:CASCADE" json:"apps"`
+	Code                string              `gorm:"unique;index;not null" json:"code"`
+	Description         string              `json:"description"`
+	CreatedAt           time.Time           `json:"created_at"`
+	UpdatedAt           time.Time           `json:"updated_at"`
+	DeletedAt           gorm.DeletedAt      `gorm:"index" json:"deleted_at"`
+	DefaultExperimentID *int32              `gorm:"not null" json:"default_experiment_id"`
+	Experiments         []Experiment        `gorm:"constraint:OnDelete:CASCADE" json:"experiments"`
+	Webhooks            []Webhook           `gorm:"constraint:OnDelete:CASCADE" json:"webhooks"`
+	AimTags             []AimTag            `gorm:"constraint:OnDelete:CASCADE" json:"aim_tags"`
+	AimPinnedSequences  []AimPinnedSequence `gorm:"constraint:OnDelete:CASCADE" json:"aim_pinned_sequences"`
 }
 
 type Experiment struct {
@@ -62,6 +65,10 @@ type Experiment struct {
 	Namespace        Namespace
 	Tags             []ExperimentTag `gorm:"constraint:OnDelete:CASCADE"`
 	Runs             []Run           `gorm:"constraint:OnDelete:CASCADE"`
+	Datasets         []Dataset       `gorm:"constraint:OnDelete:CASCADE"`
+	Traces           []TraceInfo     `gorm:"constraint:OnDelete:CASCADE"`
+	LoggedModels     []LoggedModel   `gorm:"constraint:OnDelete:CASCADE"`
+	AlertRules       []AlertRule     `gorm:"constraint:OnDelete:CASCADE"`
 }
 
 // IsDefault makes check that Experiment is default.
@@ -92,11 +99,18 @@ type Run struct {
 	ExperimentID   int32
 	Experiment     Experiment
 	DeletedTime    sql.NullInt64  `gorm:"type:bigint"`
+	LastSeenTime   sql.NullInt64  `gorm:"type:bigint"`
 	RowNum         RowNum         `gorm:"<-:create;index"`
 	Params         []Param        `gorm:"constraint:OnDelete:CASCADE"`
 	Tags           []Tag          `gorm:"constraint:OnDelete:CASCADE"`
 	Metrics        []Metric       `gorm:"constraint:OnDelete:CASCADE"`
 	LatestMetrics  []LatestMetric `gorm:"constraint:OnDelete:CASCADE"`
+	Inputs         []Input        `gorm:"constraint:OnDelete:CASCADE"`
+	Alerts         []Alert        `gorm:"constraint:OnDelete:CASCADE"`
+	AimTags        []AimTag       `gorm:"many2many:aim_run_tags;joinForeignKey:RunUUID;constraint:OnDelete:CASCADE"`
+	Images         []Image        `gorm:"constraint:OnDelete:CASCADE"`
+	Texts          []Text         `gorm:"constraint:OnDelete:CASCADE"`
+	Distributions  []Distribution `gorm:"constraint:OnDelete:CASCADE"`
 }
 
 type RowNum int64
@@ -126,6 +140,182 @@ func (rn RowNum) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
 	}
 }
 
+type Dataset struct {
+	ID           string  `gorm:"column:dataset_uuid;type:varchar(36);not null;primaryKey"`
+	Name         string  `gorm:"type:varchar(500);not null;index:,unique,composite:dataset"`
+	Digest       string  `gorm:"type:varchar(36);not null;index:,unique,composite:dataset"`
+	SourceType   string  `gorm:"column:dataset_source_type;type:varchar(36);not null"`
+	Source       string  `gorm:"column:dataset_source;type:text;not null"`
+	Schema       string  `gorm:"column:dataset_schema;type:text"`
+	Profile      string  `gorm:"column:dataset_profile;type:text"`
+	ExperimentID int32   `gorm:"not null;index:,unique,composite:dataset"`
+	Inputs       []Input `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type Input struct {
+	ID        string     `gorm:"column:input_uuid;type:varchar(36);not null;primaryKey"`
+	DatasetID string     `gorm:"column:dataset_uuid;type:varchar(36);not null;index:,unique,composite:input"`
+	RunID     string     `gorm:"column:run_uuid;type:varchar(32);not null;index:,unique,composite:input"`
+	Tags      []InputTag `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type InputTag struct {
+	InputID string `gorm:"column:input_uuid;type:varchar(36);not null;primaryKey"`
+	Name    string `gorm:"type:varchar(255);not null;primaryKey"`
+	Value   string `gorm:"type:varchar(500);not null"`
+}
+
+type TraceStatus string
+
+const (
+	TraceStatusUnspecified TraceStatus = "TRACE_STATUS_UNSPECIFIED"
+	TraceStatusOK          TraceStatus = "OK"
+	TraceStatusError       TraceStatus = "ERROR"
+	TraceStatusInProgress  TraceStatus = "IN_PROGRESS"
+)
+
+type TraceInfo struct {
+	RequestID       string                 `gorm:"type:varchar(50);not null;primaryKey"`
+	ExperimentID    int32                  `gorm:"not null;index"`
+	TimestampMS     int64                  `gorm:"column:timestamp_ms;not null;index"`
+	ExecutionTimeMS sql.NullInt64          `gorm:"column:execution_time_ms"`
+	Status          TraceStatus            `gorm:"type:varchar(50);not null"`
+	Tags            []TraceTag             `gorm:"foreignKey:RequestID;constraint:OnDelete:CASCADE"`
+	RequestMetadata []TraceRequestMetadata `gorm:"foreignKey:RequestID;constraint:OnDelete:CASCADE"`
+}
+
+func (TraceInfo) TableName() string {
+	return "trace_info"
+}
+
+type TraceTag struct {
+	Key       string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value     string `gorm:"type:varchar(8000)"`
+	RequestID string `gorm:"type:varchar(50);not null;primaryKey;index"`
+}
+
+type TraceRequestMetadata struct {
+	Key       string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value     string `gorm:"type:varchar(8000)"`
+	RequestID string `gorm:"type:varchar(50);not null;primaryKey;index"`
+}
+
+func (TraceRequestMetadata) TableName() string {
+	return "trace_request_metadata"
+}
+
+type LoggedModelStatus string
+
+const (
+	LoggedModelStatusUnspecified  LoggedModelStatus = "LOGGED_MODEL_STATUS_UNSPECIFIED"
+	LoggedModelStatusPending      LoggedModelStatus = "LOGGED_MODEL_PENDING"
+	LoggedModelStatusReady        LoggedModelStatus = "LOGGED_MODEL_READY"
+	LoggedModelStatusUploadFailed LoggedModelStatus = "LOGGED_MODEL_UPLOAD_FAILED"
+)
+
+type LoggedModel struct {
+	ID                     string             `gorm:"column:model_id;type:varchar(50);not null;primaryKey"`
+	ExperimentID           int32              `gorm:"not null;index"`
+	Name                   string             `gorm:"type:varchar(500);not null"`
+	ArtifactLocation       string             `gorm:"type:varchar(1000)"`
+	CreationTimestampMS    int64              `gorm:"column:creation_timestamp_ms;not null"`
+	LastUpdatedTimestampMS int64              `gorm:"column:last_updated_timestamp_ms;not null"`
+	Status                 LoggedModelStatus  `gorm:"type:varchar(50);not null"`
+	StatusMessage          string             `gorm:"type:varchar(1000)"`
+	LifecycleStage         LifecycleStage     `gorm:"type:varchar(32);check:lifecycle_stage IN ('active', 'deleted')"`
+	ModelType              string             `gorm:"type:varchar(500)"`
+	SourceRunID            string             `gorm:"type:varchar(32)"`
+	Params                 []LoggedModelParam `gorm:"foreignKey:ModelID;constraint:OnDelete:CASCADE"`
+	Tags                   []LoggedModelTag   `gorm:"foreignKey:ModelID;constraint:OnDelete:CASCADE"`
+}
+
+type LoggedModelParam struct {
+	Key     string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value   string `gorm:"type:varchar(8000);not null"`
+	ModelID string `gorm:"type:varchar(50);not null;primaryKey;index"`
+}
+
+type LoggedModelTag struct {
+	Key     string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value   string `gorm:"type:varchar(8000)"`
+	ModelID string `gorm:"type:varchar(50);not null;primaryKey;index"`
+}
+
+type Webhook struct {
+	ID          uint   `gorm:"primaryKey;autoIncrement"`
+	NamespaceID uint   `gorm:"not null;index"`
+	URL         string `gorm:"type:varchar(2000);not null"`
+	Secret      string `gorm:"type:varchar(500);not null"`
+	Events      string `gorm:"type:varchar(1000);not null"`
+	Description string `gorm:"type:varchar(1000)"`
+	Active      bool   `gorm:"not null"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Deliveries  []WebhookDelivery `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+type WebhookDelivery struct {
+	ID            string                `gorm:"type:varchar(36);not null;primaryKey"`
+	WebhookID     uint                  `gorm:"not null;index"`
+	Event         string                `gorm:"type:varchar(100);not null"`
+	Payload       string                `gorm:"type:text;not null"`
+	Status        WebhookDeliveryStatus `gorm:"type:varchar(20);not null"`
+	Attempts      int                   `gorm:"not null"`
+	ResponseCode  int
+	Error         string    `gorm:"type:text"`
+	NextAttemptAt time.Time `gorm:"not null;index"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type AlertRuleCondition string
+
+const (
+	AlertRuleConditionIsNan              AlertRuleCondition = "IS_NAN"
+	AlertRuleConditionLessThan           AlertRuleCondition = "LESS_THAN"
+	AlertRuleConditionLessThanOrEqual    AlertRuleCondition = "LESS_THAN_OR_EQUAL"
+	AlertRuleConditionGreaterThan        AlertRuleCondition = "GREATER_THAN"
+	AlertRuleConditionGreaterThanOrEqual AlertRuleCondition = "GREATER_THAN_OR_EQUAL"
+	AlertRuleConditionNotLogged          AlertRuleCondition = "NOT_LOGGED"
+)
+
+type AlertRule struct {
+	ID             uint               `gorm:"primaryKey;autoIncrement"`
+	ExperimentID   int32              `gorm:"not null;index"`
+	Name           string             `gorm:"type:varchar(256);not null"`
+	Key            string             `gorm:"type:varchar(250);not null"`
+	Condition      AlertRuleCondition `gorm:"type:varchar(30);not null"`
+	Threshold      float64            `gorm:"not null"`
+	MinStep        int64              `gorm:"not null"`
+	WindowSeconds  int64              `gorm:"not null"`
+	Active         bool               `gorm:"not null"`
+	CreationTime   int64              `gorm:"not null"`
+	LastUpdateTime int64              `gorm:"not null"`
+	Alerts         []Alert            `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type Alert struct {
+	ID            string        `gorm:"type:varchar(36);not null;primaryKey"`
+	AlertRuleID   uint          `gorm:"not null;index:,unique,composite:rule_run"`
+	RunID         string        `gorm:"column:run_uuid;type:varchar(32);not null;index:,unique,composite:rule_run"`
+	Key           string        `gorm:"type:varchar(250);not null"`
+	Value         float64       `gorm:"not null"`
+	IsNan         bool          `gorm:"not null"`
+	Step          int64         `gorm:"not null"`
+	Timestamp     int64         `gorm:"not null"`
+	Message       string        `gorm:"type:varchar(1000);not null"`
+	CreationTime  int64         `gorm:"not null;index"`
+	DeliveredTime sql.NullInt64 `gorm:"type:bigint;index"`
+}
+
 type Param struct {
 	Key   string `gorm:"type:varchar(250);not null;primaryKey"`
 	Value string `gorm:"type:varchar(500);not null"`
@@ -148,6 +338,7 @@ type Metric struct {
 	Iter      int64   `gorm:"index"`
 	ContextID uint    `gorm:"not null;primaryKey"`
 	Context   Context
+	ModelID   sql.NullString `gorm:"type:varchar(50);index"`
 }
 
 type LatestMetric struct {
@@ -167,6 +358,121 @@ type Context struct {
 	Json datatypes.JSON `gorm:"not null;unique;index"`
 }
 
+type Image struct {
+	RunID     string `gorm:"column:run_uuid;type:varchar(32);not null;primaryKey;index"`
+	Name      string `gorm:"type:varchar(250);not null;primaryKey"`
+	ContextID uint   `gorm:"not null;primaryKey"`
+	Context   Context
+	Step      int64  `gorm:"not null;primaryKey"`
+	Index     int64  `gorm:"column:idx;not null;primaryKey"`
+	Timestamp int64  `gorm:"not null"`
+	Caption   string `gorm:"type:varchar(1000);not null"`
+	Format    string `gorm:"type:varchar(20);not null"`
+	Width     int64  `gorm:"not null"`
+	Height    int64  `gorm:"not null"`
+	BlobURI   string `gorm:"type:varchar(1000);not null"`
+}
+
+type Text struct {
+	RunID     string `gorm:"column:run_uuid;type:varchar(32);not null;primaryKey;index"`
+	Name      string `gorm:"type:varchar(250);not null;primaryKey"`
+	ContextID uint   `gorm:"not null;primaryKey"`
+	Context   Context
+	Step      int64  `gorm:"not null;primaryKey"`
+	Index     int64  `gorm:"column:idx;not null;primaryKey"`
+	Timestamp int64  `gorm:"not null"`
+	Data      string `gorm:"type:text;not null"`
+}
+
+type Distribution struct {
+	RunID     string `gorm:"column:run_uuid;type:varchar(32);not null;primaryKey;index"`
+	Name      string `gorm:"type:varchar(250);not null;primaryKey"`
+	ContextID uint   `gorm:"not null;primaryKey"`
+	Context   Context
+	Step      int64  `gorm:"not null;primaryKey"`
+	Timestamp int64  `gorm:"not null"`
+	BinEdges  []byte `gorm:"not null"`
+	Counts    []byte `gorm:"not null"`
+}
+
+type RegisteredModel struct {
+	ID              uint          `gorm:"primaryKey;autoIncrement"`
+	Name            string        `gorm:"type:varchar(256);not null;index:,unique,composite:name"`
+	Description     string        `gorm:"type:varchar(5000)"`
+	UserID          string        `gorm:"type:varchar(256)"`
+	CreationTime    sql.NullInt64 `gorm:"type:bigint"`
+	LastUpdatedTime sql.NullInt64 `gorm:"type:bigint"`
+	NamespaceID     uint          `gorm:"not null;index:,unique,composite:name"`
+	Namespace       Namespace
+	Tags            []RegisteredModelTag   `gorm:"constraint:OnDelete:CASCADE"`
+	Aliases         []RegisteredModelAlias `gorm:"constraint:OnDelete:CASCADE"`
+	Versions        []ModelVersion         `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type RegisteredModelTag struct {
+	Key               string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value             string `gorm:"type:varchar(5000)"`
+	RegisteredModelID uint   `gorm:"not null;primaryKey"`
+}
+
+type RegisteredModelAlias struct {
+	Alias             string `gorm:"type:varchar(256);not null;primaryKey"`
+	Version           int64  `gorm:"not null"`
+	RegisteredModelID uint   `gorm:"not null;primaryKey"`
+}
+
+//nolint:lll
+type ModelVersion struct {
+	ID                uint          `gorm:"primaryKey;autoIncrement"`
+	Version           int64         `gorm:"not null;index:,unique,composite:version"`
+	Description       string        `gorm:"type:varchar(5000)"`
+	UserID            string        `gorm:"type:varchar(256)"`
+	CurrentStage      string        `gorm:"type:varchar(20);not null;default:None"`
+	Source            string        `gorm:"type:varchar(500)"`
+	RunID             string        `gorm:"column:run_uuid;type:varchar(32);index"`
+	RunLink           string        `gorm:"type:varchar(500)"`
+	Status            string        `gorm:"type:varchar(20);check:status IN ('PENDING_REGISTRATION', 'FAILED_REGISTRATION', 'READY')"`
+	StatusMessage     string        `gorm:"type:varchar(500)"`
+	CreationTime      sql.NullInt64 `gorm:"type:bigint"`
+	LastUpdatedTime   sql.NullInt64 `gorm:"type:bigint"`
+	RegisteredModelID uint          `gorm:"not null;index:,unique,composite:version"`
+	RegisteredModel   RegisteredModel
+	Tags              []ModelVersionTag `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type ModelVersionTag struct {
+	Key            string `gorm:"type:varchar(250);not null;primaryKey"`
+	Value          string `gorm:"type:varchar(5000)"`
+	ModelVersionID uint   `gorm:"not null;primaryKey"`
+}
+
+type ModelVersionTransitionRequest struct {
+	ID              uint          `gorm:"primaryKey;autoIncrement"`
+	ToStage         string        `gorm:"type:varchar(20);not null"`
+	Status          string        `gorm:"type:varchar(20);not null;default:PENDING;check:status IN ('PENDING', 'APPROVED', 'REJECTED')"`
+	Comment         string        `gorm:"type:varchar(5000)"`
+	UserID          string        `gorm:"type:varchar(256)"`
+	ReviewerID      string        `gorm:"type:varchar(256)"`
+	ReviewComment   string        `gorm:"type:varchar(5000)"`
+	CreationTime    sql.NullInt64 `gorm:"type:bigint"`
+	LastUpdatedTime sql.NullInt64 `gorm:"type:bigint"`
+	ModelVersionID  uint          `gorm:"not null;index"`
+	ModelVersion    ModelVersion
+}
+
+type ModelVersionTransition struct {
+	ID                  uint          `gorm:"primaryKey;autoIncrement"`
+	FromStage           string        `gorm:"type:varchar(20);not null"`
+	ToStage             string        `gorm:"type:varchar(20);not null"`
+	UserID              string        `gorm:"type:varchar(256)"`
+	Comment             string        `gorm:"type:varchar(5000)"`
+	CreationTime        sql.NullInt64 `gorm:"type:bigint"`
+	TransitionRequestID *uint
+	TransitionRequest   *ModelVersionTransitionRequest
+	ModelVersionID      uint `gorm:"not null;index"`
+	ModelVersion        ModelVersion
+}
+
 type AlembicVersion struct {
 	Version string `gorm:"column:version_num;type:varchar(32);not null;primaryKey"`
 }
@@ -230,6 +536,25 @@ type App struct {
 
 type AppState map[string]any
 
+// AimTag is a label of the Aim UI, which can be attached to the runs of the same namespace.
+type AimTag struct {
+	Base
+	Name        string    `gorm:"not null;index:,unique,composite:name" json:"name"`
+	Color       string    `json:"color"`
+	Description string    `json:"description"`
+	Namespace   Namespace `json:"-"`
+	NamespaceID uint      `gorm:"not null;index:,unique,composite:name" json:"-"`
+}
+
+// AimPinnedSequence is a sequence pinned in the Aim UI, kept in pinning order per namespace.
+type AimPinnedSequence struct {
+	ID          uint           `gorm:"primaryKey;autoIncrement"`
+	Name        string         `gorm:"type:varchar(250);not null"`
+	Context     datatypes.JSON `gorm:"not null"`
+	Namespace   Namespace
+	NamespaceID uint `gorm:"not null;index"`
+}
+
 func (s AppState) Value() (driver.Value, error) {
 	v, err := json.Marshal(s)
 	if err != nil {
@@ -3,7 +3,9 @@ package server
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"regexp"
 	"strings"
 	"time"
 
@@ -19,20 +21,29 @@ import (
 	adminAPI "github.com/G-Research/fasttrackml/pkg/api/admin"
 	adminAPIController "github.com/G-Research/fasttrackml/pkg/api/admin/controller"
 	"github.com/G-Research/fasttrackml/pkg/api/admin/service/namespace"
+	adminWebhook "github.com/G-Research/fasttrackml/pkg/api/admin/service/webhook"
 	aimAPI "github.com/G-Research/fasttrackml/pkg/api/aim"
 	mlflowAPI "github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	mlflowCommon "github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
 	mlflowConfig "github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
 	mlflowController "github.com/G-Research/fasttrackml/pkg/api/mlflow/controller"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 	mlflowRepositories "github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
 	mlflowService "github.com/G-Research/fasttrackml/pkg/api/mlflow/service"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/alert"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/dataset"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/experiment"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/loggedmodel"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/metric"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/model"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/run"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/sequence"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/sweeper"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/trace"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/webhook"
 	namespaceMiddleware "github.com/G-Research/fasttrackml/pkg/common/middleware/namespace"
 	"github.com/G-Research/fasttrackml/pkg/database"
 	adminUI "github.com/G-Research/fasttrackml/pkg/ui/admin"
@@ -44,6 +55,15 @@ import (
 	"github.com/G-Research/fasttrackml/pkg/version"
 )
 
+// bodyLimit is a maximum size of request body. Only proxied artifact uploads are allowed to exceed it.
+const bodyLimit = 16 * 1024 * 1024
+
+// List of path patterns of the `mlflow` api. Paths could be prefixed with the namespace and ui prefix.
+var (
+	mlflowPathRegexp          = regexp.MustCompile(`^(/ns/[^/]+)?(/mlflow)?/(api|ajax-api)/2\.0/mlflow(-artifacts)?/`)
+	proxiedArtifactPathRegexp = regexp.MustCompile(`^(/ns/[^/]+)?(/mlflow)?/(api|ajax-api)/2\.0/mlflow-artifacts/`)
+)
+
 type Server interface {
 	Listen(address string) error
 	ShutdownWithTimeout(timeout time.Duration) error
@@ -76,9 +96,26 @@ func NewServer(ctx context.Context, config *mlflowConfig.ServiceConfig) (Server,
 		return nil, err
 	}
 
+	// create webhook dispatcher, which is shared by the services emitting the events,
+	// and start delivering the pending events.
+	webhookDispatcher := webhook.NewDispatcher(config, mlflowRepositories.NewWebhookRepository(db.GormDB()))
+	go webhookDispatcher.Start(ctx)
+
+	// start stale run sweeper, if it was enabled.
+	if config.StaleRunTimeout > 0 {
+		go sweeper.NewService(
+			config, mlflowRepositories.NewRunRepository(db.GormDB()), webhookDispatcher,
+		).Start(ctx)
+	}
+
+	// start alert notifier, if it was enabled.
+	if config.AlertInterval > 0 {
+		go alert.NewNotifier(config, mlflowRepositories.NewAlertRepository(db.GormDB())).Start(ctx)
+	}
+
 	// create fiber app.
 	//nolint:contextcheck
-	app := createApp(config, db, artifactStorageFactory, namespaceRepository)
+	app := createApp(config, db, artifactStorageFactory, namespaceRepository, webhookDispatcher)
 
 	return server{app}, nil
 }
@@ -148,9 +185,11 @@ func createApp(
 	db database.DBProvider,
 	artifactStorageFactory storage.ArtifactStorageFactoryProvider,
 	namespaceRepository repositories.NamespaceRepositoryProvider,
+	webhookDispatcher webhook.DispatcherProvider,
 ) *fiber.App {
 	app := fiber.New(fiber.Config{
-		BodyLimit:             16 * 1024 * 1024,
+		BodyLimit:             bodyLimit,
+		StreamRequestBody:     true,
 		ReadBufferSize:        16384,
 		ReadTimeout:           5 * time.Second,
 		WriteTimeout:          600 * time.Second,
@@ -162,9 +201,9 @@ func createApp(
 			switch {
 			case strings.HasPrefix(p, "/aim/api/"):
 				return aimAPI.ErrorHandler(c, err)
-			case strings.HasPrefix(p, "/api/2.0/mlflow/") ||
-				strings.HasPrefix(p, "/ajax-api/2.0/mlflow/") ||
-				strings.HasPrefix(p, "/mlflow/ajax-api/2.0/mlflow/"):
+			case mlflowPathRegexp.MatchString(p),
+				strings.HasPrefix(p, "/admin/webhooks/"),
+				strings.HasPrefix(p, "/admin/transition-requests/"):
 				return mlflowService.ErrorHandler(c, err)
 
 			default:
@@ -173,6 +212,8 @@ func createApp(
 		},
 	})
 
+	app.Use(limitRequestBody)
+
 	app.Hooks().OnShutdown(func() error {
 		log.Info("Shutting down database connection")
 		return db.Close()
@@ -183,11 +224,10 @@ func createApp(
 		app.Use(cors.New())
 	}
 
-	if config.AuthUsername != "" && config.AuthPassword != "" {
+	if users := config.GetAuthUsers(); len(users) > 0 {
 		app.Use(basicauth.New(basicauth.Config{
-			Users: map[string]string{
-				config.AuthUsername: config.AuthPassword,
-			},
+			Users:           users,
+			ContextUsername: mlflowCommon.UserContextKey,
 		}))
 	}
 
@@ -214,23 +254,36 @@ func createApp(
 		return c.SendString(version.Version)
 	})
 
+	// init run service, which is shared by `aim` and `mlflow` api.
+	runService := run.NewService(
+		config,
+		mlflowRepositories.NewTagRepository(db.GormDB()),
+		mlflowRepositories.NewRunRepository(db.GormDB()),
+		mlflowRepositories.NewParamRepository(db.GormDB()),
+		mlflowRepositories.NewMetricRepository(db.GormDB()),
+		mlflowRepositories.NewExperimentRepository(db.GormDB()),
+		namespaceRepository,
+		webhookDispatcher,
+		alert.NewEvaluator(mlflowRepositories.NewAlertRepository(db.GormDB())),
+		artifactStorageFactory,
+	)
+
 	// init `aim` api and ui routes.
 	router := app.Group("/aim/api/")
-	aimAPI.AddRoutes(router)
+	aimAPI.AddRoutes(router, runService, artifactStorageFactory)
 	aimUI.AddRoutes(app)
 
 	// init `mlflow` api and ui routes.
 	// TODO:DSuhinin right now it might look scary. we prettify it a bit later.
 	mlflowAPI.NewRouter(
 		mlflowController.NewController(
-			run.NewService(
-				mlflowRepositories.NewTagRepository(db.GormDB()),
-				mlflowRepositories.NewRunRepository(db.GormDB()),
-				mlflowRepositories.NewParamRepository(db.GormDB()),
-				mlflowRepositories.NewMetricRepository(db.GormDB()),
-				mlflowRepositories.NewExperimentRepository(db.GormDB()),
+			runService,
+			model.NewService(
+				config,
+				mlflowRepositories.NewModelVersionRepository(db.GormDB()),
+				mlflowRepositories.NewRegisteredModelRepository(db.GormDB()),
+				mlflowRepositories.NewModelVersionTransitionRepository(db.GormDB()),
 			),
-			model.NewService(),
 			metric.NewService(
 				mlflowRepositories.NewRunRepository(db.GormDB()),
 				mlflowRepositories.NewMetricRepository(db.GormDB()),
@@ -243,6 +296,30 @@ func createApp(
 				config,
 				mlflowRepositories.NewTagRepository(db.GormDB()),
 				mlflowRepositories.NewExperimentRepository(db.GormDB()),
+				webhookDispatcher,
+			),
+			dataset.NewService(
+				mlflowRepositories.NewRunRepository(db.GormDB()),
+				mlflowRepositories.NewDatasetRepository(db.GormDB()),
+			),
+			trace.NewService(
+				mlflowRepositories.NewTraceRepository(db.GormDB()),
+				mlflowRepositories.NewExperimentRepository(db.GormDB()),
+				artifactStorageFactory,
+			),
+			loggedmodel.NewService(
+				mlflowRepositories.NewLoggedModelRepository(db.GormDB()),
+				mlflowRepositories.NewExperimentRepository(db.GormDB()),
+			),
+			alert.NewService(
+				mlflowRepositories.NewAlertRepository(db.GormDB()),
+				mlflowRepositories.NewExperimentRepository(db.GormDB()),
+			),
+			sequence.NewService(
+				mlflowRepositories.NewRunRepository(db.GormDB()),
+				mlflowRepositories.NewImageRepository(db.GormDB()),
+				mlflowRepositories.NewTextRepository(db.GormDB()),
+				mlflowRepositories.NewDistributionRepository(db.GormDB()),
 			),
 		),
 	).Init(app)
@@ -256,6 +333,15 @@ func createApp(
 				namespaceRepository,
 				mlflowRepositories.NewExperimentRepository(db.GormDB()),
 			),
+			adminWebhook.NewService(
+				mlflowRepositories.NewWebhookRepository(db.GormDB()),
+			),
+			model.NewService(
+				config,
+				mlflowRepositories.NewModelVersionRepository(db.GormDB()),
+				mlflowRepositories.NewRegisteredModelRepository(db.GormDB()),
+				mlflowRepositories.NewModelVersionTransitionRepository(db.GormDB()),
+			),
 		),
 	).Init(app)
 
@@ -283,3 +369,37 @@ func createApp(
 
 	return app
 }
+
+// limitRequestBody enforces body limit for all the requests except proxied artifact uploads.
+// Request bodies are streamed by the server, so bigger bodies are not rejected before reaching handlers.
+func limitRequestBody(c *fiber.Ctx) error {
+	if c.Method() == fiber.MethodPut && proxiedArtifactPathRegexp.Match(c.Request().URI().Path()) {
+		// unread rest of the failed upload must not be treated as the next request on the same connection.
+		// errors could be already handled by the inner middlewares, so the response status is checked instead.
+		err := c.Next()
+		if err != nil || c.Response().StatusCode() != fiber.StatusOK {
+			c.Context().SetConnectionClose()
+		}
+		return err
+	}
+
+	if c.Request().Header.ContentLength() > bodyLimit {
+		c.Context().SetConnectionClose()
+		return c.SendStatus(fiber.StatusRequestEntityTooLarge)
+	}
+
+	// all the other handlers expect the whole body to be read in advance.
+	if c.Request().IsBodyStream() {
+		body, err := io.ReadAll(io.LimitReader(c.Request().BodyStream(), bodyLimit+1))
+		if err != nil {
+			return eris.Wrap(err, "error reading request body")
+		}
+		if len(body) > bodyLimit {
+			c.Context().SetConnectionClose()
+			return c.SendStatus(fiber.StatusRequestEntityTooLarge)
+		}
+		c.Request().SetBody(body)
+	}
+
+	return c.Next()
+}
@@ -0,0 +1,119 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/admin/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/admin/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CreateWebhookTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestCreateWebhookTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateWebhookTestSuite))
+}
+
+func (s *CreateWebhookTestSuite) Test_Ok() {
+	tests := []struct {
+		name    string
+		request request.WebhookRequest
+	}{
+		{
+			name: "WithSecret",
+			request: request.WebhookRequest{
+				URL:         "http://localhost:8080/hooks",
+				Secret:      "secret",
+				Events:      []string{"run.finished", "run.failed"},
+				Description: "ci",
+			},
+		},
+		{
+			name: "WithGeneratedSecret",
+			request: request.WebhookRequest{
+				URL:    "https://example.com/hooks",
+				Events: []string{"experiment.created"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.CreateWebhook
+			s.Require().Nil(
+				s.AdminClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest("/webhooks/create"),
+			)
+			s.NotZero(resp.ID)
+			s.Equal(tt.request.URL, resp.URL)
+			s.Equal(tt.request.Events, resp.Events)
+			s.Equal(tt.request.Description, resp.Description)
+			s.True(resp.Active)
+			if tt.request.Secret != "" {
+				s.Equal(tt.request.Secret, resp.Secret)
+			} else {
+				s.Len(resp.Secret, 64)
+			}
+
+			webhooks, err := s.WebhookFixtures.GetWebhooks(context.Background(), s.DefaultNamespace.ID)
+			s.Require().Nil(err)
+			s.Require().NotEmpty(webhooks)
+			webhook := webhooks[len(webhooks)-1]
+			s.Equal(resp.ID, webhook.ID)
+			s.Equal(resp.Secret, webhook.Secret)
+		})
+	}
+}
+
+func (s *CreateWebhookTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.WebhookRequest
+	}{
+		{
+			name:  "InvalidURL",
+			error: api.NewInvalidParameterValueError("webhook url is invalid -- must be an absolute http(s) url"),
+			request: request.WebhookRequest{
+				URL:    "localhost:8080",
+				Events: []string{"run.finished"},
+			},
+		},
+		{
+			name:  "UnsupportedEvent",
+			error: api.NewInvalidParameterValueError("Unsupported webhook event 'run.started'"),
+			request: request.WebhookRequest{
+				URL:    "http://localhost:8080",
+				Events: []string{"run.started"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp api.ErrorResponse
+			client := s.AdminClient()
+			s.Require().Nil(
+				client.WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest("/webhooks/create"),
+			)
+			s.Equal(http.StatusBadRequest, client.GetStatusCode())
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
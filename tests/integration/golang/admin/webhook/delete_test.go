@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteWebhookTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDeleteWebhookTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteWebhookTestSuite))
+}
+
+func (s *DeleteWebhookTestSuite) Test_Ok() {
+	webhook, err := s.WebhookFixtures.CreateWebhook(context.Background(), &models.Webhook{
+		NamespaceID: s.DefaultNamespace.ID,
+		URL:         "http://localhost:8080",
+		Secret:      "secret",
+		Events:      "run.finished",
+		Active:      true,
+	})
+	s.Require().Nil(err)
+
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.AdminClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&resp,
+		).DoRequest("/webhooks/%d", webhook.ID),
+	)
+	s.Empty(resp)
+
+	webhooks, err := s.WebhookFixtures.GetWebhooks(context.Background(), s.DefaultNamespace.ID)
+	s.Require().Nil(err)
+	s.Empty(webhooks)
+}
+
+func (s *DeleteWebhookTestSuite) Test_Error() {
+	var resp api.ErrorResponse
+	client := s.AdminClient()
+	s.Require().Nil(
+		client.WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&resp,
+		).DoRequest("/webhooks/%d", 1000),
+	)
+	s.Equal(http.StatusNotFound, client.GetStatusCode())
+	s.Equal(api.NewResourceDoesNotExistError("unable to find webhook '1000'").Error(), resp.Error())
+}
@@ -0,0 +1,287 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/admin/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/webhook"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+type WebhookDeliveriesTestSuite struct {
+	helpers.BaseTestSuite
+	receiver *httptest.Server
+	requests chan receivedRequest
+	status   atomic.Int32
+}
+
+func TestWebhookDeliveriesTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookDeliveriesTestSuite))
+}
+
+func (s *WebhookDeliveriesTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+	s.status.Store(http.StatusOK)
+	s.requests = make(chan receivedRequest, 10)
+	s.receiver = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		s.Require().Nil(err)
+		s.requests <- receivedRequest{header: r.Header, body: body}
+		w.WriteHeader(int(s.status.Load()))
+	}))
+}
+
+func (s *WebhookDeliveriesTestSuite) TearDownTest() {
+	s.receiver.Close()
+	s.BaseTestSuite.TearDownTest()
+}
+
+func (s *WebhookDeliveriesTestSuite) receive() (receivedRequest, webhook.Payload) {
+	select {
+	case req := <-s.requests:
+		var payload webhook.Payload
+		s.Require().Nil(json.Unmarshal(req.body, &payload))
+		return req, payload
+	case <-time.After(5 * time.Second):
+		s.FailNow("webhook request hasn't been received")
+		return receivedRequest{}, webhook.Payload{}
+	}
+}
+
+func (s *WebhookDeliveriesTestSuite) getDeliveries(id uint) response.ListWebhookDeliveries {
+	var deliveries response.ListWebhookDeliveries
+	if err := s.AdminClient().WithResponse(&deliveries).DoRequest("/webhooks/%d/deliveries", id); err != nil {
+		return nil
+	}
+	return deliveries
+}
+
+func (s *WebhookDeliveriesTestSuite) Test_Ok() {
+	hook, err := s.WebhookFixtures.CreateWebhook(context.Background(), &models.Webhook{
+		NamespaceID: s.DefaultNamespace.ID,
+		URL:         s.receiver.URL,
+		Secret:      "secret",
+		Events:      "run.finished,run.deleted,experiment.created",
+		Active:      true,
+	})
+	s.Require().Nil(err)
+
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "run",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	// finish the run.
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.UpdateRunRequest{
+				RunID:   run.ID,
+				Name:    "run",
+				Status:  string(models.StatusFinished),
+				EndTime: 1234567890,
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest("%s%s", mlflow.RunsRoutePrefix, mlflow.RunsUpdateRoute),
+	)
+	req, payload := s.receive()
+	s.Equal("run.finished", req.header.Get(webhook.HeaderEvent))
+	s.Equal(payload.ID, req.header.Get(webhook.HeaderDelivery))
+	s.Equal(webhook.Sign("secret", req.body), req.header.Get(webhook.HeaderSignature))
+	s.Equal(models.WebhookEventRunFinished, payload.Event)
+	s.Equal(models.DefaultNamespaceCode, payload.Namespace)
+	s.Equal(map[string]any{
+		"run_id":          run.ID,
+		"run_name":        "run",
+		"experiment_id":   "0",
+		"status":          "FINISHED",
+		"lifecycle_stage": "active",
+		"end_time":        float64(1234567890),
+	}, payload.Data)
+
+	// delete the run.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.DeleteRunRequest{RunID: run.ID},
+		).WithResponse(
+			&resp,
+		).DoRequest("%s%s", mlflow.RunsRoutePrefix, mlflow.RunsDeleteRoute),
+	)
+	_, payload = s.receive()
+	s.Equal(models.WebhookEventRunDeleted, payload.Event)
+
+	// create an experiment.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateExperimentRequest{Name: "experiment"},
+		).WithResponse(
+			&resp,
+		).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsCreateRoute),
+	)
+	_, payload = s.receive()
+	s.Equal(models.WebhookEventExperimentCreated, payload.Event)
+	s.Equal("experiment", payload.Data.(map[string]any)["name"])
+
+	// restoring the run is not subscribed to.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.RestoreRunRequest{RunID: run.ID},
+		).WithResponse(
+			&resp,
+		).DoRequest("%s%s", mlflow.RunsRoutePrefix, mlflow.RunsRestoreRoute),
+	)
+
+	s.Eventually(func() bool {
+		deliveries := s.getDeliveries(hook.ID)
+		if len(deliveries) != 3 {
+			return false
+		}
+		for _, delivery := range deliveries {
+			if delivery.Status != string(models.WebhookDeliveryStatusSucceeded) {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+	s.Empty(s.requests)
+}
+
+func (s *WebhookDeliveriesTestSuite) Test_Error() {
+	s.status.Store(http.StatusServiceUnavailable)
+	hook, err := s.WebhookFixtures.CreateWebhook(context.Background(), &models.Webhook{
+		NamespaceID: s.DefaultNamespace.ID,
+		URL:         s.receiver.URL,
+		Secret:      "secret",
+		Events:      "experiment.created",
+		Active:      true,
+	})
+	s.Require().Nil(err)
+
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateExperimentRequest{Name: "experiment"},
+		).WithResponse(
+			&resp,
+		).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsCreateRoute),
+	)
+
+	// delivery is retried until the configured number of attempts is reached.
+	s.Eventually(func() bool {
+		deliveries := s.getDeliveries(hook.ID)
+		return len(deliveries) == 1 && deliveries[0].Status == string(models.WebhookDeliveryStatusFailed)
+	}, 5*time.Second, 10*time.Millisecond)
+	deliveries := s.getDeliveries(hook.ID)
+	s.Require().Len(deliveries, 1)
+	s.Equal(3, deliveries[0].Attempts)
+	s.Equal(http.StatusServiceUnavailable, deliveries[0].ResponseCode)
+	s.Equal("unexpected response status: 503", deliveries[0].Error)
+	s.Len(s.requests, 3)
+}
+
+func (s *WebhookDeliveriesTestSuite) Test_Backlog() {
+	hook, err := s.WebhookFixtures.CreateWebhook(context.Background(), &models.Webhook{
+		NamespaceID: s.DefaultNamespace.ID,
+		URL:         s.receiver.URL,
+		Secret:      "secret",
+		Events:      "experiment.created",
+		Active:      true,
+	})
+	s.Require().Nil(err)
+
+	// fill more than a whole batch with the deliveries, which wait for their retry,
+	// so they would hold back new deliveries, if they were fetched first.
+	for i := 0; i < 150; i++ {
+		_, err := s.WebhookFixtures.CreateDelivery(context.Background(), &models.WebhookDelivery{
+			ID:            uuid.New().String(),
+			WebhookID:     hook.ID,
+			Event:         models.WebhookEventExperimentCreated,
+			Payload:       "{}",
+			Status:        models.WebhookDeliveryStatusPending,
+			Attempts:      1,
+			NextAttemptAt: time.Now().UTC().Add(time.Hour),
+		})
+		s.Require().Nil(err)
+	}
+
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateExperimentRequest{Name: "experiment"},
+		).WithResponse(
+			&resp,
+		).DoRequest("%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsCreateRoute),
+	)
+	_, payload := s.receive()
+	s.Equal(models.WebhookEventExperimentCreated, payload.Event)
+	s.Equal("experiment", payload.Data.(map[string]any)["name"])
+	s.Empty(s.requests)
+}
+
+func (s *WebhookDeliveriesTestSuite) Test_Claim() {
+	hook, err := s.WebhookFixtures.CreateWebhook(context.Background(), &models.Webhook{
+		NamespaceID: s.DefaultNamespace.ID,
+		URL:         s.receiver.URL,
+		Secret:      "secret",
+		Events:      "experiment.created",
+		Active:      true,
+	})
+	s.Require().Nil(err)
+
+	delivery, err := s.WebhookFixtures.CreateDelivery(context.Background(), &models.WebhookDelivery{
+		ID:            uuid.New().String(),
+		WebhookID:     hook.ID,
+		Event:         models.WebhookEventExperimentCreated,
+		Payload:       "{}",
+		Status:        models.WebhookDeliveryStatusPending,
+		NextAttemptAt: time.Now().UTC().Add(time.Hour),
+	})
+	s.Require().Nil(err)
+
+	// both workers have read the same delivery, only the first one claims it.
+	first, second := *delivery, *delivery
+	claimed, err := s.WebhookFixtures.ClaimDelivery(context.Background(), &first, time.Now().UTC().Add(2*time.Hour))
+	s.Require().Nil(err)
+	s.True(claimed)
+	claimed, err = s.WebhookFixtures.ClaimDelivery(context.Background(), &second, time.Now().UTC().Add(2*time.Hour))
+	s.Require().Nil(err)
+	s.False(claimed)
+	s.Equal(delivery.NextAttemptAt, second.NextAttemptAt)
+}
@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/admin/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetWebhookTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetWebhookTestSuite(t *testing.T) {
+	suite.Run(t, new(GetWebhookTestSuite))
+}
+
+func (s *GetWebhookTestSuite) Test_Ok() {
+	webhook, err := s.WebhookFixtures.CreateWebhook(context.Background(), &models.Webhook{
+		NamespaceID: s.DefaultNamespace.ID,
+		URL:         "http://localhost:8080",
+		Secret:      "secret",
+		Events:      "run.finished,run.deleted",
+		Active:      true,
+	})
+	s.Require().Nil(err)
+
+	var resp response.Webhook
+	s.Require().Nil(s.AdminClient().WithResponse(&resp).DoRequest("/webhooks/%d", webhook.ID))
+	s.Equal(webhook.ID, resp.ID)
+	s.Equal("http://localhost:8080", resp.URL)
+	s.Equal([]string{"run.finished", "run.deleted"}, resp.Events)
+	s.True(resp.Active)
+
+	// the secret is never returned, once the webhook has been created.
+	var raw map[string]any
+	s.Require().Nil(s.AdminClient().WithResponse(&raw).DoRequest("/webhooks/%d", webhook.ID))
+	s.NotContains(raw, "secret")
+}
+
+func (s *GetWebhookTestSuite) Test_Error() {
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		ID:                  2,
+		Code:                "other",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	webhook, err := s.WebhookFixtures.CreateWebhook(context.Background(), &models.Webhook{
+		NamespaceID: namespace.ID,
+		URL:         "http://localhost:8080",
+		Secret:      "secret",
+		Events:      "run.finished",
+		Active:      true,
+	})
+	s.Require().Nil(err)
+
+	// webhooks of the other namespaces are not visible.
+	var resp api.ErrorResponse
+	client := s.AdminClient()
+	s.Require().Nil(client.WithResponse(&resp).DoRequest("/webhooks/%d", webhook.ID))
+	s.Equal(http.StatusNotFound, client.GetStatusCode())
+	s.Equal(
+		api.NewResourceDoesNotExistError("unable to find webhook '%d'", webhook.ID).Error(),
+		resp.Error(),
+	)
+}
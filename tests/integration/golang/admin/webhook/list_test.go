@@ -0,0 +1,48 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/admin/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type ListWebhooksTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestListWebhooksTestSuite(t *testing.T) {
+	suite.Run(t, new(ListWebhooksTestSuite))
+}
+
+func (s *ListWebhooksTestSuite) Test_Ok() {
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		ID:                  2,
+		Code:                "other",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+
+	for _, namespaceID := range []uint{s.DefaultNamespace.ID, namespace.ID, namespace.ID} {
+		_, err := s.WebhookFixtures.CreateWebhook(context.Background(), &models.Webhook{
+			NamespaceID: namespaceID,
+			URL:         "http://localhost:8080",
+			Secret:      "secret",
+			Events:      "run.finished",
+			Active:      true,
+		})
+		s.Require().Nil(err)
+	}
+
+	var resp response.ListWebhooks
+	s.Require().Nil(s.AdminClient().WithResponse(&resp).DoRequest("/webhooks/list"))
+	s.Len(resp, 1)
+
+	s.Require().Nil(s.AdminClient().WithNamespace(namespace.Code).WithResponse(&resp).DoRequest("/webhooks/list"))
+	s.Len(resp, 2)
+}
@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/admin/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/admin/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type UpdateWebhookTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestUpdateWebhookTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateWebhookTestSuite))
+}
+
+func (s *UpdateWebhookTestSuite) Test_Ok() {
+	webhook, err := s.WebhookFixtures.CreateWebhook(context.Background(), &models.Webhook{
+		NamespaceID: s.DefaultNamespace.ID,
+		URL:         "http://localhost:8080",
+		Secret:      "secret",
+		Events:      "run.finished",
+		Active:      true,
+	})
+	s.Require().Nil(err)
+
+	var resp response.Webhook
+	s.Require().Nil(
+		s.AdminClient().WithMethod(
+			http.MethodPut,
+		).WithRequest(
+			request.WebhookRequest{
+				URL:         "http://localhost:9090",
+				Events:      []string{"experiment.created", "experiment.deleted"},
+				Description: "updated",
+				Active:      common.GetPointer(false),
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest("/webhooks/%d", webhook.ID),
+	)
+	s.Equal("http://localhost:9090", resp.URL)
+	s.Equal([]string{"experiment.created", "experiment.deleted"}, resp.Events)
+	s.Equal("updated", resp.Description)
+	s.False(resp.Active)
+
+	webhooks, err := s.WebhookFixtures.GetWebhooks(context.Background(), s.DefaultNamespace.ID)
+	s.Require().Nil(err)
+	s.Require().Len(webhooks, 1)
+	s.Equal("experiment.created,experiment.deleted", webhooks[0].Events)
+	s.Equal("secret", webhooks[0].Secret)
+	s.False(webhooks[0].Active)
+}
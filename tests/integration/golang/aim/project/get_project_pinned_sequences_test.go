@@ -0,0 +1,48 @@
+package run
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetProjectPinnedSequencesTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetProjectPinnedSequencesTestSuite(t *testing.T) {
+	suite.Run(t, new(GetProjectPinnedSequencesTestSuite))
+}
+
+func (s *GetProjectPinnedSequencesTestSuite) Test_Ok() {
+	var resp response.ProjectPinnedSequencesResponse
+	s.Require().Nil(s.AIMClient().WithResponse(&resp).DoRequest("/projects/pinned-sequences"))
+	s.Equal([]response.ProjectPinnedSequence{}, resp.Sequences)
+
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.UpdateProjectPinnedSequencesRequest{
+				Sequences: []request.PinnedSequence{
+					{Name: "loss", Context: map[string]any{"subset": "train"}},
+					{Name: "accuracy", Context: map[string]any{}},
+				},
+			},
+		).DoRequest(
+			"/projects/pinned-sequences",
+		),
+	)
+
+	resp = response.ProjectPinnedSequencesResponse{}
+	s.Require().Nil(s.AIMClient().WithResponse(&resp).DoRequest("/projects/pinned-sequences"))
+	s.Equal([]response.ProjectPinnedSequence{
+		{Name: "loss", Context: map[string]any{"subset": "train"}},
+		{Name: "accuracy", Context: map[string]any{}},
+	}, resp.Sequences)
+}
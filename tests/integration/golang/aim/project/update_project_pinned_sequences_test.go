@@ -0,0 +1,99 @@
+package run
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type UpdateProjectPinnedSequencesTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestUpdateProjectPinnedSequencesTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateProjectPinnedSequencesTestSuite))
+}
+
+func (s *UpdateProjectPinnedSequencesTestSuite) Test_Ok() {
+	tests := []struct {
+		name     string
+		request  request.UpdateProjectPinnedSequencesRequest
+		expected []response.ProjectPinnedSequence
+	}{
+		{
+			name: "PinSequences",
+			request: request.UpdateProjectPinnedSequencesRequest{
+				Sequences: []request.PinnedSequence{
+					{Name: "loss", Context: map[string]any{"subset": "train"}},
+					{Name: "loss", Context: map[string]any{"subset": "val"}},
+				},
+			},
+			expected: []response.ProjectPinnedSequence{
+				{Name: "loss", Context: map[string]any{"subset": "train"}},
+				{Name: "loss", Context: map[string]any{"subset": "val"}},
+			},
+		},
+		{
+			name: "ReplaceSequences",
+			request: request.UpdateProjectPinnedSequencesRequest{
+				Sequences: []request.PinnedSequence{
+					{Name: "accuracy"},
+				},
+			},
+			expected: []response.ProjectPinnedSequence{
+				{Name: "accuracy", Context: map[string]any{}},
+			},
+		},
+		{
+			name:     "ClearSequences",
+			request:  request.UpdateProjectPinnedSequencesRequest{},
+			expected: []response.ProjectPinnedSequence{},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.ProjectPinnedSequencesResponse
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/projects/pinned-sequences",
+				),
+			)
+			s.Equal(tt.expected, resp.Sequences)
+
+			resp = response.ProjectPinnedSequencesResponse{}
+			s.Require().Nil(s.AIMClient().WithResponse(&resp).DoRequest("/projects/pinned-sequences"))
+			s.Equal(tt.expected, resp.Sequences)
+		})
+	}
+}
+
+func (s *UpdateProjectPinnedSequencesTestSuite) Test_Error() {
+	var resp response.Error
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.UpdateProjectPinnedSequencesRequest{
+				Sequences: []request.PinnedSequence{
+					{Context: map[string]any{"subset": "train"}},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/projects/pinned-sequences",
+		),
+	)
+	s.Equal("sequence name can't be empty", resp.Message)
+}
@@ -0,0 +1,131 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type AddRunTagTestSuite struct {
+	helpers.BaseTestSuite
+	run *models.Run
+}
+
+func TestAddRunTagTestSuite(t *testing.T) {
+	suite.Run(t, new(AddRunTagTestSuite))
+}
+
+func (s *AddRunTagTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	var err error
+	s.run, err = s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+}
+
+func (s *AddRunTagTestSuite) Test_Ok() {
+	existing, err := s.AimTagFixtures.CreateTag(context.Background(), &database.AimTag{
+		Name:        "baseline",
+		Color:       "#18AB6D",
+		Description: "baseline runs",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	archived, err := s.AimTagFixtures.CreateTag(context.Background(), &database.AimTag{
+		Base:        database.Base{IsArchived: true},
+		Name:        "archived",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(s.AimTagFixtures.AttachTag(context.Background(), archived, s.run.ID))
+
+	// attach the existing tag twice and the tag, which doesn't exist yet.
+	for _, name := range []string{"baseline", "baseline", "candidate"} {
+		var resp response.Success
+		s.Require().Nil(
+			s.AIMClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				request.AddRunTagRequest{TagName: name},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"/runs/%s/tags/new", s.run.ID,
+			),
+		)
+		s.Equal("OK", resp.Status)
+		if name == "baseline" {
+			s.Equal(existing.ID.String(), resp.ID)
+		}
+	}
+
+	tags, err := s.AimTagFixtures.GetRunTags(context.Background(), s.run.ID)
+	s.Require().Nil(err)
+	s.Require().Len(tags, 3)
+	candidate := tags[2]
+	s.Equal("candidate", candidate.Name)
+	s.Equal(s.DefaultNamespace.ID, candidate.NamespaceID)
+
+	// not archived tags are returned in the run props.
+	var resp response.GetRunInfo
+	s.Require().Nil(s.AIMClient().WithResponse(&resp).DoRequest("/runs/%s/info", s.run.ID))
+	s.Equal([]response.GetRunInfoTag{
+		{
+			ID:          existing.ID.String(),
+			Name:        "baseline",
+			Color:       "#18AB6D",
+			Description: "baseline runs",
+		},
+		{
+			ID:   candidate.ID.String(),
+			Name: "candidate",
+		},
+	}, resp.Props.Tags)
+}
+
+func (s *AddRunTagTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		ID      string
+		request request.AddRunTagRequest
+		error   string
+	}{
+		{
+			name:    "EmptyTagName",
+			ID:      s.run.ID,
+			request: request.AddRunTagRequest{},
+			error:   "tag name can't be empty",
+		},
+		{
+			name:    "NotFoundRun",
+			ID:      "id",
+			request: request.AddRunTagRequest{TagName: "baseline"},
+			error:   "unable to find run 'id'",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/%s/tags/new", tt.ID,
+				),
+			)
+			s.Equal(tt.error, resp.Message)
+		})
+	}
+}
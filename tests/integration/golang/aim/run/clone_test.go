@@ -0,0 +1,112 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CloneRunTestSuite struct {
+	helpers.BaseTestSuite
+	run *models.Run
+}
+
+func TestCloneRunTestSuite(t *testing.T) {
+	suite.Run(t, new(CloneRunTestSuite))
+}
+
+func (s *CloneRunTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	var err error
+	s.run, err = s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+}
+
+func (s *CloneRunTestSuite) Test_Ok() {
+	var resp response.Success
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CloneRunRequest{
+				ExperimentID: fmt.Sprintf("%d", *s.DefaultExperiment.ID),
+				Name:         "clone",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/clone", s.run.ID,
+		),
+	)
+	s.Equal("OK", resp.Status)
+	s.NotEqual(s.run.ID, resp.ID)
+
+	source, err := s.RunFixtures.GetRun(context.Background(), s.run.ID)
+	s.Require().Nil(err)
+	clone, err := s.RunFixtures.GetRun(context.Background(), resp.ID)
+	s.Require().Nil(err)
+	s.Equal("clone", clone.Name)
+	s.Equal(source.ExperimentID, clone.ExperimentID)
+	s.Equal(source.StartTime, clone.StartTime)
+	s.Equal(len(source.LatestMetrics), len(clone.LatestMetrics))
+	s.Equal(len(source.Params), len(clone.Params))
+	s.Equal(source.RowNum+1, clone.RowNum)
+
+	sourceMetrics, err := s.MetricFixtures.GetMetricsByRunID(context.Background(), source.ID)
+	s.Require().Nil(err)
+	cloneMetrics, err := s.MetricFixtures.GetMetricsByRunID(context.Background(), clone.ID)
+	s.Require().Nil(err)
+	s.Equal(len(sourceMetrics), len(cloneMetrics))
+}
+
+func (s *CloneRunTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		ID      string
+		request request.CloneRunRequest
+		error   string
+	}{
+		{
+			name: "CloneRunWithUnknownID",
+			ID:   "incorrect-ID",
+			request: request.CloneRunRequest{
+				ExperimentID: "0",
+			},
+			error: "unable to find run 'incorrect-ID'",
+		},
+		{
+			name: "CloneRunWithIncorrectExperimentID",
+			ID:   s.run.ID,
+			request: request.CloneRunRequest{
+				ExperimentID: "incorrect",
+			},
+			error: `unable to parse experiment id 'incorrect': strconv.ParseInt: parsing "incorrect": invalid syntax`,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/%s/clone", tt.ID,
+				),
+			)
+			s.Equal(tt.error, resp.Message)
+		})
+	}
+}
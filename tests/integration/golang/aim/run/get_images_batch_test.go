@@ -0,0 +1,136 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetImagesBatchTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetImagesBatchTestSuite(t *testing.T) {
+	suite.Run(t, new(GetImagesBatchTestSuite))
+}
+
+func (s *GetImagesBatchTestSuite) Test_Ok() {
+	// 1. create test run, which keeps artifacts locally.
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	runArtifactDir := filepath.Join(s.T().TempDir(), runID, "artifacts")
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		ArtifactURI:    runArtifactDir,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// 2. create image blobs and log the images.
+	s.Require().Nil(os.MkdirAll(filepath.Join(runArtifactDir, "images"), fs.ModePerm))
+	var images []models.Image
+	for i := int64(0); i < 2; i++ {
+		blobURI := fmt.Sprintf("images/%d.png", i)
+		s.Require().Nil(os.WriteFile(
+			filepath.Join(runArtifactDir, blobURI), []byte(fmt.Sprintf("image-content-%d", i)), fs.ModePerm,
+		))
+		images = append(images, models.Image{
+			RunID:     run.ID,
+			Name:      "samples",
+			Context:   models.DefaultContext,
+			Index:     i,
+			Timestamp: 1234567890,
+			Format:    "png",
+			Width:     32,
+			Height:    32,
+			BlobURI:   blobURI,
+		})
+	}
+	s.Require().Nil(s.ImageFixtures.CreateImages(context.Background(), images))
+
+	// 3. request the content of the images.
+	resp := new(bytes.Buffer)
+	s.Require().Nil(s.AIMClient().WithMethod(
+		http.MethodPost,
+	).WithRequest(
+		[]string{fmt.Sprintf("%s/images/0.png", run.ID), fmt.Sprintf("%s/images/1.png", run.ID)},
+	).WithResponse(
+		resp,
+	).WithResponseType(
+		helpers.ResponseTypeBuffer,
+	).DoRequest(
+		"/runs/images/get-batch/",
+	))
+	for i := 0; i < 2; i++ {
+		s.True(bytes.Contains(resp.Bytes(), []byte(fmt.Sprintf("%s/images/%d.png", run.ID, i))))
+		s.True(bytes.Contains(resp.Bytes(), []byte(fmt.Sprintf("image-content-%d", i))))
+	}
+}
+
+func (s *GetImagesBatchTestSuite) Test_Error() {
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	runArtifactDir := filepath.Join(s.T().TempDir(), runID, "artifacts")
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		ArtifactURI:    runArtifactDir,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// artifact exists, but it is not a logged image.
+	s.Require().Nil(os.MkdirAll(runArtifactDir, fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(runArtifactDir, "model.pkl"), []byte("model"), fs.ModePerm))
+
+	tests := []struct {
+		name       string
+		uri        string
+		statusCode int
+	}{
+		{
+			name:       "InvalidURI",
+			uri:        run.ID,
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "NotFoundRun",
+			uri:        "not-existing-run/images/0.png",
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:       "NotLoggedImage",
+			uri:        fmt.Sprintf("%s/model.pkl", run.ID),
+			statusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp map[string]any
+			client := s.AIMClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				[]string{tt.uri},
+			).WithResponse(
+				&resp,
+			)
+			s.Require().Nil(client.DoRequest("/runs/images/get-batch/"))
+			s.Equal(tt.statusCode, client.GetStatusCode())
+		})
+	}
+}
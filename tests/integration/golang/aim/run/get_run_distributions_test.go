@@ -0,0 +1,150 @@
+package run
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetRunDistributionsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetRunDistributionsTestSuite(t *testing.T) {
+	suite.Run(t, new(GetRunDistributionsTestSuite))
+}
+
+func (s *GetRunDistributionsTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "TestRun",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	// log 5 steps of distributions in two contexts.
+	var distributions []models.Distribution
+	for _, layer := range []string{"fc1", "fc2"} {
+		for step := int64(0); step < 5; step++ {
+			distributions = append(distributions, models.Distribution{
+				RunID:     run.ID,
+				Name:      "weights",
+				Context:   models.Context{Json: []byte(fmt.Sprintf(`{"layer":%q}`, layer))},
+				Step:      step,
+				Timestamp: 1000 * (step + 1),
+				BinEdges:  encodeFloat64s([]float64{-float64(step + 1), 0, float64(step + 1)}),
+				Counts:    encodeFloat64s([]float64{float64(step), 1}),
+			})
+		}
+	}
+	s.Require().Nil(s.DistributionFixtures.CreateDistributions(context.Background(), distributions))
+
+	var resp response.GetRunDistributions
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithQuery(
+			request.GetRunSequencesBatchRequest{
+				RecordRange:   "1:",
+				RecordDensity: 2,
+			},
+		).WithRequest(
+			[]request.RunSequenceTraceRequest{
+				{Name: "weights", Context: map[string]any{"layer": "fc2"}},
+				{Name: "unknown"},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/distributions/get-batch", run.ID,
+		),
+	)
+	s.Equal(response.GetRunDistributions{
+		{
+			Name:    "weights",
+			Context: map[string]any{"layer": "fc2"},
+			Values: []response.RunDistributionValue{
+				{
+					Data: response.RunDistributionData{
+						Type: "numpy", DType: "float64", Shape: 2, Blob: encodeFloat64s([]float64{1, 1}),
+					},
+					BinCount: 2,
+					Range:    []float64{-2, 2},
+				},
+				{
+					Data: response.RunDistributionData{
+						Type: "numpy", DType: "float64", Shape: 2, Blob: encodeFloat64s([]float64{4, 1}),
+					},
+					BinCount: 2,
+					Range:    []float64{-5, 5},
+				},
+			},
+			Iters:       []int64{1, 4},
+			Timestamps:  []float64{2, 5},
+			RecordRange: []int64{0, 5},
+		},
+	}, resp)
+
+	// distributions are listed in the run info.
+	var info response.GetRunInfo
+	s.Require().Nil(s.AIMClient().WithResponse(&info).DoRequest("/runs/%s/info?sequence=distributions", run.ID))
+	s.Equal([]response.GetRunInfoTracesSequence{
+		{Name: "weights", Context: map[string]any{"layer": "fc1"}},
+		{Name: "weights", Context: map[string]any{"layer": "fc2"}},
+	}, info.Traces.Distributions)
+}
+
+func (s *GetRunDistributionsTestSuite) Test_Error() {
+	tests := []struct {
+		name  string
+		runID string
+		error string
+	}{
+		{
+			name:  "GetNonexistentRun",
+			runID: "nonexistent",
+			error: "unable to find run 'nonexistent'",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					[]request.RunSequenceTraceRequest{},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/%s/distributions/get-batch", tt.runID,
+				),
+			)
+			s.Equal(tt.error, resp.Message)
+		})
+	}
+}
+
+// encodeFloat64s encodes values as little-endian float64, in which bin edges and counts are kept.
+func encodeFloat64s(values []float64) []byte {
+	data := make([]byte, 8*len(values))
+	for i, v := range values {
+		binary.LittleEndian.PutUint64(data[i*8:], math.Float64bits(v))
+	}
+	return data
+}
@@ -0,0 +1,123 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetRunTextsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetRunTextsTestSuite(t *testing.T) {
+	suite.Run(t, new(GetRunTextsTestSuite))
+}
+
+func (s *GetRunTextsTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "TestRun",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	// log 5 steps of 2 texts each in two contexts.
+	var texts []models.Text
+	for _, subset := range []string{"train", "val"} {
+		for step := int64(0); step < 5; step++ {
+			for index := int64(0); index < 2; index++ {
+				texts = append(texts, models.Text{
+					RunID:     run.ID,
+					Name:      "samples",
+					Context:   models.Context{Json: []byte(fmt.Sprintf(`{"subset":%q}`, subset))},
+					Step:      step,
+					Index:     index,
+					Timestamp: 1000 * (step + 1),
+					Data:      fmt.Sprintf("%s-%d-%d", subset, step, index),
+				})
+			}
+		}
+	}
+	s.Require().Nil(s.TextFixtures.CreateTexts(context.Background(), texts))
+
+	var resp response.GetRunTexts
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithQuery(
+			request.GetRunSequencesBatchRequest{
+				RecordRange:   "1:",
+				RecordDensity: 2,
+				IndexDensity:  5,
+			},
+		).WithRequest(
+			[]request.RunSequenceTraceRequest{
+				{Name: "samples", Context: map[string]any{"subset": "val"}},
+				{Name: "unknown"},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/texts/get-batch", run.ID,
+		),
+	)
+	s.Equal(response.GetRunTexts{
+		{
+			Name:    "samples",
+			Context: map[string]any{"subset": "val"},
+			Values: [][]response.RunTextValue{
+				{{Data: "val-1-0", Index: 0}, {Data: "val-1-1", Index: 1}},
+				{{Data: "val-4-0", Index: 0}, {Data: "val-4-1", Index: 1}},
+			},
+			Iters:       []int64{1, 4},
+			Timestamps:  []float64{2, 5},
+			RecordRange: []int64{0, 5},
+			IndexRange:  []int64{0, 2},
+		},
+	}, resp)
+}
+
+func (s *GetRunTextsTestSuite) Test_Error() {
+	tests := []struct {
+		name  string
+		runID string
+		error string
+	}{
+		{
+			name:  "GetNonexistentRun",
+			runID: "nonexistent",
+			error: "unable to find run 'nonexistent'",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					[]request.RunSequenceTraceRequest{},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/%s/texts/get-batch", tt.runID,
+				),
+			)
+			s.Equal(tt.error, resp.Message)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type MoveRunTestSuite struct {
+	helpers.BaseTestSuite
+	run *models.Run
+}
+
+func TestMoveRunTestSuite(t *testing.T) {
+	suite.Run(t, new(MoveRunTestSuite))
+}
+
+func (s *MoveRunTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	var err error
+	s.run, err = s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+}
+
+func (s *MoveRunTestSuite) Test_Ok() {
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "target",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		NamespaceID:    namespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	var resp response.Success
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.MoveRunRequest{
+				ExperimentID: fmt.Sprintf("%d", *experiment.ID),
+				Namespace:    "target",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/move", s.run.ID,
+		),
+	)
+	s.Equal(response.Success{ID: s.run.ID, Status: "OK"}, resp)
+
+	run, err := s.RunFixtures.GetRun(context.Background(), s.run.ID)
+	s.Require().Nil(err)
+	s.Equal(*experiment.ID, run.ExperimentID)
+	s.Equal(s.run.RowNum, run.RowNum)
+	s.Equal(s.run.ArtifactURI, run.ArtifactURI)
+	s.NotEmpty(run.LatestMetrics)
+}
+
+func (s *MoveRunTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		ID      string
+		request request.MoveRunRequest
+		error   string
+	}{
+		{
+			name: "MoveRunWithUnknownID",
+			ID:   "incorrect-ID",
+			request: request.MoveRunRequest{
+				ExperimentID: "0",
+			},
+			error: "unable to find run 'incorrect-ID'",
+		},
+		{
+			name:    "MoveRunWithoutExperimentID",
+			ID:      s.run.ID,
+			request: request.MoveRunRequest{},
+			error:   "Missing value for required parameter 'experiment_id'",
+		},
+		{
+			name: "MoveRunIntoUnknownNamespace",
+			ID:   s.run.ID,
+			request: request.MoveRunRequest{
+				ExperimentID: "0",
+				Namespace:    "unknown",
+			},
+			error: "unable to find namespace 'unknown'",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/%s/move", tt.ID,
+				),
+			)
+			s.Equal(tt.error, resp.Message)
+		})
+	}
+}
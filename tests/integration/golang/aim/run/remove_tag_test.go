@@ -0,0 +1,84 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type RemoveRunTagTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestRemoveRunTagTestSuite(t *testing.T) {
+	suite.Run(t, new(RemoveRunTagTestSuite))
+}
+
+func (s *RemoveRunTagTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+	tag, err := s.AimTagFixtures.CreateTag(context.Background(), &database.AimTag{
+		Name:        "baseline",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(s.AimTagFixtures.AttachTag(context.Background(), tag, run.ID))
+
+	tests := []struct {
+		name    string
+		removed bool
+	}{
+		{
+			name:    "RemoveAttachedTag",
+			removed: true,
+		},
+		{
+			name:    "RemoveNotAttachedTag",
+			removed: false,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := map[string]any{}
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodDelete,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/runs/%s/tags/%s", run.ID, tag.ID,
+				),
+			)
+			s.Equal(map[string]any{"id": run.ID, "removed": tt.removed, "status": "OK"}, resp)
+
+			tags, err := s.AimTagFixtures.GetRunTags(context.Background(), run.ID)
+			s.Require().Nil(err)
+			s.Empty(tags)
+		})
+	}
+
+	// the tag itself is kept.
+	tags, err := s.AimTagFixtures.GetTags(context.Background())
+	s.Require().Nil(err)
+	s.Len(tags, 1)
+}
+
+func (s *RemoveRunTagTestSuite) Test_Error() {
+	var resp response.Error
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/runs/%s/tags/%s", "id", "9facd2ea-4bc1-4b27-9ae4-ef7a6ac1e2b5",
+		),
+	)
+	s.Equal("unable to find run 'id'", resp.Message)
+}
@@ -0,0 +1,180 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchImagesTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchImagesTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchImagesTestSuite))
+}
+
+func (s *SearchImagesTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:           "TestRun",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	// log 5 steps of 4 images each.
+	var images []models.Image
+	for step := int64(0); step < 5; step++ {
+		for index := int64(0); index < 4; index++ {
+			images = append(images, models.Image{
+				RunID:     run.ID,
+				Name:      "samples",
+				Context:   models.Context{Json: []byte(`{"subset":"train"}`)},
+				Step:      step,
+				Index:     index,
+				Timestamp: 1000 * (step + 1),
+				Caption:   fmt.Sprintf("caption-%d-%d", step, index),
+				Format:    "png",
+				Width:     32,
+				Height:    16,
+				BlobURI:   fmt.Sprintf("images/samples/%d-%d.png", step, index),
+			})
+		}
+	}
+	s.Require().Nil(s.ImageFixtures.CreateImages(context.Background(), images))
+
+	tests := []struct {
+		name    string
+		request request.SearchSequencesRequest
+		iters   []int64
+		indexes []int64
+	}{
+		{
+			name: "AllImages",
+			request: request.SearchSequencesRequest{
+				Query:         `images.name == "samples"`,
+				RecordDensity: 10,
+				IndexDensity:  10,
+			},
+			iters:   []int64{0, 1, 2, 3, 4},
+			indexes: []int64{0, 1, 2, 3},
+		},
+		{
+			name: "SampledImages",
+			request: request.SearchSequencesRequest{
+				Query:         `images.context.subset == "train"`,
+				RecordDensity: 3,
+				IndexDensity:  2,
+			},
+			iters:   []int64{0, 2, 4},
+			indexes: []int64{0, 3},
+		},
+		{
+			name: "ImagesInRange",
+			request: request.SearchSequencesRequest{
+				RecordRange:   "1:3",
+				RecordDensity: 10,
+				IndexRange:    "2:",
+				IndexDensity:  10,
+			},
+			iters:   []int64{1, 2},
+			indexes: []int64{2, 3},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := new(bytes.Buffer)
+			s.Require().Nil(s.AIMClient().WithQuery(
+				tt.request,
+			).WithResponse(
+				resp,
+			).WithResponseType(
+				helpers.ResponseTypeBuffer,
+			).DoRequest(
+				"/runs/search/images/",
+			))
+
+			decodedData, err := encoding.NewDecoder(resp).Decode()
+			s.Require().Nil(err)
+
+			prefix := fmt.Sprintf("%s.traces.0", run.ID)
+			s.Equal("TestRun", decodedData[fmt.Sprintf("%s.props.name", run.ID)])
+			s.Equal("samples", decodedData[prefix+".name"])
+			s.Equal("train", decodedData[prefix+".context.subset"])
+			for i, iter := range tt.iters {
+				s.Equal(iter, decodedData[fmt.Sprintf("%s.iters.%d", prefix, i)])
+				s.Equal(float64(iter+1), decodedData[fmt.Sprintf("%s.timestamps.%d", prefix, i)])
+				for j, index := range tt.indexes {
+					value := fmt.Sprintf("%s.values.%d.%d", prefix, i, j)
+					s.Equal(index, decodedData[value+".index"])
+					s.Equal(fmt.Sprintf("caption-%d-%d", iter, index), decodedData[value+".caption"])
+					s.Equal(
+						fmt.Sprintf("%s/images/samples/%d-%d.png", run.ID, iter, index), decodedData[value+".blob_uri"],
+					)
+				}
+				s.Nil(decodedData[fmt.Sprintf("%s.values.%d.%d", prefix, i, len(tt.indexes))+".index"])
+			}
+			s.Nil(decodedData[fmt.Sprintf("%s.iters.%d", prefix, len(tt.iters))])
+			s.Equal(int64(0), decodedData[fmt.Sprintf("%s.ranges.record_range_total.0", run.ID)])
+			s.Equal(int64(5), decodedData[fmt.Sprintf("%s.ranges.record_range_total.1", run.ID)])
+			s.Equal(int64(4), decodedData[fmt.Sprintf("%s.ranges.index_range_total.1", run.ID)])
+		})
+	}
+
+	// images are listed in the run info.
+	var info response.GetRunInfo
+	s.Require().Nil(s.AIMClient().WithResponse(&info).DoRequest("/runs/%s/info?sequence=images", run.ID))
+	s.Equal([]response.GetRunInfoTracesSequence{
+		{Name: "samples", Context: map[string]any{"subset": "train"}},
+	}, info.Traces.Images)
+}
+
+func (s *SearchImagesTestSuite) Test_Error() {
+	tests := []struct {
+		name       string
+		request    request.SearchSequencesRequest
+		statusCode int
+		error      string
+	}{
+		{
+			name:       "InvalidQuery",
+			request:    request.SearchSequencesRequest{Query: `images.unknown == "samples"`},
+			statusCode: http.StatusBadRequest,
+			error:      "SyntaxError",
+		},
+		{
+			name:       "InvalidRange",
+			request:    request.SearchSequencesRequest{RecordRange: "1"},
+			statusCode: http.StatusUnprocessableEntity,
+			error:      `invalid range "1"`,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp map[string]any
+			client := s.AIMClient().WithQuery(
+				tt.request,
+			).WithResponse(
+				&resp,
+			)
+			s.Require().Nil(client.DoRequest("/runs/search/images/"))
+			s.Equal(tt.statusCode, client.GetStatusCode())
+			s.Contains(resp["message"], tt.error)
+		})
+	}
+}
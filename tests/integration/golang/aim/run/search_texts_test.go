@@ -0,0 +1,137 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/encoding"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchTextsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchTextsTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchTextsTestSuite))
+}
+
+func (s *SearchTextsTestSuite) Test_Ok() {
+	runs := make([]*models.Run, 2)
+	for i := range runs {
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+			Name:           fmt.Sprintf("TestRun%d", i),
+			ExperimentID:   *s.DefaultExperiment.ID,
+			SourceType:     "JOB",
+			LifecycleStage: models.LifecycleStageActive,
+			Status:         models.StatusRunning,
+		})
+		s.Require().Nil(err)
+		runs[i] = run
+	}
+
+	// log 4 steps of 3 texts each, the runs log texts of different names.
+	var texts []models.Text
+	for i, name := range []string{"generated", "answers"} {
+		for step := int64(0); step < 4; step++ {
+			for index := int64(0); index < 3; index++ {
+				texts = append(texts, models.Text{
+					RunID:     runs[i].ID,
+					Name:      name,
+					Context:   models.Context{Json: []byte(`{"subset":"val"}`)},
+					Step:      step,
+					Index:     index,
+					Timestamp: 1000 * (step + 1),
+					Data:      fmt.Sprintf("%s-%d-%d", name, step, index),
+				})
+			}
+		}
+	}
+	s.Require().Nil(s.TextFixtures.CreateTexts(context.Background(), texts))
+
+	tests := []struct {
+		name    string
+		request request.SearchSequencesRequest
+		run     *models.Run
+		iters   []int64
+		indexes []int64
+	}{
+		{
+			name: "TextsByName",
+			request: request.SearchSequencesRequest{
+				Query:         `texts.name == "generated"`,
+				RecordDensity: 10,
+				IndexDensity:  10,
+			},
+			run:     runs[0],
+			iters:   []int64{0, 1, 2, 3},
+			indexes: []int64{0, 1, 2},
+		},
+		{
+			name: "SampledTexts",
+			request: request.SearchSequencesRequest{
+				Query:         `texts.name == "answers" and texts.context.subset == "val"`,
+				RecordDensity: 2,
+				IndexDensity:  2,
+			},
+			run:     runs[1],
+			iters:   []int64{0, 3},
+			indexes: []int64{0, 2},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := new(bytes.Buffer)
+			s.Require().Nil(s.AIMClient().WithQuery(
+				tt.request,
+			).WithResponse(
+				resp,
+			).WithResponseType(
+				helpers.ResponseTypeBuffer,
+			).DoRequest(
+				"/runs/search/texts/",
+			))
+
+			decodedData, err := encoding.NewDecoder(resp).Decode()
+			s.Require().Nil(err)
+
+			for _, run := range runs {
+				if run.ID != tt.run.ID {
+					s.Nil(decodedData[fmt.Sprintf("%s.props.name", run.ID)])
+				}
+			}
+
+			prefix := fmt.Sprintf("%s.traces.0", tt.run.ID)
+			s.Equal(tt.run.Name, decodedData[fmt.Sprintf("%s.props.name", tt.run.ID)])
+			s.Equal("val", decodedData[prefix+".context.subset"])
+			for i, iter := range tt.iters {
+				s.Equal(iter, decodedData[fmt.Sprintf("%s.iters.%d", prefix, i)])
+				for j, index := range tt.indexes {
+					value := fmt.Sprintf("%s.values.%d.%d", prefix, i, j)
+					s.Equal(index, decodedData[value+".index"])
+					s.Equal(
+						fmt.Sprintf("%s-%d-%d", decodedData[prefix+".name"], iter, index), decodedData[value+".data"],
+					)
+				}
+			}
+			s.Nil(decodedData[fmt.Sprintf("%s.iters.%d", prefix, len(tt.iters))])
+		})
+	}
+
+	// texts are listed in the run info.
+	var info response.GetRunInfo
+	s.Require().Nil(s.AIMClient().WithResponse(&info).DoRequest("/runs/%s/info?sequence=texts", runs[0].ID))
+	s.Equal([]response.GetRunInfoTracesSequence{
+		{Name: "generated", Context: map[string]any{"subset": "val"}},
+	}, info.Traces.Texts)
+}
@@ -0,0 +1,95 @@
+package tag
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CreateTagTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestCreateTagTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateTagTestSuite))
+}
+
+func (s *CreateTagTestSuite) Test_Ok() {
+	var resp response.Success
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateTagRequest{
+				Name:        "baseline",
+				Color:       "#18AB6D",
+				Description: "baseline runs",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/tags",
+		),
+	)
+	s.Equal("OK", resp.Status)
+
+	tags, err := s.AimTagFixtures.GetTags(context.Background())
+	s.Require().Nil(err)
+	s.Require().Len(tags, 1)
+	s.Equal(resp.ID, tags[0].ID.String())
+	s.Equal("baseline", tags[0].Name)
+	s.Equal("#18AB6D", tags[0].Color)
+	s.Equal("baseline runs", tags[0].Description)
+	s.Equal(s.DefaultNamespace.ID, tags[0].NamespaceID)
+}
+
+func (s *CreateTagTestSuite) Test_Error() {
+	_, err := s.AimTagFixtures.CreateTag(context.Background(), &database.AimTag{
+		Name:        "baseline",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name    string
+		request request.CreateTagRequest
+		error   string
+	}{
+		{
+			name:    "EmptyName",
+			request: request.CreateTagRequest{},
+			error:   "tag name can't be empty",
+		},
+		{
+			name: "DuplicateName",
+			request: request.CreateTagRequest{
+				Name: "baseline",
+			},
+			error: `tag with name "baseline" already exists`,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/tags",
+				),
+			)
+			s.Equal(tt.error, resp.Message)
+		})
+	}
+}
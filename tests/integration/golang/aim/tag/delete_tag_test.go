@@ -0,0 +1,61 @@
+package tag
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteTagTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDeleteTagTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteTagTestSuite))
+}
+
+func (s *DeleteTagTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+	tag, err := s.AimTagFixtures.CreateTag(context.Background(), &database.AimTag{
+		Name:        "baseline",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(s.AimTagFixtures.AttachTag(context.Background(), tag, run.ID))
+
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodDelete,
+		).DoRequest(
+			"/tags/%s", tag.ID,
+		),
+	)
+
+	tags, err := s.AimTagFixtures.GetTags(context.Background())
+	s.Require().Nil(err)
+	s.Empty(tags)
+	runTags, err := s.AimTagFixtures.GetRunTags(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Empty(runTags)
+}
+
+func (s *DeleteTagTestSuite) Test_Error() {
+	var resp response.Error
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/tags/%s", "9facd2ea-4bc1-4b27-9ae4-ef7a6ac1e2b5",
+		),
+	)
+	s.Equal("Not Found", resp.Message)
+}
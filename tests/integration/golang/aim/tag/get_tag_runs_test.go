@@ -0,0 +1,81 @@
+package tag
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetTagRunsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetTagRunsTestSuite(t *testing.T) {
+	suite.Run(t, new(GetTagRunsTestSuite))
+}
+
+func (s *GetTagRunsTestSuite) Test_Ok() {
+	tag, err := s.AimTagFixtures.CreateTag(context.Background(), &database.AimTag{
+		Name:        "baseline",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	runs := make([]*models.Run, 3)
+	for i := range runs {
+		runs[i], err = s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             []string{"run1", "run2", "run3"}[i],
+			Name:           []string{"run1", "run2", "run3"}[i],
+			Status:         models.StatusFinished,
+			StartTime:      sql.NullInt64{Int64: int64(1000 * (i + 1)), Valid: true},
+			EndTime:        sql.NullInt64{Int64: int64(2000 * (i + 1)), Valid: true},
+			SourceType:     "JOB",
+			LifecycleStage: models.LifecycleStageActive,
+			ExperimentID:   *s.DefaultExperiment.ID,
+		})
+		s.Require().Nil(err)
+	}
+	s.Require().Nil(s.AimTagFixtures.AttachTag(context.Background(), tag, runs[0].ID))
+	s.Require().Nil(s.AimTagFixtures.AttachTag(context.Background(), tag, runs[2].ID))
+
+	var resp response.TagRuns
+	s.Require().Nil(s.AIMClient().WithResponse(&resp).DoRequest("/tags/%s/runs", tag.ID))
+	s.Equal(response.TagRuns{
+		ID: tag.ID,
+		Runs: []response.TagRun{
+			{
+				RunID:        "run3",
+				Name:         "run3",
+				Experiment:   s.DefaultExperiment.Name,
+				CreationTime: 3,
+				EndTime:      6,
+			},
+			{
+				RunID:        "run1",
+				Name:         "run1",
+				Experiment:   s.DefaultExperiment.Name,
+				CreationTime: 1,
+				EndTime:      2,
+			},
+		},
+	}, resp)
+}
+
+func (s *GetTagRunsTestSuite) Test_Error() {
+	var resp response.Error
+	s.Require().Nil(
+		s.AIMClient().WithResponse(
+			&resp,
+		).DoRequest(
+			"/tags/%s/runs", "9facd2ea-4bc1-4b27-9ae4-ef7a6ac1e2b5",
+		),
+	)
+	s.Equal("Not Found", resp.Message)
+}
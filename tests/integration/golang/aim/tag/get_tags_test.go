@@ -0,0 +1,96 @@
+package tag
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetTagsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetTagsTestSuite(t *testing.T) {
+	suite.Run(t, new(GetTagsTestSuite))
+}
+
+func (s *GetTagsTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	baseline, err := s.AimTagFixtures.CreateTag(context.Background(), &database.AimTag{
+		Name:        "baseline",
+		Color:       "#18AB6D",
+		Description: "baseline runs",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(s.AimTagFixtures.AttachTag(context.Background(), baseline, run.ID))
+	candidate, err := s.AimTagFixtures.CreateTag(context.Background(), &database.AimTag{
+		Name:        "candidate",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	// tags of the other namespaces are not visible.
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "other",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	_, err = s.AimTagFixtures.CreateTag(context.Background(), &database.AimTag{
+		Name:        "baseline",
+		NamespaceID: namespace.ID,
+	})
+	s.Require().Nil(err)
+
+	baselineResponse := response.Tag{
+		ID:          baseline.ID,
+		Name:        "baseline",
+		Color:       "#18AB6D",
+		Description: "baseline runs",
+		RunCount:    1,
+	}
+	candidateResponse := response.Tag{
+		ID:   candidate.ID,
+		Name: "candidate",
+	}
+
+	var tags []response.Tag
+	s.Require().Nil(s.AIMClient().WithResponse(&tags).DoRequest("/tags"))
+	s.Equal([]response.Tag{baselineResponse, candidateResponse}, tags)
+
+	s.Require().Nil(
+		s.AIMClient().WithQuery(
+			map[any]any{"q": "cand"},
+		).WithResponse(
+			&tags,
+		).DoRequest(
+			"/tags/search",
+		),
+	)
+	s.Equal([]response.Tag{candidateResponse}, tags)
+
+	var tag response.Tag
+	s.Require().Nil(s.AIMClient().WithResponse(&tag).DoRequest("/tags/%s", baseline.ID))
+	s.Equal(baselineResponse, tag)
+}
+
+func (s *GetTagsTestSuite) Test_Error() {
+	var resp response.Error
+	s.Require().Nil(
+		s.AIMClient().WithResponse(
+			&resp,
+		).DoRequest(
+			"/tags/%s", "9facd2ea-4bc1-4b27-9ae4-ef7a6ac1e2b5",
+		),
+	)
+	s.Equal("Not Found", resp.Message)
+}
@@ -0,0 +1,113 @@
+package tag
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/aim/request"
+	"github.com/G-Research/fasttrackml/pkg/api/aim/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type UpdateTagTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestUpdateTagTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateTagTestSuite))
+}
+
+func (s *UpdateTagTestSuite) Test_Ok() {
+	tag, err := s.AimTagFixtures.CreateTag(context.Background(), &database.AimTag{
+		Name:        "baseline",
+		Color:       "#18AB6D",
+		Description: "baseline runs",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	var resp response.Success
+	s.Require().Nil(
+		s.AIMClient().WithMethod(
+			http.MethodPut,
+		).WithRequest(
+			request.UpdateTagRequest{
+				Name:        common.GetPointer("candidate"),
+				Description: common.GetPointer(""),
+				Archived:    common.GetPointer(true),
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/tags/%s", tag.ID,
+		),
+	)
+	s.Equal(response.Success{ID: tag.ID.String(), Status: "OK"}, resp)
+
+	tags, err := s.AimTagFixtures.GetTags(context.Background())
+	s.Require().Nil(err)
+	s.Require().Len(tags, 1)
+	s.Equal("candidate", tags[0].Name)
+	s.Equal("#18AB6D", tags[0].Color)
+	s.Equal("", tags[0].Description)
+	s.True(tags[0].IsArchived)
+}
+
+func (s *UpdateTagTestSuite) Test_Error() {
+	tag, err := s.AimTagFixtures.CreateTag(context.Background(), &database.AimTag{
+		Name:        "baseline",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.AimTagFixtures.CreateTag(context.Background(), &database.AimTag{
+		Name:        "candidate",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name    string
+		ID      string
+		request request.UpdateTagRequest
+		error   string
+	}{
+		{
+			name: "NotFoundTag",
+			ID:   "9facd2ea-4bc1-4b27-9ae4-ef7a6ac1e2b5",
+			request: request.UpdateTagRequest{
+				Name: common.GetPointer("other"),
+			},
+			error: "Not Found",
+		},
+		{
+			name: "DuplicateName",
+			ID:   tag.ID.String(),
+			request: request.UpdateTagRequest{
+				Name: common.GetPointer("candidate"),
+			},
+			error: `tag with name "candidate" already exists`,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var resp response.Error
+			s.Require().Nil(
+				s.AIMClient().WithMethod(
+					http.MethodPut,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"/tags/%s", tt.ID,
+				),
+			)
+			s.Equal(tt.error, resp.Message)
+		})
+	}
+}
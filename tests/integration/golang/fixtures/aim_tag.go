@@ -0,0 +1,65 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/database"
+)
+
+// AimTagFixtures represents data fixtures object.
+type AimTagFixtures struct {
+	baseFixtures
+}
+
+// NewAimTagFixtures creates new instance of AimTagFixtures.
+func NewAimTagFixtures(db *gorm.DB) (*AimTagFixtures, error) {
+	return &AimTagFixtures{
+		baseFixtures: baseFixtures{db: db},
+	}, nil
+}
+
+// CreateTag creates a new test Aim tag.
+func (f AimTagFixtures) CreateTag(ctx context.Context, tag *database.AimTag) (*database.AimTag, error) {
+	if err := f.db.WithContext(ctx).Create(tag).Error; err != nil {
+		return nil, eris.Wrap(err, "error creating test aim tag")
+	}
+	return tag, nil
+}
+
+// AttachTag attaches the Aim tag to the run.
+func (f AimTagFixtures) AttachTag(ctx context.Context, tag *database.AimTag, runID string) error {
+	if err := f.db.WithContext(ctx).Table("aim_run_tags").Create(map[string]any{
+		"run_uuid":   runID,
+		"aim_tag_id": tag.ID,
+	}).Error; err != nil {
+		return eris.Wrapf(err, "error attaching aim tag to run: %s", runID)
+	}
+	return nil
+}
+
+// GetTags returns all the Aim tags.
+func (f AimTagFixtures) GetTags(ctx context.Context) ([]database.AimTag, error) {
+	var tags []database.AimTag
+	if err := f.db.WithContext(ctx).Order("name").Find(&tags).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting aim tags")
+	}
+	return tags, nil
+}
+
+// GetRunTags returns the Aim tags attached to the run.
+func (f AimTagFixtures) GetRunTags(ctx context.Context, runID string) ([]database.AimTag, error) {
+	var tags []database.AimTag
+	if err := f.db.WithContext(ctx).Joins(
+		"INNER JOIN aim_run_tags ON aim_run_tags.aim_tag_id = aim_tags.id",
+	).Where(
+		"aim_run_tags.run_uuid = ?", runID,
+	).Order(
+		"aim_tags.name",
+	).Find(&tags).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting aim tags of run: %s", runID)
+	}
+	return tags, nil
+}
@@ -0,0 +1,89 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/alert"
+)
+
+// AlertFixtures represents data fixtures object.
+type AlertFixtures struct {
+	baseFixtures
+	alertRepository repositories.AlertRepositoryProvider
+}
+
+// NewAlertFixtures creates new instance of AlertFixtures.
+func NewAlertFixtures(db *gorm.DB) (*AlertFixtures, error) {
+	return &AlertFixtures{
+		baseFixtures:    baseFixtures{db: db},
+		alertRepository: repositories.NewAlertRepository(db),
+	}, nil
+}
+
+// CreateAlertRule creates new test AlertRule.
+func (f AlertFixtures) CreateAlertRule(ctx context.Context, rule *models.AlertRule) (*models.AlertRule, error) {
+	if err := f.alertRepository.CreateRule(ctx, rule); err != nil {
+		return nil, eris.Wrap(err, "error creating test alert rule")
+	}
+	return rule, nil
+}
+
+// GetAlertRules returns all the alert rules of the experiment.
+func (f AlertFixtures) GetAlertRules(ctx context.Context, experimentID int32) ([]models.AlertRule, error) {
+	rules, err := f.alertRepository.ListRulesByExperimentID(ctx, experimentID)
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting test alert rules")
+	}
+	return rules, nil
+}
+
+// GetAlerts returns all the alerts of the alert rule.
+func (f AlertFixtures) GetAlerts(ctx context.Context, ruleID uint) ([]models.Alert, error) {
+	var alerts []models.Alert
+	if err := f.db.WithContext(ctx).Where(
+		"alert_rule_id = ?", ruleID,
+	).Order(
+		"run_uuid",
+	).Find(&alerts).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting test alerts")
+	}
+	return alerts, nil
+}
+
+// CheckAlertRules checks the alert rules the same way, as the background notifier does.
+func (f AlertFixtures) CheckAlertRules(ctx context.Context, config *config.ServiceConfig) ([]models.Alert, error) {
+	alerts, err := alert.NewNotifier(config, f.alertRepository).Check(ctx)
+	if err != nil {
+		return nil, eris.Wrap(err, "error checking alert rules")
+	}
+	return alerts, nil
+}
+
+// DeliverAlerts delivers the alerts the same way, as the background notifier does.
+func (f AlertFixtures) DeliverAlerts(ctx context.Context, config *config.ServiceConfig) (int, error) {
+	delivered, err := alert.NewNotifier(config, f.alertRepository).Deliver(ctx)
+	if err != nil {
+		return delivered, eris.Wrap(err, "error delivering alerts")
+	}
+	return delivered, nil
+}
+
+// BreakAlerts makes storing of the fired alerts fail by renaming `alerts` table.
+// Returned function restores the table.
+func (f AlertFixtures) BreakAlerts(ctx context.Context) (func() error, error) {
+	if err := f.db.WithContext(ctx).Migrator().RenameTable("alerts", "broken_alerts"); err != nil {
+		return nil, eris.Wrap(err, "error renaming alerts table")
+	}
+	return func() error {
+		if err := f.db.WithContext(ctx).Migrator().RenameTable("broken_alerts", "alerts"); err != nil {
+			return eris.Wrap(err, "error restoring alerts table")
+		}
+		return nil
+	}, nil
+}
@@ -16,16 +16,41 @@ type baseFixtures struct {
 // TruncateTables cleans database from the old data.
 func (f baseFixtures) TruncateTables() error {
 	for _, table := range []interface{}{
-		database.Dashboard{}, // TODO update to models when available
-		database.App{},       // TODO update to models when available
+		database.Dashboard{},         // TODO update to models when available
+		database.AimTag{},            // TODO update to models when available
+		database.AimPinnedSequence{}, // TODO update to models when available
+		database.App{},               // TODO update to models when available
+		models.ModelVersionTransition{},
+		models.ModelVersionTransitionRequest{},
+		models.ModelVersionTag{},
+		models.ModelVersion{},
+		models.RegisteredModelAlias{},
+		models.RegisteredModelTag{},
+		models.RegisteredModel{},
+		models.InputTag{},
+		models.Input{},
+		models.Dataset{},
 		models.Tag{},
 		models.Param{},
+		models.Alert{},
+		models.AlertRule{},
+		models.Image{},
+		models.Text{},
+		models.Distribution{},
 		models.LatestMetric{},
 		models.Metric{},
 		models.Context{},
 		models.Run{},
+		models.TraceTag{},
+		models.TraceRequestMetadata{},
+		models.TraceInfo{},
+		models.LoggedModelTag{},
+		models.LoggedModelParam{},
+		models.LoggedModel{},
 		models.ExperimentTag{},
 		models.Experiment{},
+		models.WebhookDelivery{},
+		models.Webhook{},
 		models.Namespace{},
 	} {
 		if err := f.db.Session(&gorm.Session{AllowGlobalUpdate: true}).Unscoped().Delete(table).Error; err != nil {
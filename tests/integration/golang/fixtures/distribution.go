@@ -0,0 +1,50 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+// DistributionFixtures represents data fixtures object.
+type DistributionFixtures struct {
+	baseFixtures
+	distributionRepository repositories.DistributionRepositoryProvider
+}
+
+// NewDistributionFixtures creates new instance of DistributionFixtures.
+func NewDistributionFixtures(db *gorm.DB) (*DistributionFixtures, error) {
+	return &DistributionFixtures{
+		baseFixtures:           baseFixtures{db: db},
+		distributionRepository: repositories.NewDistributionRepository(db),
+	}, nil
+}
+
+// CreateDistributions creates new test Distributions.
+func (f DistributionFixtures) CreateDistributions(ctx context.Context, distributions []models.Distribution) error {
+	if err := f.distributionRepository.CreateBatch(ctx, len(distributions), distributions); err != nil {
+		return eris.Wrap(err, "error creating test distributions")
+	}
+	return nil
+}
+
+// GetDistributions returns all the distributions of the run ordered by name and step.
+func (f DistributionFixtures) GetDistributions(ctx context.Context, runID string) ([]models.Distribution, error) {
+	var distributions []models.Distribution
+	if err := f.db.WithContext(ctx).Preload(
+		"Context",
+	).Where(
+		"run_uuid = ?", runID,
+	).Order(
+		"name",
+	).Order(
+		"step",
+	).Find(&distributions).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting distributions of run: %s", runID)
+	}
+	return distributions, nil
+}
@@ -0,0 +1,52 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+// ImageFixtures represents data fixtures object.
+type ImageFixtures struct {
+	baseFixtures
+	imageRepository repositories.ImageRepositoryProvider
+}
+
+// NewImageFixtures creates new instance of ImageFixtures.
+func NewImageFixtures(db *gorm.DB) (*ImageFixtures, error) {
+	return &ImageFixtures{
+		baseFixtures:    baseFixtures{db: db},
+		imageRepository: repositories.NewImageRepository(db),
+	}, nil
+}
+
+// CreateImages creates new test Images.
+func (f ImageFixtures) CreateImages(ctx context.Context, images []models.Image) error {
+	if err := f.imageRepository.CreateBatch(ctx, len(images), images); err != nil {
+		return eris.Wrap(err, "error creating test images")
+	}
+	return nil
+}
+
+// GetImages returns all the images of the run ordered by name, step and index.
+func (f ImageFixtures) GetImages(ctx context.Context, runID string) ([]models.Image, error) {
+	var images []models.Image
+	if err := f.db.WithContext(ctx).Preload(
+		"Context",
+	).Where(
+		"run_uuid = ?", runID,
+	).Order(
+		"name",
+	).Order(
+		"step",
+	).Order(
+		"idx",
+	).Find(&images).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting images of run: %s", runID)
+	}
+	return images, nil
+}
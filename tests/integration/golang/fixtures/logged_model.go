@@ -0,0 +1,47 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// LoggedModelFixtures represents data fixtures object.
+type LoggedModelFixtures struct {
+	baseFixtures
+}
+
+// NewLoggedModelFixtures creates new instance of LoggedModelFixtures.
+func NewLoggedModelFixtures(db *gorm.DB) (*LoggedModelFixtures, error) {
+	return &LoggedModelFixtures{
+		baseFixtures: baseFixtures{db: db},
+	}, nil
+}
+
+// CreateLoggedModel creates new test LoggedModel together with its params and tags.
+func (f LoggedModelFixtures) CreateLoggedModel(
+	ctx context.Context, model *models.LoggedModel,
+) (*models.LoggedModel, error) {
+	if err := f.baseFixtures.db.WithContext(ctx).Create(model).Error; err != nil {
+		return nil, eris.Wrap(err, "error creating test logged model")
+	}
+	return model, nil
+}
+
+// GetLoggedModel returns the logged model by id together with its params and tags.
+func (f LoggedModelFixtures) GetLoggedModel(ctx context.Context, modelID string) (*models.LoggedModel, error) {
+	var model models.LoggedModel
+	if err := f.db.WithContext(ctx).Preload(
+		"Params",
+	).Preload(
+		"Tags",
+	).Where(
+		"model_id = ?", modelID,
+	).First(&model).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting logged model by id: %s", modelID)
+	}
+	return &model, nil
+}
@@ -0,0 +1,113 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+// RegisteredModelFixtures represents data fixtures object.
+type RegisteredModelFixtures struct {
+	baseFixtures
+	modelVersionRepository           repositories.ModelVersionRepositoryProvider
+	registeredModelRepository        repositories.RegisteredModelRepositoryProvider
+	modelVersionTransitionRepository repositories.ModelVersionTransitionRepositoryProvider
+}
+
+// NewRegisteredModelFixtures creates new instance of RegisteredModelFixtures.
+func NewRegisteredModelFixtures(db *gorm.DB) (*RegisteredModelFixtures, error) {
+	return &RegisteredModelFixtures{
+		baseFixtures:                     baseFixtures{db: db},
+		modelVersionRepository:           repositories.NewModelVersionRepository(db),
+		registeredModelRepository:        repositories.NewRegisteredModelRepository(db),
+		modelVersionTransitionRepository: repositories.NewModelVersionTransitionRepository(db),
+	}, nil
+}
+
+// CreateRegisteredModel creates a new test RegisteredModel.
+func (f RegisteredModelFixtures) CreateRegisteredModel(
+	ctx context.Context, registeredModel *models.RegisteredModel,
+) (*models.RegisteredModel, error) {
+	if err := f.registeredModelRepository.Create(ctx, registeredModel); err != nil {
+		return nil, eris.Wrap(err, "error creating test registered model")
+	}
+	return registeredModel, nil
+}
+
+// CreateModelVersion creates a new test ModelVersion.
+func (f RegisteredModelFixtures) CreateModelVersion(
+	ctx context.Context, modelVersion *models.ModelVersion,
+) (*models.ModelVersion, error) {
+	if err := f.modelVersionRepository.Create(ctx, modelVersion); err != nil {
+		return nil, eris.Wrap(err, "error creating test model version")
+	}
+	return modelVersion, nil
+}
+
+// CreateRegisteredModelAlias creates a new test RegisteredModelAlias.
+func (f RegisteredModelFixtures) CreateRegisteredModelAlias(
+	ctx context.Context, alias *models.RegisteredModelAlias,
+) (*models.RegisteredModelAlias, error) {
+	if err := f.registeredModelRepository.SetAlias(ctx, alias); err != nil {
+		return nil, eris.Wrap(err, "error creating test registered model alias")
+	}
+	return alias, nil
+}
+
+// GetRegisteredModel returns the registered model by Namespace ID and name.
+func (f RegisteredModelFixtures) GetRegisteredModel(
+	ctx context.Context, namespaceID uint, name string,
+) (*models.RegisteredModel, error) {
+	registeredModel, err := f.registeredModelRepository.GetByNamespaceIDAndName(ctx, namespaceID, name)
+	if err != nil {
+		return nil, eris.Wrapf(err, "error getting registered model with name %s", name)
+	}
+	return registeredModel, nil
+}
+
+// GetModelVersion returns the model version by Registered Model ID and version.
+func (f RegisteredModelFixtures) GetModelVersion(
+	ctx context.Context, registeredModelID uint, version int64,
+) (*models.ModelVersion, error) {
+	modelVersion, err := f.modelVersionRepository.GetByRegisteredModelIDAndVersion(ctx, registeredModelID, version)
+	if err != nil {
+		return nil, eris.Wrapf(err, "error getting model version %d", version)
+	}
+	return modelVersion, nil
+}
+
+// CreateTransitionRequest creates a new test ModelVersionTransitionRequest.
+func (f RegisteredModelFixtures) CreateTransitionRequest(
+	ctx context.Context, transitionRequest *models.ModelVersionTransitionRequest,
+) (*models.ModelVersionTransitionRequest, error) {
+	if err := f.modelVersionTransitionRepository.CreateRequest(ctx, transitionRequest); err != nil {
+		return nil, eris.Wrap(err, "error creating test transition request")
+	}
+	return transitionRequest, nil
+}
+
+// GetTransitionRequests returns the transition requests by Model Version ID.
+func (f RegisteredModelFixtures) GetTransitionRequests(
+	ctx context.Context, modelVersionID uint,
+) ([]models.ModelVersionTransitionRequest, error) {
+	transitionRequests, err := f.modelVersionTransitionRepository.GetRequestsByModelVersionID(ctx, modelVersionID)
+	if err != nil {
+		return nil, eris.Wrapf(err, "error getting transition requests of model version %d", modelVersionID)
+	}
+	return transitionRequests, nil
+}
+
+// GetTransitions returns the history of stage transitions by Registered Model ID.
+func (f RegisteredModelFixtures) GetTransitions(
+	ctx context.Context, registeredModelID uint,
+) ([]models.ModelVersionTransition, error) {
+	transitions, err := f.modelVersionTransitionRepository.GetByRegisteredModelID(ctx, registeredModelID, 0)
+	if err != nil {
+		return nil, eris.Wrapf(err, "error getting transitions of registered model %d", registeredModelID)
+	}
+	return transitions, nil
+}
@@ -9,8 +9,11 @@ import (
 	"github.com/rotisserie/eris"
 	"gorm.io/gorm"
 
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/sweeper"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/webhook"
 )
 
 // RunFixtures represents data fixtures object.
@@ -133,6 +136,17 @@ func (f RunFixtures) GetRun(ctx context.Context, runID string) (*models.Run, err
 	return run, nil
 }
 
+// SweepStaleRuns terminates stale runs the same way, as the background sweeper does.
+func (f RunFixtures) SweepStaleRuns(ctx context.Context, config *config.ServiceConfig) ([]models.Run, error) {
+	runs, err := sweeper.NewService(
+		config, f.runRepository, webhook.NewDispatcher(config, repositories.NewWebhookRepository(f.db)),
+	).Sweep(ctx)
+	if err != nil {
+		return nil, eris.Wrap(err, "error sweeping stale runs")
+	}
+	return runs, nil
+}
+
 // GetRuns fetches all runs for an experiment.
 func (f RunFixtures) GetRuns(
 	ctx context.Context, experimentID int32,
@@ -0,0 +1,52 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+// TextFixtures represents data fixtures object.
+type TextFixtures struct {
+	baseFixtures
+	textRepository repositories.TextRepositoryProvider
+}
+
+// NewTextFixtures creates new instance of TextFixtures.
+func NewTextFixtures(db *gorm.DB) (*TextFixtures, error) {
+	return &TextFixtures{
+		baseFixtures:   baseFixtures{db: db},
+		textRepository: repositories.NewTextRepository(db),
+	}, nil
+}
+
+// CreateTexts creates new test Texts.
+func (f TextFixtures) CreateTexts(ctx context.Context, texts []models.Text) error {
+	if err := f.textRepository.CreateBatch(ctx, len(texts), texts); err != nil {
+		return eris.Wrap(err, "error creating test texts")
+	}
+	return nil
+}
+
+// GetTexts returns all the texts of the run ordered by name, step and index.
+func (f TextFixtures) GetTexts(ctx context.Context, runID string) ([]models.Text, error) {
+	var texts []models.Text
+	if err := f.db.WithContext(ctx).Preload(
+		"Context",
+	).Where(
+		"run_uuid = ?", runID,
+	).Order(
+		"name",
+	).Order(
+		"step",
+	).Order(
+		"idx",
+	).Find(&texts).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting texts of run: %s", runID)
+	}
+	return texts, nil
+}
@@ -0,0 +1,47 @@
+package fixtures
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+)
+
+// TraceFixtures represents data fixtures object.
+type TraceFixtures struct {
+	baseFixtures
+}
+
+// NewTraceFixtures creates new instance of TraceFixtures.
+func NewTraceFixtures(db *gorm.DB) (*TraceFixtures, error) {
+	return &TraceFixtures{
+		baseFixtures: baseFixtures{db: db},
+	}, nil
+}
+
+// CreateTrace creates new test Trace together with its tags and request metadata.
+func (f TraceFixtures) CreateTrace(ctx context.Context, trace *models.TraceInfo) (*models.TraceInfo, error) {
+	if err := f.baseFixtures.db.WithContext(ctx).Create(trace).Error; err != nil {
+		return nil, eris.Wrap(err, "error creating test trace")
+	}
+	return trace, nil
+}
+
+// GetTraces returns all the traces of the experiment ordered by request id.
+func (f TraceFixtures) GetTraces(ctx context.Context, experimentID int32) ([]models.TraceInfo, error) {
+	var traces []models.TraceInfo
+	if err := f.db.WithContext(ctx).Preload(
+		"Tags",
+	).Preload(
+		"RequestMetadata",
+	).Where(
+		"experiment_id = ?", experimentID,
+	).Order(
+		"request_id",
+	).Find(&traces).Error; err != nil {
+		return nil, eris.Wrapf(err, "error getting traces by experiment id: %d", experimentID)
+	}
+	return traces, nil
+}
@@ -0,0 +1,77 @@
+package fixtures
+
+import (
+	"context"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+)
+
+// WebhookFixtures represents data fixtures object.
+type WebhookFixtures struct {
+	baseFixtures
+	webhookRepository repositories.WebhookRepositoryProvider
+}
+
+// NewWebhookFixtures creates new instance of WebhookFixtures.
+func NewWebhookFixtures(db *gorm.DB) (*WebhookFixtures, error) {
+	return &WebhookFixtures{
+		baseFixtures:      baseFixtures{db: db},
+		webhookRepository: repositories.NewWebhookRepository(db),
+	}, nil
+}
+
+// CreateWebhook creates new test Webhook.
+func (f WebhookFixtures) CreateWebhook(ctx context.Context, webhook *models.Webhook) (*models.Webhook, error) {
+	if err := f.webhookRepository.Create(ctx, webhook); err != nil {
+		return nil, eris.Wrap(err, "error creating test webhook")
+	}
+	return webhook, nil
+}
+
+// GetWebhooks returns all the webhooks of the namespace.
+func (f WebhookFixtures) GetWebhooks(ctx context.Context, namespaceID uint) ([]models.Webhook, error) {
+	webhooks, err := f.webhookRepository.ListByNamespaceID(ctx, namespaceID)
+	if err != nil {
+		return nil, eris.Wrap(err, "error getting test webhooks")
+	}
+	return webhooks, nil
+}
+
+// GetDeliveries returns all the deliveries of the webhook.
+func (f WebhookFixtures) GetDeliveries(ctx context.Context, webhookID uint) ([]models.WebhookDelivery, error) {
+	var deliveries []models.WebhookDelivery
+	if err := f.db.WithContext(ctx).Where(
+		"webhook_id = ?", webhookID,
+	).Order(
+		"created_at",
+	).Find(&deliveries).Error; err != nil {
+		return nil, eris.Wrap(err, "error getting test webhook deliveries")
+	}
+	return deliveries, nil
+}
+
+// CreateDelivery creates new test WebhookDelivery.
+func (f WebhookFixtures) CreateDelivery(
+	ctx context.Context, delivery *models.WebhookDelivery,
+) (*models.WebhookDelivery, error) {
+	if err := f.webhookRepository.CreateDelivery(ctx, delivery); err != nil {
+		return nil, eris.Wrap(err, "error creating test webhook delivery")
+	}
+	return delivery, nil
+}
+
+// ClaimDelivery claims the delivery the same way as the worker does before the attempt.
+func (f WebhookFixtures) ClaimDelivery(
+	ctx context.Context, delivery *models.WebhookDelivery, until time.Time,
+) (bool, error) {
+	claimed, err := f.webhookRepository.ClaimDelivery(ctx, delivery, until)
+	if err != nil {
+		return false, eris.Wrap(err, "error claiming test webhook delivery")
+	}
+	return claimed, nil
+}
@@ -0,0 +1,274 @@
+package gc
+
+import (
+	"context"
+	"database/sql"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/repositories"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/artifact/storage"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/gc"
+	"github.com/G-Research/fasttrackml/pkg/database"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/fixtures"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GCTestSuite struct {
+	suite.Suite
+	db                  *gorm.DB
+	service             *gc.Service
+	artifactRoot        string
+	runFixtures         *fixtures.RunFixtures
+	traceFixtures       *fixtures.TraceFixtures
+	experimentFixtures  *fixtures.ExperimentFixtures
+	loggedModelFixtures *fixtures.LoggedModelFixtures
+}
+
+func TestGCTestSuite(t *testing.T) {
+	suite.Run(t, new(GCTestSuite))
+}
+
+func (s *GCTestSuite) SetupTest() {
+	dsn, err := helpers.GenerateDatabaseURI(s.T(), helpers.GetDatabaseBackend())
+	s.Require().Nil(err)
+	db, err := database.NewDBProvider(dsn, 1*time.Second, 20)
+	s.Require().Nil(err)
+	s.T().Cleanup(func() {
+		s.Require().Nil(db.Close())
+	})
+	s.Require().Nil(database.CheckAndMigrateDB(true, db.GormDB()))
+	s.Require().Nil(database.CreateDefaultNamespace(db.GormDB()))
+	s.db = db.GormDB()
+
+	s.runFixtures, err = fixtures.NewRunFixtures(s.db)
+	s.Require().Nil(err)
+	s.traceFixtures, err = fixtures.NewTraceFixtures(s.db)
+	s.Require().Nil(err)
+	s.loggedModelFixtures, err = fixtures.NewLoggedModelFixtures(s.db)
+	s.Require().Nil(err)
+	s.experimentFixtures, err = fixtures.NewExperimentFixtures(s.db)
+	s.Require().Nil(err)
+
+	artifactStorageFactory, err := storage.NewArtifactStorageFactory(&config.ServiceConfig{})
+	s.Require().Nil(err)
+	s.service = gc.NewService(
+		repositories.NewRunRepository(s.db),
+		repositories.NewExperimentRepository(s.db),
+		repositories.NewLoggedModelRepository(s.db),
+		artifactStorageFactory,
+	)
+	s.artifactRoot = s.T().TempDir()
+}
+
+func (s *GCTestSuite) Test_Ok() {
+	now := time.Now()
+	longAgo := sql.NullInt64{Int64: now.Add(-48 * time.Hour).UnixMilli(), Valid: true}
+	recently := sql.NullInt64{Int64: now.Add(-1 * time.Hour).UnixMilli(), Valid: true}
+
+	// 1. create active experiment with runs and logged models deleted long ago and recently, and active ones.
+	activeExperiment := s.createExperiment(models.LifecycleStageActive, recently)
+	oldDeletedRun := s.createRun(activeExperiment, models.LifecycleStageDeleted, longAgo)
+	newDeletedRun := s.createRun(activeExperiment, models.LifecycleStageDeleted, recently)
+	activeRun := s.createRun(activeExperiment, models.LifecycleStageActive, sql.NullInt64{})
+	oldDeletedModel := s.createLoggedModel(activeExperiment, models.LifecycleStageDeleted, longAgo.Int64)
+	newDeletedModel := s.createLoggedModel(activeExperiment, models.LifecycleStageDeleted, recently.Int64)
+	activeModel := s.createLoggedModel(activeExperiment, models.LifecycleStageActive, longAgo.Int64)
+
+	// 2. create experiment deleted long ago with active run, run which has no artifacts, trace and logged model.
+	deletedExperiment := s.createExperiment(models.LifecycleStageDeleted, longAgo)
+	deletedExperimentRun := s.createRun(deletedExperiment, models.LifecycleStageActive, sql.NullInt64{})
+	runWithoutArtifacts := s.createRun(deletedExperiment, models.LifecycleStageActive, sql.NullInt64{})
+	s.Require().Nil(os.RemoveAll(strings.TrimPrefix(runWithoutArtifacts.ArtifactURI, "file://")))
+	deletedExperimentTrace := s.createTrace(deletedExperiment)
+	deletedExperimentModel := s.createLoggedModel(deletedExperiment, models.LifecycleStageActive, longAgo.Int64)
+
+	// 3. dry run reports, but doesn't remove anything.
+	result, err := s.service.Collect(context.Background(), 24*time.Hour, true)
+	s.Require().Nil(err)
+	s.Require().Len(result.Experiments, 1)
+	s.Equal(*deletedExperiment.ID, *result.Experiments[0].ID)
+	s.Len(result.Experiments[0].Runs, 2)
+	s.Len(result.Experiments[0].Traces, 1)
+	s.Len(result.Experiments[0].LoggedModels, 1)
+	s.Require().Len(result.Runs, 1)
+	s.Equal(oldDeletedRun.ID, result.Runs[0].ID)
+	s.Require().Len(result.LoggedModels, 1)
+	s.Equal(oldDeletedModel.ID, result.LoggedModels[0].ID)
+	for _, run := range []*models.Run{oldDeletedRun, newDeletedRun, activeRun, deletedExperimentRun} {
+		s.assertRunExists(run, true)
+	}
+	s.assertTraceExists(deletedExperiment, deletedExperimentTrace, true)
+	for _, model := range []*models.LoggedModel{oldDeletedModel, newDeletedModel, activeModel, deletedExperimentModel} {
+		s.assertLoggedModelExists(model, true)
+	}
+
+	// 4. collect garbage for real.
+	result, err = s.service.Collect(context.Background(), 24*time.Hour, false)
+	s.Require().Nil(err)
+	s.Len(result.Experiments, 1)
+	s.Len(result.Runs, 1)
+	s.Len(result.LoggedModels, 1)
+
+	for _, run := range []*models.Run{oldDeletedRun, deletedExperimentRun, runWithoutArtifacts} {
+		s.assertRunExists(run, false)
+	}
+	for _, run := range []*models.Run{newDeletedRun, activeRun} {
+		s.assertRunExists(run, true)
+	}
+	s.assertTraceExists(deletedExperiment, deletedExperimentTrace, false)
+	for _, model := range []*models.LoggedModel{oldDeletedModel, deletedExperimentModel} {
+		s.assertLoggedModelExists(model, false)
+	}
+	for _, model := range []*models.LoggedModel{newDeletedModel, activeModel} {
+		s.assertLoggedModelExists(model, true)
+	}
+	var count int64
+	s.Require().Nil(s.db.Model(&models.Experiment{}).Where(
+		"experiment_id = ?", *deletedExperiment.ID,
+	).Count(&count).Error)
+	s.Zero(count)
+
+	// 5. nothing is left to collect.
+	result, err = s.service.Collect(context.Background(), 24*time.Hour, false)
+	s.Require().Nil(err)
+	s.Empty(result.Experiments)
+	s.Empty(result.Runs)
+	s.Empty(result.LoggedModels)
+}
+
+func (s *GCTestSuite) createExperiment(
+	lifecycleStage models.LifecycleStage, lastUpdateTime sql.NullInt64,
+) *models.Experiment {
+	experiment, err := s.experimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             uuid.New().String(),
+		NamespaceID:      1,
+		LifecycleStage:   lifecycleStage,
+		LastUpdateTime:   lastUpdateTime,
+		ArtifactLocation: "file://" + s.artifactRoot,
+	})
+	s.Require().Nil(err)
+	return experiment
+}
+
+func (s *GCTestSuite) createRun(
+	experiment *models.Experiment, lifecycleStage models.LifecycleStage, deletedTime sql.NullInt64,
+) *models.Run {
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	artifactDir := filepath.Join(s.artifactRoot, runID, "artifacts")
+	run, err := s.runFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusFinished,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    "file://" + artifactDir,
+		LifecycleStage: lifecycleStage,
+		DeletedTime:    deletedTime,
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(s.runFixtures.CreateMetrics(context.Background(), run, 2))
+	s.Require().Nil(s.runFixtures.CreateParams(context.Background(), run, 2))
+	s.Require().Nil(s.runFixtures.CreateTag(context.Background(), models.Tag{Key: "key", Value: "value", RunID: runID}))
+
+	s.Require().Nil(os.MkdirAll(artifactDir, fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(artifactDir, "artifact.file"), []byte("content"), fs.ModePerm))
+	return run
+}
+
+func (s *GCTestSuite) createTrace(experiment *models.Experiment) *models.TraceInfo {
+	requestID := "tr-" + strings.ReplaceAll(uuid.New().String(), "-", "")
+	artifactDir := filepath.Join(s.artifactRoot, "traces", requestID, "artifacts")
+	trace, err := s.traceFixtures.CreateTrace(context.Background(), &models.TraceInfo{
+		RequestID:    requestID,
+		ExperimentID: *experiment.ID,
+		TimestampMS:  time.Now().UnixMilli(),
+		Status:       models.TraceStatusOK,
+		Tags: []models.TraceTag{
+			{Key: models.TraceTagArtifactLocation, Value: "file://" + artifactDir},
+		},
+	})
+	s.Require().Nil(err)
+
+	s.Require().Nil(os.MkdirAll(artifactDir, fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(artifactDir, "traces.json"), []byte("{}"), fs.ModePerm))
+	return trace
+}
+
+func (s *GCTestSuite) createLoggedModel(
+	experiment *models.Experiment, lifecycleStage models.LifecycleStage, lastUpdatedTimestampMS int64,
+) *models.LoggedModel {
+	modelID := "m-" + strings.ReplaceAll(uuid.New().String(), "-", "")
+	artifactDir := filepath.Join(s.artifactRoot, "models", modelID, "artifacts")
+	model, err := s.loggedModelFixtures.CreateLoggedModel(context.Background(), &models.LoggedModel{
+		ID:                     modelID,
+		ExperimentID:           *experiment.ID,
+		Name:                   modelID,
+		ArtifactLocation:       "file://" + artifactDir,
+		CreationTimestampMS:    lastUpdatedTimestampMS,
+		LastUpdatedTimestampMS: lastUpdatedTimestampMS,
+		Status:                 models.LoggedModelStatusReady,
+		LifecycleStage:         lifecycleStage,
+		Params:                 []models.LoggedModelParam{{Key: "key", Value: "value"}},
+		Tags:                   []models.LoggedModelTag{{Key: "key", Value: "value"}},
+	})
+	s.Require().Nil(err)
+
+	s.Require().Nil(os.MkdirAll(artifactDir, fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(artifactDir, "model.pkl"), []byte("content"), fs.ModePerm))
+	return model
+}
+
+func (s *GCTestSuite) assertLoggedModelExists(model *models.LoggedModel, exists bool) {
+	for _, row := range []any{&models.LoggedModel{}, &models.LoggedModelParam{}, &models.LoggedModelTag{}} {
+		var count int64
+		s.Require().Nil(s.db.Model(row).Where("model_id = ?", model.ID).Count(&count).Error)
+		s.Equal(exists, count > 0, "unexpected number of %T rows of logged model %s: %d", row, model.ID, count)
+	}
+
+	_, err := os.Stat(strings.TrimPrefix(model.ArtifactLocation, "file://"))
+	if exists {
+		s.Nil(err)
+	} else {
+		s.ErrorIs(err, fs.ErrNotExist)
+	}
+}
+
+func (s *GCTestSuite) assertTraceExists(experiment *models.Experiment, trace *models.TraceInfo, exists bool) {
+	traces, err := s.traceFixtures.GetTraces(context.Background(), *experiment.ID)
+	s.Require().Nil(err)
+	s.Equal(exists, len(traces) > 0, "unexpected number of traces of experiment %d: %d", *experiment.ID, len(traces))
+
+	artifactLocation, ok := trace.GetTag(models.TraceTagArtifactLocation)
+	s.Require().True(ok)
+	_, err = os.Stat(strings.TrimPrefix(artifactLocation, "file://"))
+	if exists {
+		s.Nil(err)
+	} else {
+		s.ErrorIs(err, fs.ErrNotExist)
+	}
+}
+
+func (s *GCTestSuite) assertRunExists(run *models.Run, exists bool) {
+	for _, model := range []any{&models.Run{}, &models.Metric{}, &models.LatestMetric{}, &models.Param{}, &models.Tag{}} {
+		var count int64
+		s.Require().Nil(s.db.Model(model).Where("run_uuid = ?", run.ID).Count(&count).Error)
+		s.Equal(exists, count > 0, "unexpected number of %T rows of run %s: %d", model, run.ID, count)
+	}
+
+	_, err := os.Stat(strings.TrimPrefix(run.ArtifactURI, "file://"))
+	if exists {
+		s.Nil(err)
+	} else {
+		s.ErrorIs(err, fs.ErrNotExist)
+	}
+}
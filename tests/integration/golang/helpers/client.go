@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -30,16 +31,17 @@ const (
 
 // HttpClient represents HTTP client.
 type HttpClient struct {
-	server       server.Server
-	basePath     string
-	namespace    string
-	method       string
-	params       any
-	headers      map[string]string
-	request      any
-	response     any
-	responseType ResponseType
-	statusCode   int
+	server          server.Server
+	basePath        string
+	namespace       string
+	method          string
+	params          any
+	headers         map[string]string
+	request         any
+	response        any
+	responseType    ResponseType
+	statusCode      int
+	responseHeaders http.Header
 }
 
 // NewClient creates new preconfigured HTTP client.
@@ -60,6 +62,11 @@ func NewMlflowApiClient(server server.Server) *HttpClient {
 	return NewClient(server, "/api/2.0/mlflow")
 }
 
+// NewMlflowArtifactsApiClient creates new HTTP client for the mlflow artifacts proxy api
+func NewMlflowArtifactsApiClient(server server.Server) *HttpClient {
+	return NewClient(server, "/api/2.0/mlflow-artifacts")
+}
+
 // NewAimApiClient creates new HTTP client for the aim api
 func NewAimApiClient(server server.Server) *HttpClient {
 	return NewClient(server, "/aim/api")
@@ -100,6 +107,16 @@ func (c *HttpClient) WithHeaders(headers map[string]string) *HttpClient {
 	return c
 }
 
+// WithBasicAuth adds basic auth credentials to the HTTP request, when username is provided.
+func (c *HttpClient) WithBasicAuth(username, password string) *HttpClient {
+	if username != "" {
+		c.headers["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString(
+			[]byte(username+":"+password),
+		)
+	}
+	return c
+}
+
 // WithResponse sets the response object where HTTP response will be deserialized.
 func (c *HttpClient) WithResponse(response any) *HttpClient {
 	c.response = response
@@ -117,12 +134,20 @@ func (c *HttpClient) GetStatusCode() int {
 	return c.statusCode
 }
 
+// GetResponseHeaders returns HTTP headers of the last response, if available.
+func (c *HttpClient) GetResponseHeaders() http.Header {
+	return c.responseHeaders
+}
+
 // DoRequest do actual HTTP request based on provided parameters.
 // nolint:gocyclo
 func (c *HttpClient) DoRequest(uri string, values ...any) error {
 	// 1. check if request object were provided. if provided then marshal it.
+	// io.Reader is sent as is, so raw content could be provided as well.
 	var requestBody io.Reader
-	if c.request != nil {
+	if reader, ok := c.request.(io.Reader); ok {
+		requestBody = reader
+	} else if c.request != nil {
 		data, err := json.Marshal(c.request)
 		if err != nil {
 			return eris.Wrap(err, "error marshaling request object")
@@ -183,6 +208,7 @@ func (c *HttpClient) DoRequest(uri string, values ...any) error {
 	}
 
 	c.statusCode = resp.StatusCode
+	c.responseHeaders = resp.Header
 
 	// 8. read and check response data.
 	if c.response != nil {
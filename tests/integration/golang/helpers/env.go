@@ -43,3 +43,14 @@ func GetS3EndpointUri() string {
 	}
 	return "http://localhost:9000"
 }
+
+func GetAzureStorageConnectionString() string {
+	connectionString, ok := os.LookupEnv("FML_AZURE_STORAGE_CONNECTION_STRING")
+	if ok {
+		return connectionString
+	}
+	// well-known development credentials of Azurite.
+	return "DefaultEndpointsProtocol=http;AccountName=devstoreaccount1;" +
+		"AccountKey=Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw==;" +
+		"BlobEndpoint=http://localhost:10000/devstoreaccount1;"
+}
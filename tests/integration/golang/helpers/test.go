@@ -17,28 +17,45 @@ import (
 
 type BaseTestSuite struct {
 	suite.Suite
-	server                      server.Server
-	db                          database.DBProvider
-	setupHooks                  []func()
-	tearDownHooks               []func()
-	AIMClient                   func() *HttpClient
-	MlflowClient                func() *HttpClient
-	AdminClient                 func() *HttpClient
-	AppFixtures                 *fixtures.AppFixtures
-	RunFixtures                 *fixtures.RunFixtures
-	TagFixtures                 *fixtures.TagFixtures
-	MetricFixtures              *fixtures.MetricFixtures
-	ContextFixtures             *fixtures.ContextFixtures
-	ParamFixtures               *fixtures.ParamFixtures
-	ProjectFixtures             *fixtures.ProjectFixtures
-	DashboardFixtures           *fixtures.DashboardFixtures
-	ExperimentFixtures          *fixtures.ExperimentFixtures
-	DefaultExperiment           *models.Experiment
-	NamespaceFixtures           *fixtures.NamespaceFixtures
-	DefaultNamespace            *models.Namespace
-	ResetOnSubTest              bool
-	SkipCreateDefaultNamespace  bool
-	SkipCreateDefaultExperiment bool
+	server                          server.Server
+	stopWorkers                     context.CancelFunc
+	db                              database.DBProvider
+	setupHooks                      []func()
+	tearDownHooks                   []func()
+	AIMClient                       func() *HttpClient
+	MlflowClient                    func() *HttpClient
+	MlflowArtifactsClient           func() *HttpClient
+	AdminClient                     func() *HttpClient
+	AppFixtures                     *fixtures.AppFixtures
+	AimTagFixtures                  *fixtures.AimTagFixtures
+	RunFixtures                     *fixtures.RunFixtures
+	TagFixtures                     *fixtures.TagFixtures
+	TraceFixtures                   *fixtures.TraceFixtures
+	LoggedModelFixtures             *fixtures.LoggedModelFixtures
+	WebhookFixtures                 *fixtures.WebhookFixtures
+	AlertFixtures                   *fixtures.AlertFixtures
+	ImageFixtures                   *fixtures.ImageFixtures
+	TextFixtures                    *fixtures.TextFixtures
+	DistributionFixtures            *fixtures.DistributionFixtures
+	MetricFixtures                  *fixtures.MetricFixtures
+	ContextFixtures                 *fixtures.ContextFixtures
+	ParamFixtures                   *fixtures.ParamFixtures
+	ProjectFixtures                 *fixtures.ProjectFixtures
+	RegisteredModelFixtures         *fixtures.RegisteredModelFixtures
+	DashboardFixtures               *fixtures.DashboardFixtures
+	ExperimentFixtures              *fixtures.ExperimentFixtures
+	DefaultExperiment               *models.Experiment
+	NamespaceFixtures               *fixtures.NamespaceFixtures
+	DefaultNamespace                *models.Namespace
+	ArtifactsDestination            string
+	AuthUsername                    string
+	AuthPassword                    string
+	AuthUsers                       []string
+	AllowDirectProductionTransition bool
+	StaleRunTimeout                 time.Duration
+	ResetOnSubTest                  bool
+	SkipCreateDefaultNamespace      bool
+	SkipCreateDefaultExperiment     bool
 }
 
 func (s *BaseTestSuite) runSetupHooks() {
@@ -79,6 +96,10 @@ func (s *BaseTestSuite) initFixtures() {
 	s.Require().Nil(err)
 	s.AppFixtures = appFixtures
 
+	aimTagFixtures, err := fixtures.NewAimTagFixtures(db)
+	s.Require().Nil(err)
+	s.AimTagFixtures = aimTagFixtures
+
 	dashboardFixtures, err := fixtures.NewDashboardFixtures(db)
 	s.Require().Nil(err)
 	s.DashboardFixtures = dashboardFixtures
@@ -107,6 +128,10 @@ func (s *BaseTestSuite) initFixtures() {
 	s.Require().Nil(err)
 	s.ParamFixtures = paramFixtures
 
+	registeredModelFixtures, err := fixtures.NewRegisteredModelFixtures(db)
+	s.Require().Nil(err)
+	s.RegisteredModelFixtures = registeredModelFixtures
+
 	runFixtures, err := fixtures.NewRunFixtures(db)
 	s.Require().Nil(err)
 	s.RunFixtures = runFixtures
@@ -114,6 +139,34 @@ func (s *BaseTestSuite) initFixtures() {
 	tagFixtures, err := fixtures.NewTagFixtures(db)
 	s.Require().Nil(err)
 	s.TagFixtures = tagFixtures
+
+	traceFixtures, err := fixtures.NewTraceFixtures(db)
+	s.Require().Nil(err)
+	s.TraceFixtures = traceFixtures
+
+	loggedModelFixtures, err := fixtures.NewLoggedModelFixtures(db)
+	s.Require().Nil(err)
+	s.LoggedModelFixtures = loggedModelFixtures
+
+	webhookFixtures, err := fixtures.NewWebhookFixtures(db)
+	s.Require().Nil(err)
+	s.WebhookFixtures = webhookFixtures
+
+	alertFixtures, err := fixtures.NewAlertFixtures(db)
+	s.Require().Nil(err)
+	s.AlertFixtures = alertFixtures
+
+	imageFixtures, err := fixtures.NewImageFixtures(db)
+	s.Require().Nil(err)
+	s.ImageFixtures = imageFixtures
+
+	textFixtures, err := fixtures.NewTextFixtures(db)
+	s.Require().Nil(err)
+	s.TextFixtures = textFixtures
+
+	distributionFixtures, err := fixtures.NewDistributionFixtures(db)
+	s.Require().Nil(err)
+	s.DistributionFixtures = distributionFixtures
 }
 
 func (s *BaseTestSuite) closeDB() {
@@ -121,30 +174,50 @@ func (s *BaseTestSuite) closeDB() {
 }
 
 func (s *BaseTestSuite) startServer() {
+	// background workers of the server, e.g. webhook dispatcher, are stopped together with it.
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopWorkers = cancel
+
 	var err error
-	s.server, err = server.NewServer(context.Background(), &config.ServiceConfig{
-		DatabaseURI:           s.db.Dsn(),
-		DatabasePoolMax:       10,
-		DatabaseSlowThreshold: 1 * time.Second,
-		DatabaseMigrate:       true,
-		DefaultArtifactRoot:   s.T().TempDir(),
-		S3EndpointURI:         GetS3EndpointUri(),
-		GSEndpointURI:         GetGSEndpointUri(),
+	s.server, err = server.NewServer(ctx, &config.ServiceConfig{
+		DatabaseURI:                     s.db.Dsn(),
+		DatabasePoolMax:                 10,
+		DatabaseSlowThreshold:           1 * time.Second,
+		DatabaseMigrate:                 true,
+		DefaultArtifactRoot:             s.T().TempDir(),
+		ArtifactsDestination:            s.ArtifactsDestination,
+		S3EndpointURI:                   GetS3EndpointUri(),
+		GSEndpointURI:                   GetGSEndpointUri(),
+		AzureStorageConnectionString:    GetAzureStorageConnectionString(),
+		WebhookMaxAttempts:              3,
+		WebhookRetryBackoff:             10 * time.Millisecond,
+		WebhookTimeout:                  5 * time.Second,
+		AuthUsername:                    s.AuthUsername,
+		AuthPassword:                    s.AuthPassword,
+		AuthUsers:                       s.AuthUsers,
+		AllowDirectProductionTransition: s.AllowDirectProductionTransition,
+		StaleRunTimeout:                 s.StaleRunTimeout,
+		StaleRunStatus:                  string(models.StatusKilled),
+		StaleRunSweepInterval:           time.Hour,
 	})
 	s.Require().Nil(err)
 
 	s.AIMClient = func() *HttpClient {
-		return NewAimApiClient(s.server)
+		return NewAimApiClient(s.server).WithBasicAuth(s.AuthUsername, s.AuthPassword)
 	}
 	s.MlflowClient = func() *HttpClient {
-		return NewMlflowApiClient(s.server)
+		return NewMlflowApiClient(s.server).WithBasicAuth(s.AuthUsername, s.AuthPassword)
+	}
+	s.MlflowArtifactsClient = func() *HttpClient {
+		return NewMlflowArtifactsApiClient(s.server).WithBasicAuth(s.AuthUsername, s.AuthPassword)
 	}
 	s.AdminClient = func() *HttpClient {
-		return NewAdminApiClient(s.server)
+		return NewAdminApiClient(s.server).WithBasicAuth(s.AuthUsername, s.AuthPassword)
 	}
 }
 
 func (s *BaseTestSuite) stopServer() {
+	s.stopWorkers()
 	s.Require().Nil(s.server.ShutdownWithTimeout(5 * time.Second))
 }
 
@@ -0,0 +1,104 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CreateAlertRuleTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestCreateAlertRuleTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateAlertRuleTestSuite))
+}
+
+func (s *CreateAlertRuleTestSuite) Test_Ok() {
+	resp := response.AlertRuleResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateAlertRuleRequest{
+				ExperimentID: fmt.Sprint(*s.DefaultExperiment.ID),
+				Name:         "val_acc is too low",
+				Key:          "val_acc",
+				Condition:    string(models.AlertRuleConditionLessThan),
+				Threshold:    0.5,
+				MinStep:      1000,
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest("%s%s", mlflow.AlertRulesRoutePrefix, mlflow.AlertRulesCreateRoute),
+	)
+	s.NotEmpty(resp.AlertRule.ID)
+	s.Equal(fmt.Sprint(*s.DefaultExperiment.ID), resp.AlertRule.ExperimentID)
+	s.Equal("val_acc is too low", resp.AlertRule.Name)
+	s.Equal("val_acc", resp.AlertRule.Key)
+	s.Equal(string(models.AlertRuleConditionLessThan), resp.AlertRule.Condition)
+	s.Equal(0.5, resp.AlertRule.Threshold)
+	s.Equal(int64(1000), resp.AlertRule.MinStep)
+	s.True(resp.AlertRule.Active)
+	s.NotZero(resp.AlertRule.CreationTime)
+
+	rules, err := s.AlertFixtures.GetAlertRules(context.Background(), *s.DefaultExperiment.ID)
+	s.Require().Nil(err)
+	s.Require().Len(rules, 1)
+	s.Equal(resp.AlertRule.ID, fmt.Sprint(rules[0].ID))
+}
+
+func (s *CreateAlertRuleTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.CreateAlertRuleRequest
+	}{
+		{
+			name:    "EmptyExperimentID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'"),
+			request: request.CreateAlertRuleRequest{},
+		},
+		{
+			name:  "UnsupportedCondition",
+			error: api.NewInvalidParameterValueError("Unsupported alert rule condition 'EQUAL'"),
+			request: request.CreateAlertRuleRequest{
+				ExperimentID: "0", Name: "rule", Key: "loss", Condition: "EQUAL",
+			},
+		},
+		{
+			name: "NotFoundExperiment",
+			error: api.NewResourceDoesNotExistError(
+				"unable to find experiment '123': error getting experiment by id: 123: record not found",
+			),
+			request: request.CreateAlertRuleRequest{
+				ExperimentID: "123", Name: "rule", Key: "loss", Condition: "IS_NAN",
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest("%s%s", mlflow.AlertRulesRoutePrefix, mlflow.AlertRulesCreateRoute),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
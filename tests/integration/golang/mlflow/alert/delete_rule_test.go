@@ -0,0 +1,65 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteAlertRuleTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDeleteAlertRuleTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteAlertRuleTestSuite))
+}
+
+func (s *DeleteAlertRuleTestSuite) Test_Ok() {
+	rule, err := s.AlertFixtures.CreateAlertRule(context.Background(), &models.AlertRule{
+		ExperimentID: *s.DefaultExperiment.ID,
+		Name:         "loss is NaN",
+		Key:          "loss",
+		Condition:    models.AlertRuleConditionIsNan,
+		Active:       true,
+	})
+	s.Require().Nil(err)
+
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.DeleteAlertRuleRequest{ID: fmt.Sprint(rule.ID)},
+		).WithResponse(
+			&resp,
+		).DoRequest("%s%s", mlflow.AlertRulesRoutePrefix, mlflow.AlertRulesDeleteRoute),
+	)
+	s.Empty(resp)
+
+	rules, err := s.AlertFixtures.GetAlertRules(context.Background(), *s.DefaultExperiment.ID)
+	s.Require().Nil(err)
+	s.Empty(rules)
+}
+
+func (s *DeleteAlertRuleTestSuite) Test_Error() {
+	resp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.DeleteAlertRuleRequest{ID: "123"},
+		).WithResponse(
+			&resp,
+		).DoRequest("%s%s", mlflow.AlertRulesRoutePrefix, mlflow.AlertRulesDeleteRoute),
+	)
+	s.Equal(api.NewResourceDoesNotExistError("unable to find alert rule '123'").Error(), resp.Error())
+}
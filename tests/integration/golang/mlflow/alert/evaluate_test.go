@@ -0,0 +1,170 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type EvaluateAlertRulesTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestEvaluateAlertRulesTestSuite(t *testing.T) {
+	suite.Run(t, new(EvaluateAlertRulesTestSuite))
+}
+
+func (s *EvaluateAlertRulesTestSuite) logBatch(runID string, metrics ...request.MetricPartialRequest) {
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogBatchRequest{RunID: runID, Metrics: metrics},
+		).WithResponse(
+			&resp,
+		).DoRequest("%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogBatchRoute),
+	)
+}
+
+func (s *EvaluateAlertRulesTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	nanRule, err := s.AlertFixtures.CreateAlertRule(context.Background(), &models.AlertRule{
+		ExperimentID: *s.DefaultExperiment.ID,
+		Name:         "loss is NaN",
+		Key:          "loss",
+		Condition:    models.AlertRuleConditionIsNan,
+		Active:       true,
+	})
+	s.Require().Nil(err)
+	thresholdRule, err := s.AlertFixtures.CreateAlertRule(context.Background(), &models.AlertRule{
+		ExperimentID: *s.DefaultExperiment.ID,
+		Name:         "val_acc is too low",
+		Key:          "val_acc",
+		Condition:    models.AlertRuleConditionLessThan,
+		Threshold:    0.5,
+		MinStep:      1000,
+		Active:       true,
+	})
+	s.Require().Nil(err)
+	inactiveRule, err := s.AlertFixtures.CreateAlertRule(context.Background(), &models.AlertRule{
+		ExperimentID: *s.DefaultExperiment.ID,
+		Name:         "val_acc is low",
+		Key:          "val_acc",
+		Condition:    models.AlertRuleConditionLessThan,
+		Threshold:    0.9,
+		Active:       false,
+	})
+	s.Require().Nil(err)
+
+	// val_acc is too low, but it is too early to fire the rule.
+	s.logBatch(
+		run.ID,
+		request.MetricPartialRequest{Key: "loss", Value: 1.5, Timestamp: 1, Step: 500},
+		request.MetricPartialRequest{Key: "val_acc", Value: 0.3, Timestamp: 1, Step: 500},
+	)
+	alerts, err := s.AlertFixtures.GetAlerts(context.Background(), thresholdRule.ID)
+	s.Require().Nil(err)
+	s.Empty(alerts)
+
+	// both rules are fired now.
+	s.logBatch(
+		run.ID,
+		request.MetricPartialRequest{Key: "loss", Value: "NaN", Timestamp: 2, Step: 1000},
+		request.MetricPartialRequest{Key: "val_acc", Value: 0.4, Timestamp: 2, Step: 1000},
+	)
+	// the rules are fired only once per run.
+	s.logBatch(
+		run.ID,
+		request.MetricPartialRequest{Key: "loss", Value: "NaN", Timestamp: 3, Step: 1001},
+		request.MetricPartialRequest{Key: "val_acc", Value: 0.2, Timestamp: 3, Step: 1001},
+	)
+
+	alerts, err = s.AlertFixtures.GetAlerts(context.Background(), nanRule.ID)
+	s.Require().Nil(err)
+	s.Require().Len(alerts, 1)
+	s.True(alerts[0].IsNan)
+	s.Equal(int64(1000), alerts[0].Step)
+
+	alerts, err = s.AlertFixtures.GetAlerts(context.Background(), thresholdRule.ID)
+	s.Require().Nil(err)
+	s.Require().Len(alerts, 1)
+	s.Equal(0.4, alerts[0].Value)
+	s.Equal(int64(1000), alerts[0].Step)
+	s.Equal("metric 'val_acc' < 0.5", alerts[0].Message)
+
+	alerts, err = s.AlertFixtures.GetAlerts(context.Background(), inactiveRule.ID)
+	s.Require().Nil(err)
+	s.Empty(alerts)
+
+	// fired alerts are listed through the api.
+	resp := response.ListAlertsResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.ListAlertsRequest{ExperimentID: fmt.Sprint(*s.DefaultExperiment.ID), RunID: run.ID},
+		).WithResponse(
+			&resp,
+		).DoRequest("%s%s", mlflow.AlertsRoutePrefix, mlflow.AlertsListRoute),
+	)
+	s.Require().Len(resp.Alerts, 2)
+	values := map[string]any{}
+	for _, alert := range resp.Alerts {
+		s.Equal(run.ID, alert.RunID)
+		s.Equal(fmt.Sprint(*s.DefaultExperiment.ID), alert.ExperimentID)
+		values[alert.AlertRuleName] = alert.Value
+	}
+	s.Equal(map[string]any{"loss is NaN": "NaN", "val_acc is too low": 0.4}, values)
+}
+
+func (s *EvaluateAlertRulesTestSuite) Test_Error() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	_, err = s.AlertFixtures.CreateAlertRule(context.Background(), &models.AlertRule{
+		ExperimentID: *s.DefaultExperiment.ID,
+		Name:         "loss is NaN",
+		Key:          "loss",
+		Condition:    models.AlertRuleConditionIsNan,
+		Active:       true,
+	})
+	s.Require().Nil(err)
+
+	// fired alert can't be stored, but metrics have been already logged, so the request still succeeds.
+	restore, err := s.AlertFixtures.BreakAlerts(context.Background())
+	s.Require().Nil(err)
+	defer func() {
+		s.Require().Nil(restore())
+	}()
+	s.logBatch(run.ID, request.MetricPartialRequest{Key: "loss", Value: "NaN", Timestamp: 1, Step: 1})
+
+	metrics, err := s.MetricFixtures.GetMetricsByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Require().Len(metrics, 1)
+	s.Equal("loss", metrics[0].Key)
+	s.True(metrics[0].IsNan)
+}
@@ -0,0 +1,109 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetAlertRuleTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetAlertRuleTestSuite(t *testing.T) {
+	suite.Run(t, new(GetAlertRuleTestSuite))
+}
+
+func (s *GetAlertRuleTestSuite) Test_Ok() {
+	rule, err := s.AlertFixtures.CreateAlertRule(context.Background(), &models.AlertRule{
+		ExperimentID:   *s.DefaultExperiment.ID,
+		Name:           "loss is NaN",
+		Key:            "loss",
+		Condition:      models.AlertRuleConditionIsNan,
+		Active:         true,
+		CreationTime:   1000,
+		LastUpdateTime: 2000,
+	})
+	s.Require().Nil(err)
+
+	resp := response.AlertRuleResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetAlertRuleRequest{ID: fmt.Sprint(rule.ID)},
+		).WithResponse(
+			&resp,
+		).DoRequest("%s%s", mlflow.AlertRulesRoutePrefix, mlflow.AlertRulesGetRoute),
+	)
+	s.Equal(&response.AlertRulePartialResponse{
+		ID:             fmt.Sprint(rule.ID),
+		ExperimentID:   fmt.Sprint(*s.DefaultExperiment.ID),
+		Name:           "loss is NaN",
+		Key:            "loss",
+		Condition:      string(models.AlertRuleConditionIsNan),
+		Active:         true,
+		CreationTime:   1000,
+		LastUpdateTime: 2000,
+	}, resp.AlertRule)
+}
+
+func (s *GetAlertRuleTestSuite) Test_Error() {
+	// rules of the other namespaces are not visible.
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "other",
+		DefaultExperimentID: s.DefaultExperiment.ID,
+	})
+	s.Require().Nil(err)
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "other",
+		NamespaceID:    namespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	rule, err := s.AlertFixtures.CreateAlertRule(context.Background(), &models.AlertRule{
+		ExperimentID: *experiment.ID,
+		Name:         "loss is NaN",
+		Key:          "loss",
+		Condition:    models.AlertRuleConditionIsNan,
+		Active:       true,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.GetAlertRuleRequest
+	}{
+		{
+			name:    "EmptyID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'alert_rule_id'"),
+			request: request.GetAlertRuleRequest{},
+		},
+		{
+			name:    "RuleOfOtherNamespace",
+			error:   api.NewResourceDoesNotExistError(fmt.Sprintf("unable to find alert rule '%d'", rule.ID)),
+			request: request.GetAlertRuleRequest{ID: fmt.Sprint(rule.ID)},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest("%s%s", mlflow.AlertRulesRoutePrefix, mlflow.AlertRulesGetRoute),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
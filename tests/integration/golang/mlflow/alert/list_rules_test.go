@@ -0,0 +1,48 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type ListAlertRulesTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestListAlertRulesTestSuite(t *testing.T) {
+	suite.Run(t, new(ListAlertRulesTestSuite))
+}
+
+func (s *ListAlertRulesTestSuite) Test_Ok() {
+	for _, key := range []string{"loss", "val_loss"} {
+		_, err := s.AlertFixtures.CreateAlertRule(context.Background(), &models.AlertRule{
+			ExperimentID: *s.DefaultExperiment.ID,
+			Name:         fmt.Sprintf("%s is NaN", key),
+			Key:          key,
+			Condition:    models.AlertRuleConditionIsNan,
+			Active:       true,
+		})
+		s.Require().Nil(err)
+	}
+
+	resp := response.ListAlertRulesResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.ListAlertRulesRequest{ExperimentID: fmt.Sprint(*s.DefaultExperiment.ID)},
+		).WithResponse(
+			&resp,
+		).DoRequest("%s%s", mlflow.AlertRulesRoutePrefix, mlflow.AlertRulesListRoute),
+	)
+	s.Require().Len(resp.AlertRules, 2)
+	s.Equal("loss", resp.AlertRules[0].Key)
+	s.Equal("val_loss", resp.AlertRules[1].Key)
+}
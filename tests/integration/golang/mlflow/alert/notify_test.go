@@ -0,0 +1,119 @@
+package alert
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/alert"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type NotifyAlertsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestNotifyAlertsTestSuite(t *testing.T) {
+	suite.Run(t, new(NotifyAlertsTestSuite))
+}
+
+func (s *NotifyAlertsTestSuite) createRun(status models.Status) *models.Run {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         status,
+		StartTime:      sql.NullInt64{Int64: time.Now().Add(-time.Hour).UnixMilli(), Valid: true},
+	})
+	s.Require().Nil(err)
+	return run
+}
+
+func (s *NotifyAlertsTestSuite) Test_Ok() {
+	rule, err := s.AlertFixtures.CreateAlertRule(context.Background(), &models.AlertRule{
+		ExperimentID:  *s.DefaultExperiment.ID,
+		Name:          "loss is not logged",
+		Key:           "loss",
+		Condition:     models.AlertRuleConditionNotLogged,
+		WindowSeconds: 1800,
+		Active:        true,
+	})
+	s.Require().Nil(err)
+
+	silentRun := s.createRun(models.StatusRunning)
+	s.createRun(models.StatusFinished)
+	activeRun := s.createRun(models.StatusRunning)
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogMetricRequest{
+				RunID:     activeRun.ID,
+				Key:       "loss",
+				Value:     1.5,
+				Timestamp: time.Now().UnixMilli(),
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest("%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogMetricRoute),
+	)
+
+	// only the running run, which hasn't logged the metric, fires the rule and only once.
+	alerts, err := s.AlertFixtures.CheckAlertRules(context.Background(), &config.ServiceConfig{})
+	s.Require().Nil(err)
+	s.Require().Len(alerts, 1)
+	s.Equal(silentRun.ID, alerts[0].RunID)
+
+	alerts, err = s.AlertFixtures.CheckAlertRules(context.Background(), &config.ServiceConfig{})
+	s.Require().Nil(err)
+	s.Empty(alerts)
+
+	// fired alert is delivered to the configured endpoint only once.
+	payloads := make(chan alert.Payload, 1)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		s.Require().Nil(err)
+		var payload alert.Payload
+		s.Require().Nil(json.Unmarshal(body, &payload))
+		payloads <- payload
+	}))
+	defer receiver.Close()
+
+	delivered, err := s.AlertFixtures.DeliverAlerts(
+		context.Background(), &config.ServiceConfig{AlertEndpoint: receiver.URL},
+	)
+	s.Require().Nil(err)
+	s.Equal(1, delivered)
+	payload := <-payloads
+	s.Equal(rule.ID, payload.AlertRuleID)
+	s.Equal("loss is not logged", payload.AlertRuleName)
+	s.Equal(*s.DefaultExperiment.ID, payload.ExperimentID)
+	s.Equal(silentRun.ID, payload.RunID)
+	s.Equal("metric 'loss' hasn't been logged for 1800 seconds", payload.Message)
+
+	delivered, err = s.AlertFixtures.DeliverAlerts(
+		context.Background(), &config.ServiceConfig{AlertEndpoint: receiver.URL},
+	)
+	s.Require().Nil(err)
+	s.Equal(0, delivered)
+
+	storedAlerts, err := s.AlertFixtures.GetAlerts(context.Background(), rule.ID)
+	s.Require().Nil(err)
+	s.Require().Len(storedAlerts, 1)
+	s.True(storedAlerts[0].DeliveredTime.Valid)
+}
@@ -0,0 +1,60 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type UpdateAlertRuleTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestUpdateAlertRuleTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateAlertRuleTestSuite))
+}
+
+func (s *UpdateAlertRuleTestSuite) Test_Ok() {
+	rule, err := s.AlertFixtures.CreateAlertRule(context.Background(), &models.AlertRule{
+		ExperimentID: *s.DefaultExperiment.ID,
+		Name:         "loss is NaN",
+		Key:          "loss",
+		Condition:    models.AlertRuleConditionIsNan,
+		Active:       true,
+	})
+	s.Require().Nil(err)
+
+	resp := response.AlertRuleResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.UpdateAlertRuleRequest{
+				ID:     fmt.Sprint(rule.ID),
+				Name:   "loss diverged",
+				Active: common.GetPointer(false),
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest("%s%s", mlflow.AlertRulesRoutePrefix, mlflow.AlertRulesUpdateRoute),
+	)
+	s.Equal("loss diverged", resp.AlertRule.Name)
+	s.False(resp.AlertRule.Active)
+
+	rules, err := s.AlertFixtures.GetAlertRules(context.Background(), *s.DefaultExperiment.ID)
+	s.Require().Nil(err)
+	s.Require().Len(rules, 1)
+	s.Equal("loss diverged", rules[0].Name)
+	s.False(rules[0].Active)
+	s.Equal(models.AlertRuleConditionIsNan, rules[0].Condition)
+}
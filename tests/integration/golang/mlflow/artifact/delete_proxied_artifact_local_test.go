@@ -0,0 +1,132 @@
+package artifact
+
+import (
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteProxiedArtifactLocalTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDeleteProxiedArtifactLocalTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteProxiedArtifactLocalTestSuite))
+}
+
+func (s *DeleteProxiedArtifactLocalTestSuite) SetupSuite() {
+	s.ArtifactsDestination = s.T().TempDir()
+	s.BaseTestSuite.SetupSuite()
+}
+
+func (s *DeleteProxiedArtifactLocalTestSuite) Test_Ok() {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{
+			name: "DeleteFile",
+			path: "1/run_id/artifacts/artifact.file",
+		},
+		{
+			name: "DeleteDirectory",
+			path: "1/run_id/artifacts/artifact.dir",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// 1. create artifacts.
+			artifactDir := filepath.Join(s.ArtifactsDestination, "1", "run_id", "artifacts")
+			s.Require().Nil(os.MkdirAll(filepath.Join(artifactDir, "artifact.dir"), fs.ModePerm))
+			s.Require().Nil(os.WriteFile(filepath.Join(artifactDir, "artifact.file"), []byte("content"), fs.ModePerm))
+			s.Require().Nil(
+				os.WriteFile(filepath.Join(artifactDir, "artifact.dir", "artifact.file"), []byte("content"), fs.ModePerm),
+			)
+
+			// 2. make actual API call.
+			resp := map[string]any{}
+			s.Require().Nil(s.MlflowArtifactsClient().WithMethod(
+				http.MethodDelete,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s/%s", mlflow.ProxiedArtifactsRoute, tt.path,
+			))
+			s.Empty(resp)
+
+			// 3. check that artifact has been deleted.
+			_, err := os.Stat(filepath.Join(s.ArtifactsDestination, tt.path))
+			s.ErrorIs(err, fs.ErrNotExist)
+		})
+	}
+}
+
+func (s *DeleteProxiedArtifactLocalTestSuite) Test_Error() {
+	// artifact, which must survive all the invalid requests.
+	artifactPath := filepath.Join(s.ArtifactsDestination, "1", "run_id", "artifacts", "artifact.file")
+	s.Require().Nil(os.MkdirAll(filepath.Dir(artifactPath), fs.ModePerm))
+	s.Require().Nil(os.WriteFile(artifactPath, []byte("content"), fs.ModePerm))
+
+	tests := []struct {
+		name  string
+		path  string
+		error *api.ErrorResponse
+	}{
+		{
+			name:  "EmptyPath",
+			path:  "",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'path'"),
+		},
+		{
+			name:  "CurrentDirectory",
+			path:  ".",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+		},
+		{
+			name:  "CurrentDirectoryWithSlash",
+			path:  "./",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+		},
+		{
+			name:  "ParentOfSubdirectory",
+			path:  "a/..",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+		},
+		{
+			name:  "DoubleEncodedCurrentDirectory",
+			path:  "%252e",
+			error: api.NewResourceDoesNotExistError("error deleting artifact object for path: %s", "%2e"),
+		},
+		{
+			name:  "NonExistentPathProvided",
+			path:  "non-existent-file",
+			error: api.NewResourceDoesNotExistError("error deleting artifact object for path: non-existent-file"),
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(s.MlflowArtifactsClient().WithMethod(
+				http.MethodDelete,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s/%s", mlflow.ProxiedArtifactsRoute, tt.path,
+			))
+			s.Equal(tt.error.Error(), resp.Error())
+
+			_, err := os.Stat(artifactPath)
+			s.Nil(err)
+		})
+	}
+}
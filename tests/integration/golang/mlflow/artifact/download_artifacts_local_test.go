@@ -0,0 +1,214 @@
+package artifact
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DownloadArtifactsLocalTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDownloadArtifactsLocalTestSuite(t *testing.T) {
+	suite.Run(t, new(DownloadArtifactsLocalTestSuite))
+}
+
+func (s *DownloadArtifactsLocalTestSuite) Test_Ok() {
+	run := s.createRunWithArtifacts()
+
+	tests := []struct {
+		name        string
+		request     request.DownloadArtifactsRequest
+		filename    string
+		contentType string
+		files       map[string]string
+	}{
+		{
+			name: "RootDirAsZip",
+			request: request.DownloadArtifactsRequest{
+				RunID: run.ID,
+			},
+			filename:    fmt.Sprintf("%s.zip", run.ID),
+			contentType: "application/zip",
+			files: map[string]string{
+				"artifact.file1":                  "content1",
+				"artifact.dir/artifact.file2":     "content2",
+				"artifact.dir/sub/artifact.file3": "content3",
+			},
+		},
+		{
+			name: "SubDirAsTarGz",
+			request: request.DownloadArtifactsRequest{
+				RunID:  run.ID,
+				Path:   "artifact.dir",
+				Format: "tar.gz",
+			},
+			filename:    "artifact.dir.tar.gz",
+			contentType: "application/gzip",
+			files: map[string]string{
+				"artifact.file2":     "content2",
+				"sub/artifact.file3": "content3",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := new(bytes.Buffer)
+			client := s.MlflowClient().WithQuery(
+				tt.request,
+			).WithResponseType(
+				helpers.ResponseTypeBuffer,
+			).WithResponse(
+				resp,
+			)
+			s.Require().Nil(client.DoRequest("%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsDownloadRoute))
+			s.Equal(tt.contentType, client.GetResponseHeaders().Get("Content-Type"))
+			s.Equal(
+				fmt.Sprintf("attachment; filename=%s", tt.filename),
+				client.GetResponseHeaders().Get("Content-Disposition"),
+			)
+
+			if tt.request.Format == "tar.gz" {
+				s.Equal(tt.files, s.readTarGz(resp))
+			} else {
+				s.Equal(tt.files, s.readZip(resp))
+			}
+		})
+	}
+}
+
+func (s *DownloadArtifactsLocalTestSuite) Test_Error() {
+	run := s.createRunWithArtifacts()
+
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.DownloadArtifactsRequest
+	}{
+		{
+			name:    "EmptyOrIncorrectRunIDOrRunUUID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: request.DownloadArtifactsRequest{},
+		},
+		{
+			name: "UnsupportedFormat",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'format' supplied. It must be one of: zip, tar.gz",
+			),
+			request: request.DownloadArtifactsRequest{
+				RunID:  run.ID,
+				Format: "rar",
+			},
+		},
+		{
+			name: "NonExistentPathProvided",
+			error: api.NewResourceDoesNotExistError(
+				"unable to find artifacts for URI: %s", filepath.Join(run.ArtifactURI, "non-existent-dir"),
+			),
+			request: request.DownloadArtifactsRequest{
+				RunID: run.ID,
+				Path:  "non-existent-dir",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(s.MlflowClient().WithQuery(
+				tt.request,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsDownloadRoute,
+			))
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
+
+func (s *DownloadArtifactsLocalTestSuite) createRunWithArtifacts() *models.Run {
+	experimentArtifactDir := s.T().TempDir()
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             fmt.Sprintf("Test Experiment In Path %s", experimentArtifactDir),
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: experimentArtifactDir,
+	})
+	s.Require().Nil(err)
+
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	runArtifactDir := filepath.Join(experimentArtifactDir, runID, "artifacts")
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    runArtifactDir,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	s.Require().Nil(os.MkdirAll(filepath.Join(runArtifactDir, "artifact.dir", "sub"), fs.ModePerm))
+	for path, content := range map[string]string{
+		"artifact.file1":                  "content1",
+		"artifact.dir/artifact.file2":     "content2",
+		"artifact.dir/sub/artifact.file3": "content3",
+	} {
+		s.Require().Nil(os.WriteFile(filepath.Join(runArtifactDir, path), []byte(content), fs.ModePerm))
+	}
+	return run
+}
+
+func (s *DownloadArtifactsLocalTestSuite) readZip(data *bytes.Buffer) map[string]string {
+	reader, err := zip.NewReader(bytes.NewReader(data.Bytes()), int64(data.Len()))
+	s.Require().Nil(err)
+	files := map[string]string{}
+	for _, file := range reader.File {
+		fileReader, err := file.Open()
+		s.Require().Nil(err)
+		content, err := io.ReadAll(fileReader)
+		s.Require().Nil(err)
+		files[file.Name] = string(content)
+	}
+	return files
+}
+
+func (s *DownloadArtifactsLocalTestSuite) readTarGz(data *bytes.Buffer) map[string]string {
+	gzipReader, err := gzip.NewReader(data)
+	s.Require().Nil(err)
+	reader := tar.NewReader(gzipReader)
+	files := map[string]string{}
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		s.Require().Nil(err)
+		content, err := io.ReadAll(reader)
+		s.Require().Nil(err)
+		files[header.Name] = string(content)
+	}
+	return files
+}
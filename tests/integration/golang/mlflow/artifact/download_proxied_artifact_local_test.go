@@ -0,0 +1,102 @@
+package artifact
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DownloadProxiedArtifactLocalTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDownloadProxiedArtifactLocalTestSuite(t *testing.T) {
+	suite.Run(t, new(DownloadProxiedArtifactLocalTestSuite))
+}
+
+func (s *DownloadProxiedArtifactLocalTestSuite) SetupSuite() {
+	s.ArtifactsDestination = s.T().TempDir()
+	s.BaseTestSuite.SetupSuite()
+}
+
+func (s *DownloadProxiedArtifactLocalTestSuite) Test_Ok() {
+	// 1. create artifacts.
+	artifactDir := filepath.Join(s.ArtifactsDestination, "1", "run_id", "artifacts")
+	s.Require().Nil(os.MkdirAll(filepath.Join(artifactDir, "artifact.dir"), fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(artifactDir, "artifact.file1"), []byte("contentX"), fs.ModePerm))
+	s.Require().Nil(
+		os.WriteFile(filepath.Join(artifactDir, "artifact.dir", "artifact.file2"), []byte("contentXX"), fs.ModePerm),
+	)
+
+	tests := []struct {
+		name    string
+		path    string
+		content string
+	}{
+		{
+			name:    "DownloadRootDirFile",
+			path:    "1/run_id/artifacts/artifact.file1",
+			content: "contentX",
+		},
+		{
+			name:    "DownloadSubDirFile",
+			path:    "1/run_id/artifacts/artifact.dir/artifact.file2",
+			content: "contentXX",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := new(bytes.Buffer)
+			s.Require().Nil(s.MlflowArtifactsClient().WithResponseType(
+				helpers.ResponseTypeBuffer,
+			).WithResponse(
+				resp,
+			).DoRequest(
+				"%s/%s", mlflow.ProxiedArtifactsRoute, tt.path,
+			))
+			s.Equal(tt.content, resp.String())
+		})
+	}
+}
+
+func (s *DownloadProxiedArtifactLocalTestSuite) Test_Error() {
+	s.Require().Nil(os.MkdirAll(filepath.Join(s.ArtifactsDestination, "subdir"), fs.ModePerm))
+
+	tests := []struct {
+		name  string
+		path  string
+		error *api.ErrorResponse
+	}{
+		{
+			name:  "NonExistentPathProvided",
+			path:  "non-existent-file",
+			error: api.NewResourceDoesNotExistError("error getting artifact object for path: non-existent-file"),
+		},
+		{
+			name:  "ExistingDirectoryProvided",
+			path:  "subdir",
+			error: api.NewResourceDoesNotExistError("error getting artifact object for path: subdir"),
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(s.MlflowArtifactsClient().WithResponse(
+				&resp,
+			).DoRequest(
+				"%s/%s", mlflow.ProxiedArtifactsRoute, tt.path,
+			))
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
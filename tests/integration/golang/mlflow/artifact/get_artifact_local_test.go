@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"io/fs"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -117,6 +118,119 @@ func (s *GetArtifactLocalTestSuite) Test_Ok() {
 	}
 }
 
+func (s *GetArtifactLocalTestSuite) Test_Range() {
+	// 1. create test experiment and run.
+	experimentArtifactDir := s.T().TempDir()
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             fmt.Sprintf("Test Experiment In Path %s", experimentArtifactDir),
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: experimentArtifactDir,
+	})
+	s.Require().Nil(err)
+
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	runArtifactDir := filepath.Join(experimentArtifactDir, runID, "artifacts")
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    runArtifactDir,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// 2. create artifact.
+	s.Require().Nil(os.MkdirAll(runArtifactDir, fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(runArtifactDir, "artifact.log"), []byte("0123456789"), fs.ModePerm))
+
+	tests := []struct {
+		name          string
+		rangeHeader   string
+		statusCode    int
+		content       string
+		contentRange  string
+		contentLength string
+	}{
+		{
+			name:          "WithoutRange",
+			statusCode:    http.StatusOK,
+			content:       "0123456789",
+			contentLength: "10",
+		},
+		{
+			name:          "WithRange",
+			rangeHeader:   "bytes=2-4",
+			statusCode:    http.StatusPartialContent,
+			content:       "234",
+			contentRange:  "bytes 2-4/10",
+			contentLength: "3",
+		},
+		{
+			name:          "WithOpenEndedRange",
+			rangeHeader:   "bytes=7-",
+			statusCode:    http.StatusPartialContent,
+			content:       "789",
+			contentRange:  "bytes 7-9/10",
+			contentLength: "3",
+		},
+		{
+			name:          "WithSuffixRange",
+			rangeHeader:   "bytes=-2",
+			statusCode:    http.StatusPartialContent,
+			content:       "89",
+			contentRange:  "bytes 8-9/10",
+			contentLength: "2",
+		},
+		{
+			name:          "WithMalformedRange",
+			rangeHeader:   "bytes",
+			statusCode:    http.StatusOK,
+			content:       "0123456789",
+			contentLength: "10",
+		},
+		{
+			name:         "WithUnsatisfiableRange",
+			rangeHeader:  "bytes=20-30",
+			statusCode:   http.StatusRequestedRangeNotSatisfiable,
+			content:      "Requested Range Not Satisfiable",
+			contentRange: "bytes */10",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			headers := map[string]string{}
+			if tt.rangeHeader != "" {
+				headers["Range"] = tt.rangeHeader
+			}
+			resp := new(bytes.Buffer)
+			client := s.MlflowClient().WithQuery(
+				request.GetArtifactRequest{
+					RunID: run.ID,
+					Path:  "artifact.log",
+				},
+			).WithHeaders(
+				headers,
+			).WithResponseType(
+				helpers.ResponseTypeBuffer,
+			).WithResponse(
+				resp,
+			)
+			s.Require().Nil(client.DoRequest("%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsGetRoute))
+			s.Equal(tt.statusCode, client.GetStatusCode())
+			s.Equal(tt.content, resp.String())
+			s.Equal(tt.contentRange, client.GetResponseHeaders().Get("Content-Range"))
+			if tt.contentLength != "" {
+				s.Equal(tt.contentLength, client.GetResponseHeaders().Get("Content-Length"))
+				s.Equal("text/plain", client.GetResponseHeaders().Get("Content-Type"))
+				s.Equal("bytes", client.GetResponseHeaders().Get("Accept-Ranges"))
+			}
+		})
+	}
+}
+
 func (s *GetArtifactLocalTestSuite) Test_Error() {
 	// create test experiment
 	experimentArtifactDir := s.T().TempDir()
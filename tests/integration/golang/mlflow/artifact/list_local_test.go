@@ -162,6 +162,98 @@ func (s *ListArtifactLocalTestSuite) Test_Ok() {
 	}
 }
 
+func (s *ListArtifactLocalTestSuite) Test_PaginatedAndRecursive() {
+	// 1. create test experiment and run.
+	experimentArtifactDir := s.T().TempDir()
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             fmt.Sprintf("Test Experiment In Path %s", experimentArtifactDir),
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: experimentArtifactDir,
+	})
+	s.Require().Nil(err)
+
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	runArtifactDir := filepath.Join(experimentArtifactDir, runID, "artifacts")
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *experiment.ID,
+		ArtifactURI:    runArtifactDir,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// 2. create artifacts.
+	s.Require().Nil(os.MkdirAll(filepath.Join(runArtifactDir, "artifact.dir", "artifact.subdir"), fs.ModePerm))
+	for _, path := range []string{
+		"artifact.file1",
+		filepath.Join("artifact.dir", "artifact.file2"),
+		filepath.Join("artifact.dir", "artifact.subdir", "artifact.file3"),
+	} {
+		s.Require().Nil(os.WriteFile(filepath.Join(runArtifactDir, path), []byte("content"), fs.ModePerm))
+	}
+
+	// 3. list all the files page by page.
+	var files []response.FilePartialResponse
+	pageToken := ""
+	for i := 0; i < 3; i++ {
+		resp := response.ListArtifactsResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithQuery(
+				request.ListArtifactsRequest{
+					RunID:      run.ID,
+					PageToken:  pageToken,
+					MaxResults: 2,
+					Recursive:  true,
+				},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsListRoute,
+			),
+		)
+		s.Equal(run.ArtifactURI, resp.RootURI)
+		files = append(files, resp.Files...)
+		pageToken = resp.NextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+	s.Empty(pageToken)
+	s.Equal([]response.FilePartialResponse{
+		{
+			Path:     "artifact.dir/artifact.file2",
+			FileSize: 7,
+		},
+		{
+			Path:     "artifact.dir/artifact.subdir/artifact.file3",
+			FileSize: 7,
+		},
+		{
+			Path:     "artifact.file1",
+			FileSize: 7,
+		},
+	}, files)
+
+	// 4. invalid page token is rejected.
+	resp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.ListArtifactsRequest{
+				RunID:     run.ID,
+				PageToken: "invalid",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsListRoute,
+		),
+	)
+	s.Equal(api.NewInvalidParameterValueError("Invalid page_token").Error(), resp.Error())
+}
+
 func (s *ListArtifactLocalTestSuite) Test_Error() {
 	tests := []struct {
 		name    string
@@ -173,6 +265,14 @@ func (s *ListArtifactLocalTestSuite) Test_Error() {
 			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
 			request: request.ListArtifactsRequest{},
 		},
+		{
+			name:  "NegativeMaxResults",
+			error: api.NewInvalidParameterValueError("Invalid value for parameter 'max_results' supplied"),
+			request: request.ListArtifactsRequest{
+				RunID:      "run_id",
+				MaxResults: -1,
+			},
+		},
 		{
 			name:  "IncorrectPathProvidedCase1",
 			error: api.NewInvalidParameterValueError("Invalid path"),
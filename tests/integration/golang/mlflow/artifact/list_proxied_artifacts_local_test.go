@@ -0,0 +1,193 @@
+package artifact
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type ListProxiedArtifactsLocalTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestListProxiedArtifactsLocalTestSuite(t *testing.T) {
+	suite.Run(t, new(ListProxiedArtifactsLocalTestSuite))
+}
+
+func (s *ListProxiedArtifactsLocalTestSuite) SetupSuite() {
+	s.ArtifactsDestination = s.T().TempDir()
+	s.BaseTestSuite.SetupSuite()
+}
+
+func (s *ListProxiedArtifactsLocalTestSuite) Test_Ok() {
+	// 1. create artifacts.
+	artifactDir := filepath.Join(s.ArtifactsDestination, "1", "run_id", "artifacts")
+	s.Require().Nil(os.MkdirAll(filepath.Join(artifactDir, "artifact.dir"), fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(artifactDir, "artifact.file1"), []byte("contentX"), fs.ModePerm))
+	s.Require().Nil(
+		os.WriteFile(filepath.Join(artifactDir, "artifact.dir", "artifact.file2"), []byte("contentXX"), fs.ModePerm),
+	)
+
+	tests := []struct {
+		name     string
+		request  request.ListProxiedArtifactsRequest
+		response *response.ListProxiedArtifactsResponse
+	}{
+		{
+			name:    "ListRootDir",
+			request: request.ListProxiedArtifactsRequest{},
+			response: &response.ListProxiedArtifactsResponse{
+				Files: []response.FilePartialResponse{
+					{
+						Path:  "1",
+						IsDir: true,
+					},
+				},
+			},
+		},
+		{
+			name: "ListRunArtifactsDir",
+			request: request.ListProxiedArtifactsRequest{
+				Path: "1/run_id/artifacts",
+			},
+			response: &response.ListProxiedArtifactsResponse{
+				Files: []response.FilePartialResponse{
+					{
+						Path:  "artifact.dir",
+						IsDir: true,
+					},
+					{
+						Path:     "artifact.file1",
+						FileSize: 8,
+					},
+				},
+			},
+		},
+		{
+			name: "ListSubDir",
+			request: request.ListProxiedArtifactsRequest{
+				Path: "1/run_id/artifacts/artifact.dir",
+			},
+			response: &response.ListProxiedArtifactsResponse{
+				Files: []response.FilePartialResponse{
+					{
+						Path:     "artifact.file2",
+						FileSize: 9,
+					},
+				},
+			},
+		},
+		{
+			name: "ListNonExistentDir",
+			request: request.ListProxiedArtifactsRequest{
+				Path: "non-existent-dir",
+			},
+			response: &response.ListProxiedArtifactsResponse{
+				Files: []response.FilePartialResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.ListProxiedArtifactsResponse{}
+			s.Require().Nil(s.MlflowArtifactsClient().WithQuery(
+				tt.request,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				mlflow.ProxiedArtifactsRoute,
+			))
+			s.Equal(tt.response, &resp)
+		})
+	}
+}
+
+func (s *ListProxiedArtifactsLocalTestSuite) Test_RunWithProxiedArtifactURI() {
+	// 1. create test run which logs artifacts through the proxy.
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		ArtifactURI:    fmt.Sprintf("mlflow-artifacts:/0/%s/artifacts", runID),
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	// 2. create artifacts in the artifacts destination.
+	artifactDir := filepath.Join(s.ArtifactsDestination, "0", runID, "artifacts")
+	s.Require().Nil(os.MkdirAll(artifactDir, fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(artifactDir, "artifact.file"), []byte("content"), fs.ModePerm))
+
+	// 3. make actual API call.
+	resp := response.ListArtifactsResponse{}
+	s.Require().Nil(s.MlflowClient().WithQuery(
+		request.ListArtifactsRequest{
+			RunID: run.ID,
+		},
+	).WithResponse(
+		&resp,
+	).DoRequest(
+		"%s%s", mlflow.ArtifactsRoutePrefix, mlflow.ArtifactsListRoute,
+	))
+	s.Equal(run.ArtifactURI, resp.RootURI)
+	s.Equal([]response.FilePartialResponse{
+		{
+			Path:     "artifact.file",
+			FileSize: 7,
+		},
+	}, resp.Files)
+}
+
+func (s *ListProxiedArtifactsLocalTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.ListProxiedArtifactsRequest
+	}{
+		{
+			name:  "IncorrectPathProvided",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: request.ListProxiedArtifactsRequest{
+				Path: "foo/../bar",
+			},
+		},
+		{
+			name:  "IncorrectLeadingSlash",
+			error: api.NewInvalidParameterValueError("Invalid path"),
+			request: request.ListProxiedArtifactsRequest{
+				Path: "/foo",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(s.MlflowArtifactsClient().WithQuery(
+				tt.request,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				mlflow.ProxiedArtifactsRoute,
+			))
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package artifact
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type MultipartUploadLocalTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestMultipartUploadLocalTestSuite(t *testing.T) {
+	suite.Run(t, new(MultipartUploadLocalTestSuite))
+}
+
+func (s *MultipartUploadLocalTestSuite) SetupSuite() {
+	s.ArtifactsDestination = s.T().TempDir()
+	s.BaseTestSuite.SetupSuite()
+}
+
+func (s *MultipartUploadLocalTestSuite) Test_Error() {
+	unsupportedError := api.NewBadRequestError("presigned urls are not supported by the artifacts destination")
+	tests := []struct {
+		name    string
+		method  string
+		route   string
+		request any
+		error   *api.ErrorResponse
+	}{
+		{
+			name:   "CreateMultipartUploadWithIncorrectNumParts",
+			method: http.MethodPost,
+			route:  mlflow.CreateMultipartUploadRoute,
+			request: request.CreateMultipartUploadRequest{
+				Path: "model.bin",
+			},
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'num_parts' supplied. It must be between 1 and 10000",
+			),
+		},
+		{
+			name:   "CreateMultipartUploadNotSupported",
+			method: http.MethodPost,
+			route:  mlflow.CreateMultipartUploadRoute,
+			request: request.CreateMultipartUploadRequest{
+				Path:     "model.bin",
+				NumParts: 2,
+			},
+			error: unsupportedError,
+		},
+		{
+			name:   "CompleteMultipartUploadNotSupported",
+			method: http.MethodPost,
+			route:  mlflow.CompleteMultipartUploadRoute,
+			request: request.CompleteMultipartUploadRequest{
+				Path:     "model.bin",
+				UploadID: "upload-id",
+				Parts: []request.MultipartUploadPartPartialRequest{
+					{PartNumber: 1, ETag: "etag"},
+				},
+			},
+			error: unsupportedError,
+		},
+		{
+			name:   "AbortMultipartUploadNotSupported",
+			method: http.MethodPost,
+			route:  mlflow.AbortMultipartUploadRoute,
+			request: request.AbortMultipartUploadRequest{
+				Path:     "model.bin",
+				UploadID: "upload-id",
+			},
+			error: unsupportedError,
+		},
+		{
+			name:   "PresignedDownloadNotSupported",
+			method: http.MethodGet,
+			route:  mlflow.PresignedDownloadRoute,
+			error:  unsupportedError,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			client := s.MlflowArtifactsClient().WithMethod(tt.method).WithResponse(&resp)
+			if tt.request != nil {
+				client = client.WithRequest(tt.request)
+			}
+			s.Require().Nil(client.DoRequest(
+				"%s/%s", strings.TrimSuffix(tt.route, "/*"), "1/run_id/artifacts",
+			))
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package artifact
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type MultipartUploadS3TestSuite struct {
+	helpers.S3TestSuite
+}
+
+func TestMultipartUploadS3TestSuite(t *testing.T) {
+	suite.Run(t, &MultipartUploadS3TestSuite{
+		helpers.NewS3TestSuite("bucket1"),
+	})
+}
+
+func (s *MultipartUploadS3TestSuite) SetupSuite() {
+	s.ArtifactsDestination = "s3://bucket1/mlartifacts"
+	s.S3TestSuite.SetupSuite()
+}
+
+func (s *MultipartUploadS3TestSuite) Test_Ok() {
+	// every part, except the last one, has to be at least 5MiB.
+	parts := [][]byte{
+		bytes.Repeat([]byte("0123456789abcdef"), 5*1024*1024/16),
+		[]byte("last part"),
+	}
+
+	// 1. create multipart upload.
+	upload := response.CreateMultipartUploadResponse{}
+	s.Require().Nil(s.MlflowArtifactsClient().WithMethod(
+		http.MethodPost,
+	).WithRequest(
+		request.CreateMultipartUploadRequest{
+			Path:     "/tmp/model.bin",
+			NumParts: int32(len(parts)),
+		},
+	).WithResponse(
+		&upload,
+	).DoRequest(
+		"/mpu/create/%s", "1/run_id/artifacts",
+	))
+	s.NotEmpty(upload.UploadID)
+	s.Require().Len(upload.Credentials, len(parts))
+
+	// 2. upload parts directly into the storage using presigned urls.
+	completeRequest := request.CompleteMultipartUploadRequest{
+		Path:     "/tmp/model.bin",
+		UploadID: upload.UploadID,
+	}
+	for i, credential := range upload.Credentials {
+		s.Equal(int32(i+1), credential.PartNumber)
+		req, err := http.NewRequest(http.MethodPut, credential.URL, bytes.NewReader(parts[i]))
+		s.Require().Nil(err)
+		for key, value := range credential.Headers {
+			req.Header.Set(key, value)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		s.Require().Nil(err)
+		s.Require().Nil(resp.Body.Close())
+		s.Require().Equal(http.StatusOK, resp.StatusCode)
+		completeRequest.Parts = append(completeRequest.Parts, request.MultipartUploadPartPartialRequest{
+			PartNumber: credential.PartNumber,
+			ETag:       resp.Header.Get("ETag"),
+			URL:        credential.URL,
+		})
+	}
+
+	// 3. complete multipart upload.
+	s.Require().Nil(s.MlflowArtifactsClient().WithMethod(
+		http.MethodPost,
+	).WithRequest(
+		completeRequest,
+	).WithResponse(
+		&map[string]any{},
+	).DoRequest(
+		"/mpu/complete/%s", "1/run_id/artifacts",
+	))
+
+	// 4. check that object has been uploaded into the destination.
+	expectedContent := bytes.Join(parts, nil)
+	object, err := s.Client.GetObject(context.Background(), &s3.GetObjectInput{
+		Bucket: aws.String("bucket1"),
+		Key:    aws.String("mlartifacts/1/run_id/artifacts/model.bin"),
+	})
+	s.Require().Nil(err)
+	//nolint:errcheck
+	defer object.Body.Close()
+	content, err := io.ReadAll(object.Body)
+	s.Require().Nil(err)
+	s.Equal(expectedContent, content)
+
+	// 5. download object directly from the storage using presigned url.
+	download := response.GetPresignedDownloadURLResponse{}
+	s.Require().Nil(s.MlflowArtifactsClient().WithResponse(
+		&download,
+	).DoRequest(
+		"/presigned-download/%s", "1/run_id/artifacts/model.bin",
+	))
+	resp, err := http.Get(download.URL)
+	s.Require().Nil(err)
+	//nolint:errcheck
+	defer resp.Body.Close()
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	content, err = io.ReadAll(resp.Body)
+	s.Require().Nil(err)
+	s.Equal(expectedContent, content)
+}
+
+func (s *MultipartUploadS3TestSuite) Test_Abort() {
+	// 1. create multipart upload.
+	upload := response.CreateMultipartUploadResponse{}
+	s.Require().Nil(s.MlflowArtifactsClient().WithMethod(
+		http.MethodPost,
+	).WithRequest(
+		request.CreateMultipartUploadRequest{
+			Path:     "model.bin",
+			NumParts: 1,
+		},
+	).WithResponse(
+		&upload,
+	).DoRequest(
+		"/mpu/create/%s", "1/run_id/artifacts",
+	))
+
+	// 2. abort multipart upload.
+	abortRequest := request.AbortMultipartUploadRequest{
+		Path:     "model.bin",
+		UploadID: upload.UploadID,
+	}
+	s.Require().Nil(s.MlflowArtifactsClient().WithMethod(
+		http.MethodPost,
+	).WithRequest(
+		abortRequest,
+	).WithResponse(
+		&map[string]any{},
+	).DoRequest(
+		"/mpu/abort/%s", "1/run_id/artifacts",
+	))
+
+	// 3. upload can't be completed anymore.
+	resp := api.ErrorResponse{}
+	s.Require().Nil(s.MlflowArtifactsClient().WithMethod(
+		http.MethodPost,
+	).WithRequest(
+		request.CompleteMultipartUploadRequest{
+			Path:     "model.bin",
+			UploadID: upload.UploadID,
+			Parts: []request.MultipartUploadPartPartialRequest{
+				{PartNumber: 1, ETag: "etag"},
+			},
+		},
+	).WithResponse(
+		&resp,
+	).DoRequest(
+		"/mpu/complete/%s", "1/run_id/artifacts",
+	))
+	s.Equal(
+		api.NewResourceDoesNotExistError(
+			"error completing multipart upload for path: 1/run_id/artifacts/model.bin",
+		).Error(),
+		resp.Error(),
+	)
+
+	// 4. presigned url can't be issued for the object which doesn't exist.
+	s.Require().Nil(s.MlflowArtifactsClient().WithResponse(
+		&resp,
+	).DoRequest(
+		"/presigned-download/%s", "1/run_id/artifacts/model.bin",
+	))
+	s.Equal(
+		api.NewResourceDoesNotExistError(
+			"error getting presigned download url for path: 1/run_id/artifacts/model.bin",
+		).Error(),
+		resp.Error(),
+	)
+}
@@ -0,0 +1,106 @@
+package artifact
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type ProxiedArtifactsS3TestSuite struct {
+	helpers.S3TestSuite
+}
+
+func TestProxiedArtifactsS3TestSuite(t *testing.T) {
+	suite.Run(t, &ProxiedArtifactsS3TestSuite{
+		helpers.NewS3TestSuite("bucket1"),
+	})
+}
+
+func (s *ProxiedArtifactsS3TestSuite) SetupSuite() {
+	s.ArtifactsDestination = "s3://bucket1/mlartifacts"
+	s.S3TestSuite.SetupSuite()
+}
+
+func (s *ProxiedArtifactsS3TestSuite) Test_Ok() {
+	tests := []struct {
+		name    string
+		path    string
+		content []byte
+	}{
+		{
+			name:    "SmallFile",
+			path:    "1/run_id/artifacts/artifact.file",
+			content: []byte("content"),
+		},
+		{
+			name:    "FileUploadedByParts",
+			path:    "1/run_id/artifacts/model.bin",
+			content: bytes.Repeat([]byte("0123456789abcdef"), 1024*1024+1),
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// 1. upload artifact through the proxy.
+			s.Require().Nil(s.MlflowArtifactsClient().WithMethod(
+				http.MethodPut,
+			).WithRequest(
+				bytes.NewReader(tt.content),
+			).WithResponse(
+				&map[string]any{},
+			).DoRequest(
+				"%s/%s", mlflow.ProxiedArtifactsRoute, tt.path,
+			))
+
+			// 2. check that object has been uploaded into the destination.
+			object, err := s.Client.GetObject(context.Background(), &s3.GetObjectInput{
+				Bucket: aws.String("bucket1"),
+				Key:    aws.String("mlartifacts/" + tt.path),
+			})
+			s.Require().Nil(err)
+			//nolint:errcheck
+			defer object.Body.Close()
+			content, err := io.ReadAll(object.Body)
+			s.Require().Nil(err)
+			s.Equal(tt.content, content)
+
+			// 3. download artifact through the proxy.
+			resp := new(bytes.Buffer)
+			s.Require().Nil(s.MlflowArtifactsClient().WithResponseType(
+				helpers.ResponseTypeBuffer,
+			).WithResponse(
+				resp,
+			).DoRequest(
+				"%s/%s", mlflow.ProxiedArtifactsRoute, tt.path,
+			))
+			s.Equal(tt.content, resp.Bytes())
+
+			// 4. delete artifact directory through the proxy.
+			s.Require().Nil(s.MlflowArtifactsClient().WithMethod(
+				http.MethodDelete,
+			).WithResponse(
+				&map[string]any{},
+			).DoRequest(
+				"%s/%s", mlflow.ProxiedArtifactsRoute, "1/run_id",
+			))
+
+			list := response.ListProxiedArtifactsResponse{}
+			s.Require().Nil(s.MlflowArtifactsClient().WithResponse(
+				&list,
+			).DoRequest(
+				mlflow.ProxiedArtifactsRoute,
+			))
+			s.Empty(list.Files)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package artifact
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type UploadProxiedArtifactLocalTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestUploadProxiedArtifactLocalTestSuite(t *testing.T) {
+	suite.Run(t, new(UploadProxiedArtifactLocalTestSuite))
+}
+
+func (s *UploadProxiedArtifactLocalTestSuite) SetupSuite() {
+	s.ArtifactsDestination = s.T().TempDir()
+	s.BaseTestSuite.SetupSuite()
+}
+
+func (s *UploadProxiedArtifactLocalTestSuite) Test_Ok() {
+	tests := []struct {
+		name      string
+		namespace string
+		path      string
+		content   []byte
+	}{
+		{
+			name:    "UploadRootFile",
+			path:    "artifact.file",
+			content: []byte("content"),
+		},
+		{
+			name:    "UploadNestedFile",
+			path:    "1/run_id/artifacts/artifact.dir/artifact.file",
+			content: []byte("nested content"),
+		},
+		{
+			name:    "UploadFileBiggerThanBodyLimit",
+			path:    "1/run_id/artifacts/model.bin",
+			content: bytes.Repeat([]byte("0123456789abcdef"), 1024*1024+1),
+		},
+		{
+			name:      "UploadFileBiggerThanBodyLimitInNamespace",
+			namespace: "default",
+			path:      "1/run_id/artifacts/namespaced-model.bin",
+			content:   bytes.Repeat([]byte("0123456789abcdef"), 1024*1024+1),
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := map[string]any{}
+			s.Require().Nil(s.MlflowArtifactsClient().WithNamespace(
+				tt.namespace,
+			).WithMethod(
+				http.MethodPut,
+			).WithHeaders(
+				map[string]string{"Content-Type": "application/octet-stream"},
+			).WithRequest(
+				bytes.NewReader(tt.content),
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s/%s", mlflow.ProxiedArtifactsRoute, tt.path,
+			))
+			s.Empty(resp)
+
+			// #nosec G304
+			content, err := os.ReadFile(filepath.Join(s.ArtifactsDestination, tt.path))
+			s.Require().Nil(err)
+			s.Equal(tt.content, content)
+		})
+	}
+}
+
+func (s *UploadProxiedArtifactLocalTestSuite) Test_Error() {
+	tests := []struct {
+		name      string
+		namespace string
+		path      string
+		error     *api.ErrorResponse
+	}{
+		{
+			name:  "EmptyPath",
+			path:  "",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'path'"),
+		},
+		{
+			name:      "EmptyPathInNamespace",
+			namespace: "default",
+			path:      "",
+			error:     api.NewInvalidParameterValueError("Missing value for required parameter 'path'"),
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(s.MlflowArtifactsClient().WithNamespace(
+				tt.namespace,
+			).WithMethod(
+				http.MethodPut,
+			).WithRequest(
+				bytes.NewReader([]byte("content")),
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s/%s", mlflow.ProxiedArtifactsRoute, tt.path,
+			))
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
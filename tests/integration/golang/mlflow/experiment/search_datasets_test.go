@@ -0,0 +1,138 @@
+package experiment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchDatasetsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchDatasetsTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchDatasetsTestSuite))
+}
+
+func (s *SearchDatasetsTestSuite) Test_Ok() {
+	// 1. prepare database with test data.
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Test Experiment",
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	s.logInputs(*s.DefaultExperiment.ID, "train", "1a2b", "training")
+	s.logInputs(*s.DefaultExperiment.ID, "train", "1a2b", "training")
+	s.logInputs(*s.DefaultExperiment.ID, "eval", "3c4d", "")
+	s.logInputs(*experiment.ID, "test", "5e6f", "testing")
+
+	// 2. make actual API call.
+	resp := response.SearchDatasetsResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.SearchDatasetsRequest{
+				ExperimentIDs: []string{
+					fmt.Sprintf("%d", *s.DefaultExperiment.ID), fmt.Sprintf("%d", *experiment.ID),
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchDatasets,
+		),
+	)
+
+	// 3. check actual API response.
+	s.Equal([]response.DatasetSummaryPartialResponse{
+		{ExperimentID: fmt.Sprintf("%d", *s.DefaultExperiment.ID), Name: "eval", Digest: "3c4d"},
+		{ExperimentID: fmt.Sprintf("%d", *s.DefaultExperiment.ID), Name: "train", Digest: "1a2b", Context: "training"},
+		{ExperimentID: fmt.Sprintf("%d", *experiment.ID), Name: "test", Digest: "5e6f", Context: "testing"},
+	}, resp.DatasetSummaries)
+}
+
+func (s *SearchDatasetsTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.SearchDatasetsRequest
+	}{
+		{
+			name:    "MissingExperimentIDs",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_ids'"),
+			request: request.SearchDatasetsRequest{},
+		},
+		{
+			name: "IncorrectExperimentID",
+			error: api.NewBadRequestError(
+				`unable to parse experiment id 'incorrect_id': strconv.ParseInt: parsing "incorrect_id": invalid syntax`,
+			),
+			request: request.SearchDatasetsRequest{ExperimentIDs: []string{"incorrect_id"}},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchDatasets,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
+
+// logInputs creates new run in the experiment and logs the dataset as its input.
+func (s *SearchDatasetsTestSuite) logInputs(experimentID int32, name, digest, datasetContext string) {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   experimentID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	datasetInput := request.DatasetInputPartialRequest{
+		Dataset: request.DatasetPartialRequest{
+			Name:       name,
+			Digest:     digest,
+			SourceType: "local",
+			Source:     "source",
+		},
+	}
+	if datasetContext != "" {
+		datasetInput.Tags = []request.InputTagPartialRequest{{Key: models.InputTagContextKey, Value: datasetContext}}
+	}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogInputsRequest{RunID: run.ID, Datasets: []request.DatasetInputPartialRequest{datasetInput}},
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogInputsRoute,
+		),
+	)
+}
@@ -2,6 +2,7 @@ package experiment
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -32,10 +33,12 @@ func (s *SearchExperimentsTestSuite) Test_Ok() {
 		{
 			Name:           "Test Experiment 1",
 			LifecycleStage: models.LifecycleStageActive,
+			Tags:           []models.ExperimentTag{{Key: "stage", Value: "dev"}},
 		},
 		{
 			Name:           "Test Experiment 2",
 			LifecycleStage: models.LifecycleStageActive,
+			Tags:           []models.ExperimentTag{{Key: "stage", Value: "prod"}},
 		},
 		{
 			Name:           "Test Experiment 3",
@@ -59,6 +62,7 @@ func (s *SearchExperimentsTestSuite) Test_Ok() {
 			Name:           ex.Name,
 			NamespaceID:    s.DefaultNamespace.ID,
 			LifecycleStage: ex.LifecycleStage,
+			Tags:           ex.Tags,
 		})
 		s.Require().Nil(err)
 	}
@@ -80,6 +84,27 @@ func (s *SearchExperimentsTestSuite) Test_Ok() {
 				"Test Experiment 4",
 			},
 		},
+		{
+			name: "TestFilterWithOrAndParentheses",
+			request: request.SearchExperimentsRequest{
+				Filter: "(name = 'Test Experiment 1' OR name = 'Test Experiment 3') AND tags.stage != 'prod'",
+			},
+			expected: []string{"Test Experiment 1"},
+		},
+		{
+			name: "TestFilterWithTagIn",
+			request: request.SearchExperimentsRequest{
+				Filter: "tags.stage IN ('dev', 'prod') OR name LIKE '%5'",
+			},
+			expected: []string{"Test Experiment 1", "Test Experiment 2", "Test Experiment 5"},
+		},
+		{
+			name: "TestFilterWithTagIsNull",
+			request: request.SearchExperimentsRequest{
+				Filter: "tags.stage IS NULL",
+			},
+			expected: []string{"Test Experiment 3", "Test Experiment 4", "Test Experiment 5"},
+		},
 		{
 			name: "TestViewType",
 			request: request.SearchExperimentsRequest{
@@ -133,6 +158,56 @@ func (s *SearchExperimentsTestSuite) Test_Ok() {
 	}
 }
 
+func (s *SearchExperimentsTestSuite) Test_Pagination_Ok() {
+	for i := 1; i <= 5; i++ {
+		_, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+			Name:           fmt.Sprintf("Test Experiment %d", i),
+			NamespaceID:    s.DefaultNamespace.ID,
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+	}
+
+	// read experiments page by page and create new experiment after the first page.
+	// it is placed before the last experiment of the first page, so it is not returned.
+	var names []string
+	req := request.SearchExperimentsRequest{OrderBy: []string{"name ASC"}, MaxResults: 2}
+	for page := 0; page < 10; page++ {
+		resp := response.SearchExperimentsResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithQuery(
+				req,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.ExperimentsRoutePrefix, mlflow.ExperimentsSearchRoute,
+			),
+		)
+		for _, experiment := range resp.Experiments {
+			names = append(names, experiment.Name)
+		}
+		if page == 0 {
+			_, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+				Name:           "Test Experiment 0",
+				NamespaceID:    s.DefaultNamespace.ID,
+				LifecycleStage: models.LifecycleStageActive,
+			})
+			s.Require().Nil(err)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	s.Equal([]string{
+		"Test Experiment 1",
+		"Test Experiment 2",
+		"Test Experiment 3",
+		"Test Experiment 4",
+		"Test Experiment 5",
+	}, names)
+}
+
 func (s *SearchExperimentsTestSuite) Test_Error() {
 	testData := []struct {
 		name    string
@@ -154,36 +229,56 @@ func (s *SearchExperimentsTestSuite) Test_Error() {
 			},
 		},
 		{
-			name:  "InvalidFilterValue",
-			error: api.NewInvalidParameterValueError("invalid numeric value 'cc'"),
+			name: "InvalidFilterValue",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter 'attribute.creation_time > cc': invalid numeric value 'cc' at position 27",
+			),
 			request: request.SearchExperimentsRequest{
 				Filter: "attribute.creation_time > cc",
 			},
 		},
 		{
-			name:  "MalformedFilter",
-			error: api.NewInvalidParameterValueError("malformed filter 'invalid_filter'"),
+			name: "MalformedFilter",
+			error: api.NewInvalidParameterValueError(
+				"malformed filter 'invalid_filter': unexpected end of filter, expected comparison operator at position 15",
+			),
 			request: request.SearchExperimentsRequest{
 				Filter: "invalid_filter",
 			},
 		},
 		{
-			name:  "InvalidNumericValue",
-			error: api.NewInvalidParameterValueError("invalid numeric value 'invalid_value'"),
+			name: "UnbalancedParentheses",
+			error: api.NewInvalidParameterValueError(
+				"malformed filter '(name = 'a' OR name = 'b'': unexpected end of filter, expected ')' at position 26",
+			),
+			request: request.SearchExperimentsRequest{
+				Filter: "(name = 'a' OR name = 'b'",
+			},
+		},
+		{
+			name: "InvalidNumericValue",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter 'creation_time > invalid_value': invalid numeric value 'invalid_value' at position 17",
+			),
 			request: request.SearchExperimentsRequest{
 				Filter: "creation_time > invalid_value",
 			},
 		},
 		{
-			name:  "InvalidStringOperator",
-			error: api.NewInvalidParameterValueError("invalid string attribute comparison operator '<'"),
+			name: "InvalidStringOperator",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter 'attribute.name < 'value'': " +
+					"invalid string attribute comparison operator '<' at position 1",
+			),
 			request: request.SearchExperimentsRequest{
 				Filter: "attribute.name < 'value'",
 			},
 		},
 		{
-			name:  "InvalidTagOperator",
-			error: api.NewInvalidParameterValueError("invalid tag comparison operator '<'"),
+			name: "InvalidTagOperator",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter 'tag.value < 'value'': invalid tag comparison operator '<' at position 1",
+			),
 			request: request.SearchExperimentsRequest{
 				Filter: "tag.value < 'value'",
 			},
@@ -191,7 +286,8 @@ func (s *SearchExperimentsTestSuite) Test_Error() {
 		{
 			name: "InvalidEntity",
 			error: api.NewInvalidParameterValueError(
-				"invalid entity type 'invalid_entity'. Valid values are ['tag', 'attribute']",
+				"invalid filter 'invalid_entity.name = value': " +
+					"invalid entity type 'invalid_entity'. Valid values are ['tag', 'attribute'] at position 1",
 			),
 			request: request.SearchExperimentsRequest{
 				Filter: "invalid_entity.name = value",
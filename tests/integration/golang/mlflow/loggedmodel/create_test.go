@@ -0,0 +1,134 @@
+package loggedmodel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CreateLoggedModelTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestCreateLoggedModelTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateLoggedModelTestSuite))
+}
+
+func (s *CreateLoggedModelTestSuite) Test_Ok() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             "Test Experiment",
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: "s3://bucket/1",
+	})
+	s.Require().Nil(err)
+
+	resp := response.LoggedModelResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateLoggedModelRequest{
+				ExperimentID: fmt.Sprint(*experiment.ID),
+				Name:         "model",
+				ModelType:    "sklearn",
+				SourceRunID:  "run1",
+				Params:       []request.LoggedModelParamPartialRequest{{Key: "alpha", Value: "0.5"}},
+				Tags:         []request.LoggedModelTagPartialRequest{{Key: "env", Value: "test"}},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.LoggedModelsRoutePrefix, mlflow.LoggedModelsCreateRoute,
+		),
+	)
+
+	s.Require().NotNil(resp.Model)
+	s.True(strings.HasPrefix(resp.Model.Info.ModelID, "m-"))
+	s.NotZero(resp.Model.Info.CreationTimestampMS)
+	s.Equal(&response.LoggedModelPartialResponse{
+		Info: response.LoggedModelInfoPartialResponse{
+			ModelID:                resp.Model.Info.ModelID,
+			ExperimentID:           fmt.Sprint(*experiment.ID),
+			Name:                   "model",
+			CreationTimestampMS:    resp.Model.Info.CreationTimestampMS,
+			LastUpdatedTimestampMS: resp.Model.Info.CreationTimestampMS,
+			ArtifactURI:            fmt.Sprintf("s3://bucket/1/models/%s/artifacts", resp.Model.Info.ModelID),
+			Status:                 string(models.LoggedModelStatusPending),
+			ModelType:              "sklearn",
+			SourceRunID:            "run1",
+			Tags:                   []response.LoggedModelTagPartialResponse{{Key: "env", Value: "test"}},
+		},
+		Data: response.LoggedModelDataPartialResponse{
+			Params:  []response.LoggedModelParamPartialResponse{{Key: "alpha", Value: "0.5"}},
+			Metrics: []response.LoggedModelMetricPartialResponse{},
+		},
+	}, resp.Model)
+
+	model, err := s.LoggedModelFixtures.GetLoggedModel(context.Background(), resp.Model.Info.ModelID)
+	s.Require().Nil(err)
+	s.Equal(*experiment.ID, model.ExperimentID)
+	s.Equal(models.LifecycleStageActive, model.LifecycleStage)
+	s.Len(model.Params, 1)
+	s.Len(model.Tags, 1)
+}
+
+func (s *CreateLoggedModelTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.CreateLoggedModelRequest
+	}{
+		{
+			name:    "EmptyExperimentID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'"),
+			request: request.CreateLoggedModelRequest{},
+		},
+		{
+			name: "IncorrectExperimentID",
+			error: api.NewBadRequestError(
+				`unable to parse experiment id 'incorrect': strconv.ParseInt: parsing "incorrect": invalid syntax`,
+			),
+			request: request.CreateLoggedModelRequest{
+				ExperimentID: "incorrect",
+			},
+		},
+		{
+			name: "NotFoundExperiment",
+			error: api.NewResourceDoesNotExistError(
+				"unable to find experiment '1000': error getting experiment by id: 1000: record not found",
+			),
+			request: request.CreateLoggedModelRequest{
+				ExperimentID: "1000",
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.LoggedModelsRoutePrefix, mlflow.LoggedModelsCreateRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
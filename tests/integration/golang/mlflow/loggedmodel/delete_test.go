@@ -0,0 +1,64 @@
+package loggedmodel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteLoggedModelTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDeleteLoggedModelTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteLoggedModelTestSuite))
+}
+
+func (s *DeleteLoggedModelTestSuite) Test_Ok() {
+	_, err := s.LoggedModelFixtures.CreateLoggedModel(context.Background(), &models.LoggedModel{
+		ID:             "m-1",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		Name:           "model",
+		Status:         models.LoggedModelStatusReady,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s/%s", mlflow.LoggedModelsRoutePrefix, "m-1",
+		),
+	)
+	s.Empty(resp)
+
+	// the model is only marked as deleted.
+	model, err := s.LoggedModelFixtures.GetLoggedModel(context.Background(), "m-1")
+	s.Require().Nil(err)
+	s.Equal(models.LifecycleStageDeleted, model.LifecycleStage)
+}
+
+func (s *DeleteLoggedModelTestSuite) Test_Error() {
+	resp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s/%s", mlflow.LoggedModelsRoutePrefix, "m-unknown",
+		),
+	)
+	s.Equal(api.NewResourceDoesNotExistError("Logged model with ID 'm-unknown' not found").Error(), resp.Error())
+}
@@ -0,0 +1,101 @@
+package loggedmodel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type FinalizeLoggedModelTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestFinalizeLoggedModelTestSuite(t *testing.T) {
+	suite.Run(t, new(FinalizeLoggedModelTestSuite))
+}
+
+func (s *FinalizeLoggedModelTestSuite) Test_Ok() {
+	_, err := s.LoggedModelFixtures.CreateLoggedModel(context.Background(), &models.LoggedModel{
+		ID:                     "m-1",
+		ExperimentID:           *s.DefaultExperiment.ID,
+		Name:                   "model",
+		CreationTimestampMS:    1234567890,
+		LastUpdatedTimestampMS: 1234567890,
+		Status:                 models.LoggedModelStatusPending,
+		LifecycleStage:         models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	resp := response.LoggedModelResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPatch,
+		).WithRequest(
+			request.FinalizeLoggedModelRequest{
+				Status: string(models.LoggedModelStatusReady),
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s/%s", mlflow.LoggedModelsRoutePrefix, "m-1",
+		),
+	)
+	s.Equal(string(models.LoggedModelStatusReady), resp.Model.Info.Status)
+	s.Greater(resp.Model.Info.LastUpdatedTimestampMS, int64(1234567890))
+
+	model, err := s.LoggedModelFixtures.GetLoggedModel(context.Background(), "m-1")
+	s.Require().Nil(err)
+	s.Equal(models.LoggedModelStatusReady, model.Status)
+	s.Equal(resp.Model.Info.LastUpdatedTimestampMS, model.LastUpdatedTimestampMS)
+}
+
+func (s *FinalizeLoggedModelTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		modelID string
+		request request.FinalizeLoggedModelRequest
+	}{
+		{
+			name: "InvalidStatus",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value 'LOGGED_MODEL_PENDING' for parameter 'status'. " +
+					"Valid values are ['LOGGED_MODEL_READY', 'LOGGED_MODEL_UPLOAD_FAILED']",
+			),
+			modelID: "m-1",
+			request: request.FinalizeLoggedModelRequest{Status: string(models.LoggedModelStatusPending)},
+		},
+		{
+			name:    "NotFoundModel",
+			error:   api.NewResourceDoesNotExistError("Logged model with ID 'm-unknown' not found"),
+			modelID: "m-unknown",
+			request: request.FinalizeLoggedModelRequest{Status: string(models.LoggedModelStatusReady)},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPatch,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s/%s", mlflow.LoggedModelsRoutePrefix, tt.modelID,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package loggedmodel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetLoggedModelTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetLoggedModelTestSuite(t *testing.T) {
+	suite.Run(t, new(GetLoggedModelTestSuite))
+}
+
+func (s *GetLoggedModelTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "run1",
+		Name:           "run1",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *s.DefaultExperiment.ID,
+	})
+	s.Require().Nil(err)
+
+	_, err = s.LoggedModelFixtures.CreateLoggedModel(context.Background(), &models.LoggedModel{
+		ID:                     "m-1",
+		ExperimentID:           *s.DefaultExperiment.ID,
+		Name:                   "model",
+		ArtifactLocation:       "s3://bucket/models/m-1/artifacts",
+		CreationTimestampMS:    1234567890,
+		LastUpdatedTimestampMS: 1234567890,
+		Status:                 models.LoggedModelStatusReady,
+		LifecycleStage:         models.LifecycleStageActive,
+		SourceRunID:            run.ID,
+		Params:                 []models.LoggedModelParam{{Key: "alpha", Value: "0.5", ModelID: "m-1"}},
+	})
+	s.Require().Nil(err)
+
+	// metrics logged with `model_id` are linked to the model.
+	for step, value := range []float64{0.5, 0.7} {
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				request.LogMetricRequest{
+					RunID:     run.ID,
+					Key:       "accuracy",
+					Value:     value,
+					Timestamp: 1234567890,
+					Step:      int64(step),
+					ModelID:   "m-1",
+				},
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogMetricRoute,
+			),
+		)
+	}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogMetricRequest{
+				RunID:     run.ID,
+				Key:       "loss",
+				Value:     0.1,
+				Timestamp: 1234567890,
+			},
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogMetricRoute,
+		),
+	)
+
+	resp := response.LoggedModelResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithResponse(
+			&resp,
+		).DoRequest(
+			"%s/%s", mlflow.LoggedModelsRoutePrefix, "m-1",
+		),
+	)
+	s.Equal(&response.LoggedModelPartialResponse{
+		Info: response.LoggedModelInfoPartialResponse{
+			ModelID:                "m-1",
+			ExperimentID:           fmt.Sprint(*s.DefaultExperiment.ID),
+			Name:                   "model",
+			CreationTimestampMS:    1234567890,
+			LastUpdatedTimestampMS: 1234567890,
+			ArtifactURI:            "s3://bucket/models/m-1/artifacts",
+			Status:                 string(models.LoggedModelStatusReady),
+			SourceRunID:            run.ID,
+			Tags:                   []response.LoggedModelTagPartialResponse{},
+		},
+		Data: response.LoggedModelDataPartialResponse{
+			Params: []response.LoggedModelParamPartialResponse{{Key: "alpha", Value: "0.5"}},
+			Metrics: []response.LoggedModelMetricPartialResponse{
+				{Key: "accuracy", Value: 0.5, Timestamp: 1234567890, Step: 0, RunID: run.ID, ModelID: "m-1"},
+				{Key: "accuracy", Value: 0.7, Timestamp: 1234567890, Step: 1, RunID: run.ID, ModelID: "m-1"},
+			},
+		},
+	}, resp.Model)
+}
+
+func (s *GetLoggedModelTestSuite) Test_Error() {
+	_, err := s.LoggedModelFixtures.CreateLoggedModel(context.Background(), &models.LoggedModel{
+		ID:             "m-deleted",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		Name:           "model",
+		Status:         models.LoggedModelStatusReady,
+		LifecycleStage: models.LifecycleStageDeleted,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		modelID string
+	}{
+		{
+			name:    "NotFoundModel",
+			error:   api.NewResourceDoesNotExistError("Logged model with ID 'm-unknown' not found"),
+			modelID: "m-unknown",
+		},
+		{
+			name:    "DeletedModel",
+			error:   api.NewResourceDoesNotExistError("Logged model with ID 'm-deleted' not found"),
+			modelID: "m-deleted",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithResponse(
+					&resp,
+				).DoRequest(
+					"%s/%s", mlflow.LoggedModelsRoutePrefix, tt.modelID,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package loggedmodel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type LogLoggedModelParamsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestLogLoggedModelParamsTestSuite(t *testing.T) {
+	suite.Run(t, new(LogLoggedModelParamsTestSuite))
+}
+
+func (s *LogLoggedModelParamsTestSuite) Test_Ok() {
+	_, err := s.LoggedModelFixtures.CreateLoggedModel(context.Background(), &models.LoggedModel{
+		ID:             "m-1",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		Name:           "model",
+		Status:         models.LoggedModelStatusPending,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogLoggedModelParamsRequest{
+				Params: []request.LoggedModelParamPartialRequest{{Key: "alpha", Value: "0.5"}},
+			},
+		).DoRequest(
+			"%s/%s/params", mlflow.LoggedModelsRoutePrefix, "m-1",
+		),
+	)
+
+	model, err := s.LoggedModelFixtures.GetLoggedModel(context.Background(), "m-1")
+	s.Require().Nil(err)
+	s.Equal([]models.LoggedModelParam{{Key: "alpha", Value: "0.5", ModelID: "m-1"}}, model.Params)
+}
+
+func (s *LogLoggedModelParamsTestSuite) Test_Error() {
+	resp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogLoggedModelParamsRequest{
+				Params: []request.LoggedModelParamPartialRequest{{Key: "alpha", Value: "0.5"}},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s/%s/params", mlflow.LoggedModelsRoutePrefix, "m-unknown",
+		),
+	)
+	s.Equal(api.NewResourceDoesNotExistError("Logged model with ID 'm-unknown' not found").Error(), resp.Error())
+}
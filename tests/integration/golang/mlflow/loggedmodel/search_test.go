@@ -0,0 +1,238 @@
+package loggedmodel
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchLoggedModelsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchLoggedModelsTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchLoggedModelsTestSuite))
+}
+
+func (s *SearchLoggedModelsTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "run1",
+		Name:           "run1",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *s.DefaultExperiment.ID,
+	})
+	s.Require().Nil(err)
+
+	for i, id := range []string{"m-1", "m-2", "m-3"} {
+		_, err := s.LoggedModelFixtures.CreateLoggedModel(context.Background(), &models.LoggedModel{
+			ID:                     id,
+			ExperimentID:           *s.DefaultExperiment.ID,
+			Name:                   fmt.Sprintf("model-%d", i+1),
+			CreationTimestampMS:    int64(i + 1),
+			LastUpdatedTimestampMS: int64(i + 1),
+			Status:                 models.LoggedModelStatusReady,
+			LifecycleStage:         models.LifecycleStageActive,
+			Params:                 []models.LoggedModelParam{{Key: "alpha", Value: fmt.Sprint(i + 1), ModelID: id}},
+			Tags:                   []models.LoggedModelTag{{Key: "env", Value: "test", ModelID: id}},
+		})
+		s.Require().Nil(err)
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "accuracy",
+			Value:     float64(i+1) / 10,
+			Timestamp: 1234567890,
+			RunID:     run.ID,
+			Iter:      1,
+			ModelID:   sql.NullString{String: id, Valid: true},
+		})
+		s.Require().Nil(err)
+	}
+	_, err = s.LoggedModelFixtures.CreateLoggedModel(context.Background(), &models.LoggedModel{
+		ID:             "m-deleted",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		Name:           "deleted",
+		Status:         models.LoggedModelStatusReady,
+		LifecycleStage: models.LifecycleStageDeleted,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name     string
+		request  request.SearchLoggedModelsRequest
+		expected []string
+	}{
+		{
+			name:     "DefaultOrder",
+			request:  request.SearchLoggedModelsRequest{},
+			expected: []string{"m-3", "m-2", "m-1"},
+		},
+		{
+			name: "OrderByName",
+			request: request.SearchLoggedModelsRequest{
+				OrderBy: []request.LoggedModelOrderByPartialRequest{{FieldName: "name"}},
+			},
+			expected: []string{"m-1", "m-2", "m-3"},
+		},
+		{
+			name:     "FilterByMetric",
+			request:  request.SearchLoggedModelsRequest{Filter: "metrics.accuracy >= 0.2"},
+			expected: []string{"m-3", "m-2"},
+		},
+		{
+			name:     "FilterByParamOrName",
+			request:  request.SearchLoggedModelsRequest{Filter: "params.alpha = '1' OR name = 'model-3'"},
+			expected: []string{"m-3", "m-1"},
+		},
+		{
+			name:     "FilterByTagAndTimestamp",
+			request:  request.SearchLoggedModelsRequest{Filter: "tags.env = 'test' AND creation_timestamp < 3"},
+			expected: []string{"m-2", "m-1"},
+		},
+		{
+			name:     "FilterByMissingMetric",
+			request:  request.SearchLoggedModelsRequest{Filter: "metrics.loss IS NULL AND model_id IN ('m-1', 'm-2')"},
+			expected: []string{"m-2", "m-1"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.request.ExperimentIDs = []string{fmt.Sprint(*s.DefaultExperiment.ID)}
+			resp := response.SearchLoggedModelsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.LoggedModelsRoutePrefix, mlflow.LoggedModelsSearchRoute,
+				),
+			)
+			ids := make([]string, len(resp.Models))
+			for i, model := range resp.Models {
+				ids[i] = model.Info.ModelID
+			}
+			s.Equal(tt.expected, ids)
+			s.Empty(resp.NextPageToken)
+		})
+	}
+}
+
+func (s *SearchLoggedModelsTestSuite) Test_Pagination_Ok() {
+	for i := 1; i <= 5; i++ {
+		id := fmt.Sprintf("m-%d", i)
+		_, err := s.LoggedModelFixtures.CreateLoggedModel(context.Background(), &models.LoggedModel{
+			ID:                     id,
+			ExperimentID:           *s.DefaultExperiment.ID,
+			Name:                   id,
+			CreationTimestampMS:    int64(i / 2),
+			LastUpdatedTimestampMS: int64(i / 2),
+			Status:                 models.LoggedModelStatusReady,
+			LifecycleStage:         models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+	}
+
+	var ids []string
+	pageToken := ""
+	for page := 0; page < 5; page++ {
+		resp := response.SearchLoggedModelsResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				request.SearchLoggedModelsRequest{
+					ExperimentIDs: []string{fmt.Sprint(*s.DefaultExperiment.ID)},
+					MaxResults:    2,
+					PageToken:     pageToken,
+				},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.LoggedModelsRoutePrefix, mlflow.LoggedModelsSearchRoute,
+			),
+		)
+		for _, model := range resp.Models {
+			ids = append(ids, model.Info.ModelID)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	s.Equal([]string{"m-4", "m-5", "m-2", "m-3", "m-1"}, ids)
+}
+
+func (s *SearchLoggedModelsTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.SearchLoggedModelsRequest
+	}{
+		{
+			name:    "EmptyExperimentIDs",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_ids'"),
+			request: request.SearchLoggedModelsRequest{},
+		},
+		{
+			name: "InvalidAttribute",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter 'unknown = 'a'': invalid attribute 'unknown'. Valid values are " +
+					"['name', 'model_id', 'model_type', 'status', " +
+					"'source_run_id', 'creation_timestamp', 'last_updated_timestamp'] at position 1",
+			),
+			request: request.SearchLoggedModelsRequest{ExperimentIDs: []string{"0"}, Filter: "unknown = 'a'"},
+		},
+		{
+			name: "InvalidMetricOperator",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter 'name = 'a' AND metrics.a LIKE '1'': " +
+					"invalid metric comparison operator 'LIKE' at position 16",
+			),
+			request: request.SearchLoggedModelsRequest{
+				ExperimentIDs: []string{"0"}, Filter: "name = 'a' AND metrics.a LIKE '1'",
+			},
+		},
+		{
+			name: "InvalidOrderBy",
+			error: api.NewInvalidParameterValueError(
+				"invalid order_by field 'metrics.accuracy'. Valid values are ['name', 'model_id', 'model_type', " +
+					"'status', 'source_run_id', 'creation_timestamp', 'last_updated_timestamp']",
+			),
+			request: request.SearchLoggedModelsRequest{
+				ExperimentIDs: []string{"0"},
+				OrderBy:       []request.LoggedModelOrderByPartialRequest{{FieldName: "metrics.accuracy"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.LoggedModelsRoutePrefix, mlflow.LoggedModelsSearchRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package loggedmodel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type LoggedModelTagsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestLoggedModelTagsTestSuite(t *testing.T) {
+	suite.Run(t, new(LoggedModelTagsTestSuite))
+}
+
+func (s *LoggedModelTagsTestSuite) Test_Ok() {
+	_, err := s.LoggedModelFixtures.CreateLoggedModel(context.Background(), &models.LoggedModel{
+		ID:             "m-1",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		Name:           "model",
+		Status:         models.LoggedModelStatusReady,
+		LifecycleStage: models.LifecycleStageActive,
+		Tags:           []models.LoggedModelTag{{Key: "env", Value: "dev", ModelID: "m-1"}},
+	})
+	s.Require().Nil(err)
+
+	// existing tag is updated and the new one is added.
+	resp := response.LoggedModelResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPatch,
+		).WithRequest(
+			request.SetLoggedModelTagsRequest{
+				Tags: []request.LoggedModelTagPartialRequest{
+					{Key: "env", Value: "prod"},
+					{Key: "owner", Value: "team"},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s/%s/tags", mlflow.LoggedModelsRoutePrefix, "m-1",
+		),
+	)
+	s.ElementsMatch([]response.LoggedModelTagPartialResponse{
+		{Key: "env", Value: "prod"},
+		{Key: "owner", Value: "team"},
+	}, resp.Model.Info.Tags)
+
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).DoRequest(
+			"%s/%s/tags/%s", mlflow.LoggedModelsRoutePrefix, "m-1", "env",
+		),
+	)
+
+	model, err := s.LoggedModelFixtures.GetLoggedModel(context.Background(), "m-1")
+	s.Require().Nil(err)
+	s.Equal([]models.LoggedModelTag{{Key: "owner", Value: "team", ModelID: "m-1"}}, model.Tags)
+}
+
+func (s *LoggedModelTagsTestSuite) Test_Error() {
+	_, err := s.LoggedModelFixtures.CreateLoggedModel(context.Background(), &models.LoggedModel{
+		ID:             "m-1",
+		ExperimentID:   *s.DefaultExperiment.ID,
+		Name:           "model",
+		Status:         models.LoggedModelStatusReady,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	resp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPatch,
+		).WithRequest(
+			request.SetLoggedModelTagsRequest{
+				Tags: []request.LoggedModelTagPartialRequest{{Value: "prod"}},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s/%s/tags", mlflow.LoggedModelsRoutePrefix, "m-1",
+		),
+	)
+	s.Equal(api.NewInvalidParameterValueError("Missing value for required parameter 'tag.key'").Error(), resp.Error())
+
+	resp = api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s/%s/tags/%s", mlflow.LoggedModelsRoutePrefix, "m-1", "unknown",
+		),
+	)
+	s.Equal(
+		api.NewResourceDoesNotExistError("No tag with key 'unknown' found for logged model 'm-1'").Error(),
+		resp.Error(),
+	)
+}
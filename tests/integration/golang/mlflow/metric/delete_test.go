@@ -0,0 +1,157 @@
+package metric
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteMetricTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDeleteMetricTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteMetricTestSuite))
+}
+
+func (s *DeleteMetricTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "run1",
+		Name:           "run1",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *s.DefaultExperiment.ID,
+	})
+	s.Require().Nil(err)
+
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogBatchRequest{
+				RunID: run.ID,
+				Metrics: []request.MetricPartialRequest{
+					{Key: "loss", Value: 1.0, Timestamp: 1, Step: 1},
+					{Key: "loss", Value: 2.0, Timestamp: 2, Step: 2},
+					{Key: "loss", Value: 0.5, Timestamp: 1, Step: 1, Context: map[string]any{"subset": "val"}},
+					{Key: "accuracy", Value: 0.1, Timestamp: 1, Step: 1},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogBatchRoute,
+		),
+	)
+
+	// delete only the validation series of the metric.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.DeleteMetricRequest{
+				RunID:   run.ID,
+				Key:     "loss",
+				Context: map[string]string{"subset": "val"},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsDeleteRoute,
+		),
+	)
+	s.Empty(resp)
+
+	metrics, err := s.MetricFixtures.GetMetricsByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Len(metrics, 3)
+	latestMetrics, err := s.MetricFixtures.GetLatestMetricsByKey(context.Background(), "loss")
+	s.Require().Nil(err)
+	s.Require().Len(latestMetrics, 1)
+	s.Equal(2.0, latestMetrics[0].Value)
+	s.Equal(int64(2), latestMetrics[0].LastIter)
+
+	// delete the metric key in all the contexts.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.DeleteMetricRequest{
+				RunID: run.ID,
+				Key:   "loss",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsDeleteRoute,
+		),
+	)
+	s.Empty(resp)
+
+	metrics, err = s.MetricFixtures.GetMetricsByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Require().Len(metrics, 1)
+	s.Equal("accuracy", metrics[0].Key)
+	latestMetrics, err = s.MetricFixtures.GetLatestMetricsByKey(context.Background(), "loss")
+	s.Require().Nil(err)
+	s.Empty(latestMetrics)
+	latestAccuracy, err := s.MetricFixtures.GetLatestMetricByKey(context.Background(), "accuracy")
+	s.Require().Nil(err)
+	s.Equal(0.1, latestAccuracy.Value)
+}
+
+func (s *DeleteMetricTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.DeleteMetricRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			request: request.DeleteMetricRequest{},
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+		},
+		{
+			name: "EmptyKey",
+			request: request.DeleteMetricRequest{
+				RunID: "id",
+			},
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'key'"),
+		},
+		{
+			name: "NotFoundRun",
+			request: request.DeleteMetricRequest{
+				RunID: "id",
+				Key:   "loss",
+			},
+			error: api.NewResourceDoesNotExistError("unable to find run 'id'"),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsDeleteRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package metric
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/service/metric"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetHistoryBulkIntervalTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetHistoryBulkIntervalTestSuite(t *testing.T) {
+	suite.Run(t, new(GetHistoryBulkIntervalTestSuite))
+}
+
+func (s *GetHistoryBulkIntervalTestSuite) Test_Ok() {
+	// create 2 runs: run1 has 100 steps of the metric, run2 has only 5 steps.
+	for _, data := range []struct {
+		id    string
+		steps int
+	}{
+		{id: "run1", steps: 100},
+		{id: "run2", steps: 5},
+	} {
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             data.id,
+			Name:           data.id,
+			Status:         models.StatusRunning,
+			SourceType:     "JOB",
+			LifecycleStage: models.LifecycleStageActive,
+			ExperimentID:   *s.DefaultExperiment.ID,
+		})
+		s.Require().Nil(err)
+		for step := 0; step < data.steps; step++ {
+			_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+				Key:       "loss",
+				Value:     float64(step),
+				Timestamp: int64(1000 + step),
+				RunID:     run.ID,
+				Step:      int64(step),
+				Iter:      int64(step + 1),
+			})
+			s.Require().Nil(err)
+		}
+	}
+
+	tests := []struct {
+		name          string
+		request       request.GetMetricHistoryBulkIntervalRequest
+		expectedSteps map[string][]int64
+	}{
+		{
+			name: "WholeHistory",
+			request: request.GetMetricHistoryBulkIntervalRequest{
+				RunIDs:     []string{"run1", "run2"},
+				MetricKey:  "loss",
+				MaxResults: 10,
+			},
+			expectedSteps: map[string][]int64{
+				"run1": {0, 11, 22, 33, 44, 55, 66, 77, 88, 99},
+				"run2": {0, 1, 2, 3, 4},
+			},
+		},
+		{
+			name: "StepsInterval",
+			request: request.GetMetricHistoryBulkIntervalRequest{
+				RunIDs:     []string{"run1", "run2"},
+				MetricKey:  "loss",
+				StartStep:  common.GetPointer[int64](10),
+				EndStep:    common.GetPointer[int64](20),
+				MaxResults: 10,
+			},
+			expectedSteps: map[string][]int64{
+				"run1": {10, 12, 13, 14, 15, 16, 17, 18, 19, 20},
+			},
+		},
+		{
+			name: "ShortSeriesInterval",
+			request: request.GetMetricHistoryBulkIntervalRequest{
+				RunIDs:     []string{"run1", "run2"},
+				MetricKey:  "loss",
+				StartStep:  common.GetPointer[int64](3),
+				EndStep:    common.GetPointer[int64](6),
+				MaxResults: 10,
+			},
+			expectedSteps: map[string][]int64{
+				"run1": {3, 4, 5, 6},
+				"run2": {3, 4},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.GetMetricHistoryBulkResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryBulkIntervalRoute,
+				),
+			)
+
+			steps := map[string][]int64{}
+			for _, metric := range resp.Metrics {
+				s.Equal("loss", metric.Key)
+				s.Equal(float64(metric.Step), metric.Value)
+				s.Equal(1000+metric.Step, metric.Timestamp)
+				steps[metric.RunID] = append(steps[metric.RunID], metric.Step)
+			}
+			s.Equal(tt.expectedSteps, steps)
+		})
+	}
+}
+
+func (s *GetHistoryBulkIntervalTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.GetMetricHistoryBulkIntervalRequest
+	}{
+		{
+			name:    "EmptyOrIncorrectRunIDs",
+			request: request.GetMetricHistoryBulkIntervalRequest{},
+			error: api.NewInvalidParameterValueError(
+				"GetMetricHistoryBulkInterval request must specify at least one run_id.",
+			),
+		},
+		{
+			name: "LengthOfRunIDsMoreThenAllowed",
+			request: request.GetMetricHistoryBulkIntervalRequest{
+				RunIDs: make([]string, metric.MaxRunIDsForMetricHistoryBulkIntervalRequest+1),
+			},
+			error: api.NewInvalidParameterValueError(
+				"GetMetricHistoryBulkInterval request cannot specify more than 100 run_ids. Received 101 run_ids.",
+			),
+		},
+		{
+			name: "EmptyOrIncorrectMetricKey",
+			request: request.GetMetricHistoryBulkIntervalRequest{
+				RunIDs: []string{"id"},
+			},
+			error: api.NewInvalidParameterValueError(
+				"GetMetricHistoryBulkInterval request must specify a metric_key.",
+			),
+		},
+		{
+			name: "EndStepWithoutStartStep",
+			request: request.GetMetricHistoryBulkIntervalRequest{
+				RunIDs:    []string{"id"},
+				MetricKey: "key",
+				EndStep:   common.GetPointer[int64](10),
+			},
+			error: api.NewInvalidParameterValueError(
+				"If either start step or end step are specified, both must be specified.",
+			),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryBulkIntervalRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
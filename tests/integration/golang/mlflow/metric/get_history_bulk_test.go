@@ -2,9 +2,11 @@ package metric
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"gorm.io/datatypes"
 
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
 	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
@@ -109,6 +111,78 @@ func (s *GetHistoriesBulkTestSuite) Test_Ok() {
 	}, resp)
 }
 
+func (s *GetHistoriesBulkTestSuite) Test_PaginationWithContext_Ok() {
+	// create 2 runs with 3 steps of the metric for both `train` and `val` subsets.
+	for _, id := range []string{"run1", "run2"} {
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             id,
+			Name:           id,
+			Status:         models.StatusRunning,
+			SourceType:     "JOB",
+			LifecycleStage: models.LifecycleStageActive,
+			ExperimentID:   *s.DefaultExperiment.ID,
+		})
+		s.Require().Nil(err)
+		for _, subset := range []string{"train", "val"} {
+			for step := int64(0); step < 3; step++ {
+				_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+					Key:       "loss",
+					Value:     float64(step),
+					Timestamp: 1234567890 + step,
+					RunID:     run.ID,
+					Step:      step,
+					Iter:      step + 1,
+					Context: models.Context{
+						Json: datatypes.JSON(fmt.Sprintf(`{"subset": "%s"}`, subset)),
+					},
+				})
+				s.Require().Nil(err)
+			}
+		}
+	}
+
+	// read `val` subset of both runs page by page.
+	var metrics []response.MetricPartialResponseBulk
+	pageToken := ""
+	for page := 0; page < 5; page++ {
+		resp := response.GetMetricHistoryBulkResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithQuery(
+				request.GetMetricHistoryBulkRequest{
+					RunIDs:     []string{"run1", "run2"},
+					MetricKey:  "loss",
+					MaxResults: 4,
+					PageToken:  pageToken,
+					Context:    `{"subset": "val"}`,
+				},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryBulkRoute,
+			),
+		)
+		metrics = append(metrics, resp.Metrics...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	var expected []response.MetricPartialResponseBulk
+	for _, id := range []string{"run1", "run2"} {
+		for step := int64(0); step < 3; step++ {
+			expected = append(expected, response.MetricPartialResponseBulk{
+				RunID:     id,
+				Key:       "loss",
+				Value:     float64(step),
+				Timestamp: 1234567890 + step,
+				Step:      step,
+			})
+		}
+	}
+	s.Equal(expected, metrics)
+}
+
 func (s *GetHistoriesBulkTestSuite) Test_Error() {
 	tests := []struct {
 		name    string
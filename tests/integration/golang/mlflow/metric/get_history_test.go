@@ -2,6 +2,7 @@ package metric
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -86,6 +87,67 @@ func (s *GetHistoryTestSuite) Test_Ok() {
 	}, resp)
 }
 
+func (s *GetHistoryTestSuite) Test_PaginationWithContext_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "id",
+		Name:           "chill-run",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *s.DefaultExperiment.ID,
+	})
+	s.Require().Nil(err)
+
+	// create 5 steps of the metric for both `train` and `val` subsets.
+	for _, subset := range []string{"train", "val"} {
+		for step := int64(0); step < 5; step++ {
+			_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+				Key:       "loss",
+				Value:     float64(step) / 10,
+				Timestamp: 1234567890 + step,
+				RunID:     run.ID,
+				Step:      step,
+				Iter:      step + 1,
+				Context: models.Context{
+					Json: datatypes.JSON(fmt.Sprintf(`{"subset": "%s"}`, subset)),
+				},
+			})
+			s.Require().Nil(err)
+		}
+	}
+
+	// read `val` subset page by page.
+	var steps []int64
+	pageToken := ""
+	for page := 0; page < 5; page++ {
+		resp := response.GetMetricHistoryResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithQuery(
+				request.GetMetricHistoryRequest{
+					RunID:      run.ID,
+					MetricKey:  "loss",
+					MaxResults: 2,
+					PageToken:  pageToken,
+					Context:    `{"subset": "val"}`,
+				},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsGetHistoryRoute,
+			),
+		)
+		for _, metric := range resp.Metrics {
+			s.Equal(map[string]any{"subset": "val"}, metric.Context)
+			steps = append(steps, metric.Step)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	s.Equal([]int64{0, 1, 2, 3, 4}, steps)
+}
+
 func (s *GetHistoryTestSuite) Test_Error() {
 	tests := []struct {
 		name    string
@@ -104,6 +166,18 @@ func (s *GetHistoryTestSuite) Test_Error() {
 			},
 			error: api.NewInvalidParameterValueError("Missing value for required parameter 'metric_key'"),
 		},
+		{
+			name: "IncorrectContext",
+			request: request.GetMetricHistoryRequest{
+				RunID:     "id",
+				MetricKey: "key",
+				Context:   `["val"]`,
+			},
+			error: api.NewInvalidParameterValueError(
+				`invalid context '["val"]': error decoding context: ` +
+					"json: cannot unmarshal array into Go value of type map[string]string",
+			),
+		},
 	}
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
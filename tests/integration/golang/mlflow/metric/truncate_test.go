@@ -0,0 +1,187 @@
+package metric
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type TruncateMetricsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestTruncateMetricsTestSuite(t *testing.T) {
+	suite.Run(t, new(TruncateMetricsTestSuite))
+}
+
+func (s *TruncateMetricsTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             "run1",
+		Name:           "run1",
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		ExperimentID:   *s.DefaultExperiment.ID,
+	})
+	s.Require().Nil(err)
+
+	// log steps 1-3 of the metrics, then resume the run from step 1 again.
+	s.logMetrics(run.ID, []request.MetricPartialRequest{
+		{Key: "loss", Value: 1.0, Timestamp: 1, Step: 1},
+		{Key: "loss", Value: 2.0, Timestamp: 2, Step: 2},
+		{Key: "loss", Value: 3.0, Timestamp: 3, Step: 3},
+		{Key: "accuracy", Value: 0.1, Timestamp: 1, Step: 1},
+		{Key: "accuracy", Value: 0.2, Timestamp: 2, Step: 2},
+	})
+	s.logMetrics(run.ID, []request.MetricPartialRequest{
+		{Key: "loss", Value: 1.5, Timestamp: 4, Step: 1},
+	})
+
+	// truncate all the metrics of the run after step 1.
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.TruncateMetricsRequest{
+				RunID: run.ID,
+				Step:  common.GetPointer[int64](1),
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsTruncateRoute,
+		),
+	)
+	s.Empty(resp)
+
+	// remaining metrics have to be renumbered and the latest metrics have to be recomputed.
+	s.Equal([]models.Metric{
+		{Key: "accuracy", Value: 0.1, Timestamp: 1, Step: 1, Iter: 1},
+		{Key: "loss", Value: 1.0, Timestamp: 1, Step: 1, Iter: 1},
+		{Key: "loss", Value: 1.5, Timestamp: 4, Step: 1, Iter: 2},
+	}, s.getMetrics(run.ID))
+	latestLoss, err := s.MetricFixtures.GetLatestMetricByKey(context.Background(), "loss")
+	s.Require().Nil(err)
+	s.Equal(1.5, latestLoss.Value)
+	s.Equal(int64(1), latestLoss.Step)
+	s.Equal(int64(2), latestLoss.LastIter)
+	latestAccuracy, err := s.MetricFixtures.GetLatestMetricByKey(context.Background(), "accuracy")
+	s.Require().Nil(err)
+	s.Equal(0.1, latestAccuracy.Value)
+	s.Equal(int64(1), latestAccuracy.LastIter)
+
+	// resumed logging continues the numbering of the truncated history.
+	s.logMetrics(run.ID, []request.MetricPartialRequest{
+		{Key: "loss", Value: 2.5, Timestamp: 5, Step: 2},
+	})
+	s.Equal([]models.Metric{
+		{Key: "accuracy", Value: 0.1, Timestamp: 1, Step: 1, Iter: 1},
+		{Key: "loss", Value: 1.0, Timestamp: 1, Step: 1, Iter: 1},
+		{Key: "loss", Value: 1.5, Timestamp: 4, Step: 1, Iter: 2},
+		{Key: "loss", Value: 2.5, Timestamp: 5, Step: 2, Iter: 3},
+	}, s.getMetrics(run.ID))
+	latestLoss, err = s.MetricFixtures.GetLatestMetricByKey(context.Background(), "loss")
+	s.Require().Nil(err)
+	s.Equal(2.5, latestLoss.Value)
+	s.Equal(int64(3), latestLoss.LastIter)
+}
+
+func (s *TruncateMetricsTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.TruncateMetricsRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			request: request.TruncateMetricsRequest{},
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+		},
+		{
+			name: "EmptyStep",
+			request: request.TruncateMetricsRequest{
+				RunID: "id",
+			},
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'step'"),
+		},
+		{
+			name: "NotFoundRun",
+			request: request.TruncateMetricsRequest{
+				RunID: "id",
+				Step:  common.GetPointer[int64](1),
+			},
+			error: api.NewResourceDoesNotExistError("unable to find run 'id'"),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.MetricsRoutePrefix, mlflow.MetricsTruncateRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
+
+// logMetrics logs the metrics of the run through the `POST /runs/log-batch` endpoint.
+func (s *TruncateMetricsTestSuite) logMetrics(runID string, metrics []request.MetricPartialRequest) {
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogBatchRequest{
+				RunID:   runID,
+				Metrics: metrics,
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogBatchRoute,
+		),
+	)
+}
+
+// getMetrics returns the metrics of the run ordered by key and iteration, keeping only the compared fields.
+func (s *TruncateMetricsTestSuite) getMetrics(runID string) []models.Metric {
+	metrics, err := s.MetricFixtures.GetMetricsByRunID(context.Background(), runID)
+	s.Require().Nil(err)
+	result := make([]models.Metric, len(metrics))
+	for i, metric := range metrics {
+		result[i] = models.Metric{
+			Key:       metric.Key,
+			Value:     metric.Value,
+			Timestamp: metric.Timestamp,
+			Step:      metric.Step,
+			Iter:      metric.Iter,
+		}
+	}
+	slices.SortFunc(result, func(a, b models.Metric) int {
+		if a.Key != b.Key {
+			return strings.Compare(a.Key, b.Key)
+		}
+		return int(a.Iter - b.Iter)
+	})
+	return result
+}
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CreateModelVersionTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestCreateModelVersionTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateModelVersionTestSuite))
+}
+
+func (s *CreateModelVersionTestSuite) Test_Ok() {
+	_, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	for _, version := range []string{"1", "2"} {
+		resp := response.ModelVersionResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				request.CreateModelVersionRequest{
+					Name:        "model",
+					Source:      "s3://bucket/model",
+					RunID:       "run-id",
+					Description: "description",
+					Tags: []request.ModelVersionTagPartialRequest{
+						{Key: "key1", Value: "value1"},
+					},
+				},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsCreateRoute,
+			),
+		)
+		s.Equal("model", resp.ModelVersion.Name)
+		s.Equal(version, resp.ModelVersion.Version)
+		s.Equal("s3://bucket/model", resp.ModelVersion.Source)
+		s.Equal("run-id", resp.ModelVersion.RunID)
+		s.Equal("description", resp.ModelVersion.Description)
+		s.Equal(string(models.ModelVersionStageNone), resp.ModelVersion.CurrentStage)
+		s.Equal(string(models.ModelVersionStatusReady), resp.ModelVersion.Status)
+		s.Equal([]response.ModelVersionTagPartialResponse{{Key: "key1", Value: "value1"}}, resp.ModelVersion.Tags)
+		s.NotZero(resp.ModelVersion.CreationTimestamp)
+	}
+}
+
+func (s *CreateModelVersionTestSuite) Test_Error() {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.CreateModelVersionRequest
+	}{
+		{
+			name:    "EmptyName",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: request.CreateModelVersionRequest{},
+		},
+		{
+			name:    "EmptySource",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'source'"),
+			request: request.CreateModelVersionRequest{Name: "model"},
+		},
+		{
+			name:    "NotFound",
+			error:   api.NewResourceDoesNotExistError("Registered Model with name=not-found not found"),
+			request: request.CreateModelVersionRequest{Name: "not-found", Source: "s3://bucket/model"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsCreateRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
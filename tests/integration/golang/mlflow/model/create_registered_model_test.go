@@ -0,0 +1,115 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CreateRegisteredModelTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestCreateRegisteredModelTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateRegisteredModelTestSuite))
+}
+
+func (s *CreateRegisteredModelTestSuite) Test_Ok() {
+	req := request.CreateRegisteredModelRequest{
+		Name:        "model",
+		Description: "description",
+		Tags: []request.RegisteredModelTagPartialRequest{
+			{
+				Key:   "key1",
+				Value: "value1",
+			},
+		},
+	}
+	resp := response.RegisteredModelResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			req,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsCreateRoute,
+		),
+	)
+	s.Equal("model", resp.RegisteredModel.Name)
+	s.Equal("description", resp.RegisteredModel.Description)
+	s.NotZero(resp.RegisteredModel.CreationTimestamp)
+	s.Equal(resp.RegisteredModel.CreationTimestamp, resp.RegisteredModel.LastUpdatedTimestamp)
+	s.Equal([]response.RegisteredModelTagPartialResponse{{Key: "key1", Value: "value1"}}, resp.RegisteredModel.Tags)
+
+	registeredModel, err := s.RegisteredModelFixtures.GetRegisteredModel(
+		context.Background(), s.DefaultNamespace.ID, "model",
+	)
+	s.Require().Nil(err)
+	s.Require().NotNil(registeredModel)
+	s.Equal("description", registeredModel.Description)
+	s.Equal([]models.RegisteredModelTag{
+		{Key: "key1", Value: "value1", RegisteredModelID: registeredModel.ID},
+	}, registeredModel.Tags)
+}
+
+func (s *CreateRegisteredModelTestSuite) Test_Error() {
+	_, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "existing",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.CreateRegisteredModelRequest
+	}{
+		{
+			name:    "EmptyName",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: request.CreateRegisteredModelRequest{},
+		},
+		{
+			name:  "EmptyTagKey",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'key'"),
+			request: request.CreateRegisteredModelRequest{
+				Name: "model",
+				Tags: []request.RegisteredModelTagPartialRequest{{Value: "value"}},
+			},
+		},
+		{
+			name:    "AlreadyExists",
+			error:   api.NewResourceAlreadyExistsError("Registered Model (name=existing) already exists."),
+			request: request.CreateRegisteredModelRequest{Name: "existing"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsCreateRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
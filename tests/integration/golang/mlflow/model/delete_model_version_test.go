@@ -0,0 +1,110 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteModelVersionTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDeleteModelVersionTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteModelVersionTestSuite))
+}
+
+func (s *DeleteModelVersionTestSuite) Test_Ok() {
+	registeredModel, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.RegisteredModelFixtures.CreateModelVersion(context.Background(), &models.ModelVersion{
+		Source:            "s3://bucket/model",
+		Status:            models.ModelVersionStatusReady,
+		CurrentStage:      models.ModelVersionStageNone,
+		RegisteredModelID: registeredModel.ID,
+		Tags:              []models.ModelVersionTag{{Key: "key", Value: "value"}},
+	})
+	s.Require().Nil(err)
+	_, err = s.RegisteredModelFixtures.CreateRegisteredModelAlias(context.Background(), &models.RegisteredModelAlias{
+		Alias:             "champion",
+		Version:           1,
+		RegisteredModelID: registeredModel.ID,
+	})
+	s.Require().Nil(err)
+
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).WithRequest(
+			request.DeleteModelVersionRequest{Name: "model", Version: "1"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsDeleteRoute,
+		),
+	)
+	s.Empty(resp)
+
+	modelVersion, err := s.RegisteredModelFixtures.GetModelVersion(context.Background(), registeredModel.ID, 1)
+	s.Require().Nil(err)
+	s.Nil(modelVersion)
+	registeredModel, err = s.RegisteredModelFixtures.GetRegisteredModel(
+		context.Background(), s.DefaultNamespace.ID, "model",
+	)
+	s.Require().Nil(err)
+	s.Empty(registeredModel.Aliases)
+}
+
+func (s *DeleteModelVersionTestSuite) Test_Error() {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.DeleteModelVersionRequest
+	}{
+		{
+			name:    "EmptyName",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: request.DeleteModelVersionRequest{},
+		},
+		{
+			name:    "EmptyVersion",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'version'"),
+			request: request.DeleteModelVersionRequest{Name: "model"},
+		},
+		{
+			name:    "NotFound",
+			error:   api.NewResourceDoesNotExistError("Registered Model with name=not-found not found"),
+			request: request.DeleteModelVersionRequest{Name: "not-found", Version: "1"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodDelete,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsDeleteRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
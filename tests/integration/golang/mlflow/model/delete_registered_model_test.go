@@ -0,0 +1,100 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteRegisteredModelTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDeleteRegisteredModelTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteRegisteredModelTestSuite))
+}
+
+func (s *DeleteRegisteredModelTestSuite) Test_Ok() {
+	registeredModel, err := s.RegisteredModelFixtures.CreateRegisteredModel(
+		context.Background(), &models.RegisteredModel{
+			Name:        "model",
+			NamespaceID: s.DefaultNamespace.ID,
+			Tags:        []models.RegisteredModelTag{{Key: "key", Value: "value"}},
+		},
+	)
+	s.Require().Nil(err)
+	_, err = s.RegisteredModelFixtures.CreateModelVersion(context.Background(), &models.ModelVersion{
+		Source:            "s3://bucket/model",
+		Status:            models.ModelVersionStatusReady,
+		CurrentStage:      models.ModelVersionStageNone,
+		RegisteredModelID: registeredModel.ID,
+		Tags:              []models.ModelVersionTag{{Key: "key", Value: "value"}},
+	})
+	s.Require().Nil(err)
+
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).WithRequest(
+			request.DeleteRegisteredModelRequest{Name: "model"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsDeleteRoute,
+		),
+	)
+	s.Empty(resp)
+
+	deleted, err := s.RegisteredModelFixtures.GetRegisteredModel(context.Background(), s.DefaultNamespace.ID, "model")
+	s.Require().Nil(err)
+	s.Nil(deleted)
+	modelVersion, err := s.RegisteredModelFixtures.GetModelVersion(context.Background(), registeredModel.ID, 1)
+	s.Require().Nil(err)
+	s.Nil(modelVersion)
+}
+
+func (s *DeleteRegisteredModelTestSuite) Test_Error() {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.DeleteRegisteredModelRequest
+	}{
+		{
+			name:    "EmptyName",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: request.DeleteRegisteredModelRequest{},
+		},
+		{
+			name:    "NotFound",
+			error:   api.NewResourceDoesNotExistError("Registered Model with name=not-found not found"),
+			request: request.DeleteRegisteredModelRequest{Name: "not-found"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodDelete,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsDeleteRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
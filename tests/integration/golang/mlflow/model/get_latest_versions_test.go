@@ -0,0 +1,137 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetLatestVersionsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetLatestVersionsTestSuite(t *testing.T) {
+	suite.Run(t, new(GetLatestVersionsTestSuite))
+}
+
+func (s *GetLatestVersionsTestSuite) Test_Ok() {
+	registeredModel, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	for _, stage := range []models.ModelVersionStage{
+		models.ModelVersionStageStaging,
+		models.ModelVersionStageProduction,
+		models.ModelVersionStageStaging,
+		models.ModelVersionStageNone,
+	} {
+		_, err := s.RegisteredModelFixtures.CreateModelVersion(context.Background(), &models.ModelVersion{
+			Source:            "s3://bucket/model",
+			Status:            models.ModelVersionStatusReady,
+			CurrentStage:      stage,
+			RegisteredModelID: registeredModel.ID,
+		})
+		s.Require().Nil(err)
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		stages   []string
+		expected map[string]string
+	}{
+		{
+			name:   "AllStagesWithGet",
+			method: http.MethodGet,
+			expected: map[string]string{
+				"3": string(models.ModelVersionStageStaging),
+				"2": string(models.ModelVersionStageProduction),
+				"4": string(models.ModelVersionStageNone),
+			},
+		},
+		{
+			name:     "StagingWithPost",
+			method:   http.MethodPost,
+			stages:   []string{string(models.ModelVersionStageStaging)},
+			expected: map[string]string{"3": string(models.ModelVersionStageStaging)},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			req := request.GetLatestVersionsRequest{Name: "model", Stages: tt.stages}
+			client := s.MlflowClient().WithMethod(tt.method)
+			if tt.method == http.MethodGet {
+				client = client.WithQuery(req)
+			} else {
+				client = client.WithRequest(req)
+			}
+			resp := response.ModelVersionsResponse{}
+			s.Require().Nil(
+				client.WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsGetLatestVersionsRoute,
+				),
+			)
+			actual := map[string]string{}
+			for _, modelVersion := range resp.ModelVersions {
+				actual[modelVersion.Version] = modelVersion.CurrentStage
+			}
+			s.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func (s *GetLatestVersionsTestSuite) Test_Error() {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.GetLatestVersionsRequest
+	}{
+		{
+			name:    "EmptyName",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: request.GetLatestVersionsRequest{},
+		},
+		{
+			name: "InvalidStage",
+			error: api.NewInvalidParameterValueError(
+				"Invalid Model Version stage: Unknown. Value must be one of None, Staging, Production, Archived.",
+			),
+			request: request.GetLatestVersionsRequest{Name: "model", Stages: []string{"Unknown"}},
+		},
+		{
+			name:    "NotFound",
+			error:   api.NewResourceDoesNotExistError("Registered Model with name=not-found not found"),
+			request: request.GetLatestVersionsRequest{Name: "not-found"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsGetLatestVersionsRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
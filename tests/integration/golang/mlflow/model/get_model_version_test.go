@@ -0,0 +1,152 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetModelVersionTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetModelVersionTestSuite(t *testing.T) {
+	suite.Run(t, new(GetModelVersionTestSuite))
+}
+
+func (s *GetModelVersionTestSuite) Test_Ok() {
+	registeredModel, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.RegisteredModelFixtures.CreateModelVersion(context.Background(), &models.ModelVersion{
+		Source:            "s3://bucket/model",
+		RunID:             "run-id",
+		Status:            models.ModelVersionStatusReady,
+		CurrentStage:      models.ModelVersionStageStaging,
+		CreationTime:      sql.NullInt64{Int64: 1, Valid: true},
+		LastUpdatedTime:   sql.NullInt64{Int64: 1, Valid: true},
+		RegisteredModelID: registeredModel.ID,
+		Tags:              []models.ModelVersionTag{{Key: "key1", Value: "value1"}},
+	})
+	s.Require().Nil(err)
+	_, err = s.RegisteredModelFixtures.CreateRegisteredModelAlias(context.Background(), &models.RegisteredModelAlias{
+		Alias:             "champion",
+		Version:           1,
+		RegisteredModelID: registeredModel.ID,
+	})
+	s.Require().Nil(err)
+
+	resp := response.ModelVersionResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetModelVersionRequest{Name: "model", Version: "1"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsGetRoute,
+		),
+	)
+	s.Equal(&response.ModelVersionPartialResponse{
+		Name:                 "model",
+		Version:              "1",
+		CreationTimestamp:    1,
+		LastUpdatedTimestamp: 1,
+		CurrentStage:         string(models.ModelVersionStageStaging),
+		Source:               "s3://bucket/model",
+		RunID:                "run-id",
+		Status:               string(models.ModelVersionStatusReady),
+		Tags:                 []response.ModelVersionTagPartialResponse{{Key: "key1", Value: "value1"}},
+		Aliases:              []string{"champion"},
+	}, resp.ModelVersion)
+
+	downloadURIResp := response.GetModelVersionDownloadURIResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetModelVersionDownloadURIRequest{Name: "model", Version: "1"},
+		).WithResponse(
+			&downloadURIResp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsGetDownloadURIRoute,
+		),
+	)
+	s.Equal("s3://bucket/model", downloadURIResp.ArtifactURI)
+
+	aliasResp := response.ModelVersionResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetModelVersionByAliasRequest{Name: "model", Alias: "champion"},
+		).WithResponse(
+			&aliasResp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsAliasRoute,
+		),
+	)
+	s.Equal(resp.ModelVersion, aliasResp.ModelVersion)
+}
+
+func (s *GetModelVersionTestSuite) Test_Error() {
+	_, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.GetModelVersionRequest
+	}{
+		{
+			name:    "EmptyName",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: request.GetModelVersionRequest{},
+		},
+		{
+			name:    "EmptyVersion",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'version'"),
+			request: request.GetModelVersionRequest{Name: "model"},
+		},
+		{
+			name:    "InvalidVersion",
+			error:   api.NewInvalidParameterValueError("Model version must be an integer, got 'abc'"),
+			request: request.GetModelVersionRequest{Name: "model", Version: "abc"},
+		},
+		{
+			name:    "RegisteredModelNotFound",
+			error:   api.NewResourceDoesNotExistError("Registered Model with name=not-found not found"),
+			request: request.GetModelVersionRequest{Name: "not-found", Version: "1"},
+		},
+		{
+			name:    "ModelVersionNotFound",
+			error:   api.NewResourceDoesNotExistError("Model Version (name=model, version=2) not found"),
+			request: request.GetModelVersionRequest{Name: "model", Version: "2"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsGetRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
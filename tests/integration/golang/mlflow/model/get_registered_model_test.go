@@ -0,0 +1,124 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetRegisteredModelTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetRegisteredModelTestSuite(t *testing.T) {
+	suite.Run(t, new(GetRegisteredModelTestSuite))
+}
+
+func (s *GetRegisteredModelTestSuite) Test_Ok() {
+	// 1. prepare database with test data.
+	registeredModel, err := s.RegisteredModelFixtures.CreateRegisteredModel(
+		context.Background(), &models.RegisteredModel{
+			Name:            "model",
+			Description:     "description",
+			NamespaceID:     s.DefaultNamespace.ID,
+			CreationTime:    sql.NullInt64{Int64: 1, Valid: true},
+			LastUpdatedTime: sql.NullInt64{Int64: 1, Valid: true},
+			Tags: []models.RegisteredModelTag{
+				{Key: "key1", Value: "value1"},
+			},
+		},
+	)
+	s.Require().Nil(err)
+	for _, stage := range []models.ModelVersionStage{
+		models.ModelVersionStageNone, models.ModelVersionStageNone, models.ModelVersionStageProduction,
+	} {
+		_, err := s.RegisteredModelFixtures.CreateModelVersion(context.Background(), &models.ModelVersion{
+			Source:            "s3://bucket/model",
+			Status:            models.ModelVersionStatusReady,
+			CurrentStage:      stage,
+			CreationTime:      sql.NullInt64{Int64: 2, Valid: true},
+			LastUpdatedTime:   sql.NullInt64{Int64: 2, Valid: true},
+			RegisteredModelID: registeredModel.ID,
+		})
+		s.Require().Nil(err)
+	}
+	_, err = s.RegisteredModelFixtures.CreateRegisteredModelAlias(context.Background(), &models.RegisteredModelAlias{
+		Alias:             "champion",
+		Version:           3,
+		RegisteredModelID: registeredModel.ID,
+	})
+	s.Require().Nil(err)
+
+	// 2. make actual API call.
+	resp := response.RegisteredModelResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetRegisteredModelRequest{Name: "model"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsGetRoute,
+		),
+	)
+
+	// 3. check actual API response.
+	s.Equal("model", resp.RegisteredModel.Name)
+	s.Equal("description", resp.RegisteredModel.Description)
+	s.Equal(int64(1), resp.RegisteredModel.CreationTimestamp)
+	s.Equal(int64(2), resp.RegisteredModel.LastUpdatedTimestamp)
+	s.Equal([]response.RegisteredModelTagPartialResponse{{Key: "key1", Value: "value1"}}, resp.RegisteredModel.Tags)
+	s.Equal(
+		[]response.RegisteredModelAliasPartialResponse{{Alias: "champion", Version: "3"}},
+		resp.RegisteredModel.Aliases,
+	)
+	s.Require().Len(resp.RegisteredModel.LatestVersions, 2)
+	s.Equal("2", resp.RegisteredModel.LatestVersions[0].Version)
+	s.Equal(string(models.ModelVersionStageNone), resp.RegisteredModel.LatestVersions[0].CurrentStage)
+	s.Equal("3", resp.RegisteredModel.LatestVersions[1].Version)
+	s.Equal(string(models.ModelVersionStageProduction), resp.RegisteredModel.LatestVersions[1].CurrentStage)
+	s.Equal([]string{"champion"}, resp.RegisteredModel.LatestVersions[1].Aliases)
+}
+
+func (s *GetRegisteredModelTestSuite) Test_Error() {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.GetRegisteredModelRequest
+	}{
+		{
+			name:    "EmptyName",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: request.GetRegisteredModelRequest{},
+		},
+		{
+			name:    "NotFound",
+			error:   api.NewResourceDoesNotExistError("Registered Model with name=not-found not found"),
+			request: request.GetRegisteredModelRequest{Name: "not-found"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsGetRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
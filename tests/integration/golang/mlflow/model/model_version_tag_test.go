@@ -0,0 +1,122 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type ModelVersionTagTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestModelVersionTagTestSuite(t *testing.T) {
+	suite.Run(t, new(ModelVersionTagTestSuite))
+}
+
+func (s *ModelVersionTagTestSuite) Test_Ok() {
+	registeredModel, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	modelVersion, err := s.RegisteredModelFixtures.CreateModelVersion(context.Background(), &models.ModelVersion{
+		Source:            "s3://bucket/model",
+		Status:            models.ModelVersionStatusReady,
+		CurrentStage:      models.ModelVersionStageNone,
+		RegisteredModelID: registeredModel.ID,
+	})
+	s.Require().Nil(err)
+
+	// 1. set tag.
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.SetModelVersionTagRequest{Name: "model", Version: "1", Key: "key", Value: "value"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsSetTagRoute,
+		),
+	)
+	s.Empty(resp)
+	actual, err := s.RegisteredModelFixtures.GetModelVersion(context.Background(), registeredModel.ID, 1)
+	s.Require().Nil(err)
+	s.Equal([]models.ModelVersionTag{
+		{Key: "key", Value: "value", ModelVersionID: modelVersion.ID},
+	}, actual.Tags)
+
+	// 2. delete tag.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).WithRequest(
+			request.DeleteModelVersionTagRequest{Name: "model", Version: "1", Key: "key"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsDeleteTagRoute,
+		),
+	)
+	s.Empty(resp)
+	actual, err = s.RegisteredModelFixtures.GetModelVersion(context.Background(), registeredModel.ID, 1)
+	s.Require().Nil(err)
+	s.Empty(actual.Tags)
+}
+
+func (s *ModelVersionTagTestSuite) Test_Error() {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.SetModelVersionTagRequest
+	}{
+		{
+			name:    "EmptyName",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: request.SetModelVersionTagRequest{},
+		},
+		{
+			name:    "EmptyVersion",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'version'"),
+			request: request.SetModelVersionTagRequest{Name: "model"},
+		},
+		{
+			name:    "EmptyKey",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'key'"),
+			request: request.SetModelVersionTagRequest{Name: "model", Version: "1"},
+		},
+		{
+			name:    "NotFound",
+			error:   api.NewResourceDoesNotExistError("Registered Model with name=not-found not found"),
+			request: request.SetModelVersionTagRequest{Name: "not-found", Version: "1", Key: "key"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsSetTagRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type RegisteredModelAliasTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestRegisteredModelAliasTestSuite(t *testing.T) {
+	suite.Run(t, new(RegisteredModelAliasTestSuite))
+}
+
+func (s *RegisteredModelAliasTestSuite) Test_Ok() {
+	registeredModel, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	for i := 0; i < 2; i++ {
+		_, err := s.RegisteredModelFixtures.CreateModelVersion(context.Background(), &models.ModelVersion{
+			Source:            "s3://bucket/model",
+			Status:            models.ModelVersionStatusReady,
+			CurrentStage:      models.ModelVersionStageNone,
+			RegisteredModelID: registeredModel.ID,
+		})
+		s.Require().Nil(err)
+	}
+
+	// 1. set alias and then move it to another version.
+	for _, version := range []string{"1", "2"} {
+		resp := map[string]any{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				request.SetRegisteredModelAliasRequest{Name: "model", Alias: "champion", Version: version},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsAliasRoute,
+			),
+		)
+		s.Empty(resp)
+	}
+	registeredModel, err = s.RegisteredModelFixtures.GetRegisteredModel(
+		context.Background(), s.DefaultNamespace.ID, "model",
+	)
+	s.Require().Nil(err)
+	s.Equal([]models.RegisteredModelAlias{
+		{Alias: "champion", Version: 2, RegisteredModelID: registeredModel.ID},
+	}, registeredModel.Aliases)
+
+	// 2. delete alias.
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).WithRequest(
+			request.DeleteRegisteredModelAliasRequest{Name: "model", Alias: "champion"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsAliasRoute,
+		),
+	)
+	s.Empty(resp)
+	registeredModel, err = s.RegisteredModelFixtures.GetRegisteredModel(
+		context.Background(), s.DefaultNamespace.ID, "model",
+	)
+	s.Require().Nil(err)
+	s.Empty(registeredModel.Aliases)
+}
+
+func (s *RegisteredModelAliasTestSuite) Test_Error() {
+	_, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.SetRegisteredModelAliasRequest
+	}{
+		{
+			name:    "EmptyName",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: request.SetRegisteredModelAliasRequest{},
+		},
+		{
+			name:    "EmptyAlias",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'alias'"),
+			request: request.SetRegisteredModelAliasRequest{Name: "model"},
+		},
+		{
+			name:    "EmptyVersion",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'version'"),
+			request: request.SetRegisteredModelAliasRequest{Name: "model", Alias: "champion"},
+		},
+		{
+			name:    "ModelVersionNotFound",
+			error:   api.NewResourceDoesNotExistError("Model Version (name=model, version=1) not found"),
+			request: request.SetRegisteredModelAliasRequest{Name: "model", Alias: "champion", Version: "1"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsAliasRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+
+	resp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetModelVersionByAliasRequest{Name: "model", Alias: "unknown"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsAliasRoute,
+		),
+	)
+	s.Equal(api.NewInvalidParameterValueError("Registered model alias unknown not found.").Error(), resp.Error())
+}
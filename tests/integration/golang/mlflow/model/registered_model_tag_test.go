@@ -0,0 +1,124 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type RegisteredModelTagTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestRegisteredModelTagTestSuite(t *testing.T) {
+	suite.Run(t, new(RegisteredModelTagTestSuite))
+}
+
+func (s *RegisteredModelTagTestSuite) Test_Ok() {
+	registeredModel, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model",
+		NamespaceID: s.DefaultNamespace.ID,
+		Tags:        []models.RegisteredModelTag{{Key: "key1", Value: "value1"}},
+	})
+	s.Require().Nil(err)
+
+	// 1. set a new tag and overwrite an existing one.
+	for _, req := range []request.SetRegisteredModelTagRequest{
+		{Name: "model", Key: "key1", Value: "value2"},
+		{Name: "model", Key: "key2", Value: "value2"},
+	} {
+		resp := map[string]any{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				req,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsSetTagRoute,
+			),
+		)
+		s.Empty(resp)
+	}
+	registeredModel, err = s.RegisteredModelFixtures.GetRegisteredModel(
+		context.Background(), s.DefaultNamespace.ID, "model",
+	)
+	s.Require().Nil(err)
+	s.ElementsMatch([]models.RegisteredModelTag{
+		{Key: "key1", Value: "value2", RegisteredModelID: registeredModel.ID},
+		{Key: "key2", Value: "value2", RegisteredModelID: registeredModel.ID},
+	}, registeredModel.Tags)
+
+	// 2. delete tag.
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).WithRequest(
+			request.DeleteRegisteredModelTagRequest{Name: "model", Key: "key1"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsDeleteTagRoute,
+		),
+	)
+	s.Empty(resp)
+	registeredModel, err = s.RegisteredModelFixtures.GetRegisteredModel(
+		context.Background(), s.DefaultNamespace.ID, "model",
+	)
+	s.Require().Nil(err)
+	s.Equal([]models.RegisteredModelTag{
+		{Key: "key2", Value: "value2", RegisteredModelID: registeredModel.ID},
+	}, registeredModel.Tags)
+}
+
+func (s *RegisteredModelTagTestSuite) Test_Error() {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.SetRegisteredModelTagRequest
+	}{
+		{
+			name:    "EmptyName",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: request.SetRegisteredModelTagRequest{},
+		},
+		{
+			name:    "EmptyKey",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'key'"),
+			request: request.SetRegisteredModelTagRequest{Name: "model"},
+		},
+		{
+			name:    "NotFound",
+			error:   api.NewResourceDoesNotExistError("Registered Model with name=not-found not found"),
+			request: request.SetRegisteredModelTagRequest{Name: "not-found", Key: "key"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsSetTagRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type RenameRegisteredModelTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestRenameRegisteredModelTestSuite(t *testing.T) {
+	suite.Run(t, new(RenameRegisteredModelTestSuite))
+}
+
+func (s *RenameRegisteredModelTestSuite) Test_Ok() {
+	_, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	resp := response.RegisteredModelResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.RenameRegisteredModelRequest{Name: "model", NewName: "renamed"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsRenameRoute,
+		),
+	)
+	s.Equal("renamed", resp.RegisteredModel.Name)
+
+	registeredModel, err := s.RegisteredModelFixtures.GetRegisteredModel(
+		context.Background(), s.DefaultNamespace.ID, "model",
+	)
+	s.Require().Nil(err)
+	s.Nil(registeredModel)
+	registeredModel, err = s.RegisteredModelFixtures.GetRegisteredModel(
+		context.Background(), s.DefaultNamespace.ID, "renamed",
+	)
+	s.Require().Nil(err)
+	s.NotNil(registeredModel)
+}
+
+func (s *RenameRegisteredModelTestSuite) Test_Error() {
+	for _, name := range []string{"model1", "model2"} {
+		_, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+			Name:        name,
+			NamespaceID: s.DefaultNamespace.ID,
+		})
+		s.Require().Nil(err)
+	}
+
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.RenameRegisteredModelRequest
+	}{
+		{
+			name:    "EmptyName",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: request.RenameRegisteredModelRequest{NewName: "renamed"},
+		},
+		{
+			name:    "EmptyNewName",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'new_name'"),
+			request: request.RenameRegisteredModelRequest{Name: "model1"},
+		},
+		{
+			name:    "NotFound",
+			error:   api.NewResourceDoesNotExistError("Registered Model with name=not-found not found"),
+			request: request.RenameRegisteredModelRequest{Name: "not-found", NewName: "renamed"},
+		},
+		{
+			name:    "NewNameAlreadyExists",
+			error:   api.NewResourceAlreadyExistsError("Registered Model (name=model2) already exists."),
+			request: request.RenameRegisteredModelRequest{Name: "model1", NewName: "model2"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsRenameRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchModelVersionsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchModelVersionsTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchModelVersionsTestSuite))
+}
+
+func (s *SearchModelVersionsTestSuite) Test_Ok() {
+	for _, name := range []string{"model-a", "model-b"} {
+		registeredModel, err := s.RegisteredModelFixtures.CreateRegisteredModel(
+			context.Background(), &models.RegisteredModel{
+				Name:        name,
+				NamespaceID: s.DefaultNamespace.ID,
+			},
+		)
+		s.Require().Nil(err)
+		for _, runID := range []string{"run-1", "run-2"} {
+			_, err := s.RegisteredModelFixtures.CreateModelVersion(context.Background(), &models.ModelVersion{
+				Source:            "s3://bucket/" + name,
+				RunID:             runID,
+				Status:            models.ModelVersionStatusReady,
+				CurrentStage:      models.ModelVersionStageNone,
+				RegisteredModelID: registeredModel.ID,
+				Tags:              []models.ModelVersionTag{{Key: "run", Value: runID}},
+			})
+			s.Require().Nil(err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		request  request.SearchModelVersionsRequest
+		expected []string
+	}{
+		{
+			name:     "DefaultOrder",
+			request:  request.SearchModelVersionsRequest{},
+			expected: []string{"model-a/2", "model-a/1", "model-b/2", "model-b/1"},
+		},
+		{
+			name:     "FilterByName",
+			request:  request.SearchModelVersionsRequest{Filter: `name = "model-b"`},
+			expected: []string{"model-b/2", "model-b/1"},
+		},
+		{
+			name:     "FilterByRunID",
+			request:  request.SearchModelVersionsRequest{Filter: `run_id IN ('run-1')`},
+			expected: []string{"model-a/1", "model-b/1"},
+		},
+		{
+			name:     "FilterByVersionNumber",
+			request:  request.SearchModelVersionsRequest{Filter: `version_number > 1 AND name LIKE "%-a"`},
+			expected: []string{"model-a/2"},
+		},
+		{
+			name:     "FilterByNameOrRunID",
+			request:  request.SearchModelVersionsRequest{Filter: `name = "model-a" OR run_id = "run-2"`},
+			expected: []string{"model-a/2", "model-a/1", "model-b/2"},
+		},
+		{
+			name:     "FilterByTag",
+			request:  request.SearchModelVersionsRequest{Filter: `tags.run = "run-2"`},
+			expected: []string{"model-a/2", "model-b/2"},
+		},
+		{
+			name: "OrderByVersionNumber",
+			request: request.SearchModelVersionsRequest{
+				OrderBy: []string{"version_number ASC"},
+			},
+			expected: []string{"model-a/1", "model-b/1", "model-a/2", "model-b/2"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.SearchModelVersionsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsSearchRoute,
+				),
+			)
+			actual := make([]string, len(resp.ModelVersions))
+			for n, modelVersion := range resp.ModelVersions {
+				actual[n] = modelVersion.Name + "/" + modelVersion.Version
+			}
+			s.Equal(tt.expected, actual)
+		})
+	}
+
+	// check pagination, the next page starts right after the last version of the previous one.
+	var actual []string
+	pageToken := ""
+	for page := 0; page < 2; page++ {
+		resp := response.SearchModelVersionsResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithQuery(
+				request.SearchModelVersionsRequest{MaxResults: 3, PageToken: pageToken},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsSearchRoute,
+			),
+		)
+		for _, modelVersion := range resp.ModelVersions {
+			actual = append(actual, modelVersion.Name+"/"+modelVersion.Version)
+		}
+		pageToken = resp.NextPageToken
+	}
+	s.Empty(pageToken)
+	s.Equal([]string{"model-a/2", "model-a/1", "model-b/2", "model-b/1"}, actual)
+}
+
+func (s *SearchModelVersionsTestSuite) Test_Error() {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.SearchModelVersionsRequest
+	}{
+		{
+			name: "InvalidMaxResults",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'max_results' supplied. It must be at most 200000, but got value 200001",
+			),
+			request: request.SearchModelVersionsRequest{MaxResults: 200001},
+		},
+		{
+			name: "InvalidAttribute",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter 'unknown = \"value\"': invalid attribute 'unknown'. " +
+					"Valid values are ['name', 'run_id', 'source_path', 'version_number'] at position 1",
+			),
+			request: request.SearchModelVersionsRequest{Filter: `unknown = "value"`},
+		},
+		{
+			name: "InvalidRunIDOperator",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter 'run_id LIKE \"run-1\"': invalid run_id comparison operator 'LIKE' at position 1",
+			),
+			request: request.SearchModelVersionsRequest{Filter: `run_id LIKE "run-1"`},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsSearchRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchRegisteredModelsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchRegisteredModelsTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchRegisteredModelsTestSuite))
+}
+
+func (s *SearchRegisteredModelsTestSuite) Test_Ok() {
+	// 1. prepare database with test data.
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "custom",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	for i, name := range []string{"model-b", "model-a", "Other"} {
+		_, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+			Name:            name,
+			NamespaceID:     s.DefaultNamespace.ID,
+			LastUpdatedTime: sql.NullInt64{Int64: int64(i), Valid: true},
+			Tags:            []models.RegisteredModelTag{{Key: "team", Value: name}},
+		})
+		s.Require().Nil(err)
+	}
+	_, err = s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model-c",
+		NamespaceID: namespace.ID,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name     string
+		request  map[any]any
+		expected []string
+	}{
+		{
+			name:     "SearchAll",
+			request:  map[any]any{},
+			expected: []string{"Other", "model-a", "model-b"},
+		},
+		{
+			name:     "SearchByNameLike",
+			request:  map[any]any{"filter": "name LIKE 'model-%'"},
+			expected: []string{"model-a", "model-b"},
+		},
+		{
+			name:     "SearchByNameILike",
+			request:  map[any]any{"filter": "name ILIKE 'other'"},
+			expected: []string{"Other"},
+		},
+		{
+			name:     "SearchByTag",
+			request:  map[any]any{"filter": "tags.team = 'model-b'"},
+			expected: []string{"model-b"},
+		},
+		{
+			name:     "SearchByNameOrTag",
+			request:  map[any]any{"filter": "name = 'Other' OR tags.team IN ('model-a')"},
+			expected: []string{"Other", "model-a"},
+		},
+		{
+			name:     "OrderByTimestamp",
+			request:  map[any]any{"order_by": "last_updated_timestamp DESC"},
+			expected: []string{"Other", "model-a", "model-b"},
+		},
+		{
+			name:     "OrderByNameDesc",
+			request:  map[any]any{"order_by": "name DESC"},
+			expected: []string{"model-b", "model-a", "Other"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.SearchRegisteredModelsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsSearchRoute,
+				),
+			)
+			names := make([]string, len(resp.RegisteredModels))
+			for i, registeredModel := range resp.RegisteredModels {
+				names[i] = registeredModel.Name
+			}
+			s.Equal(tt.expected, names)
+		})
+	}
+
+	// check pagination.
+	resp := response.SearchRegisteredModelsResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			map[any]any{"max_results": 2},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsSearchRoute,
+		),
+	)
+	s.Len(resp.RegisteredModels, 2)
+	s.NotEmpty(resp.NextPageToken)
+
+	pageToken := resp.NextPageToken
+	resp = response.SearchRegisteredModelsResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			map[any]any{"max_results": 2, "page_token": pageToken},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsSearchRoute,
+		),
+	)
+	s.Len(resp.RegisteredModels, 1)
+	s.Empty(resp.NextPageToken)
+}
+
+func (s *SearchRegisteredModelsTestSuite) Test_Error() {
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.SearchRegisteredModelsRequest
+	}{
+		{
+			name: "InvalidMaxResults",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'max_results' supplied. It must be at most 1000, but got value 1001",
+			),
+			request: request.SearchRegisteredModelsRequest{MaxResults: 1001},
+		},
+		{
+			name: "MalformedFilter",
+			error: api.NewInvalidParameterValueError(
+				"malformed filter 'name': unexpected end of filter, expected comparison operator at position 5",
+			),
+			request: request.SearchRegisteredModelsRequest{Filter: "name"},
+		},
+		{
+			name: "InvalidAttribute",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter 'source = 'x'': invalid attribute 'source'. Valid values are ['name'] at position 1",
+			),
+			request: request.SearchRegisteredModelsRequest{Filter: "source = 'x'"},
+		},
+		{
+			name: "InvalidOrderBy",
+			error: api.NewInvalidParameterValueError(
+				"invalid attribute 'version'. Valid values are ['name', 'timestamp', 'last_updated_timestamp', " +
+					"'creation_timestamp']",
+			),
+			request: request.SearchRegisteredModelsRequest{OrderBy: []string{"version"}},
+		},
+		{
+			name: "PageTokenDoesNotMatchOrderBy",
+			error: api.NewInvalidParameterValueError(
+				"invalid page_token 'eyJrZXlzIjpbIm1vZGVsLWEiXX0K': token doesn't match order_by clause",
+			),
+			request: request.SearchRegisteredModelsRequest{
+				PageToken: "eyJrZXlzIjpbIm1vZGVsLWEiXX0K",
+				OrderBy:   []string{"creation_timestamp"},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RegisteredModelsRoutePrefix, mlflow.RegisteredModelsSearchRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type TransitionModelVersionStageTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestTransitionModelVersionStageTestSuite(t *testing.T) {
+	suite.Run(t, new(TransitionModelVersionStageTestSuite))
+}
+
+func (s *TransitionModelVersionStageTestSuite) Test_Ok() {
+	registeredModel, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	for _, stage := range []models.ModelVersionStage{
+		models.ModelVersionStageStaging, models.ModelVersionStageNone,
+	} {
+		_, err := s.RegisteredModelFixtures.CreateModelVersion(context.Background(), &models.ModelVersion{
+			Source:            "s3://bucket/model",
+			Status:            models.ModelVersionStatusReady,
+			CurrentStage:      stage,
+			RegisteredModelID: registeredModel.ID,
+		})
+		s.Require().Nil(err)
+	}
+
+	// 1. move the second version to staging and archive the first one.
+	resp := response.ModelVersionResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.TransitionModelVersionStageRequest{
+				Name:                    "model",
+				Version:                 "2",
+				Stage:                   "staging",
+				ArchiveExistingVersions: true,
+				Comment:                 "release",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsTransitionStageRoute,
+		),
+	)
+	s.Equal("2", resp.ModelVersion.Version)
+	s.Equal(string(models.ModelVersionStageStaging), resp.ModelVersion.CurrentStage)
+
+	modelVersion, err := s.RegisteredModelFixtures.GetModelVersion(context.Background(), registeredModel.ID, 1)
+	s.Require().Nil(err)
+	s.Equal(models.ModelVersionStageArchived, modelVersion.CurrentStage)
+
+	// 2. check that both changes were recorded in the history.
+	historyResp := response.GetModelVersionTransitionHistoryResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetModelVersionTransitionHistoryRequest{Name: "model"},
+		).WithResponse(
+			&historyResp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsTransitionHistoryRoute,
+		),
+	)
+	s.Require().Len(historyResp.Transitions, 2)
+	s.Equal("2", historyResp.Transitions[0].Version)
+	s.Equal(string(models.ModelVersionStageNone), historyResp.Transitions[0].FromStage)
+	s.Equal(string(models.ModelVersionStageStaging), historyResp.Transitions[0].ToStage)
+	s.Equal("release", historyResp.Transitions[0].Comment)
+	s.NotZero(historyResp.Transitions[0].Timestamp)
+	s.Equal("1", historyResp.Transitions[1].Version)
+	s.Equal(string(models.ModelVersionStageStaging), historyResp.Transitions[1].FromStage)
+	s.Equal(string(models.ModelVersionStageArchived), historyResp.Transitions[1].ToStage)
+
+	// 3. check that the history could be filtered by version.
+	historyResp = response.GetModelVersionTransitionHistoryResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetModelVersionTransitionHistoryRequest{Name: "model", Version: "1"},
+		).WithResponse(
+			&historyResp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsTransitionHistoryRoute,
+		),
+	)
+	s.Require().Len(historyResp.Transitions, 1)
+	s.Equal("1", historyResp.Transitions[0].Version)
+}
+
+func (s *TransitionModelVersionStageTestSuite) Test_Error() {
+	registeredModel, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.RegisteredModelFixtures.CreateModelVersion(context.Background(), &models.ModelVersion{
+		Source:            "s3://bucket/model",
+		Status:            models.ModelVersionStatusReady,
+		CurrentStage:      models.ModelVersionStageStaging,
+		RegisteredModelID: registeredModel.ID,
+	})
+	s.Require().Nil(err)
+
+	testData := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.TransitionModelVersionStageRequest
+	}{
+		{
+			name:    "EmptyName",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: request.TransitionModelVersionStageRequest{},
+		},
+		{
+			name:    "EmptyStage",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'stage'"),
+			request: request.TransitionModelVersionStageRequest{Name: "model", Version: "1"},
+		},
+		{
+			name: "InvalidStage",
+			error: api.NewInvalidParameterValueError(
+				"Invalid Model Version stage: unknown. Value must be one of None, Staging, Production, Archived.",
+			),
+			request: request.TransitionModelVersionStageRequest{Name: "model", Version: "1", Stage: "unknown"},
+		},
+		{
+			name:    "NotFound",
+			error:   api.NewResourceDoesNotExistError("Registered Model with name=not-found not found"),
+			request: request.TransitionModelVersionStageRequest{Name: "not-found", Version: "1", Stage: "Staging"},
+		},
+		{
+			name: "DirectProductionTransition",
+			error: api.NewPermissionDeniedError(
+				"Model Version (name=model, version=1) can be moved to stage Production only by an approved " +
+					"transition request",
+			),
+			request: request.TransitionModelVersionStageRequest{Name: "model", Version: "1", Stage: "production"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsTransitionStageRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+
+	// the version has not been moved by the refused transition.
+	modelVersion, err := s.RegisteredModelFixtures.GetModelVersion(context.Background(), registeredModel.ID, 1)
+	s.Require().Nil(err)
+	s.Equal(models.ModelVersionStageStaging, modelVersion.CurrentStage)
+}
+
+type TransitionModelVersionStageToProductionTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestTransitionModelVersionStageToProductionTestSuite(t *testing.T) {
+	suite.Run(t, &TransitionModelVersionStageToProductionTestSuite{
+		helpers.BaseTestSuite{
+			AllowDirectProductionTransition: true,
+		},
+	})
+}
+
+func (s *TransitionModelVersionStageToProductionTestSuite) Test_Ok() {
+	registeredModel, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.RegisteredModelFixtures.CreateModelVersion(context.Background(), &models.ModelVersion{
+		Source:            "s3://bucket/model",
+		Status:            models.ModelVersionStatusReady,
+		CurrentStage:      models.ModelVersionStageStaging,
+		RegisteredModelID: registeredModel.ID,
+	})
+	s.Require().Nil(err)
+
+	// direct transitions to production are allowed by configuration.
+	resp := response.ModelVersionResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.TransitionModelVersionStageRequest{Name: "model", Version: "1", Stage: "Production"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.ModelVersionsRoutePrefix, mlflow.ModelVersionsTransitionStageRoute,
+		),
+	)
+	s.Equal(string(models.ModelVersionStageProduction), resp.ModelVersion.CurrentStage)
+}
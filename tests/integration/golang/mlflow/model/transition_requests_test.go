@@ -0,0 +1,425 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type TransitionRequestsTestSuite struct {
+	helpers.BaseTestSuite
+	registeredModel *models.RegisteredModel
+	modelVersion    *models.ModelVersion
+}
+
+func TestTransitionRequestsTestSuite(t *testing.T) {
+	suite.Run(t, new(TransitionRequestsTestSuite))
+}
+
+func (s *TransitionRequestsTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	registeredModel, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+	s.registeredModel = registeredModel
+
+	modelVersion, err := s.RegisteredModelFixtures.CreateModelVersion(context.Background(), &models.ModelVersion{
+		Source:            "s3://bucket/model",
+		Status:            models.ModelVersionStatusReady,
+		CurrentStage:      models.ModelVersionStageStaging,
+		RegisteredModelID: registeredModel.ID,
+	})
+	s.Require().Nil(err)
+	s.modelVersion = modelVersion
+}
+
+func (s *TransitionRequestsTestSuite) Test_Approve() {
+	// 1. create transition request.
+	resp := response.TransitionRequestResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateTransitionRequestRequest{
+				Name: "model", Version: "1", Stage: "Production", Comment: "please promote",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.TransitionRequestsRoutePrefix, mlflow.TransitionRequestsCreateRoute,
+		),
+	)
+	s.Equal("model", resp.Request.Name)
+	s.Equal("1", resp.Request.Version)
+	s.Equal(string(models.ModelVersionStageProduction), resp.Request.ToStage)
+	s.Equal(string(models.ModelVersionTransitionRequestStatusPending), resp.Request.Status)
+	s.Equal("please promote", resp.Request.Comment)
+
+	// 2. the model version stays in its stage until the request is approved.
+	modelVersion, err := s.RegisteredModelFixtures.GetModelVersion(context.Background(), s.registeredModel.ID, 1)
+	s.Require().Nil(err)
+	s.Equal(models.ModelVersionStageStaging, modelVersion.CurrentStage)
+
+	// 3. approve transition request.
+	resp = response.TransitionRequestResponse{}
+	s.Require().Nil(
+		s.AdminClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.ApproveTransitionRequestRequest{
+				Name: "model", Version: "1", Stage: "Production", Comment: "approved",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/transition-requests/approve",
+		),
+	)
+	s.Equal(string(models.ModelVersionTransitionRequestStatusApproved), resp.Request.Status)
+	s.Equal("approved", resp.Request.ReviewComment)
+
+	modelVersion, err = s.RegisteredModelFixtures.GetModelVersion(context.Background(), s.registeredModel.ID, 1)
+	s.Require().Nil(err)
+	s.Equal(models.ModelVersionStageProduction, modelVersion.CurrentStage)
+
+	transitions, err := s.RegisteredModelFixtures.GetTransitions(context.Background(), s.registeredModel.ID)
+	s.Require().Nil(err)
+	s.Require().Len(transitions, 1)
+	s.Equal(models.ModelVersionStageStaging, transitions[0].FromStage)
+	s.Equal(models.ModelVersionStageProduction, transitions[0].ToStage)
+	s.Require().NotNil(transitions[0].TransitionRequestID)
+	s.Equal(resp.Request.ID, fmt.Sprint(*transitions[0].TransitionRequestID))
+
+	// 4. list transition requests.
+	listResp := response.ListTransitionRequestsResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.ListTransitionRequestsRequest{Name: "model", Version: "1"},
+		).WithResponse(
+			&listResp,
+		).DoRequest(
+			"%s%s", mlflow.TransitionRequestsRoutePrefix, mlflow.TransitionRequestsListRoute,
+		),
+	)
+	s.Equal([]*response.TransitionRequestPartialResponse{resp.Request}, listResp.Requests)
+}
+
+func (s *TransitionRequestsTestSuite) Test_Reject() {
+	_, err := s.RegisteredModelFixtures.CreateTransitionRequest(
+		context.Background(), &models.ModelVersionTransitionRequest{
+			ToStage:        models.ModelVersionStageProduction,
+			Status:         models.ModelVersionTransitionRequestStatusPending,
+			CreationTime:   sql.NullInt64{Int64: 1, Valid: true},
+			ModelVersionID: s.modelVersion.ID,
+		},
+	)
+	s.Require().Nil(err)
+
+	resp := response.TransitionRequestResponse{}
+	s.Require().Nil(
+		s.AdminClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.RejectTransitionRequestRequest{
+				Name: "model", Version: "1", Stage: "Production", Comment: "not yet",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/transition-requests/reject",
+		),
+	)
+	s.Equal(string(models.ModelVersionTransitionRequestStatusRejected), resp.Request.Status)
+	s.Equal("not yet", resp.Request.ReviewComment)
+
+	modelVersion, err := s.RegisteredModelFixtures.GetModelVersion(context.Background(), s.registeredModel.ID, 1)
+	s.Require().Nil(err)
+	s.Equal(models.ModelVersionStageStaging, modelVersion.CurrentStage)
+	transitions, err := s.RegisteredModelFixtures.GetTransitions(context.Background(), s.registeredModel.ID)
+	s.Require().Nil(err)
+	s.Empty(transitions)
+}
+
+func (s *TransitionRequestsTestSuite) Test_Error() {
+	_, err := s.RegisteredModelFixtures.CreateTransitionRequest(
+		context.Background(), &models.ModelVersionTransitionRequest{
+			ToStage:        models.ModelVersionStageProduction,
+			Status:         models.ModelVersionTransitionRequestStatusPending,
+			ModelVersionID: s.modelVersion.ID,
+		},
+	)
+	s.Require().Nil(err)
+
+	testData := []struct {
+		name    string
+		client  func() *helpers.HttpClient
+		route   string
+		error   *api.ErrorResponse
+		request any
+	}{
+		{
+			name:   "CreateWithCurrentStage",
+			client: s.MlflowClient,
+			route:  mlflow.TransitionRequestsRoutePrefix + mlflow.TransitionRequestsCreateRoute,
+			error: api.NewInvalidParameterValueError(
+				"Model Version (name=model, version=1) is already in stage Staging",
+			),
+			request: request.CreateTransitionRequestRequest{Name: "model", Version: "1", Stage: "Staging"},
+		},
+		{
+			name:   "CreateAlreadyPending",
+			client: s.MlflowClient,
+			route:  mlflow.TransitionRequestsRoutePrefix + mlflow.TransitionRequestsCreateRoute,
+			error: api.NewResourceAlreadyExistsError(
+				"Pending transition request to stage Production already exists for Model Version (name=model, version=1)",
+			),
+			request: request.CreateTransitionRequestRequest{Name: "model", Version: "1", Stage: "Production"},
+		},
+		{
+			name:   "ApproveNotPending",
+			client: s.AdminClient,
+			route:  "/transition-requests/approve",
+			error: api.NewResourceDoesNotExistError(
+				"Pending transition request to stage Archived not found for Model Version (name=model, version=1)",
+			),
+			request: request.ApproveTransitionRequestRequest{Name: "model", Version: "1", Stage: "Archived"},
+		},
+		{
+			name:   "ApproveOnMlflowAPI",
+			client: s.MlflowClient,
+			route:  mlflow.TransitionRequestsRoutePrefix + "/approve",
+			error:  api.NewEndpointNotFound("Not found"),
+			request: request.ApproveTransitionRequestRequest{
+				Name: "model", Version: "1", Stage: "Production",
+			},
+		},
+		{
+			name:    "RejectEmptyStage",
+			client:  s.AdminClient,
+			route:   "/transition-requests/reject",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'stage'"),
+			request: request.RejectTransitionRequestRequest{Name: "model", Version: "1"},
+		},
+	}
+
+	for _, tt := range testData {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				tt.client().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					tt.route,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
+
+type TransitionRequestsWithAuthTestSuite struct {
+	helpers.BaseTestSuite
+	modelVersion *models.ModelVersion
+}
+
+func TestTransitionRequestsWithAuthTestSuite(t *testing.T) {
+	suite.Run(t, &TransitionRequestsWithAuthTestSuite{
+		BaseTestSuite: helpers.BaseTestSuite{
+			AuthUsername: "admin",
+			AuthPassword: "password",
+			AuthUsers:    []string{"reviewer:secret"},
+		},
+	})
+}
+
+func (s *TransitionRequestsWithAuthTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+	s.modelVersion = createModelVersion(&s.BaseTestSuite)
+}
+
+func (s *TransitionRequestsWithAuthTestSuite) Test_Ok() {
+	// 1. create transition request on behalf of the authenticated user.
+	resp := response.TransitionRequestResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateTransitionRequestRequest{Name: "model", Version: "1", Stage: "Production"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.TransitionRequestsRoutePrefix, mlflow.TransitionRequestsCreateRoute,
+		),
+	)
+	s.Equal("admin", resp.Request.UserID)
+
+	// 2. the request could be approved by another user.
+	resp = response.TransitionRequestResponse{}
+	s.Require().Nil(
+		s.AdminClient().WithBasicAuth(
+			"reviewer", "secret",
+		).WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.ApproveTransitionRequestRequest{Name: "model", Version: "1", Stage: "Production"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/transition-requests/approve",
+		),
+	)
+	s.Equal(string(models.ModelVersionTransitionRequestStatusApproved), resp.Request.Status)
+	s.Equal("admin", resp.Request.UserID)
+	s.Equal("reviewer", resp.Request.ReviewerID)
+
+	// 3. the model version has been moved to the requested stage.
+	modelVersion, err := s.RegisteredModelFixtures.GetModelVersion(
+		context.Background(), s.modelVersion.RegisteredModelID, 1,
+	)
+	s.Require().Nil(err)
+	s.Equal(models.ModelVersionStageProduction, modelVersion.CurrentStage)
+}
+
+func (s *TransitionRequestsWithAuthTestSuite) Test_Error() {
+	// 1. create transition request on behalf of the authenticated user.
+	resp := response.TransitionRequestResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateTransitionRequestRequest{Name: "model", Version: "1", Stage: "Production"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.TransitionRequestsRoutePrefix, mlflow.TransitionRequestsCreateRoute,
+		),
+	)
+	s.Equal("admin", resp.Request.UserID)
+
+	// 2. the same user is not allowed to approve own request.
+	errResp := api.ErrorResponse{}
+	client := s.AdminClient()
+	s.Require().Nil(
+		client.WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.ApproveTransitionRequestRequest{Name: "model", Version: "1", Stage: "Production"},
+		).WithResponse(
+			&errResp,
+		).DoRequest(
+			"/transition-requests/approve",
+		),
+	)
+	s.Equal(http.StatusForbidden, client.GetStatusCode())
+	s.Equal(
+		api.NewPermissionDeniedError(
+			"Transition request of Model Version (name=model, version=1) can not be approved by its creator 'admin'",
+		).Error(),
+		errResp.Error(),
+	)
+
+	// 3. the request is still pending and the model version stays in its stage.
+	modelVersion, err := s.RegisteredModelFixtures.GetModelVersion(
+		context.Background(), s.modelVersion.RegisteredModelID, 1,
+	)
+	s.Require().Nil(err)
+	s.Equal(models.ModelVersionStageStaging, modelVersion.CurrentStage)
+	transitions, err := s.RegisteredModelFixtures.GetTransitions(context.Background(), s.modelVersion.RegisteredModelID)
+	s.Require().Nil(err)
+	s.Empty(transitions)
+}
+
+type TransitionRequestsWithSingleUserTestSuite struct {
+	helpers.BaseTestSuite
+	modelVersion *models.ModelVersion
+}
+
+func TestTransitionRequestsWithSingleUserTestSuite(t *testing.T) {
+	suite.Run(t, &TransitionRequestsWithSingleUserTestSuite{
+		BaseTestSuite: helpers.BaseTestSuite{
+			AuthUsername: "admin",
+			AuthPassword: "password",
+		},
+	})
+}
+
+func (s *TransitionRequestsWithSingleUserTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+	s.modelVersion = createModelVersion(&s.BaseTestSuite)
+}
+
+func (s *TransitionRequestsWithSingleUserTestSuite) Test_Ok() {
+	// 1. create transition request on behalf of the only configured user.
+	resp := response.TransitionRequestResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CreateTransitionRequestRequest{Name: "model", Version: "1", Stage: "Production"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.TransitionRequestsRoutePrefix, mlflow.TransitionRequestsCreateRoute,
+		),
+	)
+	s.Equal("admin", resp.Request.UserID)
+
+	// 2. there is nobody else to review the request, so the same user approves it.
+	resp = response.TransitionRequestResponse{}
+	s.Require().Nil(
+		s.AdminClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.ApproveTransitionRequestRequest{Name: "model", Version: "1", Stage: "Production"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"/transition-requests/approve",
+		),
+	)
+	s.Equal(string(models.ModelVersionTransitionRequestStatusApproved), resp.Request.Status)
+	s.Equal("admin", resp.Request.ReviewerID)
+
+	// 3. the model version has been moved to the requested stage.
+	modelVersion, err := s.RegisteredModelFixtures.GetModelVersion(
+		context.Background(), s.modelVersion.RegisteredModelID, 1,
+	)
+	s.Require().Nil(err)
+	s.Equal(models.ModelVersionStageProduction, modelVersion.CurrentStage)
+}
+
+// createModelVersion creates the model version in `Staging`, which transition requests are made for.
+func createModelVersion(s *helpers.BaseTestSuite) *models.ModelVersion {
+	registeredModel, err := s.RegisteredModelFixtures.CreateRegisteredModel(context.Background(), &models.RegisteredModel{
+		Name:        "model",
+		NamespaceID: s.DefaultNamespace.ID,
+	})
+	s.Require().Nil(err)
+
+	modelVersion, err := s.RegisteredModelFixtures.CreateModelVersion(context.Background(), &models.ModelVersion{
+		Source:            "s3://bucket/model",
+		Status:            models.ModelVersionStatusReady,
+		CurrentStage:      models.ModelVersionStageStaging,
+		RegisteredModelID: registeredModel.ID,
+	})
+	s.Require().Nil(err)
+	return modelVersion
+}
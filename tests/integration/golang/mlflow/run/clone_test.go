@@ -0,0 +1,248 @@
+package run
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/datatypes"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type CloneRunTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestCloneRunTestSuite(t *testing.T) {
+	suite.Run(t, new(CloneRunTestSuite))
+}
+
+func (s *CloneRunTestSuite) Test_Ok() {
+	// create source run started between two other runs.
+	sourceArtifactDir := s.T().TempDir()
+	var source *models.Run
+	for _, startTime := range []int64{100, 200, 300} {
+		runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             runID,
+			Name:           fmt.Sprintf("TestRun_%d", startTime),
+			Status:         models.StatusFinished,
+			StartTime:      sql.NullInt64{Int64: startTime, Valid: true},
+			EndTime:        sql.NullInt64{Int64: startTime + 50, Valid: true},
+			SourceType:     "JOB",
+			ArtifactURI:    filepath.Join(sourceArtifactDir, runID, "artifacts"),
+			ExperimentID:   *s.DefaultExperiment.ID,
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		if startTime == 200 {
+			source = run
+		}
+	}
+	s.Require().Nil(os.MkdirAll(source.ArtifactURI, fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(source.ArtifactURI, "artifact.txt"), []byte("content"), fs.ModePerm))
+
+	_, err := s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+		Key:   "param1",
+		Value: "value1",
+		RunID: source.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.TagFixtures.CreateTag(context.Background(), &models.Tag{
+		Key:   "tag1",
+		Value: "value1",
+		RunID: source.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.TagFixtures.CreateTag(context.Background(), &models.Tag{
+		Key:   "mlflow.runName",
+		Value: source.Name,
+		RunID: source.ID,
+	})
+	s.Require().Nil(err)
+	for _, metricContext := range []datatypes.JSON{nil, datatypes.JSON(`{"subset":"train"}`)} {
+		_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+			Key:       "metric1",
+			Value:     1.1,
+			Timestamp: 1234567890,
+			RunID:     source.ID,
+			Step:      1,
+			Iter:      1,
+			Context:   models.Context{Json: metricContext},
+		})
+		s.Require().Nil(err)
+		_, err = s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+			Key:       "metric1",
+			Value:     1.1,
+			Timestamp: 1234567890,
+			RunID:     source.ID,
+			Step:      1,
+			LastIter:  1,
+			Context:   models.Context{Json: metricContext},
+		})
+		s.Require().Nil(err)
+	}
+
+	// create target namespace and experiment.
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "target",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	targetArtifactDir := s.T().TempDir()
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             uuid.New().String(),
+		NamespaceID:      namespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: targetArtifactDir,
+	})
+	s.Require().Nil(err)
+
+	// clone the run together with its artifacts.
+	resp := response.CloneRunResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.CloneRunRequest{
+				RunID:         source.ID,
+				ExperimentID:  fmt.Sprintf("%d", *experiment.ID),
+				Namespace:     "target",
+				Name:          "baseline",
+				CopyArtifacts: true,
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsCloneRoute,
+		),
+	)
+	s.NotEqual(source.ID, resp.Run.Info.ID)
+	s.Equal("baseline", resp.Run.Info.Name)
+	s.Equal(fmt.Sprintf("%d", *experiment.ID), resp.Run.Info.ExperimentID)
+	s.Equal(string(models.StatusFinished), resp.Run.Info.Status)
+	s.Equal(int64(200), resp.Run.Info.StartTime)
+	s.Equal(int64(250), resp.Run.Info.EndTime)
+	s.Equal(filepath.Join(targetArtifactDir, resp.Run.Info.ID, "artifacts"), resp.Run.Info.ArtifactURI)
+	s.Equal([]response.RunParamPartialResponse{{Key: "param1", Value: "value1"}}, resp.Run.Data.Params)
+	s.ElementsMatch([]response.RunTagPartialResponse{
+		{Key: "tag1", Value: "value1"},
+		{Key: "mlflow.runName", Value: "baseline"},
+	}, resp.Run.Data.Tags)
+	s.Len(resp.Run.Data.Metrics, 2)
+
+	// check that metrics have been copied with their contexts and the source run is intact.
+	sourceMetrics, err := s.MetricFixtures.GetMetricsByRunID(context.Background(), source.ID)
+	s.Require().Nil(err)
+	cloneMetrics, err := s.MetricFixtures.GetMetricsByRunID(context.Background(), resp.Run.Info.ID)
+	s.Require().Nil(err)
+	s.Require().Len(cloneMetrics, 2)
+	s.ElementsMatch(
+		[]uint{sourceMetrics[0].ContextID, sourceMetrics[1].ContextID},
+		[]uint{cloneMetrics[0].ContextID, cloneMetrics[1].ContextID},
+	)
+	source, err = s.RunFixtures.GetRun(context.Background(), source.ID)
+	s.Require().Nil(err)
+	s.Equal("TestRun_200", source.Name)
+	s.Len(source.Tags, 2)
+
+	// check that artifacts have been copied.
+	content, err := os.ReadFile(filepath.Join(resp.Run.Info.ArtifactURI, "artifact.txt"))
+	s.Require().Nil(err)
+	s.Equal("content", string(content))
+	_, err = os.Stat(filepath.Join(source.ArtifactURI, "artifact.txt"))
+	s.Require().Nil(err)
+
+	// check that `row_num` is still ordered by start time.
+	runs, err := s.RunFixtures.GetRuns(context.Background(), *s.DefaultExperiment.ID)
+	s.Require().Nil(err)
+	targetRuns, err := s.RunFixtures.GetRuns(context.Background(), *experiment.ID)
+	s.Require().Nil(err)
+	runs = append(runs, targetRuns...)
+	s.Require().Len(runs, 4)
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].RowNum < runs[j].RowNum
+	})
+	for i, run := range runs {
+		s.Equal(models.RowNum(i), run.RowNum)
+		if i > 0 {
+			s.LessOrEqual(runs[i-1].StartTime.Int64, run.StartTime.Int64)
+		}
+	}
+	s.Equal(resp.Run.Info.ID, runs[2].ID)
+}
+
+func (s *CloneRunTestSuite) Test_Error() {
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		NamespaceID:    s.DefaultNamespace.ID,
+		LifecycleStage: models.LifecycleStageDeleted,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.CloneRunRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			request: request.CloneRunRequest{},
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+		},
+		{
+			name: "NotFoundRun",
+			request: request.CloneRunRequest{
+				RunID:        "id",
+				ExperimentID: fmt.Sprintf("%d", *experiment.ID),
+			},
+			error: api.NewResourceDoesNotExistError("unable to find run 'id'"),
+		},
+		{
+			name: "DeletedExperiment",
+			request: request.CloneRunRequest{
+				RunID:        run.ID,
+				ExperimentID: fmt.Sprintf("%d", *experiment.ID),
+			},
+			error: api.NewInvalidParameterValueError(
+				"experiment '%d' is not active, runs can't be moved or cloned into it", *experiment.ID,
+			),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsCloneRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
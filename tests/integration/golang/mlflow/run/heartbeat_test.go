@@ -0,0 +1,135 @@
+package run
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type HeartbeatTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestHeartbeatTestSuite(t *testing.T) {
+	suite.Run(t, &HeartbeatTestSuite{BaseTestSuite: helpers.BaseTestSuite{StaleRunTimeout: time.Hour}})
+}
+
+func (s *HeartbeatTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+		LastSeenTime:   sql.NullInt64{Int64: 1234567890, Valid: true},
+	})
+	s.Require().Nil(err)
+
+	startTime := time.Now().UnixMilli()
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.RunHeartbeatRequest{
+				RunID: run.ID,
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsHeartbeatRoute,
+		),
+	)
+	s.Empty(resp)
+
+	run, err = s.RunFixtures.GetRun(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.True(run.LastSeenTime.Valid)
+	s.GreaterOrEqual(run.LastSeenTime.Int64, startTime)
+	s.Equal(models.StatusRunning, run.Status)
+}
+
+func (s *HeartbeatTestSuite) Test_LogParam_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+		LastSeenTime:   sql.NullInt64{Int64: 1234567890, Valid: true},
+	})
+	s.Require().Nil(err)
+
+	startTime := time.Now().UnixMilli()
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.LogParamRequest{
+				RunID: run.ID,
+				Key:   "key1",
+				Value: "value1",
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogParameterRoute,
+		),
+	)
+	s.Empty(resp)
+
+	run, err = s.RunFixtures.GetRun(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.GreaterOrEqual(run.LastSeenTime.Int64, startTime)
+}
+
+func (s *HeartbeatTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.RunHeartbeatRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: request.RunHeartbeatRequest{},
+		},
+		{
+			name:  "NotFoundRun",
+			error: api.NewResourceDoesNotExistError("Run 'not-existing-run' not found"),
+			request: request.RunHeartbeatRequest{
+				RunID: "not-existing-run",
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsHeartbeatRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
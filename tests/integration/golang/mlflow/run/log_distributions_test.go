@@ -0,0 +1,168 @@
+package run
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type LogDistributionsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestLogDistributionsTestSuite(t *testing.T) {
+	suite.Run(t, new(LogDistributionsTestSuite))
+}
+
+func (s *LogDistributionsTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	req := request.LogDistributionsRequest{
+		RunID: run.ID,
+		Distributions: []request.DistributionPartialRequest{
+			{
+				Name:      "weights",
+				Context:   map[string]any{"layer": "fc1"},
+				Step:      1,
+				Timestamp: 1234567890,
+				BinEdges:  []float64{-1, 0, 1},
+				Counts:    []float64{3, 5},
+			},
+			{
+				Name:      "weights",
+				Context:   map[string]any{"layer": "fc1"},
+				Step:      2,
+				Timestamp: 1234567891,
+				BinEdges:  []float64{-2, 0, 2},
+				Counts:    []float64{4, 4},
+			},
+		},
+	}
+	// log the same distributions twice, second call has to replace already logged distributions.
+	for i := 0; i < 2; i++ {
+		resp := map[string]any{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				req,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogDistributionsRoute,
+			),
+		)
+		s.Empty(resp)
+		req.Distributions[1].Counts = []float64{1, 7}
+	}
+
+	distributions, err := s.DistributionFixtures.GetDistributions(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Require().Len(distributions, 2)
+	s.Equal("weights", distributions[0].Name)
+	s.JSONEq(`{"layer": "fc1"}`, string(distributions[0].Context.Json))
+	s.Equal(int64(1), distributions[0].Step)
+	s.Equal(int64(1234567890), distributions[0].Timestamp)
+	s.Equal([]float64{-1, 0, 1}, decodeFloat64s(distributions[0].BinEdges))
+	s.Equal([]float64{3, 5}, decodeFloat64s(distributions[0].Counts))
+	s.Equal(int64(2), distributions[1].Step)
+	s.Equal([]float64{-2, 0, 2}, decodeFloat64s(distributions[1].BinEdges))
+	s.Equal([]float64{1, 7}, decodeFloat64s(distributions[1].Counts))
+}
+
+func (s *LogDistributionsTestSuite) Test_Error() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageDeleted,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	distribution := request.DistributionPartialRequest{
+		Name:      "weights",
+		Timestamp: 1234567890,
+		BinEdges:  []float64{-1, 0, 1},
+		Counts:    []float64{3, 5},
+	}
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.LogDistributionsRequest
+	}{
+		{
+			name:    "MissingRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: request.LogDistributionsRequest{},
+		},
+		{
+			name: "BinEdgesMismatch",
+			error: api.NewInvalidParameterValueError(
+				"Distribution 'weights' must have exactly one bin edge more than counts",
+			),
+			request: request.LogDistributionsRequest{
+				RunID: run.ID,
+				Distributions: []request.DistributionPartialRequest{{
+					Name:      "weights",
+					Timestamp: 1234567890,
+					BinEdges:  []float64{-1, 1},
+					Counts:    []float64{3, 5},
+				}},
+			},
+		},
+		{
+			name:  "DeletedRun",
+			error: api.NewResourceDoesNotExistError("Run '%s' not found", run.ID),
+			request: request.LogDistributionsRequest{
+				RunID:         run.ID,
+				Distributions: []request.DistributionPartialRequest{distribution},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogDistributionsRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
+
+// decodeFloat64s decodes little-endian float64 values, in which bin edges and counts are kept.
+func decodeFloat64s(data []byte) []float64 {
+	values := make([]float64, len(data)/8)
+	for i := range values {
+		values[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:]))
+	}
+	return values
+}
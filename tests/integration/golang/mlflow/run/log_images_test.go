@@ -0,0 +1,171 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type LogImagesTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestLogImagesTestSuite(t *testing.T) {
+	suite.Run(t, new(LogImagesTestSuite))
+}
+
+func (s *LogImagesTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	req := request.LogImagesRequest{
+		RunID: run.ID,
+		Images: []request.ImagePartialRequest{
+			{
+				Name:      "samples",
+				Context:   map[string]any{"subset": "train"},
+				Step:      1,
+				Index:     0,
+				Timestamp: 1234567890,
+				Caption:   "cat",
+				Format:    "png",
+				Width:     32,
+				Height:    16,
+				BlobURI:   "images/samples/1-0.png",
+			},
+			{
+				Name:      "samples",
+				Step:      1,
+				Index:     1,
+				Timestamp: 1234567890,
+				Caption:   "dog",
+				Format:    "png",
+				Width:     32,
+				Height:    16,
+				BlobURI:   "images/samples/1-1.png",
+			},
+		},
+	}
+	// log the same images twice, second call has to replace already logged images.
+	for i := 0; i < 2; i++ {
+		resp := map[string]any{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				req,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogImagesRoute,
+			),
+		)
+		s.Empty(resp)
+		req.Images[1].Caption = "bird"
+	}
+
+	images, err := s.ImageFixtures.GetImages(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Require().Len(images, 2)
+	s.Equal("samples", images[0].Name)
+	s.JSONEq(`{"subset": "train"}`, string(images[0].Context.Json))
+	s.Equal(int64(1), images[0].Step)
+	s.Equal(int64(0), images[0].Index)
+	s.Equal("cat", images[0].Caption)
+	s.Equal("png", images[0].Format)
+	s.Equal(int64(32), images[0].Width)
+	s.Equal(int64(16), images[0].Height)
+	s.Equal("images/samples/1-0.png", images[0].BlobURI)
+	s.JSONEq(`{}`, string(images[1].Context.Json))
+	s.Equal(int64(1), images[1].Index)
+	s.Equal("bird", images[1].Caption)
+}
+
+func (s *LogImagesTestSuite) Test_Error() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageDeleted,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	image := request.ImagePartialRequest{
+		Name:      "samples",
+		Timestamp: 1234567890,
+		Format:    "png",
+		Width:     32,
+		Height:    16,
+		BlobURI:   "images/samples/0.png",
+	}
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.LogImagesRequest
+	}{
+		{
+			name:    "MissingRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: request.LogImagesRequest{},
+		},
+		{
+			name: "InvalidBlobURI",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'blob_uri' supplied: '../../secret.png'",
+			),
+			request: request.LogImagesRequest{
+				RunID: run.ID,
+				Images: []request.ImagePartialRequest{{
+					Name:      "samples",
+					Timestamp: 1234567890,
+					Format:    "png",
+					Width:     32,
+					Height:    16,
+					BlobURI:   "../../secret.png",
+				}},
+			},
+		},
+		{
+			name:  "DeletedRun",
+			error: api.NewResourceDoesNotExistError("Run '%s' not found", run.ID),
+			request: request.LogImagesRequest{
+				RunID:  run.ID,
+				Images: []request.ImagePartialRequest{image},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogImagesRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
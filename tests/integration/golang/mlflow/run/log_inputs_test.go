@@ -0,0 +1,192 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type LogInputsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestLogInputsTestSuite(t *testing.T) {
+	suite.Run(t, new(LogInputsTestSuite))
+}
+
+func (s *LogInputsTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	req := request.LogInputsRequest{
+		RunID: run.ID,
+		Datasets: []request.DatasetInputPartialRequest{
+			{
+				Tags: []request.InputTagPartialRequest{{Key: models.InputTagContextKey, Value: "training"}},
+				Dataset: request.DatasetPartialRequest{
+					Name:       "dataset",
+					Digest:     "3f2a1b",
+					SourceType: "local",
+					Source:     `{"uri": "file:///data/train.csv"}`,
+					Schema:     `{"mlflow_colspec": []}`,
+					Profile:    `{"num_rows": 10}`,
+				},
+			},
+		},
+	}
+	// log the same inputs twice, second call has to be no-op.
+	for i := 0; i < 2; i++ {
+		resp := map[string]any{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				req,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogInputsRoute,
+			),
+		)
+		s.Empty(resp)
+	}
+
+	// inputs are returned together with the run.
+	resp := response.GetRunResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetRunRequest{RunID: run.ID},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsGetRoute,
+		),
+	)
+	s.Equal(response.RunInputsPartialResponse{
+		DatasetInputs: []response.DatasetInputPartialResponse{
+			{
+				Tags: []response.InputTagPartialResponse{{Key: models.InputTagContextKey, Value: "training"}},
+				Dataset: response.DatasetPartialResponse{
+					Name:       "dataset",
+					Digest:     "3f2a1b",
+					SourceType: "local",
+					Source:     `{"uri": "file:///data/train.csv"}`,
+					Schema:     `{"mlflow_colspec": []}`,
+					Profile:    `{"num_rows": 10}`,
+				},
+			},
+		},
+	}, resp.Run.Inputs)
+
+	// the same dataset could be logged by another run of the experiment.
+	anotherRun, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+	req.RunID = anotherRun.ID
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			req,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogInputsRoute,
+		),
+	)
+	anotherResp := response.GetRunResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetRunRequest{RunID: anotherRun.ID},
+		).WithResponse(
+			&anotherResp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsGetRoute,
+		),
+	)
+	s.Equal(resp.Run.Inputs, anotherResp.Run.Inputs)
+}
+
+func (s *LogInputsTestSuite) Test_Error() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageDeleted,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	dataset := request.DatasetPartialRequest{
+		Name:       "dataset",
+		Digest:     "3f2a1b",
+		SourceType: "local",
+		Source:     "source",
+	}
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.LogInputsRequest
+	}{
+		{
+			name:    "MissingRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: request.LogInputsRequest{},
+		},
+		{
+			name:  "MissingDatasetDigest",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'dataset.digest'"),
+			request: request.LogInputsRequest{
+				RunID: run.ID,
+				Datasets: []request.DatasetInputPartialRequest{{
+					Dataset: request.DatasetPartialRequest{Name: "dataset"},
+				}},
+			},
+		},
+		{
+			name:  "DeletedRun",
+			error: api.NewResourceDoesNotExistError("Run '%s' not found", run.ID),
+			request: request.LogInputsRequest{
+				RunID:    run.ID,
+				Datasets: []request.DatasetInputPartialRequest{{Dataset: dataset}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogInputsRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package run
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type LogTextsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestLogTextsTestSuite(t *testing.T) {
+	suite.Run(t, new(LogTextsTestSuite))
+}
+
+func (s *LogTextsTestSuite) Test_Ok() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageActive,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	req := request.LogTextsRequest{
+		RunID: run.ID,
+		Texts: []request.TextPartialRequest{
+			{
+				Name:      "samples",
+				Context:   map[string]any{"subset": "train"},
+				Step:      1,
+				Index:     0,
+				Timestamp: 1234567890,
+				Data:      "the quick brown fox",
+			},
+			{
+				Name:      "samples",
+				Step:      1,
+				Index:     1,
+				Timestamp: 1234567890,
+				Data:      "jumps over",
+			},
+		},
+	}
+	// log the same texts twice, second call has to replace already logged texts.
+	for i := 0; i < 2; i++ {
+		resp := map[string]any{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPost,
+			).WithRequest(
+				req,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogTextsRoute,
+			),
+		)
+		s.Empty(resp)
+		req.Texts[1].Data = "the lazy dog"
+	}
+
+	texts, err := s.TextFixtures.GetTexts(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Require().Len(texts, 2)
+	s.Equal("samples", texts[0].Name)
+	s.JSONEq(`{"subset": "train"}`, string(texts[0].Context.Json))
+	s.Equal(int64(1), texts[0].Step)
+	s.Equal(int64(0), texts[0].Index)
+	s.Equal(int64(1234567890), texts[0].Timestamp)
+	s.Equal("the quick brown fox", texts[0].Data)
+	s.JSONEq(`{}`, string(texts[1].Context.Json))
+	s.Equal(int64(1), texts[1].Index)
+	s.Equal("the lazy dog", texts[1].Data)
+}
+
+func (s *LogTextsTestSuite) Test_Error() {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+		ExperimentID:   *s.DefaultExperiment.ID,
+		SourceType:     "JOB",
+		LifecycleStage: models.LifecycleStageDeleted,
+		Status:         models.StatusRunning,
+	})
+	s.Require().Nil(err)
+
+	text := request.TextPartialRequest{
+		Name:      "samples",
+		Timestamp: 1234567890,
+		Data:      "sample",
+	}
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.LogTextsRequest
+	}{
+		{
+			name:    "MissingRunID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+			request: request.LogTextsRequest{},
+		},
+		{
+			name:  "MissingName",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'name'"),
+			request: request.LogTextsRequest{
+				RunID: run.ID,
+				Texts: []request.TextPartialRequest{{
+					Timestamp: 1234567890,
+					Data:      "sample",
+				}},
+			},
+		},
+		{
+			name:  "DeletedRun",
+			error: api.NewResourceDoesNotExistError("Run '%s' not found", run.ID),
+			request: request.LogTextsRequest{
+				RunID: run.ID,
+				Texts: []request.TextPartialRequest{text},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsLogTextsRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
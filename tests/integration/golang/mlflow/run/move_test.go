@@ -0,0 +1,217 @@
+package run
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type MoveRunTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestMoveRunTestSuite(t *testing.T) {
+	suite.Run(t, new(MoveRunTestSuite))
+}
+
+func (s *MoveRunTestSuite) Test_Ok() {
+	// create source run with the artifact.
+	sourceArtifactDir := s.T().TempDir()
+	runID := strings.ReplaceAll(uuid.New().String(), "-", "")
+	runArtifactDir := filepath.Join(sourceArtifactDir, runID, "artifacts")
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             runID,
+		Name:           "TestRun",
+		Status:         models.StatusFinished,
+		StartTime:      sql.NullInt64{Int64: 1234567890, Valid: true},
+		SourceType:     "JOB",
+		ArtifactURI:    runArtifactDir,
+		ExperimentID:   *s.DefaultExperiment.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	s.Require().Nil(os.MkdirAll(filepath.Join(runArtifactDir, "model"), fs.ModePerm))
+	s.Require().Nil(os.WriteFile(filepath.Join(runArtifactDir, "model", "model.pkl"), []byte("model"), fs.ModePerm))
+
+	_, err = s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+		Key:   "param1",
+		Value: "value1",
+		RunID: run.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.MetricFixtures.CreateMetric(context.Background(), &models.Metric{
+		Key:       "metric1",
+		Value:     1.1,
+		Timestamp: 1234567890,
+		RunID:     run.ID,
+		Step:      1,
+	})
+	s.Require().Nil(err)
+
+	// create target namespace and experiment.
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "target",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	targetArtifactDir := s.T().TempDir()
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             uuid.New().String(),
+		NamespaceID:      namespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: targetArtifactDir,
+	})
+	s.Require().Nil(err)
+
+	// move the run together with its artifacts.
+	resp := response.MoveRunResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.MoveRunRequest{
+				RunID:         run.ID,
+				ExperimentID:  fmt.Sprintf("%d", *experiment.ID),
+				Namespace:     "target",
+				MoveArtifacts: true,
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsMoveRoute,
+		),
+	)
+	targetRunArtifactDir := filepath.Join(targetArtifactDir, run.ID, "artifacts")
+	s.Equal(run.ID, resp.Run.Info.ID)
+	s.Equal(fmt.Sprintf("%d", *experiment.ID), resp.Run.Info.ExperimentID)
+	s.Equal(targetRunArtifactDir, resp.Run.Info.ArtifactURI)
+	s.Equal([]response.RunParamPartialResponse{{Key: "param1", Value: "value1"}}, resp.Run.Data.Params)
+
+	// check that run has been moved in database, keeping its data and row number.
+	moved, err := s.RunFixtures.GetRun(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Equal(*experiment.ID, moved.ExperimentID)
+	s.Equal(run.RowNum, moved.RowNum)
+	s.Len(moved.Params, 1)
+	metrics, err := s.MetricFixtures.GetMetricsByRunID(context.Background(), run.ID)
+	s.Require().Nil(err)
+	s.Len(metrics, 1)
+
+	// check that artifacts have been moved.
+	content, err := os.ReadFile(filepath.Join(targetRunArtifactDir, "model", "model.pkl"))
+	s.Require().Nil(err)
+	s.Equal("model", string(content))
+	_, err = os.Stat(runArtifactDir)
+	s.True(os.IsNotExist(err))
+
+	// the run is not available in the source namespace anymore.
+	errResp := api.ErrorResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetRunRequest{RunID: run.ID},
+		).WithResponse(
+			&errResp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsGetRoute,
+		),
+	)
+	s.Equal(api.NewResourceDoesNotExistError("unable to find run '%s'", run.ID).Error(), errResp.Error())
+}
+
+func (s *MoveRunTestSuite) Test_Error() {
+	run, err := s.RunFixtures.CreateExampleRun(context.Background(), s.DefaultExperiment)
+	s.Require().Nil(err)
+
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "target",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           uuid.New().String(),
+		NamespaceID:    namespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.MoveRunRequest
+	}{
+		{
+			name:    "EmptyRunID",
+			request: request.MoveRunRequest{},
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'run_id'"),
+		},
+		{
+			name: "EmptyExperimentID",
+			request: request.MoveRunRequest{
+				RunID: run.ID,
+			},
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'"),
+		},
+		{
+			name: "NotFoundRun",
+			request: request.MoveRunRequest{
+				RunID:        "id",
+				ExperimentID: fmt.Sprintf("%d", *experiment.ID),
+			},
+			error: api.NewResourceDoesNotExistError("unable to find run 'id'"),
+		},
+		{
+			name: "NotFoundNamespace",
+			request: request.MoveRunRequest{
+				RunID:        run.ID,
+				ExperimentID: fmt.Sprintf("%d", *experiment.ID),
+				Namespace:    "unknown",
+			},
+			error: api.NewResourceDoesNotExistError("unable to find namespace 'unknown'"),
+		},
+		{
+			name: "ExperimentOfAnotherNamespace",
+			request: request.MoveRunRequest{
+				RunID:        run.ID,
+				ExperimentID: fmt.Sprintf("%d", *experiment.ID),
+			},
+			error: api.NewResourceDoesNotExistError(
+				"unable to find experiment with id '%d': error getting experiment by id: %d: record not found",
+				*experiment.ID, *experiment.ID,
+			),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsMoveRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
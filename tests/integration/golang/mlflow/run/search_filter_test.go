@@ -0,0 +1,222 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchFilterTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchFilterTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchFilterTestSuite))
+}
+
+func (s *SearchFilterTestSuite) Test_Ok() {
+	// create 3 runs:
+	// - run1 has param `model=cnn`, tag `stage=dev` and metrics `accuracy=0.5`, `loss=0.7`.
+	// - run2 has param `model=rnn`, tag `stage=prod` and metrics `accuracy=0.9`, `loss=0.3`.
+	// - run3 has neither params, nor tags, nor metrics.
+	for i, data := range []struct {
+		model    string
+		stage    string
+		accuracy float64
+		loss     float64
+	}{
+		{model: "cnn", stage: "dev", accuracy: 0.5, loss: 0.7},
+		{model: "rnn", stage: "prod", accuracy: 0.9, loss: 0.3},
+		{},
+	} {
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             fmt.Sprintf("run%d", i+1),
+			Name:           fmt.Sprintf("run%d", i+1),
+			Status:         models.StatusRunning,
+			SourceType:     "JOB",
+			ExperimentID:   *s.DefaultExperiment.ID,
+			LifecycleStage: models.LifecycleStageActive,
+		})
+		s.Require().Nil(err)
+		if data.model == "" {
+			continue
+		}
+		_, err = s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+			Key:   "model",
+			Value: data.model,
+			RunID: run.ID,
+		})
+		s.Require().Nil(err)
+		_, err = s.TagFixtures.CreateTag(context.Background(), &models.Tag{
+			Key:   "stage",
+			Value: data.stage,
+			RunID: run.ID,
+		})
+		s.Require().Nil(err)
+		_, err = s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+			Key:   "accuracy",
+			Value: data.accuracy,
+			RunID: run.ID,
+		})
+		s.Require().Nil(err)
+		_, err = s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+			Key:   "loss",
+			Value: data.loss,
+			RunID: run.ID,
+		})
+		s.Require().Nil(err)
+	}
+
+	tests := []struct {
+		name     string
+		filter   string
+		expected []string
+	}{
+		{
+			name:     "And",
+			filter:   "params.model = 'cnn' and metrics.accuracy < 0.7",
+			expected: []string{"run1"},
+		},
+		{
+			name:     "Or",
+			filter:   "params.model = 'cnn' OR tags.stage = 'prod'",
+			expected: []string{"run1", "run2"},
+		},
+		{
+			name:     "Parentheses",
+			filter:   "(params.model = 'cnn' OR tags.stage = 'prod') AND metrics.accuracy > 0.7",
+			expected: []string{"run2"},
+		},
+		{
+			name:     "ParamIn",
+			filter:   "params.model IN ('rnn', 'transformer')",
+			expected: []string{"run2"},
+		},
+		{
+			name:     "TagNotIn",
+			filter:   "tags.stage NOT IN ('prod')",
+			expected: []string{"run1"},
+		},
+		{
+			name:     "ParamIsNull",
+			filter:   "params.model IS NULL",
+			expected: []string{"run3"},
+		},
+		{
+			name:     "MetricIsNotNull",
+			filter:   "metrics.accuracy IS NOT NULL",
+			expected: []string{"run1", "run2"},
+		},
+		{
+			name:     "IsNullOrComparison",
+			filter:   "tags.stage is null or attributes.run_id = 'run1'",
+			expected: []string{"run1", "run3"},
+		},
+		{
+			name:     "MetricComparedWithMetric",
+			filter:   "metrics.loss > metrics.accuracy",
+			expected: []string{"run1"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.SearchRunsResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					request.SearchRunsRequest{
+						Filter:        tt.filter,
+						ExperimentIDs: []string{fmt.Sprintf("%d", *s.DefaultExperiment.ID)},
+					},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSearchRoute,
+				),
+			)
+			ids := make([]string, len(resp.Runs))
+			for i, run := range resp.Runs {
+				ids[i] = run.Info.ID
+			}
+			s.ElementsMatch(tt.expected, ids)
+		})
+	}
+}
+
+func (s *SearchFilterTestSuite) Test_Error() {
+	tests := []struct {
+		name   string
+		filter string
+		error  *api.ErrorResponse
+	}{
+		{
+			name:   "MissingRightParenthesis",
+			filter: "(params.model = 'cnn' OR tags.stage = 'prod'",
+			error: api.NewInvalidParameterValueError(
+				"malformed filter '(params.model = 'cnn' OR tags.stage = 'prod'': " +
+					"unexpected end of filter, expected ')' at position 45",
+			),
+		},
+		{
+			name:   "UnexpectedCharacter",
+			filter: "params.model = 'cnn' && tags.stage = 'prod'",
+			error: api.NewInvalidParameterValueError(
+				"malformed filter 'params.model = 'cnn' && tags.stage = 'prod'': unexpected character '&' at position 22",
+			),
+		},
+		{
+			name:   "InvalidMetricOperator",
+			filter: "params.model = 'cnn' AND metrics.accuracy IN ('0.5')",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter 'params.model = 'cnn' AND metrics.accuracy IN ('0.5')': " +
+					"invalid metric comparison operator 'IN' at position 26",
+			),
+		},
+		{
+			name:   "InvalidNumericValue",
+			filter: "metrics.accuracy > 'high'",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter 'metrics.accuracy > 'high'': invalid numeric value ''high'' at position 20",
+			),
+		},
+		{
+			name:   "MetricComparedWithParam",
+			filter: "metrics.accuracy > params.threshold",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter 'metrics.accuracy > params.threshold': " +
+					"invalid metric comparison with 'params.threshold', only other metrics could be compared at position 20",
+			),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					request.SearchRunsRequest{
+						Filter:        tt.filter,
+						ExperimentIDs: []string{fmt.Sprintf("%d", *s.DefaultExperiment.ID)},
+					},
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSearchRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package run
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchPaginationTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchPaginationTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchPaginationTestSuite))
+}
+
+func (s *SearchPaginationTestSuite) Test_Ok() {
+	// create runs, some of them share the same start time, and some don't have start time and metric at all.
+	for i, startTime := range []sql.NullInt64{
+		{Int64: 100, Valid: true},
+		{Int64: 200, Valid: true},
+		{Int64: 200, Valid: true},
+		{},
+		{Int64: 300, Valid: true},
+		{},
+	} {
+		s.createRun(fmt.Sprintf("run%d", i+1), startTime)
+	}
+
+	tests := []struct {
+		name    string
+		orderBy []string
+	}{
+		{
+			name: "DefaultOrder",
+		},
+		{
+			name:    "OrderByStartTimeAsc",
+			orderBy: []string{"attribute.start_time ASC"},
+		},
+		{
+			name:    "OrderByMetricDesc",
+			orderBy: []string{"metric.accuracy DESC"},
+		},
+		{
+			name:    "OrderByParamAndStatus",
+			orderBy: []string{"param.model", "attribute.status DESC"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// read runs page by page and create new run after the first page.
+			newRunID := fmt.Sprintf("%s-new", tt.name)
+			var ids []string
+			pageToken := ""
+			for page := 0; page < 10; page++ {
+				resp := s.searchRunsPage(request.SearchRunsRequest{
+					OrderBy:    tt.orderBy,
+					MaxResults: 4,
+					PageToken:  pageToken,
+				})
+				for _, run := range resp.Runs {
+					ids = append(ids, run.Info.ID)
+				}
+				if page == 0 {
+					s.createRun(newRunID, sql.NullInt64{Int64: 250, Valid: true})
+				}
+				if resp.NextPageToken == "" {
+					break
+				}
+				pageToken = resp.NextPageToken
+			}
+
+			// pages neither shift nor overlap, so the new run is returned
+			// only if it is placed after the last run of the first page.
+			expected := s.searchRuns(request.SearchRunsRequest{OrderBy: tt.orderBy})
+			for i, id := range expected {
+				if id == newRunID && i < 4 {
+					expected = append(expected[:i], expected[i+1:]...)
+					break
+				}
+			}
+			s.Equal(expected, ids)
+		})
+	}
+}
+
+func (s *SearchPaginationTestSuite) Test_OffsetPageToken_Ok() {
+	for i := 0; i < 5; i++ {
+		s.createRun(fmt.Sprintf("run%d", i+1), sql.NullInt64{Int64: int64(i), Valid: true})
+	}
+
+	// page tokens of the old format hold offset of the next page.
+	token, err := request.PageToken{Offset: 2}.Encode()
+	s.Require().Nil(err)
+	resp := s.searchRunsPage(request.SearchRunsRequest{MaxResults: 2, PageToken: token})
+	s.Require().Len(resp.Runs, 2)
+	s.Equal("run3", resp.Runs[0].Info.ID)
+	s.Equal("run2", resp.Runs[1].Info.ID)
+	s.NotEmpty(resp.NextPageToken)
+
+	// the next page is fetched using the keyset token.
+	resp = s.searchRunsPage(request.SearchRunsRequest{MaxResults: 2, PageToken: resp.NextPageToken})
+	s.Require().Len(resp.Runs, 1)
+	s.Equal("run1", resp.Runs[0].Info.ID)
+}
+
+func (s *SearchPaginationTestSuite) Test_Error() {
+	token, err := request.PageToken{Keys: []any{int64(1), "run1"}}.Encode()
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.SearchRunsRequest
+	}{
+		{
+			name: "TokenDoesNotMatchOrderBy",
+			error: api.NewInvalidParameterValueError(
+				"invalid page_token '%s': token doesn't match order_by clause", token,
+			),
+			request: request.SearchRunsRequest{
+				OrderBy:   []string{"param.model"},
+				PageToken: token,
+			},
+		},
+		{
+			name: "InvalidOrderByAttribute",
+			error: api.NewInvalidParameterValueError(
+				"invalid order_by clause 'attribute.unknown'",
+			),
+			request: request.SearchRunsRequest{
+				OrderBy: []string{"attribute.unknown"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.request.ExperimentIDs = []string{fmt.Sprintf("%d", *s.DefaultExperiment.ID)}
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSearchRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
+
+// createRun creates run with param, tag and metric, which are omitted for the runs without start time.
+func (s *SearchPaginationTestSuite) createRun(id string, startTime sql.NullInt64) {
+	run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+		ID:             id,
+		Name:           id,
+		Status:         models.StatusRunning,
+		SourceType:     "JOB",
+		StartTime:      startTime,
+		ExperimentID:   *s.DefaultExperiment.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	if !startTime.Valid {
+		return
+	}
+	_, err = s.ParamFixtures.CreateParam(context.Background(), &models.Param{
+		Key:   "model",
+		Value: fmt.Sprintf("model%d", startTime.Int64%200),
+		RunID: run.ID,
+	})
+	s.Require().Nil(err)
+	_, err = s.MetricFixtures.CreateLatestMetric(context.Background(), &models.LatestMetric{
+		Key:   "accuracy",
+		Value: float64(startTime.Int64) / 1000,
+		RunID: run.ID,
+	})
+	s.Require().Nil(err)
+}
+
+// searchRuns returns ids of all the runs in the requested order.
+func (s *SearchPaginationTestSuite) searchRuns(req request.SearchRunsRequest) []string {
+	resp := s.searchRunsPage(req)
+	ids := make([]string, len(resp.Runs))
+	for i, run := range resp.Runs {
+		ids[i] = run.Info.ID
+	}
+	return ids
+}
+
+// searchRunsPage returns single page of the runs of the default experiment.
+func (s *SearchPaginationTestSuite) searchRunsPage(req request.SearchRunsRequest) *response.SearchRunsResponse {
+	req.ExperimentIDs = []string{fmt.Sprintf("%d", *s.DefaultExperiment.ID)}
+	resp := response.SearchRunsResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			req,
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.RunsRoutePrefix, mlflow.RunsSearchRoute,
+		),
+	)
+	return &resp
+}
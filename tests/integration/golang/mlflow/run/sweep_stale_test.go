@@ -0,0 +1,99 @@
+package run
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/config"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/convertors"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SweepStaleRunsTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSweepStaleRunsTestSuite(t *testing.T) {
+	suite.Run(t, new(SweepStaleRunsTestSuite))
+}
+
+func (s *SweepStaleRunsTestSuite) Test_Ok() {
+	now := time.Now().UnixMilli()
+	staleTime := now - (2 * time.Hour).Milliseconds()
+	createRun := func(status models.Status, startTime, lastSeenTime sql.NullInt64) *models.Run {
+		run, err := s.RunFixtures.CreateRun(context.Background(), &models.Run{
+			ID:             strings.ReplaceAll(uuid.New().String(), "-", ""),
+			ExperimentID:   *s.DefaultExperiment.ID,
+			SourceType:     "JOB",
+			LifecycleStage: models.LifecycleStageActive,
+			Status:         status,
+			StartTime:      startTime,
+			LastSeenTime:   lastSeenTime,
+		})
+		s.Require().Nil(err)
+		return run
+	}
+
+	staleRun := createRun(
+		models.StatusRunning, sql.NullInt64{Int64: staleTime, Valid: true}, sql.NullInt64{Int64: staleTime, Valid: true},
+	)
+	// runs created before last seen time was introduced are checked by their start time.
+	legacyRun := createRun(models.StatusRunning, sql.NullInt64{Int64: staleTime, Valid: true}, sql.NullInt64{})
+	activeRun := createRun(
+		models.StatusRunning, sql.NullInt64{Int64: staleTime, Valid: true}, sql.NullInt64{Int64: now, Valid: true},
+	)
+	finishedRun := createRun(
+		models.StatusFinished, sql.NullInt64{Int64: staleTime, Valid: true}, sql.NullInt64{Int64: staleTime, Valid: true},
+	)
+
+	hook, err := s.WebhookFixtures.CreateWebhook(context.Background(), &models.Webhook{
+		NamespaceID: s.DefaultNamespace.ID,
+		URL:         "http://localhost:1/webhook",
+		Events:      string(models.WebhookEventRunKilled),
+		Active:      true,
+	})
+	s.Require().Nil(err)
+
+	runs, err := s.RunFixtures.SweepStaleRuns(context.Background(), &config.ServiceConfig{
+		StaleRunTimeout: time.Hour,
+		StaleRunStatus:  string(models.StatusKilled),
+	})
+	s.Require().Nil(err)
+	s.Len(runs, 2)
+
+	// swept runs emit the same event, as runs killed through the api.
+	deliveries, err := s.WebhookFixtures.GetDeliveries(context.Background(), hook.ID)
+	s.Require().Nil(err)
+	s.Require().Len(deliveries, 2)
+	for _, delivery := range deliveries {
+		s.Equal(models.WebhookEventRunKilled, delivery.Event)
+	}
+
+	for _, id := range []string{staleRun.ID, legacyRun.ID} {
+		run, err := s.RunFixtures.GetRun(context.Background(), id)
+		s.Require().Nil(err)
+		s.Equal(models.StatusKilled, run.Status)
+		s.Equal(sql.NullInt64{Int64: staleTime, Valid: true}, run.EndTime)
+		s.Require().Len(run.Tags, 1)
+		s.Equal(convertors.TagKeyStaleReason, run.Tags[0].Key)
+		s.Equal(
+			"Run was marked as KILLED, because it hasn't reported any activity for longer than 1h0m0s",
+			run.Tags[0].Value,
+		)
+	}
+
+	for _, expected := range []*models.Run{activeRun, finishedRun} {
+		run, err := s.RunFixtures.GetRun(context.Background(), expected.ID)
+		s.Require().Nil(err)
+		s.Equal(expected.Status, run.Status)
+		s.False(run.EndTime.Valid)
+		s.Empty(run.Tags)
+	}
+}
@@ -0,0 +1,125 @@
+package trace
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteTraceTagTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDeleteTraceTagTestSuite(t *testing.T) {
+	suite.Run(t, new(DeleteTraceTagTestSuite))
+}
+
+func (s *DeleteTraceTagTestSuite) Test_Ok() {
+	trace, err := s.TraceFixtures.CreateTrace(context.Background(), &models.TraceInfo{
+		RequestID:    "tr-1",
+		ExperimentID: *s.DefaultExperiment.ID,
+		TimestampMS:  1000,
+		Status:       models.TraceStatusOK,
+		Tags: []models.TraceTag{
+			{Key: "env", Value: "dev"},
+			{Key: "reviewed", Value: "true"},
+		},
+	})
+	s.Require().Nil(err)
+
+	resp := map[string]any{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).WithRequest(
+			request.DeleteTraceTagRequest{Key: "env"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s/%s/tags", mlflow.TracesRoutePrefix, trace.RequestID,
+		),
+	)
+	s.Empty(resp)
+
+	// the key could be provided in the query as well.
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodDelete,
+		).WithQuery(
+			request.DeleteTraceTagRequest{Key: "reviewed"},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s/%s/tags", mlflow.TracesRoutePrefix, trace.RequestID,
+		),
+	)
+	s.Empty(resp)
+
+	traces, err := s.TraceFixtures.GetTraces(context.Background(), *s.DefaultExperiment.ID)
+	s.Require().Nil(err)
+	s.Require().Len(traces, 1)
+	s.Empty(traces[0].Tags)
+}
+
+func (s *DeleteTraceTagTestSuite) Test_Error() {
+	_, err := s.TraceFixtures.CreateTrace(context.Background(), &models.TraceInfo{
+		RequestID:    "tr-1",
+		ExperimentID: *s.DefaultExperiment.ID,
+		TimestampMS:  1000,
+		Status:       models.TraceStatusOK,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name      string
+		error     *api.ErrorResponse
+		requestID string
+		request   request.DeleteTraceTagRequest
+	}{
+		{
+			name:      "EmptyKey",
+			error:     api.NewInvalidParameterValueError("Missing value for required parameter 'key'"),
+			requestID: "tr-1",
+			request:   request.DeleteTraceTagRequest{},
+		},
+		{
+			name:      "NotFoundTrace",
+			error:     api.NewResourceDoesNotExistError("Trace with request_id 'tr-2' not found"),
+			requestID: "tr-2",
+			request:   request.DeleteTraceTagRequest{Key: "env"},
+		},
+		{
+			name: "NotFoundTag",
+			error: api.NewResourceDoesNotExistError(
+				"No trace tag with key 'env' for trace with request_id 'tr-1'",
+			),
+			requestID: "tr-1",
+			request:   request.DeleteTraceTagRequest{Key: "env"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodDelete,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s/%s/tags", mlflow.TracesRoutePrefix, tt.requestID,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package trace
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type DeleteTracesTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestDeleteTracesTestSuite(t *testing.T) {
+	suite.Run(t, &DeleteTracesTestSuite{
+		helpers.BaseTestSuite{
+			ResetOnSubTest: true,
+		},
+	})
+}
+
+func (s *DeleteTracesTestSuite) Test_Ok() {
+	tests := []struct {
+		name       string
+		request    request.DeleteTracesRequest
+		deleted    int
+		requestIDs []string
+	}{
+		{
+			name: "DeleteByRequestIDs",
+			request: request.DeleteTracesRequest{
+				RequestIDs: []string{"tr-0", "tr-2", "tr-unknown"},
+			},
+			deleted:    2,
+			requestIDs: []string{"tr-1", "tr-3"},
+		},
+		{
+			name: "DeleteByMaxTimestamp",
+			request: request.DeleteTracesRequest{
+				MaxTimestampMillis: 2000,
+			},
+			deleted:    2,
+			requestIDs: []string{"tr-2", "tr-3"},
+		},
+		{
+			name: "DeleteByMaxTimestampWithMaxTraces",
+			request: request.DeleteTracesRequest{
+				MaxTimestampMillis: 3000,
+				MaxTraces:          1,
+			},
+			deleted:    1,
+			requestIDs: []string{"tr-1", "tr-2", "tr-3"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// create experiment and traces with the logged data.
+			artifactDir := s.T().TempDir()
+			experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+				Name:             "Test Experiment",
+				NamespaceID:      s.DefaultNamespace.ID,
+				LifecycleStage:   models.LifecycleStageActive,
+				ArtifactLocation: artifactDir,
+			})
+			s.Require().Nil(err)
+			for i := 0; i < 4; i++ {
+				requestID := fmt.Sprintf("tr-%d", i)
+				traceDir := filepath.Join(artifactDir, "traces", requestID, "artifacts")
+				s.Require().Nil(os.MkdirAll(traceDir, fs.ModePerm))
+				s.Require().Nil(os.WriteFile(filepath.Join(traceDir, "traces.json"), []byte("{}"), fs.ModePerm))
+				_, err := s.TraceFixtures.CreateTrace(context.Background(), &models.TraceInfo{
+					RequestID:    requestID,
+					ExperimentID: *experiment.ID,
+					TimestampMS:  int64(1000 * (i + 1)),
+					Status:       models.TraceStatusOK,
+					Tags: []models.TraceTag{
+						{Key: models.TraceTagArtifactLocation, Value: traceDir},
+					},
+				})
+				s.Require().Nil(err)
+			}
+
+			req := tt.request
+			req.ExperimentID = fmt.Sprint(*experiment.ID)
+			resp := response.DeleteTracesResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					req,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.TracesRoutePrefix, mlflow.TracesDeleteTracesRoute,
+				),
+			)
+			s.Equal(tt.deleted, resp.TracesDeleted)
+
+			traces, err := s.TraceFixtures.GetTraces(context.Background(), *experiment.ID)
+			s.Require().Nil(err)
+			requestIDs := make([]string, len(traces))
+			for i, trace := range traces {
+				requestIDs[i] = trace.RequestID
+			}
+			s.Equal(tt.requestIDs, requestIDs)
+
+			// data of the deleted traces has to be deleted as well.
+			var dataRequestIDs []string
+			for i := 0; i < 4; i++ {
+				requestID := fmt.Sprintf("tr-%d", i)
+				_, err := os.Stat(filepath.Join(artifactDir, "traces", requestID, "artifacts", "traces.json"))
+				if err == nil {
+					dataRequestIDs = append(dataRequestIDs, requestID)
+				}
+			}
+			s.Equal(tt.requestIDs, dataRequestIDs)
+		})
+	}
+}
+
+func (s *DeleteTracesTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.DeleteTracesRequest
+	}{
+		{
+			name:    "EmptyExperimentID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'"),
+			request: request.DeleteTracesRequest{},
+		},
+		{
+			name: "NeitherTimestampNorRequestIDs",
+			error: api.NewInvalidParameterValueError(
+				"Exactly one of 'max_timestamp_millis' and 'request_ids' must be specified.",
+			),
+			request: request.DeleteTracesRequest{
+				ExperimentID: "0",
+			},
+		},
+		{
+			name: "MaxTracesWithRequestIDs",
+			error: api.NewInvalidParameterValueError(
+				"'max_traces' can't be specified if 'request_ids' is specified.",
+			),
+			request: request.DeleteTracesRequest{
+				ExperimentID: "0",
+				RequestIDs:   []string{"tr-1"},
+				MaxTraces:    1,
+			},
+		},
+		{
+			name: "NotFoundExperiment",
+			error: api.NewResourceDoesNotExistError(
+				"unable to find experiment '1000': error getting experiment by id: 1000: record not found",
+			),
+			request: request.DeleteTracesRequest{
+				ExperimentID: "1000",
+				RequestIDs:   []string{"tr-1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.TracesRoutePrefix, mlflow.TracesDeleteTracesRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
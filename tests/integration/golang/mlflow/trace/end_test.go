@@ -0,0 +1,149 @@
+package trace
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type EndTraceTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestEndTraceTestSuite(t *testing.T) {
+	suite.Run(t, new(EndTraceTestSuite))
+}
+
+func (s *EndTraceTestSuite) Test_Ok() {
+	trace, err := s.TraceFixtures.CreateTrace(context.Background(), &models.TraceInfo{
+		RequestID:    "tr-1",
+		ExperimentID: *s.DefaultExperiment.ID,
+		TimestampMS:  1000,
+		Status:       models.TraceStatusInProgress,
+		Tags: []models.TraceTag{
+			{Key: "mlflow.traceName", Value: "predict"},
+		},
+	})
+	s.Require().Nil(err)
+
+	resp := response.TraceInfoResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPatch,
+		).WithRequest(
+			request.EndTraceRequest{
+				RequestID:   trace.RequestID,
+				TimestampMS: 1500,
+				Status:      string(models.TraceStatusOK),
+				RequestMetadata: []request.TraceRequestMetadataPartialRequest{
+					{Key: "mlflow.traceOutputs", Value: "because"},
+				},
+				Tags: []request.TraceTagPartialRequest{
+					{Key: "mlflow.traceName", Value: "predict_v2"},
+					{Key: "env", Value: "test"},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s/%s", mlflow.TracesRoutePrefix, trace.RequestID,
+		),
+	)
+	s.Equal(&response.TraceInfoPartialResponse{
+		RequestID:       trace.RequestID,
+		ExperimentID:    fmt.Sprint(*s.DefaultExperiment.ID),
+		TimestampMS:     1000,
+		ExecutionTimeMS: 500,
+		Status:          string(models.TraceStatusOK),
+		RequestMetadata: []response.TraceRequestMetadataPartialResponse{
+			{Key: "mlflow.traceOutputs", Value: "because"},
+		},
+		Tags: []response.TraceTagPartialResponse{
+			{Key: "mlflow.traceName", Value: "predict_v2"},
+			{Key: "env", Value: "test"},
+		},
+	}, resp.TraceInfo)
+
+	traces, err := s.TraceFixtures.GetTraces(context.Background(), *s.DefaultExperiment.ID)
+	s.Require().Nil(err)
+	s.Require().Len(traces, 1)
+	s.Equal(models.TraceStatusOK, traces[0].Status)
+	s.Equal(sql.NullInt64{Int64: 500, Valid: true}, traces[0].ExecutionTimeMS)
+	s.ElementsMatch([]models.TraceTag{
+		{Key: "mlflow.traceName", Value: "predict_v2", RequestID: trace.RequestID},
+		{Key: "env", Value: "test", RequestID: trace.RequestID},
+	}, traces[0].Tags)
+	s.Equal([]models.TraceRequestMetadata{
+		{Key: "mlflow.traceOutputs", Value: "because", RequestID: trace.RequestID},
+	}, traces[0].RequestMetadata)
+}
+
+func (s *EndTraceTestSuite) Test_Error() {
+	_, err := s.TraceFixtures.CreateTrace(context.Background(), &models.TraceInfo{
+		RequestID:    "tr-1",
+		ExperimentID: *s.DefaultExperiment.ID,
+		TimestampMS:  1000,
+		Status:       models.TraceStatusInProgress,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name      string
+		error     *api.ErrorResponse
+		requestID string
+		request   request.EndTraceRequest
+	}{
+		{
+			name:      "EmptyTimestamp",
+			error:     api.NewInvalidParameterValueError("Missing value for required parameter 'timestamp_ms'"),
+			requestID: "tr-1",
+			request:   request.EndTraceRequest{},
+		},
+		{
+			name:      "InvalidStatus",
+			error:     api.NewInvalidParameterValueError("Invalid value 'DONE' for parameter 'status'"),
+			requestID: "tr-1",
+			request: request.EndTraceRequest{
+				TimestampMS: 1500,
+				Status:      "DONE",
+			},
+		},
+		{
+			name:      "NotFoundTrace",
+			error:     api.NewResourceDoesNotExistError("Trace with request_id 'tr-2' not found"),
+			requestID: "tr-2",
+			request: request.EndTraceRequest{
+				TimestampMS: 1500,
+				Status:      string(models.TraceStatusOK),
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPatch,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s/%s", mlflow.TracesRoutePrefix, tt.requestID,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package trace
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/common"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetTraceInfoTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetTraceInfoTestSuite(t *testing.T) {
+	suite.Run(t, new(GetTraceInfoTestSuite))
+}
+
+func (s *GetTraceInfoTestSuite) Test_Ok() {
+	trace, err := s.TraceFixtures.CreateTrace(context.Background(), &models.TraceInfo{
+		RequestID:    "tr-1",
+		ExperimentID: *s.DefaultExperiment.ID,
+		TimestampMS:  1000,
+		Status:       models.TraceStatusError,
+		Tags: []models.TraceTag{
+			{Key: "mlflow.traceName", Value: "predict"},
+		},
+		RequestMetadata: []models.TraceRequestMetadata{
+			{Key: "mlflow.traceInputs", Value: "inputs"},
+		},
+	})
+	s.Require().Nil(err)
+
+	resp := response.TraceInfoResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithResponse(
+			&resp,
+		).DoRequest(
+			"%s/%s/info", mlflow.TracesRoutePrefix, trace.RequestID,
+		),
+	)
+	s.Equal(&response.TraceInfoPartialResponse{
+		RequestID:    trace.RequestID,
+		ExperimentID: fmt.Sprint(*s.DefaultExperiment.ID),
+		TimestampMS:  1000,
+		Status:       string(models.TraceStatusError),
+		RequestMetadata: []response.TraceRequestMetadataPartialResponse{
+			{Key: "mlflow.traceInputs", Value: "inputs"},
+		},
+		Tags: []response.TraceTagPartialResponse{
+			{Key: "mlflow.traceName", Value: "predict"},
+		},
+	}, resp.TraceInfo)
+}
+
+func (s *GetTraceInfoTestSuite) Test_Error() {
+	// trace of the other namespace can't be found.
+	namespace, err := s.NamespaceFixtures.CreateNamespace(context.Background(), &models.Namespace{
+		Code:                "custom",
+		DefaultExperimentID: common.GetPointer(models.DefaultExperimentID),
+	})
+	s.Require().Nil(err)
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:           "Custom Experiment",
+		NamespaceID:    namespace.ID,
+		LifecycleStage: models.LifecycleStageActive,
+	})
+	s.Require().Nil(err)
+	_, err = s.TraceFixtures.CreateTrace(context.Background(), &models.TraceInfo{
+		RequestID:    "tr-custom",
+		ExperimentID: *experiment.ID,
+		TimestampMS:  1000,
+		Status:       models.TraceStatusOK,
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name      string
+		error     *api.ErrorResponse
+		requestID string
+	}{
+		{
+			name:      "NotFoundTrace",
+			error:     api.NewResourceDoesNotExistError("Trace with request_id 'tr-1' not found"),
+			requestID: "tr-1",
+		},
+		{
+			name:      "TraceOfOtherNamespace",
+			error:     api.NewResourceDoesNotExistError("Trace with request_id 'tr-custom' not found"),
+			requestID: "tr-custom",
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithResponse(
+					&resp,
+				).DoRequest(
+					"%s/%s/info", mlflow.TracesRoutePrefix, tt.requestID,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
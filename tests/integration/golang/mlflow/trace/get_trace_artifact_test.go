@@ -0,0 +1,109 @@
+package trace
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type GetTraceArtifactTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestGetTraceArtifactTestSuite(t *testing.T) {
+	suite.Run(t, new(GetTraceArtifactTestSuite))
+}
+
+func (s *GetTraceArtifactTestSuite) Test_Ok() {
+	traceDir := filepath.Join(s.T().TempDir(), "traces", "tr-1", "artifacts")
+	s.Require().Nil(os.MkdirAll(traceDir, fs.ModePerm))
+	data := `{"spans": [{"name": "predict"}]}`
+	s.Require().Nil(os.WriteFile(filepath.Join(traceDir, "traces.json"), []byte(data), fs.ModePerm))
+
+	trace, err := s.TraceFixtures.CreateTrace(context.Background(), &models.TraceInfo{
+		RequestID:    "tr-1",
+		ExperimentID: *s.DefaultExperiment.ID,
+		TimestampMS:  1000,
+		Status:       models.TraceStatusOK,
+		Tags: []models.TraceTag{
+			{Key: models.TraceTagArtifactLocation, Value: fmt.Sprintf("file://%s", traceDir)},
+		},
+	})
+	s.Require().Nil(err)
+
+	resp := new(bytes.Buffer)
+	s.Require().Nil(
+		s.MlflowClient().WithQuery(
+			request.GetTraceArtifactRequest{RequestID: trace.RequestID},
+		).WithResponseType(
+			helpers.ResponseTypeBuffer,
+		).WithResponse(
+			resp,
+		).DoRequest(
+			"%s", mlflow.GetTraceArtifactRoute,
+		),
+	)
+	s.Equal(data, resp.String())
+}
+
+func (s *GetTraceArtifactTestSuite) Test_Error() {
+	_, err := s.TraceFixtures.CreateTrace(context.Background(), &models.TraceInfo{
+		RequestID:    "tr-1",
+		ExperimentID: *s.DefaultExperiment.ID,
+		TimestampMS:  1000,
+		Status:       models.TraceStatusOK,
+		Tags: []models.TraceTag{
+			{Key: models.TraceTagArtifactLocation, Value: s.T().TempDir()},
+		},
+	})
+	s.Require().Nil(err)
+
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.GetTraceArtifactRequest
+	}{
+		{
+			name:    "EmptyRequestID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'request_id'"),
+			request: request.GetTraceArtifactRequest{},
+		},
+		{
+			name:    "NotFoundTrace",
+			error:   api.NewResourceDoesNotExistError("Trace with request_id 'tr-2' not found"),
+			request: request.GetTraceArtifactRequest{RequestID: "tr-2"},
+		},
+		{
+			name:    "NotLoggedData",
+			error:   api.NewResourceDoesNotExistError("unable to find data of trace 'tr-1'"),
+			request: request.GetTraceArtifactRequest{RequestID: "tr-1"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s", mlflow.GetTraceArtifactRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package trace
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SearchTracesTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSearchTracesTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchTracesTestSuite))
+}
+
+func (s *SearchTracesTestSuite) createTraces() {
+	for i, status := range []models.TraceStatus{
+		models.TraceStatusOK, models.TraceStatusError, models.TraceStatusOK, models.TraceStatusInProgress,
+	} {
+		trace := models.TraceInfo{
+			RequestID:    fmt.Sprintf("tr-%d", i),
+			ExperimentID: *s.DefaultExperiment.ID,
+			TimestampMS:  int64(1000 * (i + 1)),
+			Status:       status,
+			Tags: []models.TraceTag{
+				{Key: "mlflow.traceName", Value: fmt.Sprintf("predict_%d", i%2)},
+			},
+			RequestMetadata: []models.TraceRequestMetadata{
+				{Key: "mlflow.sourceRun", Value: fmt.Sprintf("run%d", i)},
+			},
+		}
+		if status != models.TraceStatusInProgress {
+			trace.ExecutionTimeMS.Int64, trace.ExecutionTimeMS.Valid = int64(100*(4-i)), true
+		}
+		_, err := s.TraceFixtures.CreateTrace(context.Background(), &trace)
+		s.Require().Nil(err)
+	}
+}
+
+func (s *SearchTracesTestSuite) Test_Ok() {
+	s.createTraces()
+
+	experimentID := fmt.Sprint(*s.DefaultExperiment.ID)
+	tests := []struct {
+		name       string
+		request    request.SearchTracesRequest
+		requestIDs []string
+	}{
+		{
+			name:       "DefaultOrder",
+			request:    request.SearchTracesRequest{ExperimentIDs: []string{experimentID}},
+			requestIDs: []string{"tr-3", "tr-2", "tr-1", "tr-0"},
+		},
+		{
+			name: "FilterByStatus",
+			request: request.SearchTracesRequest{
+				ExperimentIDs: []string{experimentID},
+				Filter:        "status = 'OK'",
+			},
+			requestIDs: []string{"tr-2", "tr-0"},
+		},
+		{
+			name: "FilterByTimestampAndTag",
+			request: request.SearchTracesRequest{
+				ExperimentIDs: []string{experimentID},
+				Filter:        "timestamp_ms > 1000 AND tags.mlflow.traceName = 'predict_1'",
+			},
+			requestIDs: []string{"tr-3", "tr-1"},
+		},
+		{
+			name: "FilterByRequestMetadataOrStatus",
+			request: request.SearchTracesRequest{
+				ExperimentIDs: []string{experimentID},
+				Filter:        "request_metadata.mlflow.sourceRun IN ('run0', 'run1') OR status = 'IN_PROGRESS'",
+			},
+			requestIDs: []string{"tr-3", "tr-1", "tr-0"},
+		},
+		{
+			name: "FilterByMissingExecutionTime",
+			request: request.SearchTracesRequest{
+				ExperimentIDs: []string{experimentID},
+				Filter:        "execution_time_ms IS NULL",
+			},
+			requestIDs: []string{"tr-3"},
+		},
+		{
+			name: "OrderByExecutionTime",
+			request: request.SearchTracesRequest{
+				ExperimentIDs: []string{experimentID},
+				Filter:        "execution_time_ms IS NOT NULL",
+				OrderBy:       []string{"execution_time_ms ASC"},
+			},
+			requestIDs: []string{"tr-2", "tr-1", "tr-0"},
+		},
+		{
+			name: "NotExistingExperiment",
+			request: request.SearchTracesRequest{
+				ExperimentIDs: []string{"1000"},
+			},
+			requestIDs: []string{},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := response.SearchTracesResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s", mlflow.TracesRoutePrefix,
+				),
+			)
+			requestIDs := make([]string, len(resp.Traces))
+			for i, trace := range resp.Traces {
+				requestIDs[i] = trace.RequestID
+			}
+			s.Equal(tt.requestIDs, requestIDs)
+			s.Empty(resp.NextPageToken)
+		})
+	}
+}
+
+func (s *SearchTracesTestSuite) Test_Pagination() {
+	s.createTraces()
+
+	var requestIDs []string
+	pageToken := ""
+	for page := 0; page < 5; page++ {
+		resp := response.SearchTracesResponse{}
+		s.Require().Nil(
+			s.MlflowClient().WithQuery(
+				request.SearchTracesRequest{
+					ExperimentIDs: []string{fmt.Sprint(*s.DefaultExperiment.ID)},
+					MaxResults:    3,
+					OrderBy:       []string{"status", "timestamp_ms DESC"},
+					PageToken:     pageToken,
+				},
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s", mlflow.TracesRoutePrefix,
+			),
+		)
+		for _, trace := range resp.Traces {
+			requestIDs = append(requestIDs, trace.RequestID)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	s.Equal([]string{"tr-1", "tr-3", "tr-2", "tr-0"}, requestIDs)
+}
+
+func (s *SearchTracesTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.SearchTracesRequest
+	}{
+		{
+			name:    "EmptyExperimentIDs",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_ids'"),
+			request: request.SearchTracesRequest{},
+		},
+		{
+			name: "InvalidMaxResults",
+			error: api.NewInvalidParameterValueError(
+				"Invalid value for parameter 'max_results' supplied. It must be at most 500, but got value 501",
+			),
+			request: request.SearchTracesRequest{
+				ExperimentIDs: []string{"0"},
+				MaxResults:    501,
+			},
+		},
+		{
+			name: "InvalidAttribute",
+			error: api.NewInvalidParameterValueError(
+				"invalid filter 'name = 'test'': invalid attribute 'name'. Valid values are " +
+					"['request_id', 'timestamp_ms', 'execution_time_ms', 'status'] at position 1",
+			),
+			request: request.SearchTracesRequest{
+				ExperimentIDs: []string{"0"},
+				Filter:        "name = 'test'",
+			},
+		},
+		{
+			name:  "InvalidOrderBy",
+			error: api.NewInvalidParameterValueError("invalid order_by clause 'status SIDEWAYS'"),
+			request: request.SearchTracesRequest{
+				ExperimentIDs: []string{"0"},
+				OrderBy:       []string{"status SIDEWAYS"},
+			},
+		},
+		{
+			name: "PageTokenMismatch",
+			error: api.NewInvalidParameterValueError(
+				"invalid page_token 'eyJrZXlzIjpbMV19': token doesn't match order_by clause",
+			),
+			request: request.SearchTracesRequest{
+				ExperimentIDs: []string{"0"},
+				PageToken:     "eyJrZXlzIjpbMV19",
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithQuery(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s", mlflow.TracesRoutePrefix,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
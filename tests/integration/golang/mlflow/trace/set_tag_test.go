@@ -0,0 +1,102 @@
+package trace
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type SetTraceTagTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestSetTraceTagTestSuite(t *testing.T) {
+	suite.Run(t, new(SetTraceTagTestSuite))
+}
+
+func (s *SetTraceTagTestSuite) Test_Ok() {
+	trace, err := s.TraceFixtures.CreateTrace(context.Background(), &models.TraceInfo{
+		RequestID:    "tr-1",
+		ExperimentID: *s.DefaultExperiment.ID,
+		TimestampMS:  1000,
+		Status:       models.TraceStatusOK,
+		Tags: []models.TraceTag{
+			{Key: "env", Value: "dev"},
+		},
+	})
+	s.Require().Nil(err)
+
+	for _, tag := range []request.SetTraceTagRequest{
+		{Key: "env", Value: "prod"},
+		{Key: "reviewed", Value: "true"},
+	} {
+		resp := map[string]any{}
+		s.Require().Nil(
+			s.MlflowClient().WithMethod(
+				http.MethodPatch,
+			).WithRequest(
+				tag,
+			).WithResponse(
+				&resp,
+			).DoRequest(
+				"%s/%s/tags", mlflow.TracesRoutePrefix, trace.RequestID,
+			),
+		)
+		s.Empty(resp)
+	}
+
+	traces, err := s.TraceFixtures.GetTraces(context.Background(), *s.DefaultExperiment.ID)
+	s.Require().Nil(err)
+	s.Require().Len(traces, 1)
+	s.ElementsMatch([]models.TraceTag{
+		{Key: "env", Value: "prod", RequestID: trace.RequestID},
+		{Key: "reviewed", Value: "true", RequestID: trace.RequestID},
+	}, traces[0].Tags)
+}
+
+func (s *SetTraceTagTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.SetTraceTagRequest
+	}{
+		{
+			name:    "EmptyKey",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'key'"),
+			request: request.SetTraceTagRequest{},
+		},
+		{
+			name:  "NotFoundTrace",
+			error: api.NewResourceDoesNotExistError("Trace with request_id 'tr-1' not found"),
+			request: request.SetTraceTagRequest{
+				Key:   "env",
+				Value: "prod",
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPatch,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s/%s/tags", mlflow.TracesRoutePrefix, "tr-1",
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package trace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/request"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/api/response"
+	"github.com/G-Research/fasttrackml/pkg/api/mlflow/dao/models"
+	"github.com/G-Research/fasttrackml/tests/integration/golang/helpers"
+)
+
+type StartTraceTestSuite struct {
+	helpers.BaseTestSuite
+}
+
+func TestStartTraceTestSuite(t *testing.T) {
+	suite.Run(t, new(StartTraceTestSuite))
+}
+
+func (s *StartTraceTestSuite) Test_Ok() {
+	experiment, err := s.ExperimentFixtures.CreateExperiment(context.Background(), &models.Experiment{
+		Name:             "Test Experiment",
+		NamespaceID:      s.DefaultNamespace.ID,
+		LifecycleStage:   models.LifecycleStageActive,
+		ArtifactLocation: "s3://bucket/1",
+	})
+	s.Require().Nil(err)
+
+	resp := response.TraceInfoResponse{}
+	s.Require().Nil(
+		s.MlflowClient().WithMethod(
+			http.MethodPost,
+		).WithRequest(
+			request.StartTraceRequest{
+				ExperimentID: fmt.Sprint(*experiment.ID),
+				TimestampMS:  1234567890,
+				RequestMetadata: []request.TraceRequestMetadataPartialRequest{
+					{Key: "mlflow.traceInputs", Value: `{"question": "why?"}`},
+				},
+				Tags: []request.TraceTagPartialRequest{
+					{Key: "mlflow.traceName", Value: "predict"},
+				},
+			},
+		).WithResponse(
+			&resp,
+		).DoRequest(
+			"%s%s", mlflow.TracesRoutePrefix, mlflow.TracesStartRoute,
+		),
+	)
+
+	s.True(strings.HasPrefix(resp.TraceInfo.RequestID, "tr-"))
+	s.Equal(&response.TraceInfoPartialResponse{
+		RequestID:    resp.TraceInfo.RequestID,
+		ExperimentID: fmt.Sprint(*experiment.ID),
+		TimestampMS:  1234567890,
+		Status:       string(models.TraceStatusInProgress),
+		RequestMetadata: []response.TraceRequestMetadataPartialResponse{
+			{Key: "mlflow.traceInputs", Value: `{"question": "why?"}`},
+		},
+		Tags: []response.TraceTagPartialResponse{
+			{Key: "mlflow.traceName", Value: "predict"},
+			{
+				Key:   models.TraceTagArtifactLocation,
+				Value: fmt.Sprintf("s3://bucket/1/traces/%s/artifacts", resp.TraceInfo.RequestID),
+			},
+		},
+	}, resp.TraceInfo)
+
+	traces, err := s.TraceFixtures.GetTraces(context.Background(), *experiment.ID)
+	s.Require().Nil(err)
+	s.Require().Len(traces, 1)
+	s.Equal(resp.TraceInfo.RequestID, traces[0].RequestID)
+	s.Equal(models.TraceStatusInProgress, traces[0].Status)
+	s.Len(traces[0].Tags, 2)
+	s.Len(traces[0].RequestMetadata, 1)
+}
+
+func (s *StartTraceTestSuite) Test_Error() {
+	tests := []struct {
+		name    string
+		error   *api.ErrorResponse
+		request request.StartTraceRequest
+	}{
+		{
+			name:    "EmptyExperimentID",
+			error:   api.NewInvalidParameterValueError("Missing value for required parameter 'experiment_id'"),
+			request: request.StartTraceRequest{},
+		},
+		{
+			name:  "EmptyTimestamp",
+			error: api.NewInvalidParameterValueError("Missing value for required parameter 'timestamp_ms'"),
+			request: request.StartTraceRequest{
+				ExperimentID: "1",
+			},
+		},
+		{
+			name: "IncorrectExperimentID",
+			error: api.NewBadRequestError(
+				`unable to parse experiment id 'incorrect': strconv.ParseInt: parsing "incorrect": invalid syntax`,
+			),
+			request: request.StartTraceRequest{
+				ExperimentID: "incorrect",
+				TimestampMS:  1234567890,
+			},
+		},
+		{
+			name: "NotFoundExperiment",
+			error: api.NewResourceDoesNotExistError(
+				"unable to find experiment '1000': error getting experiment by id: 1000: record not found",
+			),
+			request: request.StartTraceRequest{
+				ExperimentID: "1000",
+				TimestampMS:  1234567890,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			resp := api.ErrorResponse{}
+			s.Require().Nil(
+				s.MlflowClient().WithMethod(
+					http.MethodPost,
+				).WithRequest(
+					tt.request,
+				).WithResponse(
+					&resp,
+				).DoRequest(
+					"%s%s", mlflow.TracesRoutePrefix, mlflow.TracesStartRoute,
+				),
+			)
+			s.Equal(tt.error.Error(), resp.Error())
+		})
+	}
+}